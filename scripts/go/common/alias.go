@@ -0,0 +1,64 @@
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const aliasNameKey = "alias-name"
+
+var mnemonicRE = regexp.MustCompile(`^[A-Za-z][0-9A-Za-z_.]*$`)
+
+// AliasNames returns the alternative spellings of the mnemonic, as listed in
+// the @alias-name attrib, e.g. @alias-name=ext.w.h for sext.h. Unlike the
+// aliases of InsnAliases, these are the same insn by another name, always
+// assembled to the same word; disassembly uses the mnemonic.
+func (d *InsnDescription) AliasNames() []string {
+	result, err := d.aliasNames()
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func (d *InsnDescription) aliasNames() ([]string, error) {
+	s, ok := d.Attribs[aliasNameKey]
+	if !ok {
+		return nil, nil
+	}
+
+	seen := map[string]bool{d.Mnemonic: true}
+	var result []string
+	for _, name := range strings.Split(s, ",") {
+		if !mnemonicRE.MatchString(name) {
+			return nil, fmt.Errorf("alias name %q of %s is not a valid mnemonic", name, d.Mnemonic)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("alias name %s of %s given twice", name, d.Mnemonic)
+		}
+		seen[name] = true
+		result = append(result, name)
+	}
+	return result, nil
+}
+
+// CheckAliasNames returns an error if an alias name of an insn is also the
+// mnemonic or an alias name of another insn, so that every spelling names
+// exactly one insn.
+func CheckAliasNames(descs []*InsnDescription) error {
+	owners := make(map[string]string, len(descs))
+	for _, d := range descs {
+		owners[d.Mnemonic] = d.Mnemonic
+	}
+
+	for _, d := range descs {
+		for _, name := range d.AliasNames() {
+			if owner, ok := owners[name]; ok {
+				return fmt.Errorf("alias name %s of %s already names %s", name, d.Mnemonic, owner)
+			}
+			owners[name] = d.Mnemonic
+		}
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAliasNames(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00005800 sext.h                 DJ              @alias-name=ext.w.h",
+		"002a8000 dbgcall                Ud15            @alias-name=dbcl,dbgcl",
+		"00100000 add.w                  DJK",
+	)
+	assert.Equal(t, []string{"ext.w.h"}, descs[0].AliasNames())
+	assert.Equal(t, []string{"dbcl", "dbgcl"}, descs[1].AliasNames())
+	assert.Empty(t, descs[2].AliasNames())
+	assert.NoError(t, CheckAliasNames(descs))
+
+	for _, l := range []string{
+		"00005800 sext.h                 DJ              @alias-name=",
+		"00005800 sext.h                 DJ              @alias-name=sext.h",
+		"00005800 sext.h                 DJ              @alias-name=a,a",
+		"00005800 sext.h                 DJ              @alias-name=1a",
+	} {
+		_, err := ParseInsnDescriptionLine(l)
+		assert.Error(t, err, l)
+	}
+
+	clash := mustParseInsnDescriptionLines(
+		t,
+		"00005800 sext.h                 DJ              @alias-name=add.w",
+		"00100000 add.w                  DJK",
+	)
+	assert.EqualError(t, CheckAliasNames(clash), "alias name add.w of sext.h already names add.w")
+
+	clash = mustParseInsnDescriptionLines(
+		t,
+		"00005800 sext.h                 DJ              @alias-name=foo",
+		"00005c00 sext.b                 DJ              @alias-name=foo",
+	)
+	assert.EqualError(t, CheckAliasNames(clash), "alias name foo of sext.b already names sext.h")
+}
+
+func TestAssembleAliasName(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00005800 sext.h                 DJ              @alias-name=ext.w.h",
+	)
+	asm := NewAssembler(descs)
+
+	canonical, err := asm.AssembleLine("sext.h $r4, $r5")
+	assert.NoError(t, err)
+	aliased, err := asm.AssembleLine("ext.w.h $r4, $r5")
+	assert.NoError(t, err)
+	assert.Equal(t, canonical, aliased)
+
+	// and the disassembly uses the mnemonic
+	x, ok := NewDecoder(descs).Decode(aliased)
+	if assert.True(t, ok) {
+		assert.Equal(t, "sext.h $r4, $r5", x.String())
+	}
+}
@@ -0,0 +1,63 @@
+package common
+
+import "fmt"
+
+// UnsupportedArgKindError is returned for an insn with an arg of a kind the
+// generator doesn't support.
+type UnsupportedArgKindError struct {
+	Desc *InsnDescription
+	Arg  *Arg
+}
+
+func (e *UnsupportedArgKindError) Error() string {
+	return fmt.Sprintf(
+		"%s: arg %s (%s) is not supported",
+		e.Desc.Mnemonic,
+		e.Arg.Name(),
+		DescribeArg(e.Arg, ArgRoleNone),
+	)
+}
+
+func firstUnsupportedArg(d *InsnDescription, supported []ArgKind) *Arg {
+	for i, k := range d.Format.ArgKinds() {
+		ok := false
+		for _, sk := range supported {
+			if k == sk {
+				ok = true
+				break
+			}
+		}
+		if !ok {
+			return d.Format.Args[i]
+		}
+	}
+	return nil
+}
+
+// CheckArgKinds returns an *UnsupportedArgKindError for the first insn with
+// an arg of a kind not in supported, for generators to reject such insns
+// before emitting broken code for them.
+func CheckArgKinds(descs []*InsnDescription, supported []ArgKind) error {
+	for _, d := range descs {
+		if a := firstUnsupportedArg(d, supported); a != nil {
+			return &UnsupportedArgKindError{Desc: d, Arg: a}
+		}
+	}
+	return nil
+}
+
+// FilterArgKinds returns the insns whose args are all of the kinds in
+// supported, and the errors describing the other insns, in the order of the
+// insns.
+func FilterArgKinds(descs []*InsnDescription, supported []ArgKind) ([]*InsnDescription, []error) {
+	var result []*InsnDescription
+	var errs []error
+	for _, d := range descs {
+		if a := firstUnsupportedArg(d, supported); a != nil {
+			errs = append(errs, &UnsupportedArgKindError{Desc: d, Arg: a})
+			continue
+		}
+		result = append(result, d)
+	}
+	return result, errs
+}
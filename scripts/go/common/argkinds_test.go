@@ -0,0 +1,33 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckArgKinds(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"700a0000 vadd.b                 VdVjVk",
+		"02c00000 addi.d                 DJSk12",
+		"00000800 gr2scr                 TdJ",
+	)
+	supported := []ArgKind{ArgKindIntReg, ArgKindSignedImm}
+
+	assert.NoError(t, CheckArgKinds(descs[:1], supported))
+
+	err := CheckArgKinds(descs, supported)
+	var kindErr *UnsupportedArgKindError
+	if assert.ErrorAs(t, err, &kindErr) {
+		assert.Equal(t, "vadd.b", kindErr.Desc.Mnemonic)
+	}
+	assert.EqualError(t, err, "vadd.b: arg vd (LSX register) is not supported")
+
+	kept, errs := FilterArgKinds(descs, supported)
+	assert.Equal(t, []*InsnDescription{descs[0], descs[2]}, kept)
+	if assert.Len(t, errs, 2) {
+		assert.EqualError(t, errs[1], "gr2scr: arg td (scratch register) is not supported")
+	}
+}
@@ -0,0 +1,142 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Assembler encodes insns written in the syntax of DecodedInsn.String, i.e.
+// with the operands in canonical order and the immediates as encoded, so that
+// disassembly can be fed back as is. The "$" prefix of the registers is
+// optional, and the operation code of cacop can also be given by name, as
+// rendered by DecodedInsn.AsmString. The insns can also be written with
+// their alias names.
+type Assembler struct {
+	descs map[string]*InsnDescription
+}
+
+func NewAssembler(descs []*InsnDescription) *Assembler {
+	m := make(map[string]*InsnDescription, len(descs))
+	for _, d := range descs {
+		if _, ok := m[d.Mnemonic]; !ok {
+			m[d.Mnemonic] = d
+		}
+	}
+	// the mnemonics take precedence over the alias names
+	for _, d := range descs {
+		for _, name := range d.AliasNames() {
+			if _, ok := m[name]; !ok {
+				m[name] = d
+			}
+		}
+	}
+
+	return &Assembler{
+		descs: m,
+	}
+}
+
+// AssembleLine encodes a line like "addi.d $r4, $r5, -16".
+func (x *Assembler) AssembleLine(line string) (uint32, error) {
+	line = strings.TrimSpace(line)
+	mnemonic, operandsStr, _ := strings.Cut(line, " ")
+
+	d, ok := x.descs[mnemonic]
+	if !ok {
+		return 0, fmt.Errorf("unknown mnemonic %q", mnemonic)
+	}
+
+	var operands []string
+	if operandsStr = strings.TrimSpace(operandsStr); operandsStr != "" {
+		operands = strings.Split(operandsStr, ",")
+	}
+	if len(operands) != d.Format.ArgCount() {
+		return 0, fmt.Errorf("%s: want %d operand(s), got %d", d.Mnemonic, d.Format.ArgCount(), len(operands))
+	}
+
+	roles := d.ArgRoles()
+	args := make([]int64, len(operands))
+	for i, a := range d.Format.Args {
+		v, err := parseOperand(a, roles[i], strings.TrimSpace(operands[i]))
+		if err != nil {
+			return 0, fmt.Errorf("%s: operand %s: %w", d.Mnemonic, a.Name(), err)
+		}
+
+		min, max := a.MinValue(), a.MaxValue()
+		if v < min || v > max {
+			return 0, &OperandError{
+				Mnemonic: d.Mnemonic,
+				Name:     a.Name(),
+				Desc:     DescribeArg(a, roles[i]),
+				Value:    v,
+				Min:      min,
+				Max:      max,
+			}
+		}
+
+		args[i] = v
+	}
+
+	return d.Encode(args), nil
+}
+
+// RegName returns the name of the register numbered n of the register arg
+// kind, e.g. "$r4" or "$fcc0".
+func RegName(k ArgKind, n int64) string {
+	prefix := regPrefixForKind(k)
+	if prefix == "" {
+		panic("unreachable")
+	}
+	return fmt.Sprintf("$%s%d", prefix, n)
+}
+
+// regPrefixForKind returns the register name prefix of the arg kind, without
+// the "$".
+func regPrefixForKind(k ArgKind) string {
+	switch k {
+	case ArgKindIntReg:
+		return "r"
+	case ArgKindFPReg:
+		return "f"
+	case ArgKindFCCReg:
+		return "fcc"
+	case ArgKindScratchReg:
+		return "scr"
+	case ArgKindVReg:
+		return "vr"
+	case ArgKindXReg:
+		return "xr"
+	default:
+		return ""
+	}
+}
+
+func parseOperand(a *Arg, role ArgRole, s string) (int64, error) {
+	if role == ArgRoleCacheOp {
+		if v, ok := ParseCacheOpName(s); ok {
+			return v, nil
+		}
+	}
+
+	if a.Kind.IsImm() {
+		v, err := strconv.ParseInt(s, 0, 64)
+		if err != nil {
+			return 0, fmt.Errorf("want an integer, got %q", s)
+		}
+		return v, nil
+	}
+
+	prefix := regPrefixForKind(a.Kind)
+	name := strings.TrimPrefix(s, "$")
+	if !strings.HasPrefix(name, prefix) {
+		return 0, fmt.Errorf("want a register like $%s0, got %q", prefix, s)
+	}
+
+	numStr := name[len(prefix):]
+	v, err := strconv.ParseUint(numStr, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("want a register like $%s0, got %q", prefix, s)
+	}
+	return int64(v), nil
+}
@@ -0,0 +1,83 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssembleLine(t *testing.T) {
+	asm := NewAssembler(mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"02c00000 addi.d                 DJSk12",
+		"40000000 beqz                   JSd5k16",
+		"06483800 eret                   EMPTY",
+		"0c100000 fcmp.caf.s             CdFjFk",
+	))
+
+	testcases := []struct {
+		line     string
+		expected uint32
+	}{
+		{line: "add.w $r3, $r4, $r5", expected: 0x00101483},
+		{line: "add.w r3,r4,r5", expected: 0x00101483},
+		{line: "addi.d $r4, $r5, -16", expected: 0x02ffc0a4},
+		{line: "addi.d r4, r5, 0x7ff", expected: 0x02dffca4},
+		{line: "beqz $r4, -1", expected: 0x43fffc9f},
+		{line: "eret", expected: 0x06483800},
+		{line: "  fcmp.caf.s $fcc7, $f5, $f6  ", expected: 0x0c1018a7},
+	}
+
+	for _, tc := range testcases {
+		word, err := asm.AssembleLine(tc.line)
+		assert.NoError(t, err, tc.line)
+		assert.Equal(t, tc.expected, word, tc.line)
+	}
+
+	errcases := []struct {
+		line   string
+		errMsg string
+	}{
+		{line: "foo r1", errMsg: `unknown mnemonic "foo"`},
+		{line: "add.w r1, r2", errMsg: "add.w: want 3 operand(s), got 2"},
+		{line: "eret r1", errMsg: "eret: want 0 operand(s), got 1"},
+		{line: "add.w r1, f2, r3", errMsg: `add.w: operand j: want a register like $r0, got "f2"`},
+		{line: "add.w r1, $2, r3", errMsg: `add.w: operand j: want a register like $r0, got "$2"`},
+		{line: "add.w r1, r32, r3", errMsg: "add.w: operand j: integer register 32 out of range [0, 31]"},
+		{line: "fcmp.caf.s $f7, $f5, $f6", errMsg: `fcmp.caf.s: operand cd: want a register like $fcc0, got "$f7"`},
+		{line: "addi.d r4, r5, x", errMsg: `addi.d: operand sk12: want an integer, got "x"`},
+		{line: "addi.d r4, r5, 2048", errMsg: "addi.d: operand sk12: signed immediate 2048 out of range [-2048, 2047]"},
+	}
+
+	for _, tc := range errcases {
+		_, err := asm.AssembleLine(tc.line)
+		if assert.Error(t, err, tc.line) {
+			assert.Equal(t, tc.errMsg, err.Error(), tc.line)
+		}
+	}
+}
+
+func TestAssembleLineRoundTripOverCorpus(t *testing.T) {
+	descs := Builtin()
+	asm := NewAssembler(descs)
+	dec := NewDecoder(descs)
+
+	for _, d := range descs {
+		args := make([]int64, len(d.Format.Args))
+		for i, a := range d.Format.Args {
+			args[i] = a.MaxValue()
+		}
+		word := d.Encode(args)
+
+		x, ok := dec.Decode(word)
+		if !assert.True(t, ok, d.Mnemonic) || x.Desc != d {
+			// shadowed by a more specific encoding
+			continue
+		}
+
+		actual, err := asm.AssembleLine(x.String())
+		assert.NoError(t, err, x.String())
+		assert.Equal(t, word, actual, x.String())
+	}
+}
@@ -0,0 +1,270 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Bit pattern notation spells out all 32 bits of the insn word, MSB first,
+// after a "0b" prefix; underscores may be freely inserted for readability.
+// Every bit is one of:
+//
+//   - '0' or '1' for fixed bits of the opcode;
+//   - 'd', 'j', 'k' or 'a' for the integer register at the respective slot;
+//   - 'f', 'v', 'x', 'c' or 't' for an FP, LSX, LASX, FCC or LBT scratch
+//     register respectively, whose slot is given by the position;
+//   - 's' or 'u' for a signed or unsigned immediate, and 'S' or 'U' for the
+//     second one of the same signedness if present.
+//
+// For example, add.w is 0b00000000000100000_kkkkk_jjjjj_ddddd. Every run of
+// register letters is a separate register, while all runs of the same
+// immediate letter are slots of the same immediate, concatenated from the
+// lowest offset (MSB direction) to the highest; so beqz is
+// 0b010000_ssssssssssssssss_jjjjj_sssss, or JSd5k16. Underscores are only
+// significant inside runs of immediate letters, where they separate adjacent
+// slots, as in b (Sd10k16): 0b010100_ssssssssssssssss_ssssssssss.
+
+const bitPatternPrefix = "0b"
+
+// ParseInsnBitPattern parses an insn word in bit pattern notation, returning
+// the fixed bits and the format.
+func ParseInsnBitPattern(input string) (uint32, *InsnFormat, error) {
+	if !strings.HasPrefix(input, bitPatternPrefix) {
+		return 0, nil, fmt.Errorf("bit pattern %q does not start with %q", input, bitPatternPrefix)
+	}
+
+	// bits[i] is the symbol of the i-th bit, counting from the LSB, and
+	// splits[i] is whether there is an underscore right above it
+	var bits []byte
+	var splits []bool
+	for i := len(input) - 1; i >= len(bitPatternPrefix); i-- {
+		if input[i] == '_' {
+			if len(splits) > 0 {
+				splits[len(splits)-1] = true
+			}
+			continue
+		}
+		bits = append(bits, input[i])
+		splits = append(splits, false)
+	}
+
+	if len(bits) != 32 {
+		return 0, nil, fmt.Errorf("bit pattern %q has %d bits, want 32", input, len(bits))
+	}
+
+	var word uint32
+	var regs []*Arg
+	imms := map[byte]*Arg{}
+	for lsb := 0; lsb < 32; {
+		ch := bits[lsb]
+		kind, isArg := bitPatternArgKinds[ch]
+		if !isArg && ch != '0' && ch != '1' {
+			return 0, nil, fmt.Errorf("invalid bit pattern char %q", ch)
+		}
+
+		// registers take exactly their width, so that adjacent registers
+		// of the same kind can be told apart
+		maxWidth := 32 - lsb
+		if regWidth, isReg := bitPatternRegWidth(kind); isReg {
+			maxWidth = regWidth
+		}
+
+		width := 1
+		for width < maxWidth && lsb+width < 32 && bits[lsb+width] == ch {
+			if kind.IsImm() && splits[lsb+width-1] {
+				break
+			}
+			width++
+		}
+		s := &Slot{Offset: uint(lsb), Width: uint(width)}
+		lsb += width
+
+		switch {
+		case ch == '0':
+			continue
+		case ch == '1':
+			word |= s.Bitmask()
+			continue
+		}
+
+		if offsetCharsLower[s.Offset] == '_' {
+			return 0, nil, fmt.Errorf("%q bits at offset %d do not start at a slot", ch, s.Offset)
+		}
+
+		if kind.IsImm() {
+			if a, ok := imms[ch]; ok {
+				a.Slots = append(a.Slots, s)
+			} else {
+				imms[ch] = &Arg{Kind: kind, Slots: []*Slot{s}}
+			}
+			continue
+		}
+
+		a, err := makeBitPatternRegArg(ch, kind, s)
+		if err != nil {
+			return 0, nil, err
+		}
+		regs = append(regs, a)
+	}
+
+	if imms['S'] != nil && imms['s'] == nil {
+		return 0, nil, errors.New("second signed immediate 'S' without the first one 's'")
+	}
+	if imms['U'] != nil && imms['u'] == nil {
+		return 0, nil, errors.New("second unsigned immediate 'U' without the first one 'u'")
+	}
+
+	immArgs := make([]*Arg, 0, len(imms))
+	for _, a := range imms {
+		immArgs = append(immArgs, a)
+	}
+	sort.Slice(immArgs, func(i int, j int) bool {
+		return immArgs[i].Slots[0].Offset < immArgs[j].Slots[0].Offset
+	})
+
+	insnFmt := &InsnFormat{Args: append(regs, immArgs...)}
+	err := insnFmt.Validate()
+	if err != nil {
+		return 0, nil, err
+	}
+
+	return word, insnFmt, nil
+}
+
+var bitPatternArgKinds = map[byte]ArgKind{
+	'd': ArgKindIntReg,
+	'j': ArgKindIntReg,
+	'k': ArgKindIntReg,
+	'a': ArgKindIntReg,
+	'f': ArgKindFPReg,
+	'v': ArgKindVReg,
+	'x': ArgKindXReg,
+	'c': ArgKindFCCReg,
+	't': ArgKindScratchReg,
+	's': ArgKindSignedImm,
+	'S': ArgKindSignedImm,
+	'u': ArgKindUnsignedImm,
+	'U': ArgKindUnsignedImm,
+}
+
+func bitPatternRegWidth(kind ArgKind) (int, bool) {
+	switch kind {
+	case ArgKindIntReg, ArgKindFPReg, ArgKindVReg, ArgKindXReg:
+		return 5, true
+	case ArgKindFCCReg:
+		return 3, true
+	case ArgKindScratchReg:
+		return 2, true
+	default:
+		return 0, false
+	}
+}
+
+func makeBitPatternRegArg(ch byte, kind ArgKind, s *Slot) (*Arg, error) {
+	var want *Arg
+	switch kind {
+	case ArgKindIntReg:
+		offset, _ := parseOffsetCh(rune(ch))
+		want = makeRegArg(offset, kind)
+	case ArgKindFCCReg:
+		want = makeFCCRegArg(s.Offset)
+	case ArgKindScratchReg:
+		want = makeScratchRegArg(s.Offset)
+	default:
+		want = makeRegArg(s.Offset, kind)
+	}
+
+	if *want.Slots[0] != *s {
+		return nil, fmt.Errorf(
+			"%q bits %d..%d do not form the register slot %s",
+			ch,
+			s.MSB(),
+			s.Offset,
+			want.Slots[0].CanonicalRepr(),
+		)
+	}
+
+	return want, nil
+}
+
+// FormatInsnBitPattern returns the bit pattern notation of the insn word with
+// the given format, with underscores separating the slots from each other and
+// from the fixed bits.
+func FormatInsnBitPattern(word uint32, f *InsnFormat) (string, error) {
+	var bits [32]byte
+	// owners[i] is 1 + the index of the arg the i-th bit belongs to, or 0
+	// for fixed bits
+	var owners [32]int
+	var isSlotStart [32]bool
+	for i := range bits {
+		if word&(1<<i) != 0 {
+			bits[i] = '1'
+		} else {
+			bits[i] = '0'
+		}
+	}
+
+	seenImms := map[ArgKind]int{}
+	for argIdx, a := range f.Args {
+		ch, err := bitPatternCharForArg(a, seenImms)
+		if err != nil {
+			return "", err
+		}
+
+		for _, s := range a.Slots {
+			for i := s.Offset; i <= s.MSB(); i++ {
+				bits[i] = ch
+				owners[i] = argIdx + 1
+			}
+			isSlotStart[s.Offset] = true
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(bitPatternPrefix)
+	for i := 31; i >= 0; i-- {
+		if i < 31 && (owners[i] != owners[i+1] || isSlotStart[i+1]) {
+			sb.WriteByte('_')
+		}
+		sb.WriteByte(bits[i])
+	}
+
+	return sb.String(), nil
+}
+
+func bitPatternCharForArg(a *Arg, seenImms map[ArgKind]int) (byte, error) {
+	switch a.Kind {
+	case ArgKindIntReg:
+		ch := offsetCharsLower[a.Slots[0].Offset]
+		if len(a.Slots) != 1 || a.Slots[0].Width != 5 || ch == '_' || ch == 'm' || ch == 'n' {
+			return 0, fmt.Errorf("integer register arg %s is not at a register slot", a)
+		}
+		return ch, nil
+	case ArgKindFPReg:
+		return 'f', nil
+	case ArgKindVReg:
+		return 'v', nil
+	case ArgKindXReg:
+		return 'x', nil
+	case ArgKindFCCReg:
+		return 'c', nil
+	case ArgKindScratchReg:
+		return 't', nil
+	case ArgKindSignedImm, ArgKindUnsignedImm:
+		chars := "sS"
+		if a.Kind == ArgKindUnsignedImm {
+			chars = "uU"
+		}
+
+		n := seenImms[a.Kind]
+		if n >= len(chars) {
+			return 0, fmt.Errorf("more than %d immediates of the same signedness", len(chars))
+		}
+		seenImms[a.Kind] = n + 1
+		return chars[n], nil
+	}
+
+	return 0, fmt.Errorf("unknown arg kind: %d", a.Kind)
+}
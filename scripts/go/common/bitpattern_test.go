@@ -0,0 +1,76 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseInsnBitPattern(t *testing.T) {
+	testcases := []struct {
+		x       string
+		ok      bool
+		word    uint32
+		fmtRepr string
+	}{
+		{x: "0b00000000000100000_kkkkk_jjjjj_ddddd", ok: true, word: 0x00100000, fmtRepr: "DJK"},
+		{x: "0b0000_0000_0001_0000_0kkk_kkjj_jjjd_dddd", ok: true, word: 0x00100000, fmtRepr: "DJK"},
+		{x: "0b010000_ssssssssssssssss_jjjjj_sssss", ok: true, word: 0x40000000, fmtRepr: "JSd5k16"},
+		{x: "0b010100_ssssssssssssssss_ssssssssss", ok: true, word: 0x50000000, fmtRepr: "Sd10k16"},
+		{x: "0b010100_ssssssssssssssssssssssssss", ok: true, word: 0x50000000, fmtRepr: "Sd26"},
+		{x: "0b0000000011_UUUUUU_uuuuuu_jjjjj_ddddd", ok: true, word: 0x00c00000, fmtRepr: "DJUk6Um6"},
+		{x: "0b00000001000000001_fffff_fffff_fffff", ok: true, word: 0x01008000, fmtRepr: "FdFjFk"},
+		{x: "0b0011000100010_u_ssssssss_jjjjj_vvvvv", ok: true, word: 0x31100000, fmtRepr: "VdJSk8Un1"},
+		{x: "0b00000110010010000011100000000000", ok: true, word: 0x06483800, fmtRepr: "EMPTY"},
+
+		{x: "00000000000100000_kkkkk_jjjjj_ddddd", ok: false},
+		{x: "0b0000000000100000_kkkkk_jjjjj_ddddd", ok: false},
+		{x: "0b00000000000100000_kkkkk_jjjjj_dddqd", ok: false},
+		{x: "0b0000000000010000_kkkkkk_jjjjj_ddddd", ok: false},
+		{x: "0b00000000000100000_jjjjj_kkkkk_ddddd", ok: false},
+		{x: "0b0000000000010000000_fff_fffff_fffff", ok: false},
+		{x: "0b00000000000100_UUUUUUUU_jjjjj_ddddd", ok: false},
+		{x: "0b000000000001000_ssssssss_u_jjjjj_ddddd", ok: false},
+	}
+
+	for _, tc := range testcases {
+		word, f, err := ParseInsnBitPattern(tc.x)
+		if tc.ok {
+			if !assert.NoError(t, err, tc.x) {
+				continue
+			}
+			assert.Equal(t, tc.word, word, tc.x)
+			assert.Equal(t, tc.fmtRepr, f.CanonicalRepr(), tc.x)
+		} else {
+			assert.Error(t, err, tc.x)
+		}
+	}
+}
+
+func TestParseInsnDescriptionLineBitPattern(t *testing.T) {
+	hex, err := ParseInsnDescriptionLine("02c00000 addi.d                 DJSk12          @reloc=sk12")
+	assert.NoError(t, err)
+
+	bp, err := ParseInsnDescriptionLine("0b0000001011_ssssssssssss_jjjjj_ddddd addi.d @reloc=sk12")
+	assert.NoError(t, err)
+	assert.Equal(t, hex, bp)
+
+	_, err = ParseInsnDescriptionLine("0b0000001011_ssssssssssss_jjjjj_ddddd")
+	assert.Error(t, err)
+}
+
+func TestInsnBitPatternRoundTripOverCorpus(t *testing.T) {
+	for _, d := range Builtin() {
+		bp, err := FormatInsnBitPattern(d.Word, d.Format)
+		if !assert.NoError(t, err, d.Mnemonic) {
+			continue
+		}
+
+		word, f, err := ParseInsnBitPattern(bp)
+		if !assert.NoError(t, err, "%s: %s", d.Mnemonic, bp) {
+			continue
+		}
+		assert.Equal(t, d.Word, word, "%s: %s", d.Mnemonic, bp)
+		assert.Equal(t, d.Format.CanonicalRepr(), f.CanonicalRepr(), "%s: %s", d.Mnemonic, bp)
+	}
+}
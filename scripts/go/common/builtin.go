@@ -0,0 +1,136 @@
+package common
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"sync"
+)
+
+//go:generate sh -c "go run ../genbuiltin ../../../*.txt > builtin.json"
+
+//go:embed builtin.json
+var builtinJSON []byte
+
+var (
+	builtinOnce  sync.Once
+	builtinDescs []*InsnDescription
+)
+
+// Builtin returns the descriptions of all insns in the description files of
+// this repo, as embedded in the package when it was generated, so that users
+// of the package don't need the description files at hand. The result is the
+// same as reading all the description files with ReadInsnDescs, and is owned
+// by the caller.
+func Builtin() []*InsnDescription {
+	builtinOnce.Do(func() {
+		var err error
+		builtinDescs, err = UnmarshalInsnDescsJSON(builtinJSON)
+		if err != nil {
+			panic(fmt.Sprintf("corrupt builtin insn descriptions: %v", err))
+		}
+	})
+
+	result := make([]*InsnDescription, len(builtinDescs))
+	for i, d := range builtinDescs {
+		result[i] = d.Clone()
+	}
+	return result
+}
+
+// insnDescJSON is the JSON representation of an InsnDescription, in canonical
+// form. The orig_fmt and reserved attribs are kept in Attribs, as in the
+// description files.
+type insnDescJSON struct {
+	Word           uint32            `json:"word"`
+	Mnemonic       string            `json:"mnemonic"`
+	SourceMnemonic string            `json:"source_mnemonic,omitempty"`
+	Format         string            `json:"format"`
+	Attribs        map[string]string `json:"attribs,omitempty"`
+}
+
+// MarshalInsnDescsJSON returns the JSON representation of the insns, an array
+// of one object per line for every insn in the given order, e.g.:
+//
+//	{"word":717225984,"mnemonic":"preld","format":"JUd5Sk12","attribs":{"orig_fmt":"Ud5JSk12","primary":"true"}}
+//
+// See UnmarshalInsnDescsJSON for the reverse.
+func MarshalInsnDescsJSON(descs []*InsnDescription) ([]byte, error) {
+	items := make([]insnDescJSON, len(descs))
+	for i, d := range descs {
+		attribs := make(map[string]string, len(d.Attribs)+2)
+		for k, v := range d.Attribs {
+			attribs[k] = v
+		}
+		if d.OrigFormat != nil {
+			attribs[origFmtKey] = d.OrigFormat.CanonicalRepr()
+		}
+		if len(d.Reserved) > 0 {
+			var reserved string
+			for _, s := range d.Reserved {
+				reserved += s.CanonicalRepr()
+			}
+			attribs[reservedKey] = reserved
+		}
+
+		items[i] = insnDescJSON{
+			Word:           d.Word,
+			Mnemonic:       d.Mnemonic,
+			SourceMnemonic: d.SourceMnemonic,
+			Format:         d.Format.CanonicalRepr(),
+			Attribs:        attribs,
+		}
+	}
+
+	// one line per insn, for readable diffs
+	var buf bytes.Buffer
+	buf.WriteString("[\n")
+	for i, item := range items {
+		line, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(line)
+		if i < len(items)-1 {
+			buf.WriteByte(',')
+		}
+		buf.WriteByte('\n')
+	}
+	buf.WriteString("]\n")
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalInsnDescsJSON parses the JSON representation of insns returned by
+// MarshalInsnDescsJSON, validating the insns like the description files.
+func UnmarshalInsnDescsJSON(data []byte) ([]*InsnDescription, error) {
+	var items []insnDescJSON
+	err := json.Unmarshal(data, &items)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*InsnDescription, len(items))
+	for i, item := range items {
+		insnFmt, err := ParseInsnFormat(item.Format)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", item.Mnemonic, err)
+		}
+
+		attribs := item.Attribs
+		if attribs == nil {
+			attribs = map[string]string{}
+		}
+
+		result[i], err = makeInsnDescriptionWithAttribs(item.Word, item.Mnemonic, insnFmt, attribs)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", item.Mnemonic, err)
+		}
+		if item.SourceMnemonic != "" {
+			result[i].SourceMnemonic = item.SourceMnemonic
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,49 @@
+package common
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuiltin(t *testing.T) {
+	paths, err := filepath.Glob("../../../*.txt")
+	assert.NoError(t, err)
+	expected, err := ReadInsnDescs(paths)
+	assert.NoError(t, err)
+
+	// if this fails, run go generate in this package
+	assert.Equal(t, expected, Builtin())
+
+	// the result is owned by the caller
+	descs := Builtin()
+	descs[0].Attribs["foo"] = "bar"
+	descs[1] = nil
+	assert.Equal(t, expected, Builtin())
+}
+
+func TestInsnDescsJSONRoundTrip(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK             @qemu @commutative",
+		"2ac00000 preld                  JUd5Sk12        @orig_fmt=Ud5JSk12 @syntax_order=ud5,j,sk12",
+		"00010000 asrtle                 JK              @reserved=d5",
+		"06483800 eret                   EMPTY",
+		"29c00000 st.d                   DJSk12          @writes=",
+		"02c00000 ADDI.D                 DJSk12",
+	)
+	assert.Equal(t, "ADDI.D", descs[5].SourceMnemonic)
+
+	data, err := MarshalInsnDescsJSON(descs)
+	assert.NoError(t, err)
+	actual, err := UnmarshalInsnDescsJSON(data)
+	assert.NoError(t, err)
+	assert.Equal(t, descs, actual)
+
+	_, err = UnmarshalInsnDescsJSON([]byte(`[{"word":1048576,"mnemonic":"add.w","format":"DJQ"}]`))
+	assert.Error(t, err)
+
+	_, err = UnmarshalInsnDescsJSON([]byte(`[{"word":1048576,"mnemonic":"add.w","format":"DJK","attribs":{"role":"foo"}}]`))
+	assert.EqualError(t, err, `add.w: unknown arg role "foo"`)
+}
@@ -0,0 +1,52 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// cacheOpWidth is the width of the operation code of cacop.
+const cacheOpWidth = 5
+
+// cacheOpOps are the names of the operations in bits [4:3] of a cacop code;
+// operation 3 is implementation-defined, and has no name.
+var cacheOpOps = [...]string{
+	"store_tag",
+	"index_wb_inv",
+	"hit_wb_inv",
+}
+
+// CacheOpName returns the symbolic name of a cacop code, made of the
+// operation in bits [4:3] and the cache leaf in bits [2:0], e.g.
+// "hit_wb_inv_leaf1" for 0x11. It returns false if the operation is
+// implementation-defined, or the code is out of range.
+func CacheOpName(code int64) (string, bool) {
+	if code < 0 || code >= 1<<cacheOpWidth {
+		return "", false
+	}
+
+	op := code >> 3
+	if op >= int64(len(cacheOpOps)) {
+		return "", false
+	}
+	return fmt.Sprintf("%s_leaf%d", cacheOpOps[op], code&7), true
+}
+
+// ParseCacheOpName is the inverse of CacheOpName.
+func ParseCacheOpName(name string) (int64, bool) {
+	for op, opName := range cacheOpOps {
+		prefix := opName + "_leaf"
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		leafStr := name[len(prefix):]
+
+		leaf, err := strconv.ParseUint(leafStr, 10, 3)
+		if err != nil || leafStr != strconv.FormatUint(leaf, 10) {
+			return 0, false
+		}
+		return int64(op)<<3 | int64(leaf), true
+	}
+	return 0, false
+}
@@ -0,0 +1,74 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheOpName(t *testing.T) {
+	testcases := []struct {
+		code int64
+		name string
+		ok   bool
+	}{
+		{code: 0x00, name: "store_tag_leaf0", ok: true},
+		{code: 0x09, name: "index_wb_inv_leaf1", ok: true},
+		{code: 0x11, name: "hit_wb_inv_leaf1", ok: true},
+		{code: 0x17, name: "hit_wb_inv_leaf7", ok: true},
+		{code: 0x18, ok: false}, // implementation-defined
+		{code: 0x20, ok: false},
+		{code: -1, ok: false},
+	}
+
+	for _, tc := range testcases {
+		name, ok := CacheOpName(tc.code)
+		assert.Equal(t, tc.ok, ok, "%#x", tc.code)
+		assert.Equal(t, tc.name, name, "%#x", tc.code)
+
+		if ok {
+			code, ok := ParseCacheOpName(name)
+			assert.True(t, ok, name)
+			assert.Equal(t, tc.code, code, name)
+		}
+	}
+
+	for _, name := range []string{"", "hit_wb_inv", "hit_wb_inv_leaf8", "hit_wb_inv_leaf01", "foo_leaf1"} {
+		_, ok := ParseCacheOpName(name)
+		assert.False(t, ok, name)
+	}
+}
+
+func TestCacheOpRole(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"06000000 cacop                  JUd5Sk12        @orig_fmt=Ud5JSk12 @syntax_order=ud5,j,sk12 @role=cacheop",
+	)
+	d := descs[0]
+	assert.Equal(t, []ArgRole{ArgRoleNone, ArgRoleCacheOp, ArgRoleNone}, d.ArgRoles())
+
+	// the code comes first in assembly order, and is named if known
+	x, ok := NewDecoder(descs).Decode(0x06004091)
+	assert.True(t, ok)
+	assert.Equal(t, "cacop $r4, 17, 16", x.String())
+	assert.Equal(t, "cacop hit_wb_inv_leaf1, $r4, 16", x.AsmString())
+
+	x, ok = NewDecoder(descs).Decode(0x06004099)
+	assert.True(t, ok)
+	assert.Equal(t, "cacop 25, $r4, 16", x.AsmString())
+
+	asm := NewAssembler(descs)
+	for _, line := range []string{"cacop $r4, 17, 16", "cacop $r4, hit_wb_inv_leaf1, 16"} {
+		word, err := asm.AssembleLine(line)
+		assert.NoError(t, err, line)
+		assert.Equal(t, uint32(0x06004091), word, line)
+	}
+
+	_, err := asm.AssembleLine("cacop $r4, 32, 16")
+	assert.EqualError(t, err, "cacop: operand ud5: cache operation code 32 out of range [0, 31]")
+
+	_, err = ParseInsnDescriptionLine("00100000 add.w                  DJK             @role=cacheop")
+	assert.EqualError(t, err, "role cacheop needs exactly one unsigned immediate arg, 5 bits wide")
+	_, err = ParseInsnDescriptionLine("03400000 andi                   DJUk12          @role=cacheop")
+	assert.Error(t, err)
+}
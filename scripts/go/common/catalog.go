@@ -0,0 +1,87 @@
+package common
+
+import (
+	"sort"
+	"strings"
+)
+
+// FormatInfo describes an insn format for reference, along with the insns
+// using it.
+type FormatInfo struct {
+	Format string `json:"format"`
+	// Mnemonics are the mnemonics of the insns of the format, sorted by
+	// insn word.
+	Mnemonics []string `json:"mnemonics"`
+	// Layout is the bit pattern notation of the format, with '-' for the
+	// opcode bits, e.g. "0b-----------------_kkkkk_jjjjj_ddddd" for DJK.
+	Layout string `json:"layout"`
+	// Syntax is the assembly syntax of the first of the insns, with the
+	// operands named after their args, e.g. "add.w $rd, $rj, $rk".
+	Syntax string `json:"syntax"`
+}
+
+// FormatCatalog returns the descriptions of all distinct formats used by
+// descs, sorted by their canonical representations like GatherFormats.
+func FormatCatalog(descs []*InsnDescription) []FormatInfo {
+	sorted := make([]*InsnDescription, len(descs))
+	copy(sorted, descs)
+	sort.Slice(sorted, func(i int, j int) bool {
+		return sorted[i].Word < sorted[j].Word
+	})
+
+	byFormat := make(map[string][]*InsnDescription)
+	for _, d := range sorted {
+		repr := d.Format.CanonicalRepr()
+		byFormat[repr] = append(byFormat[repr], d)
+	}
+
+	formats := GatherFormats(descs)
+	result := make([]FormatInfo, len(formats))
+	for i, f := range formats {
+		users := byFormat[f.CanonicalRepr()]
+
+		mnemonics := make([]string, len(users))
+		for j, d := range users {
+			mnemonics[j] = d.Mnemonic
+		}
+
+		layout, err := FormatInsnBitPattern(0, f)
+		if err != nil {
+			panic(err)
+		}
+
+		result[i] = FormatInfo{
+			Format:    f.CanonicalRepr(),
+			Mnemonics: mnemonics,
+			Layout:    bitPatternPrefix + strings.ReplaceAll(strings.TrimPrefix(layout, bitPatternPrefix), "0", "-"),
+			Syntax:    syntaxTemplate(users[0]),
+		}
+	}
+
+	return result
+}
+
+// syntaxTemplate returns the assembly syntax of the insn, with the register
+// operands named like registers after their args, and the immediates named
+// after their args.
+func syntaxTemplate(d *InsnDescription) string {
+	var sb strings.Builder
+	sb.WriteString(d.Mnemonic)
+	for i, a := range d.SyntaxArgs() {
+		if i == 0 {
+			sb.WriteRune(' ')
+		} else {
+			sb.WriteString(", ")
+		}
+
+		switch {
+		case a.Kind.IsImm():
+			sb.WriteString(a.Name())
+		case a.Kind == ArgKindIntReg:
+			sb.WriteString("$r" + a.Name())
+		default:
+			sb.WriteString("$" + a.Name())
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,59 @@
+package common
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+func TestFormatCatalog(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00110000 sub.w                  DJK",
+		"00100000 add.w                  DJK",
+		"02c00000 addi.d                 DJSk12",
+		"0a100000 fmadd.s                FdFjFkFa",
+	)
+
+	assert.Equal(t, []FormatInfo{
+		{
+			Format:    "DJK",
+			Mnemonics: []string{"add.w", "sub.w"},
+			Layout:    "0b-----------------_kkkkk_jjjjj_ddddd",
+			Syntax:    "add.w $rd, $rj, $rk",
+		},
+		{
+			Format:    "DJSk12",
+			Mnemonics: []string{"addi.d"},
+			Layout:    "0b----------_ssssssssssss_jjjjj_ddddd",
+			Syntax:    "addi.d $rd, $rj, sk12",
+		},
+		{
+			Format:    "FdFjFkFa",
+			Mnemonics: []string{"fmadd.s"},
+			Layout:    "0b------------_fffff_fffff_fffff_fffff",
+			Syntax:    "fmadd.s $fd, $fj, $fk, $fa",
+		},
+	}, FormatCatalog(descs))
+}
+
+func TestFormatCatalogCorpus(t *testing.T) {
+	result, err := json.MarshalIndent(FormatCatalog(Builtin()), "", "  ")
+	assert.NoError(t, err)
+	result = append(result, '\n')
+
+	const goldenPath = "testdata/formatcatalog.json.golden"
+	if *update {
+		err := ioutil.WriteFile(goldenPath, result, 0644)
+		assert.NoError(t, err)
+	}
+
+	expected, err := ioutil.ReadFile(goldenPath)
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(result))
+}
@@ -0,0 +1,274 @@
+package common
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+)
+
+// FixedMask returns the mask of bits in the insn word that are not covered by
+// any operand or reserved slot, i.e. the bits that take part in opcode
+// matching.
+func (d *InsnDescription) FixedMask() uint32 {
+	return d.Format.MatchBitmask() &^ d.ReservedMask()
+}
+
+// FixedBitRanges returns the maximal runs of fixed opcode bits in the insn
+// word, from the MSB to the LSB.
+func (d *InsnDescription) FixedBitRanges() []BitRange {
+	mask := d.FixedMask()
+
+	var result []BitRange
+	for i := 31; i >= 0; i-- {
+		if mask&(1<<i) == 0 {
+			continue
+		}
+
+		msb := uint(i)
+		for i > 0 && mask&(1<<(i-1)) != 0 {
+			i--
+		}
+		result = append(result, BitRange{MSB: msb, LSB: uint(i)})
+	}
+	return result
+}
+
+// Matches reports whether word is an encoding of this instruction, without
+// regard to the reserved slots.
+func (d *InsnDescription) Matches(word uint32) bool {
+	return word&d.FixedMask() == d.Word
+}
+
+// ReservedBitsSet reports whether word has any bit set in the reserved slots
+// of this instruction, which makes the encoding illegal.
+func (d *InsnDescription) ReservedBitsSet(word uint32) bool {
+	return word&d.ReservedMask() != 0
+}
+
+// Extract returns the value of the arg in the insn word, with the slots
+// concatenated from left (MSB direction) to right (LSB direction), and sign
+// extended if the arg is a signed immediate.
+func (a *Arg) Extract(word uint32) int64 {
+	// e.g. for Sd5k16 the 5 bits at offset 0 end up as bits [20:16] of the
+	// value, and the 16 bits at offset 10 as bits [15:0]
+	var result uint64
+	valueOffsets := a.SlotValueOffsets()
+	for i, s := range a.Slots {
+		slotVal := (word & s.Bitmask()) >> s.Offset
+		result |= uint64(slotVal) << valueOffsets[i]
+	}
+
+	if a.Kind == ArgKindSignedImm {
+		// sign-extend
+		shamt := 64 - a.TotalWidth()
+		return int64(result<<shamt) >> shamt
+	}
+
+	return int64(result)
+}
+
+// ExtractArgs returns the values of all args of the format in the insn word.
+func (f *InsnFormat) ExtractArgs(word uint32) []int64 {
+	result := make([]int64, len(f.Args))
+	for i, a := range f.Args {
+		result[i] = a.Extract(word)
+	}
+	return result
+}
+
+type DecodedInsn struct {
+	Word uint32
+	Desc *InsnDescription
+	Args []int64
+	// Illegal is set if the word matches the opcode of Desc, but has
+	// reserved bits set.
+	Illegal bool
+}
+
+func (x *DecodedInsn) String() string {
+	if x.Illegal {
+		return "<illegal: reserved bits set>"
+	}
+
+	if len(x.Args) == 0 {
+		return x.Desc.Mnemonic
+	}
+
+	var sb strings.Builder
+	sb.WriteString(x.Desc.Mnemonic)
+	for i, a := range x.Desc.Format.Args {
+		if i == 0 {
+			sb.WriteRune(' ')
+		} else {
+			sb.WriteString(", ")
+		}
+
+		sb.WriteString(formatArgValue(a, x.Args[i]))
+	}
+
+	return sb.String()
+}
+
+// AsmString returns the insn in assembly syntax, with the operands in
+// assembly order (see InsnDescription.SyntaxArgIndices), and rendered
+// symbolically where their role allows, like the operation code of cacop.
+// Unlike String, the result is not necessarily accepted by Assembler.
+func (x *DecodedInsn) AsmString() string {
+	if x.Illegal || len(x.Args) == 0 {
+		return x.String()
+	}
+
+	roles := x.Desc.ArgRoles()
+
+	var sb strings.Builder
+	sb.WriteString(x.Desc.Mnemonic)
+	for j, i := range x.Desc.SyntaxArgIndices() {
+		if j == 0 {
+			sb.WriteRune(' ')
+		} else {
+			sb.WriteString(", ")
+		}
+
+		if roles[i] == ArgRoleCacheOp {
+			if name, ok := CacheOpName(x.Args[i]); ok {
+				sb.WriteString(name)
+				continue
+			}
+		}
+		sb.WriteString(formatArgValue(x.Desc.Format.Args[i], x.Args[i]))
+	}
+
+	return sb.String()
+}
+
+func formatArgValue(a *Arg, v int64) string {
+	if a.Kind.IsImm() {
+		return fmt.Sprintf("%d", v)
+	}
+	return RegName(a.Kind, v)
+}
+
+// InsnDecoder finds the instruction a given word encodes.
+type InsnDecoder interface {
+	// Lookup returns the description of the instruction encoded by word,
+	// or nil if the word does not encode any known instruction.
+	Lookup(word uint32) *InsnDescription
+	Decode(word uint32) (*DecodedInsn, bool)
+}
+
+// decoderEntry caches the (mask, match) pair of an insn for faster matching.
+type decoderEntry struct {
+	mask  uint32
+	match uint32
+	desc  *InsnDescription
+}
+
+func makeDecoderEntries(descs []*InsnDescription) []decoderEntry {
+	result := make([]decoderEntry, len(descs))
+	for i, d := range descs {
+		result[i] = decoderEntry{
+			mask:  d.FixedMask(),
+			match: d.Word,
+			desc:  d,
+		}
+	}
+
+	// more specific encodings (more fixed bits) are tried first, so that
+	// special-cased sub-encodings of other instructions take precedence
+	sort.SliceStable(result, func(i int, j int) bool {
+		ni := bits.OnesCount32(result[i].mask)
+		nj := bits.OnesCount32(result[j].mask)
+		if ni != nj {
+			return ni > nj
+		}
+		return result[i].match < result[j].match
+	})
+
+	return result
+}
+
+func lookupInDecoderEntries(entries []decoderEntry, word uint32) *InsnDescription {
+	for i := range entries {
+		if word&entries[i].mask == entries[i].match {
+			return entries[i].desc
+		}
+	}
+	return nil
+}
+
+func decodeWithDesc(d *InsnDescription, word uint32) (*DecodedInsn, bool) {
+	if d == nil {
+		return nil, false
+	}
+
+	return &DecodedInsn{
+		Word:    word,
+		Desc:    d,
+		Args:    d.Format.ExtractArgs(word),
+		Illegal: d.ReservedBitsSet(word),
+	}, true
+}
+
+// Decoder is an InsnDecoder that linearly scans the (mask, match) pairs of
+// all known instructions.
+type Decoder struct {
+	entries []decoderEntry
+}
+
+func NewDecoder(descs []*InsnDescription) *Decoder {
+	return &Decoder{
+		entries: makeDecoderEntries(descs),
+	}
+}
+
+func (x *Decoder) Lookup(word uint32) *InsnDescription {
+	return lookupInDecoderEntries(x.entries, word)
+}
+
+func (x *Decoder) Decode(word uint32) (*DecodedInsn, bool) {
+	return decodeWithDesc(x.Lookup(word), word)
+}
+
+const maxIndexedDecoderBits = 10
+
+// IndexedDecoder is an InsnDecoder that first dispatches on the high-order
+// bits that are fixed in every known instruction, then linearly scans the
+// much smaller set of candidates sharing those bits.
+type IndexedDecoder struct {
+	shift   uint
+	buckets [][]decoderEntry
+}
+
+func NewIndexedDecoder(descs []*InsnDescription) *IndexedDecoder {
+	// find the run of high-order bits fixed in every insn
+	commonMask := ^uint32(0)
+	for _, d := range descs {
+		commonMask &= d.FixedMask()
+	}
+
+	indexBits := uint(bits.LeadingZeros32(^commonMask))
+	if indexBits > maxIndexedDecoderBits {
+		indexBits = maxIndexedDecoderBits
+	}
+
+	shift := 32 - indexBits
+	buckets := make([][]decoderEntry, 1<<indexBits)
+	for _, e := range makeDecoderEntries(descs) {
+		idx := uint64(e.match) >> shift
+		buckets[idx] = append(buckets[idx], e)
+	}
+
+	return &IndexedDecoder{
+		shift:   shift,
+		buckets: buckets,
+	}
+}
+
+func (x *IndexedDecoder) Lookup(word uint32) *InsnDescription {
+	return lookupInDecoderEntries(x.buckets[uint64(word)>>x.shift], word)
+}
+
+func (x *IndexedDecoder) Decode(word uint32) (*DecodedInsn, bool) {
+	return decodeWithDesc(x.Lookup(word), word)
+}
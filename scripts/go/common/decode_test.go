@@ -0,0 +1,315 @@
+package common
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArgExtract(t *testing.T) {
+	testcases := []struct {
+		fmt      string
+		word     uint32
+		expected []int64
+	}{
+		{fmt: "DJK", word: 0x00101483, expected: []int64{3, 4, 5}},
+		{fmt: "DJSk12", word: 0x02ffc0a4, expected: []int64{4, 5, -16}},
+		{fmt: "DJSk12", word: 0x021ffca4, expected: []int64{4, 5, 2047}},
+		{fmt: "DJSk12", word: 0x022000a4, expected: []int64{4, 5, -2048}},
+		{fmt: "DJUk12", word: 0x03bffca4, expected: []int64{4, 5, 4095}},
+		{fmt: "JSd5k16", word: 0x40000090, expected: []int64{4, -0x100000}},
+		{fmt: "JSd5k16", word: 0x43fffc8f, expected: []int64{4, 0xfffff}},
+		{fmt: "JSd5k16", word: 0x43fffc9f, expected: []int64{4, -1}},
+		{fmt: "Sd10k16", word: 0x50000200, expected: []int64{-0x2000000}},
+		{fmt: "Sd10k16", word: 0x53fffdff, expected: []int64{0x1ffffff}},
+		{fmt: "Sd10k16", word: 0x50000001, expected: []int64{0x10000}},
+		{fmt: "Sd10k16", word: 0x50000400, expected: []int64{1}},
+		{fmt: "CdFjFk", word: 0x0c1018a7, expected: []int64{7, 5, 6}},
+	}
+
+	for _, tc := range testcases {
+		f, err := ParseInsnFormat(tc.fmt)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, f.ExtractArgs(tc.word), "%s %08x", tc.fmt, tc.word)
+	}
+}
+
+func TestMultiSlotImmRoundTripOverCorpus(t *testing.T) {
+	descs := Builtin()
+	dec := NewIndexedDecoder(descs)
+
+	seenFormats := make(map[string]bool)
+	for _, d := range descs {
+		for i, a := range d.Format.Args {
+			if !a.Kind.IsImm() || len(a.Slots) < 2 {
+				continue
+			}
+			seenFormats[d.Format.CanonicalRepr()] = true
+
+			min, max := a.MinValue(), a.MaxValue()
+			values := []int64{min, min + 1, 0, 1, max - 1, max}
+			if a.Kind == ArgKindSignedImm {
+				values = append(values, -1)
+			}
+			// alternating bits across the slot boundaries
+			values = append(values, 0x5555555&max, 0xaaaaaaa&max)
+
+			for _, v := range values {
+				args := make([]int64, len(d.Format.Args))
+				args[i] = v
+				word := d.Encode(args)
+
+				x, ok := dec.Decode(word)
+				if !assert.True(t, ok, "%s %08x", d.Mnemonic, word) {
+					continue
+				}
+				assert.Equal(t, d, x.Desc, "%s %08x", d.Mnemonic, word)
+				assert.Equal(t, args, x.Args, "%s %08x", d.Mnemonic, word)
+			}
+		}
+	}
+
+	// make sure the interesting cases are really exercised
+	for _, f := range []string{"JSd5k16", "Sd10k16"} {
+		assert.True(t, seenFormats[f], f)
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"02c00000 addi.d                 DJSk12",
+		"40000000 beqz                   JSd5k16",
+		"06483800 tlbclr                 EMPTY",
+		"06480000 iocsrrd.b              DJ",
+		"00010000 asrtle                 JK              @reserved=d5",
+	)
+
+	for _, dec := range []InsnDecoder{NewDecoder(descs), NewIndexedDecoder(descs), BuildDecodeTree(descs)} {
+		testDecoder(t, dec)
+	}
+}
+
+func testDecoder(t *testing.T, dec InsnDecoder) {
+	x, ok := dec.Decode(0x02ffc0a4)
+	assert.True(t, ok)
+	assert.Equal(t, "addi.d", x.Desc.Mnemonic)
+	assert.Equal(t, []int64{4, 5, -16}, x.Args)
+	assert.Equal(t, "addi.d $r4, $r5, -16", x.String())
+
+	x, ok = dec.Decode(0x06483800)
+	assert.True(t, ok)
+	assert.Equal(t, "tlbclr", x.String())
+
+	x, ok = dec.Decode(0x43fffc9f)
+	assert.True(t, ok)
+	assert.Equal(t, "beqz $r4, -1", x.String())
+
+	_, ok = dec.Decode(0xffffffff)
+	assert.False(t, ok)
+
+	x, ok = dec.Decode(0x00011480)
+	assert.True(t, ok)
+	assert.False(t, x.Illegal)
+	assert.Equal(t, "asrtle $r4, $r5", x.String())
+
+	// matches the opcode of asrtle, but with the reserved rd field non-zero
+	x, ok = dec.Decode(0x00011481)
+	assert.True(t, ok)
+	assert.Equal(t, "asrtle", x.Desc.Mnemonic)
+	assert.True(t, x.Illegal)
+	assert.Equal(t, "<illegal: reserved bits set>", x.String())
+}
+
+func TestDecodersAgreeOverCorpus(t *testing.T) {
+	descs := Builtin()
+	linear := NewDecoder(descs)
+	indexed := NewIndexedDecoder(descs)
+
+	for _, d := range descs {
+		// the base word itself must decode to the insn, or to one of its
+		// more specific sub-encodings
+		assert.Equal(t, linear.Lookup(d.Word), indexed.Lookup(d.Word), d.Mnemonic)
+		assert.NotNil(t, linear.Lookup(d.Word), d.Mnemonic)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 20000; i++ {
+		w := rng.Uint32()
+		assert.Equal(t, linear.Lookup(w), indexed.Lookup(w), "%08x", w)
+	}
+}
+
+func TestDecodeTreeOverCorpus(t *testing.T) {
+	descs := Builtin()
+	linear := NewDecoder(descs)
+	tree := BuildDecodeTree(descs)
+
+	// the first switch is on the primary opcode field, minus the MSB that is
+	// zero in all insns
+	assert.Equal(t, uint(26), tree.Start)
+	assert.Greater(t, tree.Depth(), 1)
+
+	rng := rand.New(rand.NewSource(1))
+	check := func(w uint32) {
+		assert.Equal(t, linear.Lookup(w), tree.Lookup(w), "%08x", w)
+	}
+
+	for _, d := range descs {
+		check(d.Word)
+
+		// valid encodings with random operands, and their neighbors one
+		// bit flip away
+		for i := 0; i < 8; i++ {
+			args := make([]int64, len(d.Format.Args))
+			for j, a := range d.Format.Args {
+				args[j] = randomArgValue(rng, a)
+			}
+			w := d.Encode(args)
+			check(w)
+			check(w ^ (1 << rng.Intn(32)))
+		}
+	}
+
+	for i := 0; i < 20000; i++ {
+		check(rng.Uint32())
+	}
+}
+
+// insnMixWeight returns the relative frequency of the insn in the
+// representative mix used for benchmarking: mostly integer ALU ops and
+// branches, some FP, and few SIMD and LBT insns.
+func insnMixWeight(d *InsnDescription) int {
+	switch d.Mnemonic {
+	case "b", "bl", "jirl", "beq", "bne", "bgt", "ble", "bgtu", "bleu",
+		"beqz", "bnez":
+		return 32
+	case "ld.b", "ld.h", "ld.w", "ld.d", "ld.bu", "ld.hu", "ld.wu",
+		"st.b", "st.h", "st.w", "st.d":
+		return 32
+	}
+
+	f := d.Format
+	switch {
+	case f.HasKind(ArgKindVReg), f.HasKind(ArgKindXReg), f.HasKind(ArgKindScratchReg):
+		return 1
+	case f.HasKind(ArgKindFPReg), f.HasKind(ArgKindFCCReg):
+		return 4
+	}
+	return 16
+}
+
+func randomArgValue(rng *rand.Rand, a *Arg) int64 {
+	return a.MinValue() + rng.Int63n(a.MaxValue()-a.MinValue()+1)
+}
+
+func makeInsnMixForBenchmark(b *testing.B, descs []*InsnDescription, n int) []uint32 {
+	var cumulativeWeights []int
+	totalWeight := 0
+	for _, d := range descs {
+		totalWeight += insnMixWeight(d)
+		cumulativeWeights = append(cumulativeWeights, totalWeight)
+	}
+
+	// fixed seed for reproducibility
+	rng := rand.New(rand.NewSource(42))
+	result := make([]uint32, n)
+	for i := range result {
+		r := rng.Intn(totalWeight)
+		idx := 0
+		for cumulativeWeights[idx] <= r {
+			idx++
+		}
+
+		d := descs[idx]
+		args := make([]int64, len(d.Format.Args))
+		for j, a := range d.Format.Args {
+			args[j] = randomArgValue(rng, a)
+		}
+
+		result[i] = d.Encode(args)
+	}
+
+	return result
+}
+
+func BenchmarkDecode(b *testing.B) {
+	descs := Builtin()
+	words := makeInsnMixForBenchmark(b, descs, 1<<16)
+
+	decoders := []struct {
+		name string
+		dec  InsnDecoder
+	}{
+		{name: "Linear", dec: NewDecoder(descs)},
+		{name: "Indexed", dec: NewIndexedDecoder(descs)},
+		{name: "Tree", dec: BuildDecodeTree(descs)},
+	}
+
+	for _, x := range decoders {
+		b.Run(x.name, func(b *testing.B) {
+			// one op is decoding the whole buffer
+			b.SetBytes(int64(len(words) * 4))
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				for _, w := range words {
+					if _, ok := x.dec.Decode(w); !ok {
+						b.Fatalf("failed to decode %08x", w)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestBitRanges(t *testing.T) {
+	// b: 010100 Sd10k16
+	d, err := ParseInsnDescriptionLine("50000000 b                      Sd10k16")
+	assert.NoError(t, err)
+	assert.Equal(t, []BitRange{{MSB: 9, LSB: 0}, {MSB: 25, LSB: 10}}, d.Format.Args[0].BitRanges())
+	assert.Equal(t, []BitRange{{MSB: 31, LSB: 26}}, d.FixedBitRanges())
+
+	// the reserved slot splits the fixed bits
+	d, err = ParseInsnDescriptionLine("00010000 asrtle.d               JK              @reserved=d5")
+	assert.NoError(t, err)
+	assert.Equal(t, []BitRange{{MSB: 31, LSB: 15}}, d.FixedBitRanges())
+
+	d, err = ParseInsnDescriptionLine("06483800 eret                   EMPTY")
+	assert.NoError(t, err)
+	assert.Equal(t, []BitRange{{MSB: 31, LSB: 0}}, d.FixedBitRanges())
+
+	d, err = ParseInsnDescriptionLine("38720000 ldgt.b                 DJK")
+	assert.NoError(t, err)
+	assert.Equal(t, []BitRange{{MSB: 4, LSB: 0}, {MSB: 9, LSB: 5}, {MSB: 14, LSB: 10}}, []BitRange{
+		d.Format.Args[0].BitRanges()[0],
+		d.Format.Args[1].BitRanges()[0],
+		d.Format.Args[2].BitRanges()[0],
+	})
+}
+
+// TestReorderedSplitImm checks a split imm whose slot at the higher offset
+// holds the less significant bits, unlike in Sd5k16.
+func TestReorderedSplitImm(t *testing.T) {
+	f, err := ParseInsnFormat("JSk16d5")
+	assert.NoError(t, err)
+	assert.Equal(t, "JSk16d5", f.CanonicalRepr())
+
+	a := f.Args[1]
+	assert.Equal(t, []uint{5, 0}, a.SlotValueOffsets())
+	// value bits [20:5] are at word bits [25:10], and [4:0] at [4:0]
+	assert.Equal(t, uint32(0x0000041f), a.Encode(0x3f))
+	assert.Equal(t, []int64{0, 0x3f}, f.ExtractArgs(0x0000041f))
+	assert.Equal(t, []int64{0, -1}, f.ExtractArgs(0x03fffc1f))
+
+	ref, err := ParseInsnFormat("JSd5k16")
+	assert.NoError(t, err)
+	assert.NotEqual(t, ref.Args[1].Encode(0x3f), a.Encode(0x3f))
+
+	for _, v := range []int64{a.MinValue(), -1, 0, 1, 0x12345, a.MaxValue()} {
+		word := a.Encode(v)
+		assert.Equal(t, v, a.Extract(word), "%d", v)
+	}
+}
@@ -0,0 +1,153 @@
+package common
+
+// maxDecodeTreeFieldBits is the maximum width of the field a DecodeTree node
+// switches on, to keep the number of cases per switch manageable.
+const maxDecodeTreeFieldBits = 8
+
+// DecodeTree is a node of a decision tree for decoding, suitable for
+// emitting nested switches in generated decoders. Inner nodes dispatch on the
+// field of Len bits at Start of the insn word; leaf nodes (Len == 0) hold the
+// candidates that have to be checked one by one.
+type DecodeTree struct {
+	Start uint
+	Len   uint
+	// Children are the subtrees indexed by field values; words with field
+	// values without a subtree don't encode any known insn.
+	Children []*DecodeTree
+
+	// Candidates are the insns to check in order, if this is a leaf node.
+	// More specific encodings come first.
+	Candidates []*InsnDescription
+	entries    []decoderEntry
+}
+
+// BuildDecodeTree builds a decision tree for the insns. At every node, the
+// field is chosen among the bits fixed in all remaining candidates, to split
+// the candidates into as many groups as possible.
+func BuildDecodeTree(descs []*InsnDescription) *DecodeTree {
+	return buildDecodeTree(makeDecoderEntries(descs))
+}
+
+func buildDecodeTree(entries []decoderEntry) *DecodeTree {
+	start, length := bestDecodeTreeField(entries)
+	if length == 0 {
+		candidates := make([]*InsnDescription, len(entries))
+		for i, e := range entries {
+			candidates[i] = e.desc
+		}
+		return &DecodeTree{
+			Candidates: candidates,
+			entries:    entries,
+		}
+	}
+
+	groups := make(map[uint32][]decoderEntry)
+	for _, e := range entries {
+		v := extractField(e.match, start, length)
+		groups[v] = append(groups[v], e)
+	}
+
+	children := make([]*DecodeTree, 1<<length)
+	for v, g := range groups {
+		children[v] = buildDecodeTree(g)
+	}
+
+	return &DecodeTree{
+		Start:    start,
+		Len:      length,
+		Children: children,
+	}
+}
+
+// bestDecodeTreeField returns the field splitting the entries into the most
+// groups, preferring narrower then higher fields in case of ties. The length
+// is 0 if the entries can't be split any further.
+func bestDecodeTreeField(entries []decoderEntry) (start uint, length uint) {
+	if len(entries) < 2 {
+		return 0, 0
+	}
+
+	commonMask := ^uint32(0)
+	for _, e := range entries {
+		commonMask &= e.mask
+	}
+
+	bestGroups := 1
+	seen := make(map[uint32]struct{})
+	for l := uint(1); l <= maxDecodeTreeFieldBits; l++ {
+		for s := 32 - l; ; s-- {
+			fieldMask := uint32((uint64(1)<<l)-1) << s
+			if commonMask&fieldMask == fieldMask {
+				for k := range seen {
+					delete(seen, k)
+				}
+				for _, e := range entries {
+					seen[extractField(e.match, s, l)] = struct{}{}
+				}
+
+				if len(seen) > bestGroups {
+					bestGroups = len(seen)
+					start, length = s, l
+				}
+			}
+
+			if s == 0 {
+				break
+			}
+		}
+	}
+
+	return start, length
+}
+
+func extractField(word uint32, start uint, length uint) uint32 {
+	return uint32((uint64(word) >> start) & ((uint64(1) << length) - 1))
+}
+
+// IsLeaf returns whether the node holds candidates instead of dispatching.
+func (t *DecodeTree) IsLeaf() bool {
+	return t.Len == 0
+}
+
+// Cases returns the field values with children, in ascending order.
+func (t *DecodeTree) Cases() []uint32 {
+	var result []uint32
+	for v, c := range t.Children {
+		if c != nil {
+			result = append(result, uint32(v))
+		}
+	}
+	return result
+}
+
+// Depth returns the maximum number of switches from this node to a leaf.
+func (t *DecodeTree) Depth() int {
+	if t.IsLeaf() {
+		return 0
+	}
+
+	result := 0
+	for _, c := range t.Children {
+		if c == nil {
+			continue
+		}
+		if d := c.Depth(); d > result {
+			result = d
+		}
+	}
+	return result + 1
+}
+
+func (t *DecodeTree) Lookup(word uint32) *InsnDescription {
+	for !t.IsLeaf() {
+		t = t.Children[extractField(word, t.Start, t.Len)]
+		if t == nil {
+			return nil
+		}
+	}
+	return lookupInDecoderEntries(t.entries, word)
+}
+
+func (t *DecodeTree) Decode(word uint32) (*DecodedInsn, bool) {
+	return decodeWithDesc(t.Lookup(word), word)
+}
@@ -0,0 +1,160 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+const readsKey = "reads"
+const writesKey = "writes"
+
+// ArgAccess tells how an insn accesses a register arg. Immediate args are
+// never accessed.
+type ArgAccess uint8
+
+const (
+	ArgAccessRead ArgAccess = 1 << iota
+	ArgAccessWrite
+
+	ArgAccessNone      ArgAccess = 0
+	ArgAccessReadWrite           = ArgAccessRead | ArgAccessWrite
+)
+
+func (x ArgAccess) String() string {
+	switch x {
+	case ArgAccessNone:
+		return "none"
+	case ArgAccessRead:
+		return "read"
+	case ArgAccessWrite:
+		return "write"
+	case ArgAccessReadWrite:
+		return "read-write"
+	default:
+		return fmt.Sprintf("ArgAccess(%d)", uint8(x))
+	}
+}
+
+// ArgAccesses returns how the insn accesses each arg, in the order of the
+// args.
+//
+// By default the register in the d slot, if any, is the only one written, and
+// all other registers are read, which is how the vast majority of insns are
+// laid out. Insns deviating from this list the register args they write
+// and read in the @writes and @reads attribs respectively; e.g. the
+// conditional branches and the stores read the register in the d slot and
+// write nothing (@writes=), while sc.w also reads it (@reads=d,j).
+func (d *InsnDescription) ArgAccesses() []ArgAccess {
+	result, err := d.argAccesses()
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// IsOutput reports whether the insn writes the arg i.
+func (d *InsnDescription) IsOutput(i int) bool {
+	return d.ArgAccesses()[i]&ArgAccessWrite != 0
+}
+
+// IsInput reports whether the insn reads the arg i.
+func (d *InsnDescription) IsInput(i int) bool {
+	return d.ArgAccesses()[i]&ArgAccessRead != 0
+}
+
+// OutputArgs returns the indices of all args the insn writes, in the order of
+// the args. Insns may write any number of register args; e.g. stores write
+// none, while an insn producing a pair of results writes two (@writes=d,a).
+func (d *InsnDescription) OutputArgs() []int {
+	return d.argsWithAccess(ArgAccessWrite)
+}
+
+// InputArgs returns the indices of all args the insn reads, in the order of
+// the args.
+func (d *InsnDescription) InputArgs() []int {
+	return d.argsWithAccess(ArgAccessRead)
+}
+
+func (d *InsnDescription) argsWithAccess(access ArgAccess) []int {
+	var result []int
+	for i, acc := range d.ArgAccesses() {
+		if acc&access != 0 {
+			result = append(result, i)
+		}
+	}
+	return result
+}
+
+func (d *InsnDescription) argAccesses() ([]ArgAccess, error) {
+	result := make([]ArgAccess, len(d.Format.Args))
+
+	writes, ok, err := d.parseAccessedArgs(writesKey)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		for _, i := range writes {
+			result[i] |= ArgAccessWrite
+		}
+	} else {
+		for i, a := range d.Format.Args {
+			if !a.Kind.IsImm() && a.Slots[0].Offset == 0 {
+				result[i] |= ArgAccessWrite
+			}
+		}
+	}
+
+	reads, ok, err := d.parseAccessedArgs(readsKey)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		for _, i := range reads {
+			result[i] |= ArgAccessRead
+		}
+	} else {
+		for i, a := range d.Format.Args {
+			if !a.Kind.IsImm() && result[i] == ArgAccessNone {
+				result[i] |= ArgAccessRead
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// parseAccessedArgs parses the comma-separated register arg names in the
+// attrib, returning their indices, and whether the attrib is present at all.
+func (d *InsnDescription) parseAccessedArgs(key string) ([]int, bool, error) {
+	names, ok := d.Attribs[key]
+	if !ok {
+		return nil, false, nil
+	}
+	if names == "" {
+		return nil, true, nil
+	}
+
+	var result []int
+	for _, name := range strings.Split(names, ",") {
+		idx := -1
+		for i, a := range d.Format.Args {
+			if a.Name() == name {
+				idx = i
+				break
+			}
+		}
+
+		if idx < 0 || d.Format.Args[idx].Kind.IsImm() {
+			return nil, false, fmt.Errorf(
+				"%s arg %s is not a register arg of %s",
+				key,
+				name,
+				d.Format.CanonicalRepr(),
+			)
+		}
+
+		result = append(result, idx)
+	}
+
+	return result, true, nil
+}
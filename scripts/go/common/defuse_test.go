@@ -0,0 +1,132 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsnDescriptionArgAccesses(t *testing.T) {
+	const (
+		none = ArgAccessNone
+		r    = ArgAccessRead
+		w    = ArgAccessWrite
+		rw   = ArgAccessReadWrite
+	)
+
+	testcases := []struct {
+		line     string
+		expected []ArgAccess
+	}{
+		{line: "00100000 add.w                  DJK", expected: []ArgAccess{w, r, r}},
+		{line: "06483800 eret                   EMPTY", expected: []ArgAccess{}},
+		{line: "4c000000 jirl                   DJSk16", expected: []ArgAccess{w, r, none}},
+		{line: "0c100000 fcmp.caf.s             CdFjFk", expected: []ArgAccess{w, r, r}},
+
+		// the d slot is part of the offset, so there is no output
+		{line: "40000000 beqz                   JSd5k16", expected: []ArgAccess{r, none}},
+		{line: "44000000 bnez                   JSd5k16", expected: []ArgAccess{r, none}},
+		{line: "48000000 bceqz                  CjSd5k16", expected: []ArgAccess{r, none}},
+		{line: "48000100 bcnez                  CjSd5k16", expected: []ArgAccess{r, none}},
+		{line: "50000000 b                      Sd10k16", expected: []ArgAccess{none}},
+
+		// the register in the d slot is compared, not written
+		{line: "58000000 beq                    DJSk16          @writes=", expected: []ArgAccess{r, r, none}},
+		{line: "29c00000 st.d                   DJSk12          @writes=", expected: []ArgAccess{r, r, none}},
+		{line: "21000000 sc.d                   DJSk14          @reads=d,j", expected: []ArgAccess{rw, r, none}},
+		{line: "00000000 foo                    DJK             @writes=d,k @reads=j", expected: []ArgAccess{w, r, w}},
+	}
+
+	for _, tc := range testcases {
+		d, err := ParseInsnDescriptionLine(tc.line)
+		if !assert.NoError(t, err, tc.line) {
+			continue
+		}
+
+		assert.Equal(t, tc.expected, d.ArgAccesses(), tc.line)
+		var outputs, inputs []int
+		for i, x := range tc.expected {
+			if x&ArgAccessWrite != 0 {
+				outputs = append(outputs, i)
+			}
+			if x&ArgAccessRead != 0 {
+				inputs = append(inputs, i)
+			}
+			assert.Equal(t, x&ArgAccessWrite != 0, d.IsOutput(i), "%s: arg %d", tc.line, i)
+			assert.Equal(t, x&ArgAccessRead != 0, d.IsInput(i), "%s: arg %d", tc.line, i)
+		}
+		assert.Equal(t, outputs, d.OutputArgs(), tc.line)
+		assert.Equal(t, inputs, d.InputArgs(), tc.line)
+	}
+}
+
+func TestInsnDescriptionMultipleOutputs(t *testing.T) {
+	// a synthetic insn writing the quotient to rd and the remainder to ra
+	d, err := ParseInsnDescriptionLine("00200000 divmod.w               DJKA            @writes=d,a")
+	assert.NoError(t, err)
+	assert.Equal(t, []int{0, 3}, d.OutputArgs())
+	assert.Equal(t, []int{1, 2}, d.InputArgs())
+	assert.Equal(t, []int{0, 3}, MakeInsnSpec(d).Outputs)
+
+	x, ok := NewDecoder([]*InsnDescription{d}).Decode(0x00231483)
+	assert.True(t, ok)
+	assert.Equal(t, "divmod.w $r3, $r4, $r5, $r6", x.String())
+
+	// no outputs at all
+	d, err = ParseInsnDescriptionLine("29c00000 st.d                   DJSk12          @writes=")
+	assert.NoError(t, err)
+	assert.Empty(t, d.OutputArgs())
+	assert.Empty(t, MakeInsnSpec(d).Outputs)
+}
+
+func TestInsnDescriptionArgAccessesErrors(t *testing.T) {
+	for _, l := range []string{
+		"58000000 beq                    DJSk16          @writes=sk16",
+		"58000000 beq                    DJSk16          @writes=a",
+		"21000000 sc.d                   DJSk14          @reads=d,x",
+	} {
+		_, err := ParseInsnDescriptionLine(l)
+		assert.Error(t, err, l)
+	}
+}
+
+func TestConditionalBranchesWriteNothingOverCorpus(t *testing.T) {
+	descs := Builtin()
+
+	seen := 0
+	for _, d := range descs {
+		switch d.Mnemonic {
+		case "beqz", "bnez", "bceqz", "bcnez", "beq", "bne", "bgt", "ble", "bgtu", "bleu":
+		default:
+			continue
+		}
+		seen++
+
+		for i, a := range d.Format.Args {
+			assert.False(t, d.IsOutput(i), "%s %s", d.Mnemonic, a.Name())
+			assert.Equal(t, !a.Kind.IsImm(), d.IsInput(i), "%s %s", d.Mnemonic, a.Name())
+		}
+	}
+	assert.Equal(t, 10, seen)
+}
+
+func TestLBTFlagMovesOverCorpus(t *testing.T) {
+	expected := map[string][]ArgAccess{
+		// rd is moved into the flags, nothing is written
+		"x86mtflag": {ArgAccessRead, ArgAccessNone},
+		"armmtflag": {ArgAccessRead, ArgAccessNone},
+		// rd is only overwritten if the condition holds
+		"armmove": {ArgAccessReadWrite, ArgAccessRead, ArgAccessNone},
+	}
+
+	seen := 0
+	for _, d := range Builtin() {
+		want, ok := expected[d.Mnemonic]
+		if !ok {
+			continue
+		}
+		seen++
+		assert.Equal(t, want, d.ArgAccesses(), d.Mnemonic)
+	}
+	assert.Equal(t, len(expected), seen)
+}
@@ -3,6 +3,7 @@ package common
 import (
 	"bytes"
 	"fmt"
+	"go/build/constraint"
 	"go/format"
 )
 
@@ -28,3 +29,38 @@ func (c *EmitterCtx) Finalize() []byte {
 
 	return result
 }
+
+// BuildConstraintLines returns the "//go:build" line and the equivalent
+// legacy "// +build" lines for the build constraint expression expr.
+func BuildConstraintLines(expr string) ([]string, error) {
+	x, err := constraint.Parse("//go:build " + expr)
+	if err != nil {
+		return nil, err
+	}
+
+	plusBuildLines, err := constraint.PlusBuildLines(x)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]string, 0, 1+len(plusBuildLines))
+	result = append(result, "//go:build "+x.String())
+	result = append(result, plusBuildLines...)
+	return result, nil
+}
+
+// EmitBuildConstraint emits the build constraint lines for expr, followed by
+// a blank line. It must be called before the package clause is emitted.
+func (c *EmitterCtx) EmitBuildConstraint(expr string) error {
+	lines, err := BuildConstraintLines(expr)
+	if err != nil {
+		return err
+	}
+
+	for _, l := range lines {
+		c.Emit("%s\n", l)
+	}
+	c.Emit("\n")
+
+	return nil
+}
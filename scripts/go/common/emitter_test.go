@@ -0,0 +1,28 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmitBuildConstraint(t *testing.T) {
+	var ectx EmitterCtx
+	ectx.Emit("// Code generated by test; DO NOT EDIT.\n\n")
+	assert.NoError(t, ectx.EmitBuildConstraint("loong64 &&   !purego"))
+	ectx.Emit("package loong\n\nvar x   = 1\n")
+
+	expected := `// Code generated by test; DO NOT EDIT.
+
+//go:build loong64 && !purego
+// +build loong64,!purego
+
+package loong
+
+var x = 1
+`
+	assert.Equal(t, expected, string(ectx.Finalize()))
+
+	var ectx2 EmitterCtx
+	assert.Error(t, ectx2.EmitBuildConstraint("loong64 &&"))
+}
@@ -0,0 +1,139 @@
+package common
+
+import "sort"
+
+// MinValue returns the minimum value the arg can take.
+func (a *Arg) MinValue() int64 {
+	if a.Kind == ArgKindSignedImm {
+		return -(int64(1) << (a.TotalWidth() - 1))
+	}
+	return 0
+}
+
+// MaxValue returns the maximum value the arg can take.
+func (a *Arg) MaxValue() int64 {
+	if max, isReg := a.Kind.RegClassMax(); isReg {
+		return int64(max)
+	}
+
+	if a.Kind == ArgKindSignedImm {
+		return (int64(1) << (a.TotalWidth() - 1)) - 1
+	}
+	return (int64(1) << a.TotalWidth()) - 1
+}
+
+// Scale returns the factor the encoded value is multiplied by, as given by the
+// postprocess op of the arg. It is only meaningful for manual syntax args.
+func (a *Arg) Scale() int64 {
+	if a.Post.Kind == PostprocessOpKindShl {
+		return int64(1) << a.Post.Amount
+	}
+	return 1
+}
+
+// Bias returns the amount added to the encoded value, as given by the
+// postprocess op of the arg. It is only meaningful for manual syntax args.
+func (a *Arg) Bias() int64 {
+	if a.Post.Kind == PostprocessOpKindAdd {
+		return int64(a.Post.Amount)
+	}
+	return 0
+}
+
+// ArgShifts returns, for every arg in the canonical order, the amount the
+// value written in assembly is implicitly shifted right by to get the encoded
+// value, as given by the shift postprocess op of the manual syntax arg, e.g. 2
+// for the offsets of the branches; 0 for the args without one.
+func (d *InsnDescription) ArgShifts() []uint {
+	result := make([]uint, len(d.Format.Args))
+	indices := d.ManualSyntaxArgIndices()
+	for i, a := range d.ManualSyntaxArgs() {
+		if a.Post.Kind == PostprocessOpKindShl {
+			result[indices[i]] = uint(a.Post.Amount)
+		}
+	}
+	return result
+}
+
+// AsmMinValue returns the minimum value of the arg as written in assembly,
+// i.e. after applying the postprocess op to MinValue.
+func (a *Arg) AsmMinValue() int64 {
+	return a.MinValue()*a.Scale() + a.Bias()
+}
+
+// AsmMaxValue returns the maximum value of the arg as written in assembly,
+// i.e. after applying the postprocess op to MaxValue.
+func (a *Arg) AsmMaxValue() int64 {
+	return a.MaxValue()*a.Scale() + a.Bias()
+}
+
+// SampleValues returns some interesting values of the arg for tests, in
+// ascending order: the bounds, the values around zero, and the alternating
+// bit patterns of the arg's width.
+func (a *Arg) SampleValues() []int64 {
+	min, max := a.MinValue(), a.MaxValue()
+	width := a.TotalWidth()
+	mask := uint64(1)<<width - 1
+
+	candidates := []int64{min, max, 0, 1, -1}
+	for _, pattern := range []uint64{0x5555555555555555, 0xaaaaaaaaaaaaaaaa} {
+		v := int64(pattern & mask)
+		if a.Kind == ArgKindSignedImm && v > max {
+			// sign-extend
+			v -= int64(1) << width
+		}
+		candidates = append(candidates, v)
+	}
+
+	var result []int64
+	for _, v := range candidates {
+		if v < min || v > max {
+			continue
+		}
+		result = append(result, v)
+	}
+
+	sort.Slice(result, func(i int, j int) bool {
+		return result[i] < result[j]
+	})
+
+	// dedup
+	n := 0
+	for i, v := range result {
+		if i == 0 || v != result[n-1] {
+			result[n] = v
+			n++
+		}
+	}
+	return result[:n]
+}
+
+// Encode returns the bits of the insn word that represent the value v of the
+// arg. Bits of v that don't fit in the arg are silently discarded.
+func (a *Arg) Encode(v int64) uint32 {
+	var result uint32
+
+	valueOffsets := a.SlotValueOffsets()
+	for i, s := range a.Slots {
+		slotWidthMask := (uint64(1) << s.Width) - 1
+		slotVal := (uint64(v) >> valueOffsets[i]) & slotWidthMask
+
+		result |= uint32(slotVal) << s.Offset
+	}
+
+	return result
+}
+
+// Encode returns the insn word with the given arg values. The values are not
+// validated; see Arg.Encode for the handling of out-of-range values.
+func (d *InsnDescription) Encode(args []int64) uint32 {
+	if len(args) != len(d.Format.Args) {
+		panic("wrong number of args")
+	}
+
+	result := d.Word
+	for i, a := range d.Format.Args {
+		result |= a.Encode(args[i])
+	}
+	return result
+}
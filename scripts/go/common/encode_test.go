@@ -0,0 +1,198 @@
+package common
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestArgRange(t *testing.T) {
+	testcases := []struct {
+		fmt string
+		min []int64
+		max []int64
+	}{
+		{fmt: "DJSk12", min: []int64{0, 0, -2048}, max: []int64{31, 31, 2047}},
+		{fmt: "DJUk12", min: []int64{0, 0, 0}, max: []int64{31, 31, 4095}},
+		{fmt: "CdFjFk", min: []int64{0, 0, 0}, max: []int64{7, 31, 31}},
+		{fmt: "JSd5k16", min: []int64{0, -0x100000}, max: []int64{31, 0xfffff}},
+		{fmt: "VdJSk8Un1", min: []int64{0, 0, -128, 0}, max: []int64{31, 31, 127, 1}},
+	}
+
+	for _, tc := range testcases {
+		f, err := ParseInsnFormat(tc.fmt)
+		assert.NoError(t, err)
+
+		for i, a := range f.Args {
+			assert.Equal(t, tc.min[i], a.MinValue(), "%s arg %d", tc.fmt, i)
+			assert.Equal(t, tc.max[i], a.MaxValue(), "%s arg %d", tc.fmt, i)
+		}
+	}
+}
+
+func TestArgAsmRange(t *testing.T) {
+	testcases := []struct {
+		fmt    string
+		scale  []int64
+		bias   []int64
+		asmMin []int64
+		asmMax []int64
+	}{
+		{
+			fmt:    "Sd10k16ps2",
+			scale:  []int64{4},
+			bias:   []int64{0},
+			asmMin: []int64{-0x8000000},
+			asmMax: []int64{0x7fffffc},
+		},
+		{
+			fmt:    "DJKUa2pp1",
+			scale:  []int64{1, 1, 1, 1},
+			bias:   []int64{0, 0, 0, 1},
+			asmMin: []int64{0, 0, 0, 1},
+			asmMax: []int64{31, 31, 31, 4},
+		},
+		{
+			fmt:    "JSd5k16ps2",
+			scale:  []int64{1, 4},
+			bias:   []int64{0, 0},
+			asmMin: []int64{0, -0x400000},
+			asmMax: []int64{31, 0x3ffffc},
+		},
+	}
+
+	for _, tc := range testcases {
+		f, err := ParseInsnFormat(tc.fmt)
+		assert.NoError(t, err)
+
+		for i, a := range f.Args {
+			assert.Equal(t, tc.scale[i], a.Scale(), "%s arg %d", tc.fmt, i)
+			assert.Equal(t, tc.bias[i], a.Bias(), "%s arg %d", tc.fmt, i)
+			assert.Equal(t, tc.asmMin[i], a.AsmMinValue(), "%s arg %d", tc.fmt, i)
+			assert.Equal(t, tc.asmMax[i], a.AsmMaxValue(), "%s arg %d", tc.fmt, i)
+
+			// the bounds are exactly the encodable values
+			for _, v := range []int64{a.MinValue(), a.MaxValue()} {
+				assert.Equal(t, v, a.Extract(a.Encode(v)), "%s arg %d", tc.fmt, i)
+			}
+			if a.Kind.IsImm() {
+				assert.NotEqual(t, a.MinValue()-1, a.Extract(a.Encode(a.MinValue()-1)), "%s arg %d", tc.fmt, i)
+				assert.NotEqual(t, a.MaxValue()+1, a.Extract(a.Encode(a.MaxValue()+1)), "%s arg %d", tc.fmt, i)
+			}
+		}
+	}
+}
+
+func TestInsnDescriptionEncode(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"02c00000 addi.d                 DJSk12",
+		"40000000 beqz                   JSd5k16",
+		"50000000 b                      Sd10k16",
+	)
+
+	assert.Equal(t, uint32(0x00101483), descs[0].Encode([]int64{3, 4, 5}))
+	assert.Equal(t, uint32(0x02ffc0a4), descs[1].Encode([]int64{4, 5, -16}))
+	assert.Equal(t, uint32(0x40000090), descs[2].Encode([]int64{4, -0x100000}))
+	assert.Equal(t, uint32(0x43fffc9f), descs[2].Encode([]int64{4, -1}))
+	assert.Equal(t, uint32(0x50000200), descs[3].Encode([]int64{-0x2000000}))
+
+	assert.Panics(t, func() { descs[0].Encode([]int64{1, 2}) })
+}
+
+func TestInsnDescriptionArgShifts(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"10000000 addu16i.d              DJSk16",
+		"4c000000 jirl                   DJSk16          @orig_fmt=DJSk16ps2",
+		"58000000 beq                    DJSk16          @orig_fmt=JDSk16ps2",
+		"50000000 b                      Sd10k16         @orig_fmt=Sd10k16ps2",
+		"00080000 bytepick.w             DJKUa2          @orig_fmt=DJKUa2pp1",
+	)
+
+	assert.Equal(t, []uint{0, 0, 0}, descs[0].ArgShifts())
+	assert.Equal(t, []uint{0, 0, 2}, descs[1].ArgShifts())
+	// in the canonical order, whatever the manual syntax order
+	assert.Equal(t, []uint{0, 0, 2}, descs[2].ArgShifts())
+	assert.Equal(t, []uint{2}, descs[3].ArgShifts())
+	// only shifts count
+	assert.Equal(t, []uint{0, 0, 0, 0}, descs[4].ArgShifts())
+}
+
+func TestArgSampleValues(t *testing.T) {
+	testcases := []struct {
+		fmt      string
+		expected []int64
+	}{
+		{fmt: "D", expected: []int64{0, 1, 10, 21, 31}},
+		{fmt: "Cd", expected: []int64{0, 1, 2, 5, 7}},
+		{fmt: "Td", expected: []int64{0, 1, 2, 3}},
+		{fmt: "Ud1", expected: []int64{0, 1}},
+		{fmt: "Uk5", expected: []int64{0, 1, 10, 21, 31}},
+		{fmt: "Sk12", expected: []int64{-2048, -1366, -1, 0, 1, 1365, 2047}},
+	}
+
+	for _, tc := range testcases {
+		f, err := ParseInsnFormat(tc.fmt)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, f.Args[0].SampleValues(), tc.fmt)
+	}
+}
+
+// TestEncodeRoundTripOverCorpus encodes operand vectors for every format of
+// the corpus, checking that every insn of the format decodes them back
+// unchanged and keeps its fixed opcode bits.
+func TestEncodeRoundTripOverCorpus(t *testing.T) {
+	descs := Builtin()
+	descsByFormat := make(map[string][]*InsnDescription)
+	for _, d := range descs {
+		repr := d.Format.CanonicalRepr()
+		descsByFormat[repr] = append(descsByFormat[repr], d)
+	}
+
+	// fixed for reproducible failures
+	rng := rand.New(rand.NewSource(1))
+
+	for _, f := range GatherFormats(descs) {
+		args := f.Args
+
+		// the bounds, the samples of every arg in turn, and some random
+		// values
+		var vectors [][]int64
+		mins := make([]int64, len(args))
+		maxs := make([]int64, len(args))
+		for i, a := range args {
+			mins[i], maxs[i] = a.MinValue(), a.MaxValue()
+		}
+		vectors = append(vectors, mins, maxs)
+		for i, a := range args {
+			for _, v := range a.SampleValues() {
+				vec := append([]int64{}, mins...)
+				vec[i] = v
+				vectors = append(vectors, vec)
+			}
+		}
+		for n := 0; n < 8; n++ {
+			vec := make([]int64, len(args))
+			for i := range args {
+				vec[i] = mins[i] + rng.Int63n(maxs[i]-mins[i]+1)
+			}
+			vectors = append(vectors, vec)
+		}
+
+		for _, d := range descsByFormat[f.CanonicalRepr()] {
+			for _, vec := range vectors {
+				word := d.Encode(vec)
+				msg := []interface{}{"%s %s %v: %08x", d.Mnemonic, f.CanonicalRepr(), vec, word}
+
+				assert.Equal(t, d.Word, word&d.FixedMask(), msg...)
+				for i, a := range d.Format.Args {
+					assert.Zero(t, a.Encode(vec[i])&^a.Bitmask(), msg...)
+					assert.Equal(t, vec[i], a.Extract(word), msg...)
+				}
+			}
+		}
+	}
+}
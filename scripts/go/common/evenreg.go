@@ -0,0 +1,18 @@
+package common
+
+import "sort"
+
+const evenRegKey = "even-reg"
+
+// EvenRegArgs returns the indices of the register args that must be
+// even-numbered, in the order of the args, as listed by name in the
+// @even-reg attrib; e.g. an insn operating on the register pair starting at
+// rd would have @even-reg=d.
+func (d *InsnDescription) EvenRegArgs() []int {
+	result, _, err := d.parseAccessedArgs(evenRegKey)
+	if err != nil {
+		panic(err)
+	}
+	sort.Ints(result)
+	return result
+}
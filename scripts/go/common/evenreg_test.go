@@ -0,0 +1,25 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEvenRegArgs(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"00110000 foo                    DJK             @even-reg=k,d",
+	)
+	assert.Empty(t, descs[0].EvenRegArgs())
+	assert.Equal(t, []int{0, 2}, descs[1].EvenRegArgs())
+
+	for _, l := range []string{
+		"02800000 foo                    DJSk12          @even-reg=sk12",
+		"00110000 foo                    DJK             @even-reg=a",
+	} {
+		_, err := ParseInsnDescriptionLine(l)
+		assert.Error(t, err, l)
+	}
+}
@@ -0,0 +1,72 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const familyPrefix = "family "
+
+var familyRE = regexp.MustCompile(`^family +([0-9a-f]{8}) +([A-Za-z][0-9A-Za-z_.]*)\{([^{}]*)\}([0-9A-Za-z_.]*) +(.*)$`)
+var familyVariantRE = regexp.MustCompile(`^([0-9A-Za-z_.]*):([0-9a-f]+)$`)
+
+// ExpandInsnFamilyLine expands a family line into the insn descriptions of
+// all its variants. A family line looks like:
+//
+//	family 28000000 ld.{b:0,h:400000,w:800000}  DJSk12  @qemu
+//
+// The mnemonic template contains exactly one brace group, listing the
+// variants as suffix:delta pairs. Every variant is described by the base word
+// plus its hex delta, the template with the suffix substituted in, and the
+// format and attributes shared by the whole family.
+func ExpandInsnFamilyLine(line string) ([]*InsnDescription, error) {
+	matches := familyRE.FindStringSubmatch(line)
+	if matches == nil {
+		return nil, errors.New("malformed insn family line")
+	}
+
+	base, err := strconv.ParseUint(matches[1], 16, 32)
+	if err != nil {
+		panic("should never happen")
+	}
+	prefix, suffix := matches[2], matches[4]
+	rest := matches[5]
+
+	variants := strings.Split(matches[3], ",")
+	result := make([]*InsnDescription, 0, len(variants))
+	seen := make(map[string]bool, len(variants))
+	for _, v := range variants {
+		vm := familyVariantRE.FindStringSubmatch(v)
+		if vm == nil {
+			return nil, fmt.Errorf("malformed insn family variant %q", v)
+		}
+
+		mnemonic := prefix + vm[1] + suffix
+		if seen[strings.ToLower(mnemonic)] {
+			return nil, fmt.Errorf("duplicate insn family variant %q", mnemonic)
+		}
+		seen[strings.ToLower(mnemonic)] = true
+
+		delta, err := strconv.ParseUint(vm[2], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid opcode delta of insn family variant %q: %w", v, err)
+		}
+
+		word := base + delta
+		if word > 0xffffffff {
+			return nil, fmt.Errorf("opcode of insn family variant %q overflows: %x", mnemonic, word)
+		}
+
+		desc, err := ParseInsnDescriptionLine(fmt.Sprintf("%08x %s %s", word, mnemonic, rest))
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", mnemonic, err)
+		}
+
+		result = append(result, desc)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,70 @@
+package common
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExpandInsnFamilyLine(t *testing.T) {
+	descs, err := ExpandInsnFamilyLine("family 28000000 ld.{b:0,h:400000,w:800000,d:c00000,bu:2000000,hu:2400000,wu:2800000}  DJSk12  @qemu @reloc=sk12")
+	assert.NoError(t, err)
+
+	expected := mustParseInsnDescriptionLines(
+		t,
+		"28000000 ld.b                   DJSk12          @qemu @reloc=sk12",
+		"28400000 ld.h                   DJSk12          @qemu @reloc=sk12",
+		"28800000 ld.w                   DJSk12          @qemu @reloc=sk12",
+		"28c00000 ld.d                   DJSk12          @qemu @reloc=sk12",
+		"2a000000 ld.bu                  DJSk12          @qemu @reloc=sk12",
+		"2a400000 ld.hu                  DJSk12          @qemu @reloc=sk12",
+		"2a800000 ld.wu                  DJSk12          @qemu @reloc=sk12",
+	)
+	assert.Equal(t, expected, descs)
+
+	// the suffix can be in the middle of the mnemonic
+	descs, err = ExpandInsnFamilyLine("family 01008000 f{add:0,sub:20000}.s  FdFjFk")
+	assert.NoError(t, err)
+	assert.Equal(t, mustParseInsnDescriptionLines(
+		t,
+		"01008000 fadd.s                 FdFjFk",
+		"01028000 fsub.s                 FdFjFk",
+	), descs)
+}
+
+func TestExpandInsnFamilyLineErrors(t *testing.T) {
+	testcases := []struct {
+		line   string
+		errMsg string
+	}{
+		{line: "family 28000000 ld.b  DJSk12", errMsg: "malformed insn family line"},
+		{line: "family 28000000 ld.{b:0}{h:1}  DJSk12", errMsg: "malformed insn family line"},
+		{line: "family 28000000 ld.{b,h:400000}  DJSk12", errMsg: `malformed insn family variant "b"`},
+		{line: "family 28000000 ld.{b:0,b:400000}  DJSk12", errMsg: `duplicate insn family variant "ld.b"`},
+		{line: "family f0000000 ld.{b:0,h:10000000}  DJSk12", errMsg: `opcode of insn family variant "ld.h" overflows: 100000000`},
+		{line: "family 28000000 ld.{b:0,h:400001}  DJSk12", errMsg: "ld.h: "},
+	}
+
+	for _, tc := range testcases {
+		_, err := ExpandInsnFamilyLine(tc.line)
+		if assert.Error(t, err, tc.line) {
+			assert.Contains(t, err.Error(), tc.errMsg, tc.line)
+		}
+	}
+}
+
+func TestReadInsnDescriptionFileFamily(t *testing.T) {
+	dir := writeTestFiles(t, map[string]string{
+		"all.txt": "00100000 add.w                  DJK\nfamily 38000000 ldx.{b:0,h:40000}  DJK  @qemu\n",
+	})
+
+	descs, err := ReadInsnDescriptionFile(filepath.Join(dir, "all.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"38000000 ldx.b                  DJK             @qemu",
+		"38040000 ldx.h                  DJK             @qemu",
+	), descs)
+}
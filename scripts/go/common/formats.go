@@ -0,0 +1,115 @@
+package common
+
+import (
+	"fmt"
+	"math/bits"
+	"sort"
+)
+
+// GatherFormats returns all distinct formats used by descs, sorted by their
+// canonical representations.
+//
+// The returned formats are deep copies, and do not alias any description's
+// format; callers are free to attach per-format state to them.
+//
+// GatherFormats panics if any insn has no format, or if any format has
+// overlapping slots, as the encoders generated for it would silently OR the
+// overlapping args together.
+func GatherFormats(descs []*InsnDescription) []*InsnFormat {
+	formatsSet := make(map[string]*InsnFormat)
+	for _, d := range descs {
+		if d.Format == nil {
+			panic(fmt.Errorf("%s: no format", d.Mnemonic))
+		}
+
+		err := CheckSlotOverlapWithinFormat(d.Format)
+		if err != nil {
+			panic(fmt.Errorf("%s: %w", d.Mnemonic, err))
+		}
+
+		canonicalFormatName := d.Format.CanonicalRepr()
+		if _, ok := formatsSet[canonicalFormatName]; !ok {
+			formatsSet[canonicalFormatName] = d.Format.Clone()
+		}
+	}
+
+	result := make([]*InsnFormat, 0, len(formatsSet))
+	for _, f := range formatsSet {
+		result = append(result, f)
+	}
+
+	sort.Slice(result, func(i int, j int) bool {
+		return result[i].CanonicalRepr() < result[j].CanonicalRepr()
+	})
+
+	return result
+}
+
+// CheckFormats checks that every insn has a valid format among formats, as
+// gathered by GatherFormats, returning an error naming the first insn that
+// doesn't. Generators call it before emitting code dispatching on the
+// formats, where an insn of any other format would only be caught at run
+// time, if at all.
+func CheckFormats(descs []*InsnDescription, formats []*InsnFormat) error {
+	known := make(map[string]bool, len(formats))
+	for _, f := range formats {
+		known[f.CanonicalRepr()] = true
+	}
+
+	for _, d := range descs {
+		if d.Format == nil {
+			return fmt.Errorf("%s: no format", d.Mnemonic)
+		}
+
+		err := d.Format.Validate()
+		if err != nil {
+			return fmt.Errorf("%s: invalid format: %w", d.Mnemonic, err)
+		}
+
+		if repr := d.Format.CanonicalRepr(); !known[repr] {
+			return fmt.Errorf("%s: format %s is not among the known formats", d.Mnemonic, repr)
+		}
+	}
+
+	return nil
+}
+
+// CheckSlotOverlapWithinFormat checks that no two slots of the format, either
+// of the same arg or of different args, share any bit.
+//
+// Formats parsed from descriptions are already checked, but formats built or
+// modified in code are not.
+func CheckSlotOverlapWithinFormat(f *InsnFormat) error {
+	type argSlot struct {
+		argIdx int
+		slot   *Slot
+	}
+
+	var seen []argSlot
+	for argIdx, a := range f.Args {
+		for _, s := range a.Slots {
+			for _, prev := range seen {
+				overlap := prev.slot.Bitmask() & s.Bitmask()
+				if overlap == 0 {
+					continue
+				}
+
+				lsb := bits.TrailingZeros32(overlap)
+				msb := 31 - bits.LeadingZeros32(overlap)
+				return fmt.Errorf(
+					"slot %s of arg %d and slot %s of arg %d overlap at bits %d..%d",
+					prev.slot.CanonicalRepr(),
+					prev.argIdx,
+					s.CanonicalRepr(),
+					argIdx,
+					msb,
+					lsb,
+				)
+			}
+
+			seen = append(seen, argSlot{argIdx: argIdx, slot: s})
+		}
+	}
+
+	return nil
+}
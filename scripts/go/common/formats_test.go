@@ -0,0 +1,100 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustParseInsnDescriptionLines(t *testing.T, lines ...string) []*InsnDescription {
+	result := make([]*InsnDescription, len(lines))
+	for i, l := range lines {
+		d, err := ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		result[i] = d
+	}
+	return result
+}
+
+func TestGatherFormats(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"00110000 sub.w                  DJK",
+		"02800000 addi.w                 DJSk12",
+		"00000000 foo                    EMPTY",
+	)
+
+	formats := GatherFormats(descs)
+
+	reprs := make([]string, len(formats))
+	for i, f := range formats {
+		reprs[i] = f.CanonicalRepr()
+	}
+	assert.Equal(t, []string{"DJK", "DJSk12", "EMPTY"}, reprs)
+
+	// mutating a gathered format must not leak into any description
+	formats[0].Args[0].Kind = ArgKindFPReg
+	formats[1].Args[2].Slots[0].Width = 5
+	assert.Equal(t, "DJK", descs[0].Format.CanonicalRepr())
+	assert.Equal(t, "DJK", descs[1].Format.CanonicalRepr())
+	assert.Equal(t, "DJSk12", descs[2].Format.CanonicalRepr())
+
+	// nor the other way around
+	descs[0].Format.Args[1].Kind = ArgKindVReg
+	assert.Equal(t, "FdJK", formats[0].CanonicalRepr())
+	assert.Equal(t, "DJK", descs[1].Format.CanonicalRepr())
+}
+
+func TestCheckFormats(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"02800000 addi.w                 DJSk12",
+		"00000000 foo                    EMPTY",
+	)
+	formats := GatherFormats(descs)
+	assert.NoError(t, CheckFormats(descs, formats))
+
+	// a format not emitted by the generator
+	assert.EqualError(t, CheckFormats(descs, formats[:1]), "addi.w: format DJSk12 is not among the known formats")
+
+	descs[0].Format.Args[2].Kind = ArgKindUnknown
+	assert.EqualError(t, CheckFormats(descs, formats), "add.w: invalid format: unknown arg kind: 0")
+
+	descs[0].Format = nil
+	assert.EqualError(t, CheckFormats(descs, formats), "add.w: no format")
+	assert.PanicsWithError(t, "add.w: no format", func() { GatherFormats(descs) })
+}
+
+func TestCheckSlotOverlapWithinFormat(t *testing.T) {
+	f, err := ParseInsnFormat("DJSk12")
+	assert.NoError(t, err)
+	assert.NoError(t, CheckSlotOverlapWithinFormat(f))
+
+	// two args both at offset 5
+	f.Args[0].Slots[0].Offset = 5
+	assert.EqualError(
+		t,
+		CheckSlotOverlapWithinFormat(f),
+		"slot j5 of arg 0 and slot j5 of arg 1 overlap at bits 9..5",
+	)
+
+	// slots of the same arg
+	f, err = ParseInsnFormat("Sd10k16")
+	assert.NoError(t, err)
+	f.Args[0].Slots[1].Offset = 5
+	assert.EqualError(
+		t,
+		CheckSlotOverlapWithinFormat(f),
+		"slot d10 of arg 0 and slot j16 of arg 0 overlap at bits 9..5",
+	)
+
+	descs := mustParseInsnDescriptionLines(t, "00100000 add.w                  DJK")
+	descs[0].Format.Args[2].Slots[0].Offset = 5
+	assert.PanicsWithError(
+		t,
+		"add.w: slot j5 of arg 1 and slot j5 of arg 2 overlap at bits 9..5",
+		func() { GatherFormats(descs) },
+	)
+}
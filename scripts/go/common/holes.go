@@ -0,0 +1,103 @@
+package common
+
+import (
+	"math/bits"
+	"sort"
+)
+
+// OpcodeRange is an inclusive range of insn words.
+type OpcodeRange struct {
+	Lo uint32
+	Hi uint32
+}
+
+func (r OpcodeRange) Size() uint64 {
+	return uint64(r.Hi) - uint64(r.Lo) + 1
+}
+
+// SharedHighBits returns the high-order bits shared by all words in the range,
+// as the number of such bits and their value (right-aligned).
+func (r OpcodeRange) SharedHighBits() (n uint, value uint32) {
+	n = uint(bits.LeadingZeros32(r.Lo ^ r.Hi))
+	if n == 0 {
+		return 0, 0
+	}
+	return n, r.Lo >> (32 - n)
+}
+
+// FindOpcodeHoles returns the ranges of insn words no insn in descs claims,
+// considering only the highest maxPrefixBits bits of the words; a range that
+// is only partially claimed at that granularity is not reported.
+//
+// Adjacent unclaimed ranges are merged, and the result is sorted by size in
+// descending order, then by the low end of the range.
+func FindOpcodeHoles(descs []*InsnDescription, maxPrefixBits uint) []OpcodeRange {
+	if maxPrefixBits > 32 {
+		maxPrefixBits = 32
+	}
+
+	entries := makeDecoderEntries(descs)
+
+	var holes []OpcodeRange
+	var walk func(prefixBits uint, prefix uint32, candidates []decoderEntry)
+	walk = func(prefixBits uint, prefix uint32, candidates []decoderEntry) {
+		prefixMask := ^uint32(0)
+		if prefixBits < 32 {
+			prefixMask = ^(^uint32(0) >> prefixBits)
+		}
+
+		var intersecting []decoderEntry
+		for _, e := range candidates {
+			// the insn can match some word with this prefix iff its fixed
+			// bits inside the prefix agree with the prefix
+			m := e.mask & prefixMask
+			if prefix&m != e.match&m {
+				continue
+			}
+
+			if e.mask&^prefixMask == 0 {
+				// all fixed bits are inside the prefix: fully claimed
+				return
+			}
+
+			intersecting = append(intersecting, e)
+		}
+
+		if len(intersecting) == 0 {
+			holes = append(holes, OpcodeRange{
+				Lo: prefix,
+				Hi: prefix | ^prefixMask,
+			})
+			return
+		}
+
+		if prefixBits == maxPrefixBits {
+			// partially claimed, stop descending
+			return
+		}
+
+		nextBit := uint32(1) << (31 - prefixBits)
+		walk(prefixBits+1, prefix, intersecting)
+		walk(prefixBits+1, prefix|nextBit, intersecting)
+	}
+	walk(0, 0, entries)
+
+	// holes are discovered in ascending order, merge adjacent ones
+	var merged []OpcodeRange
+	for _, h := range holes {
+		if len(merged) > 0 {
+			last := &merged[len(merged)-1]
+			if uint64(last.Hi)+1 == uint64(h.Lo) {
+				last.Hi = h.Hi
+				continue
+			}
+		}
+		merged = append(merged, h)
+	}
+
+	sort.SliceStable(merged, func(i int, j int) bool {
+		return merged[i].Size() > merged[j].Size()
+	})
+
+	return merged
+}
@@ -0,0 +1,63 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindOpcodeHoles(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		// claims 0x00000000-0x03ffffff entirely
+		"00000000 foo                    Sd10k16",
+		// claims 0x04000000-0x041fffff, and 0x04600000-0x047fffff
+		"04000000 bar                    DJSk11",
+		"04600000 baz                    DJSk11",
+		// claims 0x0c000000-0x0fffffff
+		"0c000000 qux                    Sd10k16",
+		// claims a single word
+		"ffffffff all1                   EMPTY",
+	)
+
+	holes := FindOpcodeHoles(descs, 32)
+	assert.Equal(
+		t,
+		[]OpcodeRange{
+			{Lo: 0x10000000, Hi: 0xfffffffe},
+			// 0x04800000-0x07ffffff and 0x08000000-0x0bffffff merged
+			{Lo: 0x04800000, Hi: 0x0bffffff},
+			{Lo: 0x04200000, Hi: 0x045fffff},
+		},
+		holes,
+	)
+
+	n, v := holes[2].SharedHighBits()
+	assert.Equal(t, uint(9), n)
+	assert.Equal(t, uint32(0b000001000), v)
+
+	// at a coarser granularity, partially claimed ranges are not reported
+	holes = FindOpcodeHoles(descs, 6)
+	assert.Equal(
+		t,
+		[]OpcodeRange{
+			{Lo: 0x10000000, Hi: 0xfbffffff},
+			{Lo: 0x08000000, Hi: 0x0bffffff},
+		},
+		holes,
+	)
+}
+
+func TestFindOpcodeHolesCorpus(t *testing.T) {
+	descs := Builtin()
+	holes := FindOpcodeHoles(descs, 22)
+
+	// no word inside a hole decodes to any insn
+	dec := NewIndexedDecoder(descs)
+	for _, h := range holes {
+		assert.Nil(t, dec.Lookup(h.Lo), "%08x", h.Lo)
+		assert.Nil(t, dec.Lookup(h.Hi), "%08x", h.Hi)
+		mid := uint32((uint64(h.Lo) + uint64(h.Hi)) / 2)
+		assert.Nil(t, dec.Lookup(mid), "%08x", mid)
+	}
+}
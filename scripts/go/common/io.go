@@ -1,13 +1,41 @@
 package common
 
 func ReadInsnDescs(paths []string) ([]*InsnDescription, error) {
+	return readInsnDescs(paths, nil, false)
+}
+
+// ReadInsnDescsStrict is like ReadInsnDescs, but reads the files with
+// ReadInsnDescriptionFileStrict.
+func ReadInsnDescsStrict(paths []string) ([]*InsnDescription, error) {
+	return readInsnDescs(paths, nil, true)
+}
+
+// ReadInsnDescsFiltered is like ReadInsnDescs, but only returns the
+// descriptions for which keep returns true. A nil keep keeps everything.
+func ReadInsnDescsFiltered(paths []string, keep func(*InsnDescription) bool) ([]*InsnDescription, error) {
+	return readInsnDescs(paths, keep, false)
+}
+
+func readInsnDescs(paths []string, keep func(*InsnDescription) bool, strict bool) ([]*InsnDescription, error) {
 	var result []*InsnDescription
+	var errs ErrorList
 	for _, path := range paths {
-		descs, err := ReadInsnDescriptionFile(path)
+		descs, err := readInsnDescriptionFile(path, nil, strict)
 		if err != nil {
-			return nil, err
+			// keep going to report the errors of the other files as well
+			errs.add(err)
+			continue
+		}
+
+		for _, d := range descs {
+			if keep == nil || keep(d) {
+				result = append(result, d)
+			}
 		}
-		result = append(result, descs...)
+	}
+
+	if err := errs.Err(); err != nil {
+		return nil, err
 	}
 	return result, nil
 }
@@ -0,0 +1,74 @@
+package common
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const latencyKey = "lat"
+
+// DefaultLatency is the latency of the insns without a @lat attrib.
+const DefaultLatency = 1
+
+// Latency tells when the result of an insn is available to the insns
+// depending on it, for scheduling.
+type Latency struct {
+	// Cycles is the number of cycles from the issue of the insn until its
+	// result can be used.
+	Cycles int
+	// ResultArg is the index of the arg holding the late-available result,
+	// or -1 if the insn writes no register arg.
+	ResultArg int
+}
+
+// Latency returns the latency of the insn, as declared by the @lat attrib,
+// e.g. @lat=4 for a load whose result is available 4 cycles after issue.
+// The result is the first arg the insn writes, unless named after the
+// cycles, e.g. @lat=3,a for an insn writing both rd and ra with ra coming
+// last. Insns without the attrib take DefaultLatency.
+func (d *InsnDescription) Latency() Latency {
+	result, err := d.latency()
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func (d *InsnDescription) latency() (Latency, error) {
+	result := Latency{
+		Cycles:    DefaultLatency,
+		ResultArg: -1,
+	}
+	if outputs := d.OutputArgs(); len(outputs) > 0 {
+		result.ResultArg = outputs[0]
+	}
+
+	s, ok := d.Attribs[latencyKey]
+	if !ok {
+		return result, nil
+	}
+
+	cyclesStr, name, hasName := strings.Cut(s, ",")
+	cycles, err := strconv.Atoi(cyclesStr)
+	if err != nil || cycles < 1 {
+		return Latency{}, fmt.Errorf("lat %q is not a positive number of cycles", cyclesStr)
+	}
+	result.Cycles = cycles
+
+	if hasName {
+		result.ResultArg = -1
+		for _, i := range d.OutputArgs() {
+			if d.Format.Args[i].Name() == name {
+				result.ResultArg = i
+			}
+		}
+		if result.ResultArg < 0 {
+			return Latency{}, fmt.Errorf("lat arg %s is not a register arg written by %s", name, d.Mnemonic)
+		}
+	} else if result.ResultArg < 0 {
+		return Latency{}, fmt.Errorf("lat given for %s, that writes no register arg", d.Mnemonic)
+	}
+
+	return result, nil
+}
@@ -0,0 +1,32 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLatency(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"28800000 ld.w                   DJSk12          @lat=4",
+		"29800000 st.w                   DJSk12          @writes= @reads=d,j",
+		"00110000 foo                    DJK             @writes=d,k @lat=3,k",
+	)
+	assert.Equal(t, Latency{Cycles: DefaultLatency, ResultArg: 0}, descs[0].Latency())
+	assert.Equal(t, Latency{Cycles: 4, ResultArg: 0}, descs[1].Latency())
+	assert.Equal(t, Latency{Cycles: DefaultLatency, ResultArg: -1}, descs[2].Latency())
+	assert.Equal(t, Latency{Cycles: 3, ResultArg: 2}, descs[3].Latency())
+
+	for _, l := range []string{
+		"28800000 ld.w                   DJSk12          @lat=0",
+		"28800000 ld.w                   DJSk12          @lat=x",
+		"28800000 ld.w                   DJSk12          @lat=4,j",
+		"28800000 ld.w                   DJSk12          @lat=4,sk12",
+		"29800000 st.w                   DJSk12          @writes= @reads=d,j @lat=4",
+	} {
+		_, err := ParseInsnDescriptionLine(l)
+		assert.Error(t, err, l)
+	}
+}
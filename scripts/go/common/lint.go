@@ -0,0 +1,182 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+const nolintKey = "nolint"
+
+// LintCheck names a heuristic check of the insn descriptions, for catching
+// likely authoring slips that are nevertheless valid descriptions.
+type LintCheck string
+
+const (
+	// LintNarrowSignedImm flags signed immediates at most 5 bits wide, as
+	// such narrow immediates are mostly shift amounts or element indices,
+	// which are unsigned. Those are as wide as the element width of the insn
+	// calls for, so immediates of the same width across all the width
+	// variants of the insn, like the Sk5 of vseqi.b/h/w/d, are not flagged.
+	LintNarrowSignedImm LintCheck = "narrow_signed_imm"
+	// LintUnsignedOffset flags unsigned immediates in offset positions,
+	// i.e. scaled in the manual syntax, as offsets are mostly signed.
+	// Relocated immediates are not flagged, as the unsigned ones take the
+	// low bits of absolute addresses, like the uk12 of ori.
+	LintUnsignedOffset LintCheck = "unsigned_offset"
+	// LintFewOpcodeBits flags formats whose args occupy so many bits that
+	// fewer than minOpcodeBits bits are left for the opcode, which usually
+	// means an arg is declared wider than it is.
+	LintFewOpcodeBits LintCheck = "few_opcode_bits"
+	// LintMissingWidthPair flags insns of the width pairs whose counterpart
+	// of the other width is not described, which usually means it was
+	// forgotten when adding the family; see LintWidthPairs.
+	LintMissingWidthPair LintCheck = "missing_width_pair"
+)
+
+// narrowSignedImmMaxWidth is the width up to which signed immediates are
+// flagged by LintNarrowSignedImm.
+const narrowSignedImmMaxWidth = 5
+
+// minOpcodeBits is the width of the primary opcode field, the least number of
+// opcode bits of any insn.
+const minOpcodeBits = 6
+
+// KnownLintChecks returns all lint checks.
+func KnownLintChecks() []LintCheck {
+	return []LintCheck{LintNarrowSignedImm, LintUnsignedOffset, LintFewOpcodeBits, LintMissingWidthPair}
+}
+
+// LintWarning is a finding of a lint check on an insn.
+type LintWarning struct {
+	Desc  *InsnDescription
+	Check LintCheck
+	// Arg is the name of the offending arg, or empty if the finding is about
+	// the format as a whole.
+	Arg string
+	// Counterpart is the missing mnemonic, for LintMissingWidthPair.
+	Counterpart string
+}
+
+func (w *LintWarning) Error() string {
+	var what string
+	switch w.Check {
+	case LintNarrowSignedImm:
+		what = "narrow signed immediate, usually unsigned"
+	case LintUnsignedOffset:
+		what = "unsigned immediate in offset position, usually signed"
+	case LintFewOpcodeBits:
+		what = fmt.Sprintf(
+			"format %s leaves %d opcode bits, fewer than %d",
+			w.Desc.Format.CanonicalRepr(),
+			32-w.Desc.Format.OperandBits(),
+			minOpcodeBits,
+		)
+	case LintMissingWidthPair:
+		what = fmt.Sprintf("counterpart %s not described", w.Counterpart)
+	}
+
+	subject := w.Desc.Mnemonic
+	if w.Arg != "" {
+		subject += ": arg " + w.Arg
+	}
+	return fmt.Sprintf("%s: %s (suppress with @%s=%s)", subject, what, nolintKey, w.Check)
+}
+
+// Lint runs the lint checks on the insns, returning the findings in the order
+// of the insns, except those suppressed by the @nolint attrib listing the
+// checks to skip for the insn, e.g. @nolint=narrow_signed_imm. The width
+// variants of an insn are looked up among descs. The check of the whole
+// corpus for missing width pairs is separate, see LintWidthPairs.
+//
+// The checks are heuristics, so the findings are advisory only.
+func Lint(descs []*InsnDescription) []*LintWarning {
+	byStem := make(map[string][]*InsnDescription)
+	for _, d := range descs {
+		stem, _ := splitVariantSuffix(d.Mnemonic)
+		byStem[stem] = append(byStem[stem], d)
+	}
+
+	var result []*LintWarning
+	for _, d := range descs {
+		suppressed := d.suppressedLintChecks()
+
+		if d.Format.OperandBits() > 32-minOpcodeBits && !suppressed[LintFewOpcodeBits] {
+			result = append(result, &LintWarning{Desc: d, Check: LintFewOpcodeBits})
+		}
+
+		// the postprocess ops are only present in the manual syntax
+		asmOrder := d.ManualSyntaxArgIndices()
+		manualArgs := make([]*Arg, len(d.Format.Args))
+		for i, a := range d.ManualSyntaxArgs() {
+			manualArgs[asmOrder[i]] = a
+		}
+
+		for i, a := range d.Format.Args {
+			var check LintCheck
+			switch {
+			case a.Kind == ArgKindSignedImm && a.TotalWidth() <= narrowSignedImmMaxWidth:
+				stem, _ := splitVariantSuffix(d.Mnemonic)
+				if sameImmWidthAcrossVariants(byStem[stem], d, i) {
+					continue
+				}
+				check = LintNarrowSignedImm
+			case a.Kind == ArgKindUnsignedImm && manualArgs[i].Scale() != 1:
+				check = LintUnsignedOffset
+			default:
+				continue
+			}
+
+			if suppressed[check] {
+				continue
+			}
+			result = append(result, &LintWarning{Desc: d, Check: check, Arg: a.Name()})
+		}
+	}
+	return result
+}
+
+// sameImmWidthAcrossVariants returns whether the i-th arg of d is as wide in
+// all the other variants of d, which must be present and take as many args.
+func sameImmWidthAcrossVariants(variants []*InsnDescription, d *InsnDescription, i int) bool {
+	width := d.Format.Args[i].TotalWidth()
+	seen := false
+	for _, v := range variants {
+		if v == d {
+			continue
+		}
+		if len(v.Format.Args) != len(d.Format.Args) || v.Format.Args[i].TotalWidth() != width {
+			return false
+		}
+		seen = true
+	}
+	return seen
+}
+
+func (d *InsnDescription) suppressedLintChecks() map[LintCheck]bool {
+	names, ok := d.Attribs[nolintKey]
+	if !ok || names == "" {
+		return nil
+	}
+
+	result := make(map[LintCheck]bool)
+	for _, name := range strings.Split(names, ",") {
+		result[LintCheck(name)] = true
+	}
+	return result
+}
+
+func (d *InsnDescription) validateNolint() error {
+	for check := range d.suppressedLintChecks() {
+		known := false
+		for _, k := range KnownLintChecks() {
+			if check == k {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown lint check %q in @%s", check, nolintKey)
+		}
+	}
+	return nil
+}
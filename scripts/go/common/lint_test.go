@@ -0,0 +1,103 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLint(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"00290000 addu12i.w              DJSk5",
+		"00408000 slli.w                 DJUk5",
+		// the absolute address bits taken by ori are not an offset
+		"03800000 ori                    DJUk12          @reloc=uk12",
+		"24000000 ldox4.w                DJSk14          @orig_fmt=DJSk14ps2",
+		"28000000 ldox4.x                DJUk12          @orig_fmt=DJUk12ps2",
+	)
+
+	warnings := Lint(descs)
+	if assert.Len(t, warnings, 2) {
+		assert.Equal(t, "addu12i.w", warnings[0].Desc.Mnemonic)
+		assert.Equal(t, LintNarrowSignedImm, warnings[0].Check)
+		assert.Equal(t, "sk5", warnings[0].Arg)
+		assert.Equal(
+			t,
+			"addu12i.w: arg sk5: narrow signed immediate, usually unsigned (suppress with @nolint=narrow_signed_imm)",
+			warnings[0].Error(),
+		)
+
+		assert.Equal(t, "ldox4.x", warnings[1].Desc.Mnemonic)
+		assert.Equal(t, LintUnsignedOffset, warnings[1].Check)
+	}
+}
+
+func TestLintNarrowSignedImmVariants(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		// comparing with an immediate independent of the element width
+		"72800000 vseqi.b                VdVjSk5",
+		"72808000 vseqi.h                VdVjSk5",
+		"72810000 vseqi.w                VdVjSk5",
+		"72818000 vseqi.d                VdVjSk5",
+		// a shift amount declared signed, narrower than in the other variant
+		"00408000 slli.w                 DJSk5",
+		"00410000 slli.d                 DJUk6",
+	)
+
+	warnings := Lint(descs)
+	if assert.Len(t, warnings, 1) {
+		assert.Equal(t, "slli.w", warnings[0].Desc.Mnemonic)
+		assert.Equal(t, LintNarrowSignedImm, warnings[0].Check)
+	}
+}
+
+func TestLintFewOpcodeBits(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		// 26 bits of args, the most of any insn
+		"50000000 b                      Sd10k16",
+		// a typo of Sk12
+		"40000000 addi.d                 DJSk20",
+	)
+
+	warnings := Lint(descs)
+	if assert.Len(t, warnings, 1) {
+		assert.Equal(t, LintFewOpcodeBits, warnings[0].Check)
+		assert.Equal(
+			t,
+			"addi.d: format DJSk20 leaves 2 opcode bits, fewer than 6 (suppress with @nolint=few_opcode_bits)",
+			warnings[0].Error(),
+		)
+	}
+}
+
+func TestLintSuppressed(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00408000 slli.w                 DJSk5           @nolint=narrow_signed_imm",
+		"28000000 ldox4.x                DJUk12          @orig_fmt=DJUk12ps2 @nolint=unsigned_offset,narrow_signed_imm",
+		// suppressing another check leaves the finding in place
+		"00298000 addu12i.d              DJSk5           @nolint=unsigned_offset",
+	)
+
+	warnings := Lint(descs)
+	if assert.Len(t, warnings, 1) {
+		assert.Equal(t, "addu12i.d", warnings[0].Desc.Mnemonic)
+	}
+}
+
+func TestLintUnknownCheck(t *testing.T) {
+	_, err := ParseInsnDescriptionLine("00290000 addu12i.w              DJSk5           @nolint=narrow_imm")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), `unknown lint check "narrow_imm" in @nolint`)
+	}
+}
+
+func TestLintCorpus(t *testing.T) {
+	for _, w := range Lint(Builtin()) {
+		t.Error(w)
+	}
+}
@@ -0,0 +1,377 @@
+package common
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const macroPrefix = "macro "
+
+var macroRE = regexp.MustCompile(`^macro +([a-z][0-9a-z_.]*)(?: +([^=]*?))? *= *(.+)$`)
+var macroOperandRE = regexp.MustCompile(`^([a-z][0-9a-z_]*):(reg|sym|[su]i[0-9]+)$`)
+var macroInsnRE = regexp.MustCompile(`^([a-z][0-9a-z_.]*)(?: +(.*))?$`)
+var macroModifierRE = regexp.MustCompile(`^%([0-9a-z_]+)\(([a-z][0-9a-z_]*)\)$`)
+var macroRegRE = regexp.MustCompile(`^\$r([0-9]+)$`)
+
+// MacroOperandKind is the kind of an operand of an assembler macro.
+type MacroOperandKind int
+
+const (
+	MacroOperandKindUnknown MacroOperandKind = iota
+	// MacroOperandKindReg is an integer register.
+	MacroOperandKindReg
+	// MacroOperandKindImm is an immediate, of a bounded width.
+	MacroOperandKindImm
+	// MacroOperandKindSym is a symbol reference, whose value is the addend.
+	MacroOperandKindSym
+)
+
+// MacroOperand is an operand in the signature of an assembler macro, like
+// "d:reg" or "v:si12".
+type MacroOperand struct {
+	Name string
+	Kind MacroOperandKind
+	// Signed and Width give the range of an immediate.
+	Signed bool
+	Width  uint
+}
+
+// MinValue returns the minimum value of an immediate operand.
+func (o *MacroOperand) MinValue() int64 {
+	if !o.Signed {
+		return 0
+	}
+	return -(1 << (o.Width - 1))
+}
+
+// MaxValue returns the maximum value of an immediate operand.
+func (o *MacroOperand) MaxValue() int64 {
+	if o.Signed {
+		return 1<<(o.Width-1) - 1
+	}
+	return 1<<o.Width - 1
+}
+
+// MacroInsnOperand is an operand of an insn in the expansion of an assembler
+// macro: a register literal like "$r0", an integer literal, a reference to a
+// macro operand, or a modifier applied to a macro operand, like
+// "%abs_hi20(v)".
+type MacroInsnOperand struct {
+	// Ref is the name of the macro operand referred to, if any.
+	Ref string
+	// Modifier is the name of the modifier applied to Ref, if any.
+	Modifier string
+	// Value is the value of a literal.
+	Value int64
+}
+
+// MacroInsn is an insn in the expansion of an assembler macro, with the
+// operands in the canonical order of the insn.
+type MacroInsn struct {
+	Mnemonic string
+	Operands []*MacroInsnOperand
+}
+
+// MacroDescription is an assembler macro, or pseudo-op, expanding to a
+// sequence of insns. A macro line looks like:
+//
+//	macro li.w  d:reg, v:si32  = lu12i.w d, %abs_hi20(v); ori d, d, %abs_lo12(v)
+//
+// Several lines may describe the same macro, with the same operand kinds but
+// different immediate ranges, in which case the first one accepting the
+// operands is used.
+type MacroDescription struct {
+	Name      string
+	Operands  []*MacroOperand
+	Expansion []*MacroInsn
+}
+
+// MacroModifier describes a modifier computing an insn operand from a macro
+// operand.
+type MacroModifier struct {
+	// Reloc is the name of the ELF relocation filling in the insn operand
+	// when applied to a symbol.
+	Reloc string
+	// Eval computes the insn operand from an immediate, or is nil if the
+	// modifier only applies to symbols.
+	Eval func(v int64) int64
+}
+
+var macroModifiers = map[string]*MacroModifier{
+	// bits 12 to 31, sign-extended
+	"abs_hi20": {Reloc: "R_LARCH_ABS_HI20", Eval: func(v int64) int64 { return v << 32 >> 44 }},
+	// bits 0 to 11, zero-extended
+	"abs_lo12": {Reloc: "R_LARCH_ABS_LO12", Eval: func(v int64) int64 { return v & 0xfff }},
+	// bits 32 to 51, sign-extended
+	"abs64_lo20": {Reloc: "R_LARCH_ABS64_LO20", Eval: func(v int64) int64 { return v << 12 >> 44 }},
+	// bits 52 to 63, sign-extended
+	"abs64_hi12":  {Reloc: "R_LARCH_ABS64_HI12", Eval: func(v int64) int64 { return v >> 52 }},
+	"pc_hi20":     {Reloc: "R_LARCH_PCALA_HI20"},
+	"pc_lo12":     {Reloc: "R_LARCH_PCALA_LO12"},
+	"got_pc_hi20": {Reloc: "R_LARCH_GOT_PC_HI20"},
+	"got_pc_lo12": {Reloc: "R_LARCH_GOT_PC_LO12"},
+}
+
+// LookupMacroModifier returns the modifier with the name, like "abs_hi20".
+func LookupMacroModifier(name string) (*MacroModifier, bool) {
+	m, ok := macroModifiers[name]
+	return m, ok
+}
+
+// ParseMacroDescriptionLine parses a macro line.
+func ParseMacroDescriptionLine(line string) (*MacroDescription, error) {
+	matches := macroRE.FindStringSubmatch(line)
+	if matches == nil {
+		return nil, errors.New("malformed macro line")
+	}
+
+	result := &MacroDescription{Name: matches[1]}
+	operandsByName := make(map[string]*MacroOperand)
+	if sig := strings.TrimSpace(matches[2]); sig != "" {
+		for _, s := range strings.Split(sig, ",") {
+			o, err := parseMacroOperand(strings.TrimSpace(s))
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", result.Name, err)
+			}
+			if _, ok := operandsByName[o.Name]; ok {
+				return nil, fmt.Errorf("%s: duplicate operand %q", result.Name, o.Name)
+			}
+			operandsByName[o.Name] = o
+			result.Operands = append(result.Operands, o)
+		}
+	}
+
+	for _, s := range strings.Split(matches[3], ";") {
+		insn, err := parseMacroInsn(strings.TrimSpace(s), operandsByName)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", result.Name, err)
+		}
+		result.Expansion = append(result.Expansion, insn)
+	}
+
+	return result, nil
+}
+
+func parseMacroOperand(s string) (*MacroOperand, error) {
+	matches := macroOperandRE.FindStringSubmatch(s)
+	if matches == nil {
+		return nil, fmt.Errorf("malformed macro operand %q", s)
+	}
+
+	result := &MacroOperand{Name: matches[1]}
+	switch typ := matches[2]; typ {
+	case "reg":
+		result.Kind = MacroOperandKindReg
+	case "sym":
+		result.Kind = MacroOperandKindSym
+	default:
+		width, _ := strconv.Atoi(typ[2:])
+		result.Kind = MacroOperandKindImm
+		result.Signed = typ[0] == 's'
+		result.Width = uint(width)
+
+		maxWidth := 63
+		if result.Signed {
+			maxWidth = 64
+		}
+		if width < 1 || width > maxWidth {
+			return nil, fmt.Errorf("invalid width of macro operand %q", s)
+		}
+	}
+
+	return result, nil
+}
+
+func parseMacroInsn(s string, operandsByName map[string]*MacroOperand) (*MacroInsn, error) {
+	matches := macroInsnRE.FindStringSubmatch(s)
+	if matches == nil {
+		return nil, fmt.Errorf("malformed macro expansion insn %q", s)
+	}
+
+	result := &MacroInsn{Mnemonic: matches[1]}
+	if matches[2] == "" {
+		return result, nil
+	}
+
+	for _, text := range strings.Split(matches[2], ",") {
+		text = strings.TrimSpace(text)
+
+		var o MacroInsnOperand
+		if m := macroRegRE.FindStringSubmatch(text); m != nil {
+			n, _ := strconv.Atoi(m[1])
+			if n > 31 {
+				return nil, fmt.Errorf("%s: invalid register %q", result.Mnemonic, text)
+			}
+			o.Value = int64(n)
+		} else if m := macroModifierRE.FindStringSubmatch(text); m != nil {
+			o.Modifier, o.Ref = m[1], m[2]
+		} else if v, err := strconv.ParseInt(text, 0, 64); err == nil {
+			o.Value = v
+		} else {
+			o.Ref = text
+		}
+
+		if o.Ref != "" {
+			ref, ok := operandsByName[o.Ref]
+			if !ok {
+				return nil, fmt.Errorf("%s: unknown macro operand %q", result.Mnemonic, o.Ref)
+			}
+
+			if o.Modifier != "" {
+				mod, ok := macroModifiers[o.Modifier]
+				if !ok {
+					return nil, fmt.Errorf("%s: unknown modifier %q", result.Mnemonic, o.Modifier)
+				}
+				if ref.Kind == MacroOperandKindReg || (ref.Kind == MacroOperandKindImm && mod.Eval == nil) {
+					return nil, fmt.Errorf("%s: modifier %q not applicable to operand %q", result.Mnemonic, o.Modifier, o.Ref)
+				}
+			} else if ref.Kind == MacroOperandKindSym {
+				return nil, fmt.Errorf("%s: symbol operand %q needs a modifier", result.Mnemonic, o.Ref)
+			}
+		}
+
+		result.Operands = append(result.Operands, &o)
+	}
+
+	return result, nil
+}
+
+// RelocOperandIndex returns the index of the operand of the insn filled in by
+// a relocation, i.e. a modifier applied to a symbol, or -1 if there is none.
+func (m *MacroDescription) RelocOperandIndex(insn *MacroInsn) int {
+	for i, o := range insn.Operands {
+		if o.Modifier != "" && m.Operand(o.Ref).Kind == MacroOperandKindSym {
+			return i
+		}
+	}
+	return -1
+}
+
+// IsAddrIdiom returns whether the macro materializes the address of a symbol
+// in a register, i.e. takes just a register and a symbol, like la.local.
+func (m *MacroDescription) IsAddrIdiom() bool {
+	return len(m.Operands) == 2 &&
+		m.Operands[0].Kind == MacroOperandKindReg &&
+		m.Operands[1].Kind == MacroOperandKindSym
+}
+
+// Operand returns the macro operand with the name, or nil if there is none.
+func (m *MacroDescription) Operand(name string) *MacroOperand {
+	for _, o := range m.Operands {
+		if o.Name == name {
+			return o
+		}
+	}
+	return nil
+}
+
+// Validate checks the expansion of the macro against the insns: every insn
+// must be known and given as many operands as it takes, the symbols must fill
+// in the relocatable operands, and every operand must fit in its slot.
+func (m *MacroDescription) Validate(descs []*InsnDescription) error {
+	byMnemonic := make(map[string]*InsnDescription, len(descs))
+	for _, d := range descs {
+		byMnemonic[d.Mnemonic] = d
+	}
+
+	for _, insn := range m.Expansion {
+		d, ok := byMnemonic[insn.Mnemonic]
+		if !ok {
+			return fmt.Errorf("%s: unknown insn %q in expansion", m.Name, insn.Mnemonic)
+		}
+		if len(insn.Operands) != len(d.Format.Args) {
+			return fmt.Errorf("%s: %s takes %d operand(s), given %d", m.Name, insn.Mnemonic, len(d.Format.Args), len(insn.Operands))
+		}
+
+		for i, o := range insn.Operands {
+			a := d.Format.Args[i]
+			var ref *MacroOperand
+			if o.Ref != "" {
+				ref = m.Operand(o.Ref)
+			}
+
+			switch {
+			case ref != nil && ref.Kind == MacroOperandKindSym:
+				if i != d.RelocArgIndex() {
+					return fmt.Errorf("%s: %s: operand %d is not relocatable", m.Name, insn.Mnemonic, i)
+				}
+			case ref != nil && ref.Kind == MacroOperandKindReg:
+				if a.Kind != ArgKindIntReg {
+					return fmt.Errorf("%s: %s: operand %d is not an integer register", m.Name, insn.Mnemonic, i)
+				}
+			case ref != nil && !a.Kind.IsImm():
+				return fmt.Errorf("%s: %s: operand %d is not an immediate", m.Name, insn.Mnemonic, i)
+			case ref != nil && o.Modifier == "":
+				if ref.MinValue() < a.MinValue() || ref.MaxValue() > a.MaxValue() {
+					return fmt.Errorf("%s: %s: operand %d does not fit %s", m.Name, insn.Mnemonic, i, o.Ref)
+				}
+			case ref == nil && (o.Value < a.MinValue() || o.Value > a.MaxValue()):
+				return fmt.Errorf("%s: %s: literal %d out of range of operand %d", m.Name, insn.Mnemonic, o.Value, i)
+			}
+		}
+	}
+
+	return nil
+}
+
+// ReadMacroDescs reads the macro lines in the files, skipping all other
+// lines.
+func ReadMacroDescs(paths []string) ([]*MacroDescription, error) {
+	var result []*MacroDescription
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+
+		sc := bufio.NewScanner(f)
+		for sc.Scan() {
+			l := sc.Text()
+			if !strings.HasPrefix(l, macroPrefix) {
+				continue
+			}
+
+			m, err := ParseMacroDescriptionLine(l)
+			if err != nil {
+				f.Close()
+				return nil, fmt.Errorf("%s: %w", path, err)
+			}
+			result = append(result, m)
+		}
+
+		f.Close()
+		if err := sc.Err(); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, checkMacroOverloads(result)
+}
+
+// checkMacroOverloads checks that all descriptions of the same macro take
+// operands of the same kinds.
+func checkMacroOverloads(macros []*MacroDescription) error {
+	first := make(map[string]*MacroDescription)
+	for _, m := range macros {
+		f, ok := first[m.Name]
+		if !ok {
+			first[m.Name] = m
+			continue
+		}
+
+		if len(f.Operands) != len(m.Operands) {
+			return fmt.Errorf("%s: overloads take different numbers of operands", m.Name)
+		}
+		for i := range f.Operands {
+			if f.Operands[i].Kind != m.Operands[i].Kind || f.Operands[i].Name != m.Operands[i].Name {
+				return fmt.Errorf("%s: overloads differ in operand %d", m.Name, i)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseMacroDescriptionLine(t *testing.T) {
+	m, err := ParseMacroDescriptionLine("macro li.w          d:reg, v:si32              = lu12i.w d, %abs_hi20(v); ori d, d, %abs_lo12(v)")
+	assert.NoError(t, err)
+	assert.Equal(t, "li.w", m.Name)
+	assert.Equal(t, []*MacroOperand{
+		{Name: "d", Kind: MacroOperandKindReg},
+		{Name: "v", Kind: MacroOperandKindImm, Signed: true, Width: 32},
+	}, m.Operands)
+	assert.Equal(t, []*MacroInsn{
+		{Mnemonic: "lu12i.w", Operands: []*MacroInsnOperand{{Ref: "d"}, {Ref: "v", Modifier: "abs_hi20"}}},
+		{Mnemonic: "ori", Operands: []*MacroInsnOperand{{Ref: "d"}, {Ref: "d"}, {Ref: "v", Modifier: "abs_lo12"}}},
+	}, m.Expansion)
+	assert.Equal(t, int64(-0x80000000), m.Operands[1].MinValue())
+	assert.Equal(t, int64(0x7fffffff), m.Operands[1].MaxValue())
+	assert.False(t, m.IsAddrIdiom())
+
+	m, err = ParseMacroDescriptionLine("macro nop                                      = andi $r0, $r0, 0")
+	assert.NoError(t, err)
+	assert.Empty(t, m.Operands)
+	assert.Equal(t, []*MacroInsnOperand{{}, {}, {}}, m.Expansion[0].Operands)
+
+	m, err = ParseMacroDescriptionLine("macro la.local d:reg, s:sym = pcalau12i d, %pc_hi20(s); addi.d d, d, %pc_lo12(s)")
+	assert.NoError(t, err)
+	assert.Equal(t, MacroOperandKindSym, m.Operand("s").Kind)
+	assert.Equal(t, 1, m.RelocOperandIndex(m.Expansion[0]))
+	assert.Equal(t, 2, m.RelocOperandIndex(m.Expansion[1]))
+	assert.True(t, m.IsAddrIdiom())
+
+	for line, msg := range map[string]string{
+		"macro nop":                                          "malformed macro line",
+		"macro foo d:int = or d, d, d":                       `foo: malformed macro operand "d:int"`,
+		"macro foo d:reg, d:reg = or d, d, d":                `foo: duplicate operand "d"`,
+		"macro foo d:reg = or d, d, x":                       `foo: or: unknown macro operand "x"`,
+		"macro foo d:reg = or d, d, $r32":                    `foo: or: invalid register "$r32"`,
+		"macro foo d:reg, v:si12 = addi.w d, d, %x(v)":       `foo: addi.w: unknown modifier "x"`,
+		"macro foo d:reg, v:si12 = addi.w d, d, %pc_lo12(v)": `foo: addi.w: modifier "pc_lo12" not applicable to operand "v"`,
+		"macro foo d:reg, s:sym = addi.w d, d, s":            `foo: addi.w: symbol operand "s" needs a modifier`,
+		"macro foo d:reg, v:ui64 = addi.w d, d, v":           `foo: invalid width of macro operand "v:ui64"`,
+	} {
+		_, err := ParseMacroDescriptionLine(line)
+		assert.EqualError(t, err, msg, line)
+	}
+}
+
+func TestMacroDescriptionValidate(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00150000 or                     DJK",
+		"02800000 addi.w                 DJSk12          @reloc=sk12",
+		"14000000 lu12i.w                DSj20           @reloc=sj20",
+		"0c100000 fcmp.caf.s             CdFjFk",
+	)
+
+	for line, msg := range map[string]string{
+		"macro move d:reg, j:reg = or d, j, $r0":              "",
+		"macro li.w d:reg, v:si12 = addi.w d, $r0, v":         "",
+		"macro la.abs d:reg, s:sym = lu12i.w d, %abs_hi20(s)": "",
+		"macro foo d:reg = nor d, d, d":                       `foo: unknown insn "nor" in expansion`,
+		"macro foo d:reg = or d, d":                           "foo: or takes 3 operand(s), given 2",
+		"macro foo d:reg, v:si13 = addi.w d, $r0, v":          "foo: addi.w: operand 2 does not fit v",
+		"macro foo d:reg = addi.w d, $r0, 2048":               "foo: addi.w: literal 2048 out of range of operand 2",
+		"macro foo d:reg = fcmp.caf.s d, d, d":                "foo: fcmp.caf.s: operand 0 is not an integer register",
+		"macro foo d:reg, s:sym = addi.w d, %abs_lo12(s), 0":  "foo: addi.w: operand 1 is not relocatable",
+	} {
+		m, err := ParseMacroDescriptionLine(line)
+		if !assert.NoError(t, err, line) {
+			continue
+		}
+
+		err = m.Validate(descs)
+		if msg == "" {
+			assert.NoError(t, err, line)
+		} else {
+			assert.EqualError(t, err, msg, line)
+		}
+	}
+}
+
+func TestReadMacroDescsOverCorpus(t *testing.T) {
+	macros, err := ReadMacroDescs([]string{"../../../la-macros.txt"})
+	assert.NoError(t, err)
+	assert.NotEmpty(t, macros)
+
+	descs := Builtin()
+	for _, m := range macros {
+		assert.NoError(t, m.Validate(descs), m.Name)
+	}
+}
+
+func TestCheckMacroOverloads(t *testing.T) {
+	a, err := ParseMacroDescriptionLine("macro li.w d:reg, v:si12 = addi.w d, $r0, v")
+	assert.NoError(t, err)
+	b, err := ParseMacroDescriptionLine("macro li.w d:reg, v:si32 = lu12i.w d, %abs_hi20(v)")
+	assert.NoError(t, err)
+	c, err := ParseMacroDescriptionLine("macro li.w d:reg, s:sym = lu12i.w d, %abs_hi20(s)")
+	assert.NoError(t, err)
+
+	assert.NoError(t, checkMacroOverloads([]*MacroDescription{a, b}))
+	assert.EqualError(t, checkMacroOverloads([]*MacroDescription{a, c}), "li.w: overloads differ in operand 1")
+}
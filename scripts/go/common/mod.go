@@ -8,11 +8,17 @@ import (
 )
 
 type InsnDescription struct {
-	Word       uint32
-	Mnemonic   string
-	Format     *InsnFormat
-	OrigFormat *InsnFormat
-	Attribs    map[string]string
+	Word     uint32
+	Mnemonic string
+	// SourceMnemonic is the mnemonic as written in the source, if it wasn't
+	// in lower case; Mnemonic is always normalized to lower case.
+	SourceMnemonic string
+	Format         *InsnFormat
+	OrigFormat     *InsnFormat
+	// Reserved holds the slots outside of the operands that must be zero
+	// for the encoding to be legal; they don't take part in opcode matching.
+	Reserved []*Slot
+	Attribs  map[string]string
 }
 
 type InsnFormat struct {
@@ -20,7 +26,12 @@ type InsnFormat struct {
 }
 
 type Arg struct {
-	Kind  ArgKind
+	Kind ArgKind
+	// Slots are listed from the most significant bits of the arg value to
+	// the least significant, regardless of their offsets in the insn word:
+	// e.g. for Sd5k16 the d5 slot holds bits [20:16] of the value, while
+	// for a hypothetical Sk16d5 the k16 slot would hold bits [20:5]. See
+	// SlotValueOffsets.
 	Slots []*Slot
 	Post  PostprocessOp
 }
@@ -70,6 +81,25 @@ const (
 	ArgKindUnsignedImm ArgKind = 8
 )
 
+// ArgRole tells what an arg means beyond its kind, for naming and rendering;
+// it never affects the encoding.
+type ArgRole string
+
+const (
+	ArgRoleNone ArgRole = ""
+	// ArgRoleElemIdx is the index of a SIMD vector element, e.g. the ui4 of
+	// vpickve2gr.b.
+	ArgRoleElemIdx ArgRole = "elemidx"
+	// ArgRoleCacheOp is the 5-bit operation code of cacop, selecting the
+	// cache and the operation on it; see CacheOpName.
+	ArgRoleCacheOp ArgRole = "cacheop"
+)
+
+// KnownArgRoles returns all roles other than ArgRoleNone.
+func KnownArgRoles() []ArgRole {
+	return []ArgRole{ArgRoleElemIdx, ArgRoleCacheOp}
+}
+
 func (k ArgKind) Validate() error {
 	switch k {
 	case ArgKindIntReg,
@@ -95,6 +125,21 @@ func (k ArgKind) IsImm() bool {
 	}
 }
 
+// RegClassMax returns the maximum valid register number for the register
+// class of the kind, and whether the kind is a register kind at all.
+func (k ArgKind) RegClassMax() (uint, bool) {
+	switch k {
+	case ArgKindIntReg, ArgKindFPReg, ArgKindVReg, ArgKindXReg:
+		return 31, true
+	case ArgKindFCCReg:
+		return 7, true
+	case ArgKindScratchReg:
+		return 3, true
+	default:
+		return 0, false
+	}
+}
+
 func (s *Slot) Validate() error {
 	if s.Offset > 31 {
 		return fmt.Errorf("slot offset %d > 31", s.Offset)
@@ -152,6 +197,24 @@ func (s *Slot) CanonicalRepr() string {
 	return sb.String()
 }
 
+// Letter returns the letter of the slot in the canonical notation, e.g. "k"
+// for the slot at offset 10.
+func (s *Slot) Letter() string {
+	if int(s.Offset) >= len(offsetCharsLower) || offsetCharsLower[s.Offset] == '_' {
+		panic("unreachable")
+	}
+	return offsetCharsLower[s.Offset : s.Offset+1]
+}
+
+func (s *Slot) Clone() *Slot {
+	if s == nil {
+		return nil
+	}
+
+	result := *s
+	return &result
+}
+
 func (a *Arg) Validate() error {
 	err := a.Kind.Validate()
 	if err != nil {
@@ -225,6 +288,34 @@ func (a *Arg) TotalWidth() uint {
 	return result
 }
 
+// SlotValueOffsets returns, for every slot of the arg, the position of the
+// slot's LSB in the arg value, as declared by the order of the slots.
+func (a *Arg) SlotValueOffsets() []uint {
+	result := make([]uint, len(a.Slots))
+	remainingBits := a.TotalWidth()
+	for i, s := range a.Slots {
+		remainingBits -= s.Width
+		result[i] = remainingBits
+	}
+	return result
+}
+
+// BitRange is an inclusive range of bit positions in the insn word.
+type BitRange struct {
+	MSB uint
+	LSB uint
+}
+
+// BitRanges returns the bit ranges of the slots of the arg, in slot order,
+// i.e. from the MSB direction of the arg value to the LSB direction.
+func (a *Arg) BitRanges() []BitRange {
+	result := make([]BitRange, len(a.Slots))
+	for i, s := range a.Slots {
+		result[i] = BitRange{MSB: s.MSB(), LSB: s.Offset}
+	}
+	return result
+}
+
 func (a *Arg) String() string {
 	if a == nil {
 		return "<nil Arg>"
@@ -238,6 +329,26 @@ func (a *Arg) String() string {
 	return fmt.Sprintf("<Arg %s>", a.CanonicalRepr())
 }
 
+func (a *Arg) Clone() *Arg {
+	if a == nil {
+		return nil
+	}
+
+	var slots []*Slot
+	if a.Slots != nil {
+		slots = make([]*Slot, len(a.Slots))
+		for i, s := range a.Slots {
+			slots[i] = s.Clone()
+		}
+	}
+
+	return &Arg{
+		Kind:  a.Kind,
+		Slots: slots,
+		Post:  a.Post,
+	}
+}
+
 const offsetCharsUpper = "D____J____K____A__________________________"
 const offsetCharsLower = "d____j____k____am_n_______________________"
 
@@ -364,6 +475,24 @@ func (f *InsnFormat) CanonicalRepr() string {
 	return sb.String()
 }
 
+func (f *InsnFormat) Clone() *InsnFormat {
+	if f == nil {
+		return nil
+	}
+
+	var args []*Arg
+	if f.Args != nil {
+		args = make([]*Arg, len(f.Args))
+		for i, a := range f.Args {
+			args[i] = a.Clone()
+		}
+	}
+
+	return &InsnFormat{
+		Args: args,
+	}
+}
+
 func (f *InsnFormat) ArgsBitmask() uint32 {
 	var mask uint32
 	for _, a := range f.Args {
@@ -376,6 +505,41 @@ func (f *InsnFormat) MatchBitmask() uint32 {
 	return ^f.ArgsBitmask()
 }
 
+// ArgCount returns the number of args, i.e. the number of operands taken by
+// the insns of the format; 0 for EMPTY.
+func (f *InsnFormat) ArgCount() int {
+	return len(f.Args)
+}
+
+// OperandBits returns the total number of bits occupied by the args, i.e. the
+// sum of the widths of all their slots.
+func (f *InsnFormat) OperandBits() uint {
+	var result uint
+	for _, a := range f.Args {
+		result += a.TotalWidth()
+	}
+	return result
+}
+
+// ArgKinds returns the kinds of the args, in the order of the args.
+func (f *InsnFormat) ArgKinds() []ArgKind {
+	result := make([]ArgKind, len(f.Args))
+	for i, a := range f.Args {
+		result[i] = a.Kind
+	}
+	return result
+}
+
+// HasKind returns whether any of the args is of the given kind.
+func (f *InsnFormat) HasKind(k ArgKind) bool {
+	for _, a := range f.Args {
+		if a.Kind == k {
+			return true
+		}
+	}
+	return false
+}
+
 func (d *InsnDescription) Validate() error {
 	if d.Mnemonic == "" {
 		return errors.New("empty mnemonic")
@@ -394,5 +558,387 @@ func (d *InsnDescription) Validate() error {
 		)
 	}
 
+	if d.OrigFormat != nil {
+		err := d.OrigFormat.ValidateManualSyntax()
+		if err != nil {
+			return err
+		}
+
+		_, err = d.manualSyntaxArgIndices()
+		if err != nil {
+			return err
+		}
+	}
+
+	if role, ok := d.Attribs[roleKey]; ok {
+		err := d.validateRole(ArgRole(role))
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, key := range []string{implicitReadKey, implicitWriteKey} {
+		_, err := parseImplicitRegs(d.Attribs[key])
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = d.syntaxArgIndices()
+	if err != nil {
+		return err
+	}
+
+	_, err = d.argAccesses()
+	if err != nil {
+		return err
+	}
+
+	_, err = d.latency()
+	if err != nil {
+		return err
+	}
+
+	_, err = d.aliasNames()
+	if err != nil {
+		return err
+	}
+
+	err = d.validateNolint()
+	if err != nil {
+		return err
+	}
+
+	_, err = d.subFunctionSlots()
+	if err != nil {
+		return err
+	}
+
+	_, _, err = d.parseAccessedArgs(evenRegKey)
+	if err != nil {
+		return err
+	}
+
+	if name, ok := d.Attribs[relocKey]; ok {
+		if d.RelocArgIndex() < 0 {
+			return fmt.Errorf("reloc arg %s not found in %s", name, d.Format.CanonicalRepr())
+		}
+	}
+
+	var seenReservedMask uint32
+	for _, s := range d.Reserved {
+		err := s.Validate()
+		if err != nil {
+			return err
+		}
+
+		mask := s.Bitmask()
+		if mask&d.Format.ArgsBitmask() != 0 {
+			return fmt.Errorf("reserved slot %s overlapped with args", s)
+		}
+
+		if mask&seenReservedMask != 0 {
+			return fmt.Errorf("reserved slot %s overlapped with other reserved slots", s)
+		}
+
+		if d.Word&mask != 0 {
+			return fmt.Errorf(
+				"insn word has non-zero bit inside reserved slot %s: %08x",
+				s,
+				d.Word,
+			)
+		}
+
+		seenReservedMask |= mask
+	}
+
 	return nil
 }
+
+// ManualSyntaxArgs returns the args in the order of the manual syntax, with
+// the postprocess ops if any.
+func (d *InsnDescription) ManualSyntaxArgs() []*Arg {
+	if d.OrigFormat == nil {
+		return d.Format.Args
+	}
+	return d.OrigFormat.Args
+}
+
+// ManualSyntaxArgIndices returns, for every arg in the manual syntax, the
+// index of the same arg in the canonical format.
+func (d *InsnDescription) ManualSyntaxArgIndices() []int {
+	result, err := d.manualSyntaxArgIndices()
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func (d *InsnDescription) manualSyntaxArgIndices() ([]int, error) {
+	if d.OrigFormat == nil {
+		result := make([]int, len(d.Format.Args))
+		for i := range result {
+			result[i] = i
+		}
+		return result, nil
+	}
+
+	if len(d.OrigFormat.Args) != len(d.Format.Args) {
+		return nil, fmt.Errorf(
+			"manual syntax %s has different number of args than %s",
+			d.OrigFormat.CanonicalRepr(),
+			d.Format.CanonicalRepr(),
+		)
+	}
+
+	result := make([]int, len(d.OrigFormat.Args))
+	for i, oa := range d.OrigFormat.Args {
+		result[i] = -1
+		for j, a := range d.Format.Args {
+			// kinds may differ, e.g. movgr2fcsr takes an fcsr as register
+			// in manual syntax, but we treat it as an immediate
+			if oa.Bitmask() == a.Bitmask() {
+				result[i] = j
+				break
+			}
+		}
+
+		if result[i] < 0 {
+			return nil, fmt.Errorf(
+				"manual syntax arg %s not found in %s",
+				oa,
+				d.Format.CanonicalRepr(),
+			)
+		}
+	}
+
+	return result, nil
+}
+
+// SyntaxArgs returns the args in the order they are written in assembly, as
+// given by the @syntax_order attrib; it is the canonical order if absent.
+//
+// Unlike ManualSyntaxArgs, this is the order of our own syntax, that may
+// deliberately differ from the manual, e.g. for bgt.
+func (d *InsnDescription) SyntaxArgs() []*Arg {
+	indices := d.SyntaxArgIndices()
+	result := make([]*Arg, len(indices))
+	for i, idx := range indices {
+		result[i] = d.Format.Args[idx]
+	}
+	return result
+}
+
+// SyntaxArgIndices returns, for every arg in assembly order, the index of the
+// same arg in the canonical format.
+func (d *InsnDescription) SyntaxArgIndices() []int {
+	result, err := d.syntaxArgIndices()
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+func (d *InsnDescription) syntaxArgIndices() ([]int, error) {
+	order, ok := d.Attribs[syntaxOrderKey]
+	if !ok {
+		result := make([]int, len(d.Format.Args))
+		for i := range result {
+			result[i] = i
+		}
+		return result, nil
+	}
+
+	names := strings.Split(order, ",")
+	if len(names) != len(d.Format.Args) {
+		return nil, fmt.Errorf(
+			"syntax order %s has different number of args than %s",
+			order,
+			d.Format.CanonicalRepr(),
+		)
+	}
+
+	result := make([]int, len(names))
+	seen := make([]bool, len(d.Format.Args))
+	for i, name := range names {
+		result[i] = -1
+		for j, a := range d.Format.Args {
+			if !seen[j] && a.Name() == name {
+				result[i] = j
+				seen[j] = true
+				break
+			}
+		}
+
+		if result[i] < 0 {
+			return nil, fmt.Errorf(
+				"syntax order arg %s not found in %s or repeated",
+				name,
+				d.Format.CanonicalRepr(),
+			)
+		}
+	}
+
+	return result, nil
+}
+
+func (d *InsnDescription) HasAttrib(key string) bool {
+	_, ok := d.Attribs[key]
+	return ok
+}
+
+const extKey = "ext"
+
+// Extension returns the name of the ISA extension the insn belongs to, e.g.
+// "LSX", or "" for the base ISA. It is the value of the "ext" attribute in
+// upper case if present, and otherwise inferred from the @lbt and @lvz
+// attributes, and from LSX or LASX register args.
+func (d *InsnDescription) Extension() string {
+	if ext, ok := d.Attribs[extKey]; ok {
+		return strings.ToUpper(ext)
+	}
+
+	switch {
+	case d.HasAttrib("lbt"):
+		return "LBT"
+	case d.HasAttrib("lvz"):
+		return "LVZ"
+	case d.Format.HasKind(ArgKindXReg):
+		return "LASX"
+	case d.Format.HasKind(ArgKindVReg):
+		return "LSX"
+	default:
+		return ""
+	}
+}
+
+// IsCommutative reports whether the two source operands following the
+// destination operand can be swapped without changing the semantics, as
+// declared by the "commutative" attribute.
+func (d *InsnDescription) IsCommutative() bool {
+	return d.HasAttrib("commutative")
+}
+
+func (d *InsnDescription) validateRole(role ArgRole) error {
+	switch role {
+	case ArgRoleElemIdx:
+		// the role is attached to the only unsigned immediate
+		if d.roleArgIndex() < 0 {
+			return fmt.Errorf("role %s needs exactly one unsigned immediate arg", role)
+		}
+		return nil
+	case ArgRoleCacheOp:
+		i := d.roleArgIndex()
+		if i < 0 || d.Format.Args[i].TotalWidth() != cacheOpWidth {
+			return fmt.Errorf("role %s needs exactly one unsigned immediate arg, %d bits wide", role, cacheOpWidth)
+		}
+		return nil
+	default:
+		return fmt.Errorf("unknown arg role %q", role)
+	}
+}
+
+func (d *InsnDescription) roleArgIndex() int {
+	result := -1
+	for i, a := range d.Format.Args {
+		if a.Kind != ArgKindUnsignedImm {
+			continue
+		}
+		if result >= 0 {
+			return -1
+		}
+		result = i
+	}
+	return result
+}
+
+// ArgRoles returns the roles of the args, as given by the @role attrib, in
+// the order of the args.
+func (d *InsnDescription) ArgRoles() []ArgRole {
+	result := make([]ArgRole, len(d.Format.Args))
+	if role, ok := d.Attribs[roleKey]; ok {
+		result[d.roleArgIndex()] = ArgRole(role)
+	}
+	return result
+}
+
+// ImplicitReads returns the numbers of the integer registers the insn reads
+// without having them as operands, as given by the @implicit-read attrib.
+func (d *InsnDescription) ImplicitReads() []uint {
+	return d.mustParseImplicitRegs(implicitReadKey)
+}
+
+// ImplicitWrites returns the numbers of the integer registers the insn writes
+// without having them as operands, as given by the @implicit-write attrib;
+// e.g. bl writes the return address to r1.
+func (d *InsnDescription) ImplicitWrites() []uint {
+	return d.mustParseImplicitRegs(implicitWriteKey)
+}
+
+func (d *InsnDescription) mustParseImplicitRegs(key string) []uint {
+	result, err := parseImplicitRegs(d.Attribs[key])
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// RelocArgIndex returns the index of the arg that can be left for the linker
+// to fill in with a relocation, as named by the @reloc attrib, or -1 if the
+// insn is not relocatable.
+func (d *InsnDescription) RelocArgIndex() int {
+	name, ok := d.Attribs[relocKey]
+	if !ok {
+		return -1
+	}
+
+	for i, a := range d.Format.Args {
+		if a.Name() == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (d *InsnDescription) ReservedMask() uint32 {
+	var result uint32
+	for _, s := range d.Reserved {
+		result |= s.Bitmask()
+	}
+	return result
+}
+
+// Clone returns a deep copy of the description, so that the result can be
+// freely mutated without affecting the original, or any other description
+// that happens to share the same format objects.
+func (d *InsnDescription) Clone() *InsnDescription {
+	if d == nil {
+		return nil
+	}
+
+	var attribs map[string]string
+	if d.Attribs != nil {
+		attribs = make(map[string]string, len(d.Attribs))
+		for k, v := range d.Attribs {
+			attribs[k] = v
+		}
+	}
+
+	var reserved []*Slot
+	if d.Reserved != nil {
+		reserved = make([]*Slot, len(d.Reserved))
+		for i, s := range d.Reserved {
+			reserved[i] = s.Clone()
+		}
+	}
+
+	return &InsnDescription{
+		Word:           d.Word,
+		Mnemonic:       d.Mnemonic,
+		SourceMnemonic: d.SourceMnemonic,
+		Format:         d.Format.Clone(),
+		OrigFormat:     d.OrigFormat.Clone(),
+		Reserved:       reserved,
+		Attribs:        attribs,
+	}
+}
@@ -1,6 +1,7 @@
 package common
 
 import (
+	"math/bits"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -211,3 +212,306 @@ func TestInsnFormat(t *testing.T) {
 		assert.Equal(t, &tc.x, roundtrip, "canonical repr should survive round-trip")
 	}
 }
+
+func TestSlotLetter(t *testing.T) {
+	for offset, letter := range map[uint]string{0: "d", 5: "j", 10: "k", 15: "a", 16: "m", 18: "n"} {
+		assert.Equal(t, letter, (&Slot{Offset: offset, Width: 1}).Letter())
+	}
+	assert.Panics(t, func() { (&Slot{Offset: 3, Width: 1}).Letter() })
+}
+
+func TestInsnDescriptionClone(t *testing.T) {
+	orig, err := ParseInsnDescriptionLine("20000000 ll.w                   DJSk14     @orig_fmt=DJSk14ps2 @la32")
+	assert.NoError(t, err)
+
+	cloned := orig.Clone()
+	assert.Equal(t, orig, cloned)
+
+	cloned.Word = 0x21000000
+	cloned.Attribs["qemu"] = "true"
+	cloned.Format.Args[0].Kind = ArgKindFPReg
+	cloned.Format.Args[2].Slots[0].Width = 12
+	cloned.OrigFormat.Args[2].Post.Amount = 3
+	cloned.Format.Args = cloned.Format.Args[:1]
+
+	assert.Equal(t, uint32(0x20000000), orig.Word)
+	assert.NotContains(t, orig.Attribs, "qemu")
+	assert.Equal(t, "DJSk14", orig.Format.CanonicalRepr())
+	assert.Equal(t, "DJSk14ps2", orig.OrigFormat.CanonicalRepr())
+
+	var nilDesc *InsnDescription
+	assert.Nil(t, nilDesc.Clone())
+}
+
+func TestArgKindRegClassMax(t *testing.T) {
+	testcases := []struct {
+		k     ArgKind
+		max   uint
+		isReg bool
+	}{
+		{k: ArgKindIntReg, max: 31, isReg: true},
+		{k: ArgKindFPReg, max: 31, isReg: true},
+		{k: ArgKindFCCReg, max: 7, isReg: true},
+		{k: ArgKindScratchReg, max: 3, isReg: true},
+		{k: ArgKindVReg, max: 31, isReg: true},
+		{k: ArgKindXReg, max: 31, isReg: true},
+		{k: ArgKindSignedImm, max: 0, isReg: false},
+		{k: ArgKindUnsignedImm, max: 0, isReg: false},
+		{k: ArgKindUnknown, max: 0, isReg: false},
+	}
+
+	for _, tc := range testcases {
+		max, isReg := tc.k.RegClassMax()
+		assert.Equal(t, tc.max, max, "kind %d", tc.k)
+		assert.Equal(t, tc.isReg, isReg, "kind %d", tc.k)
+	}
+}
+
+func TestInsnDescriptionAttribs(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK             @la32 @primary @qemu @commutative",
+		"00110000 sub.w                  DJK             @la32 @primary @qemu",
+	)
+
+	assert.True(t, descs[0].HasAttrib("qemu"))
+	assert.True(t, descs[0].IsCommutative())
+	assert.False(t, descs[1].HasAttrib("foo"))
+	assert.False(t, descs[1].IsCommutative())
+}
+
+func TestInsnDescriptionManualSyntaxArgIndices(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"38600000 amswap.w               DJK             @orig_fmt=DKJ",
+		"2ac00000 preld                  JUd5Sk12        @orig_fmt=Ud5JSk12",
+		"00600000 bstrins.w              DJUk5Um5        @orig_fmt=DJUm5Uk5",
+	)
+
+	assert.Equal(t, []int{0, 1, 2}, descs[0].ManualSyntaxArgIndices())
+	assert.Equal(t, []int{0, 2, 1}, descs[1].ManualSyntaxArgIndices())
+	assert.Equal(t, []int{1, 0, 2}, descs[2].ManualSyntaxArgIndices())
+	assert.Equal(t, []int{0, 1, 3, 2}, descs[3].ManualSyntaxArgIndices())
+
+	// orig_fmt must be a permutation of the canonical format
+	for _, l := range []string{
+		"38600000 amswap.w               DJK             @orig_fmt=DJ",
+		"38600000 amswap.w               DJK             @orig_fmt=DJA",
+		"38600000 amswap.w               DJK             @orig_fmt=DJUk4",
+	} {
+		_, err := ParseInsnDescriptionLine(l)
+		assert.Error(t, err, l)
+	}
+}
+
+func TestInsnDescriptionSyntaxArgIndices(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		// rd is a source here, but it's still written first
+		"29c00000 st.d                   DJSk12",
+		"38600000 amswap.w               DJK             @orig_fmt=DKJ @syntax_order=d,k,j",
+		"00600000 bstrins.w              DJUk5Um5        @orig_fmt=DJUm5Uk5 @syntax_order=d,j,um5,uk5",
+		"60000000 bgt                    DJSk16          @orig_fmt=JDSk16ps2",
+	)
+
+	assert.Equal(t, []int{0, 1, 2}, descs[0].SyntaxArgIndices())
+	assert.Equal(t, []string{"d", "j", "sk12"}, argNames(descs[0].SyntaxArgs()))
+	assert.Equal(t, []int{0, 2, 1}, descs[1].SyntaxArgIndices())
+	assert.Equal(t, []string{"d", "k", "j"}, argNames(descs[1].SyntaxArgs()))
+	assert.Equal(t, []int{0, 1, 3, 2}, descs[2].SyntaxArgIndices())
+	// our syntax deliberately differs from the manual
+	assert.Equal(t, []int{0, 1, 2}, descs[3].SyntaxArgIndices())
+
+	// syntax_order must be a permutation of the args
+	for _, l := range []string{
+		"38600000 amswap.w               DJK             @syntax_order=d,k",
+		"38600000 amswap.w               DJK             @syntax_order=d,k,a",
+		"38600000 amswap.w               DJK             @syntax_order=d,k,k",
+		"38600000 amswap.w               DJK             @syntax_order=d,k,j,j",
+	} {
+		_, err := ParseInsnDescriptionLine(l)
+		assert.Error(t, err, l)
+	}
+}
+
+func argNames(args []*Arg) []string {
+	result := make([]string, len(args))
+	for i, a := range args {
+		result[i] = a.Name()
+	}
+	return result
+}
+
+func TestInsnFormatArgKinds(t *testing.T) {
+	f, err := ParseInsnFormat("FdFjFkCa")
+	assert.NoError(t, err)
+	assert.Equal(
+		t,
+		[]ArgKind{ArgKindFPReg, ArgKindFPReg, ArgKindFPReg, ArgKindFCCReg},
+		f.ArgKinds(),
+	)
+
+	f, err = ParseInsnFormat("EMPTY")
+	assert.NoError(t, err)
+	assert.Empty(t, f.ArgKinds())
+	assert.False(t, f.HasKind(ArgKindIntReg))
+}
+
+func TestInsnFormatOperandBits(t *testing.T) {
+	for repr, expected := range map[string]uint{
+		"EMPTY":    0,
+		"DJK":      15,
+		"DJSk12":   22,
+		"JSd5k16":  26,
+		"Sd10k16":  26,
+		"FdFjFkCa": 18,
+	} {
+		f, err := ParseInsnFormat(repr)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, f.OperandBits(), repr)
+		assert.Equal(t, bits.OnesCount32(f.ArgsBitmask()), int(f.OperandBits()), repr)
+	}
+}
+
+func TestInsnFormatArgCount(t *testing.T) {
+	for repr, expected := range map[string]int{
+		"EMPTY":    0,
+		"DJK":      3,
+		"DJSk12":   3,
+		"Sd10k16":  1,
+		"FdFjFkCa": 4,
+	} {
+		f, err := ParseInsnFormat(repr)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, f.ArgCount(), repr)
+	}
+}
+
+func TestInsnFormatHasKindOverCorpus(t *testing.T) {
+	descs := Builtin()
+
+	var fpAndFCC []string
+	for _, f := range GatherFormats(descs) {
+		if f.HasKind(ArgKindFPReg) && f.HasKind(ArgKindFCCReg) {
+			fpAndFCC = append(fpAndFCC, f.CanonicalRepr())
+		}
+	}
+
+	// fsel and the FP compares
+	assert.Contains(t, fpAndFCC, "FdFjFkCa")
+	assert.Contains(t, fpAndFCC, "CdFjFk")
+
+	for _, d := range descs {
+		if d.Mnemonic != "fsel" {
+			continue
+		}
+		assert.True(t, d.Format.HasKind(ArgKindFPReg))
+		assert.True(t, d.Format.HasKind(ArgKindFCCReg))
+		assert.False(t, d.Format.HasKind(ArgKindIntReg))
+	}
+}
+
+func TestInsnDescriptionArgRoles(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"72ef8000 vpickve2gr.b           DVjUk4          @role=elemidx",
+		"72eb8000 vinsgr2vr.b            VdJUk4          @role=elemidx",
+		"73800000 vextrins.d             VdVjUk8",
+	)
+
+	assert.Equal(t, []ArgRole{ArgRoleNone, ArgRoleNone, ArgRoleElemIdx}, descs[0].ArgRoles())
+	assert.Equal(t, []ArgRole{ArgRoleNone, ArgRoleNone, ArgRoleElemIdx}, descs[1].ArgRoles())
+	assert.Equal(t, []ArgRole{ArgRoleNone, ArgRoleNone, ArgRoleNone}, descs[2].ArgRoles())
+
+	// the role doesn't change the encoding
+	assert.Equal(t, uint32(0x72efbc83), descs[0].Encode([]int64{3, 4, 15}))
+
+	for _, l := range []string{
+		"72ef8000 vpickve2gr.b           DVjUk4          @role=foo",
+		"72ef8000 vpickve2gr.b           DVj             @role=elemidx",
+		"00600000 bstrins.w              DJUk5Um5        @role=elemidx",
+	} {
+		_, err := ParseInsnDescriptionLine(l)
+		assert.Error(t, err, l)
+	}
+}
+
+func TestInsnDescriptionImplicitRegs(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"54000000 bl                     Sd10k16         @implicit-write=r1",
+		"50000000 b                      Sd10k16",
+		"00100000 foo                    DJK             @implicit-read=r1,r31 @implicit-write=r0",
+	)
+
+	assert.Equal(t, "r1", descs[0].Attribs["implicit-write"])
+	assert.Equal(t, []uint{1}, descs[0].ImplicitWrites())
+	assert.Empty(t, descs[0].ImplicitReads())
+	assert.Empty(t, descs[1].ImplicitWrites())
+	assert.Equal(t, []uint{1, 31}, descs[2].ImplicitReads())
+	assert.Equal(t, []uint{0}, descs[2].ImplicitWrites())
+
+	for _, l := range []string{
+		"54000000 bl                     Sd10k16         @implicit-write",
+		"54000000 bl                     Sd10k16         @implicit-write=ra",
+		"54000000 bl                     Sd10k16         @implicit-write=r32",
+		"54000000 bl                     Sd10k16         @implicit-read=r1,",
+	} {
+		_, err := ParseInsnDescriptionLine(l)
+		assert.Error(t, err, l)
+	}
+}
+
+func TestInsnDescriptionImplicitRegsOverCorpus(t *testing.T) {
+	for _, d := range Builtin() {
+		switch d.Mnemonic {
+		case "bl":
+			assert.Equal(t, []uint{1}, d.ImplicitWrites())
+		case "b":
+			assert.Empty(t, d.ImplicitWrites())
+		}
+	}
+}
+
+func TestInsnDescriptionRelocArgIndex(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"1a000000 pcalau12i              DSj20           @reloc=sj20",
+		"28c00000 ld.d                   DJSk12          @reloc=sk12",
+		"00100000 add.w                  DJK",
+	)
+
+	assert.Equal(t, 1, descs[0].RelocArgIndex())
+	assert.Equal(t, 2, descs[1].RelocArgIndex())
+	assert.Equal(t, -1, descs[2].RelocArgIndex())
+
+	_, err := ParseInsnDescriptionLine("28c00000 ld.d                   DJSk12          @reloc=sk16")
+	assert.Error(t, err)
+}
+
+func TestInsnDescriptionExtension(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"700a0000 vadd.b                 VdVjVk",
+		"740a0000 xvadd.b                XdXjXk",
+		"00000800 movgr2scr              TdJ             @lbt",
+		"06482001 gtlbclr                EMPTY           @lvz",
+		"00110000 sub.w                  DJK             @ext=foo",
+	)
+	var exts []string
+	for _, d := range descs {
+		exts = append(exts, d.Extension())
+	}
+	assert.Equal(t, []string{"", "LSX", "LASX", "LBT", "LVZ", "FOO"}, exts)
+
+	// every insn of the vector extensions is inferred to be in them
+	for file, ext := range map[string]string{"lsx.txt": "LSX", "lasx.txt": "LASX"} {
+		descs, err := ReadInsnDescriptionFile("../../../" + file)
+		assert.NoError(t, err)
+		for _, d := range descs {
+			assert.Equal(t, ext, d.Extension(), d.Mnemonic)
+		}
+	}
+}
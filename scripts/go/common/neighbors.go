@@ -0,0 +1,70 @@
+package common
+
+import (
+	"fmt"
+	"math/bits"
+)
+
+// BitNeighbors returns, for every bit of word from bit 0 to bit 31, the insn
+// that word decodes to with that bit flipped, or nil if the flipped word
+// doesn't decode to any known insn. This is for assessing how close the
+// encodings of distinct insns are, e.g. for mutation testing of decoders.
+//
+// Use BitNeighborsWithDecoder to reuse a decoder across many words.
+func BitNeighbors(descs []*InsnDescription, word uint32) []*InsnDescription {
+	return BitNeighborsWithDecoder(NewIndexedDecoder(descs), word)
+}
+
+// BitNeighborsWithDecoder is like BitNeighbors, but looks up the insns with
+// dec.
+func BitNeighborsWithDecoder(dec InsnDecoder, word uint32) []*InsnDescription {
+	result := make([]*InsnDescription, 32)
+	for i := range result {
+		result[i] = dec.Lookup(word ^ 1<<i)
+	}
+	return result
+}
+
+// WordDistance returns the Hamming distance between the words, i.e. the
+// number of bits they differ in.
+func WordDistance(a uint32, b uint32) int {
+	return bits.OnesCount32(a ^ b)
+}
+
+// OpcodeDistance returns the number of opcode bits the insns differ in,
+// counting only the bits fixed in both. A distance of 0 between distinct
+// insns means some word may match both, depending on the operand bits of one
+// covering fixed bits of the other; a distance of 1 means a single bit flip
+// in the opcode turns one into the other.
+func (d *InsnDescription) OpcodeDistance(e *InsnDescription) int {
+	return WordDistance(d.Word&d.FixedMask()&e.FixedMask(), e.Word&d.FixedMask()&e.FixedMask())
+}
+
+// EncodingCollision is a pair of distinct insns some word decodes to both of.
+type EncodingCollision struct {
+	A *InsnDescription
+	B *InsnDescription
+	// Word is a word matching both, with all operand bits zero.
+	Word uint32
+}
+
+func (c *EncodingCollision) String() string {
+	return fmt.Sprintf("%s and %s both match 0x%08x", c.A.Mnemonic, c.B.Mnemonic, c.Word)
+}
+
+// FindEncodingCollisions returns the pairs of insns whose opcodes overlap, in
+// the order of descs; a well-formed set of descriptions has none.
+func FindEncodingCollisions(descs []*InsnDescription) []*EncodingCollision {
+	var result []*EncodingCollision
+	for i, d := range descs {
+		for _, e := range descs[i+1:] {
+			if d.OpcodeDistance(e) != 0 {
+				continue
+			}
+			// the bits fixed in only one of them are zero in the other's
+			// word
+			result = append(result, &EncodingCollision{A: d, B: e, Word: d.Word | e.Word})
+		}
+	}
+	return result
+}
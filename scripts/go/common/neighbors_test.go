@@ -0,0 +1,102 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBitNeighbors(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"00108000 add.d                  DJK",
+		"00110000 sub.w                  DJK",
+		"02c00000 addi.d                 DJSk12",
+		"06483800 eret                   EMPTY",
+	)
+	byMnemonic := make(map[string]*InsnDescription)
+	for _, d := range descs {
+		byMnemonic[d.Mnemonic] = d
+	}
+
+	// add.w $r3, $r4, $r5
+	neighbors := BitNeighbors(descs, 0x00101483)
+	assert.Len(t, neighbors, 32)
+	for i, d := range neighbors {
+		switch {
+		case i < 15:
+			// the operands
+			assert.Equal(t, byMnemonic["add.w"], d, "bit %d", i)
+		case i == 15:
+			assert.Equal(t, byMnemonic["add.d"], d, "bit %d", i)
+		case i == 16:
+			assert.Equal(t, byMnemonic["sub.w"], d, "bit %d", i)
+		default:
+			assert.Nil(t, d, "bit %d", i)
+		}
+	}
+
+	// every flip of eret is a miss, as it has no operands
+	for i, d := range BitNeighbors(descs, 0x06483800) {
+		assert.Nil(t, d, "bit %d", i)
+	}
+
+	// the decoders agree
+	dec := NewDecoder(descs)
+	for _, w := range []uint32{0x00101483, 0x02ffc0a4, 0x06483800, 0xffffffff} {
+		assert.Equal(t, BitNeighbors(descs, w), BitNeighborsWithDecoder(dec, w), "%08x", w)
+	}
+}
+
+func TestWordDistance(t *testing.T) {
+	assert.Equal(t, 0, WordDistance(0x00101483, 0x00101483))
+	assert.Equal(t, 1, WordDistance(0x00100000, 0x00108000))
+	assert.Equal(t, 32, WordDistance(0, 0xffffffff))
+	assert.Equal(t, 2, WordDistance(0x80000001, 0))
+}
+
+func TestOpcodeDistance(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"00108000 add.d                  DJK",
+		"00110000 sub.w                  DJK",
+		"02800000 addi.w                 DJSk12",
+		"00000000 foo                    DJSk12",
+	)
+	addW, addD, subW, addiW, foo := descs[0], descs[1], descs[2], descs[3], descs[4]
+
+	assert.Equal(t, 0, addW.OpcodeDistance(addW))
+	// the .w/.d pair differs in a single opcode bit
+	assert.Equal(t, 1, addW.OpcodeDistance(addD))
+	assert.Equal(t, 1, addD.OpcodeDistance(addW))
+	assert.Equal(t, 2, addD.OpcodeDistance(subW))
+
+	// only the bits fixed in both count: bit 20 of add.w is in the sk12 of
+	// addi.w and foo
+	assert.Equal(t, 2, addW.OpcodeDistance(addiW))
+	assert.Equal(t, 0, addW.OpcodeDistance(foo))
+	assert.Equal(t, 2, addiW.OpcodeDistance(foo))
+}
+
+func TestFindEncodingCollisions(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"00108000 add.d                  DJK",
+		// rk of add.w covers the low fixed bits of foo
+		"00100400 foo                    DJ",
+		"02c00000 addi.d                 DJSk12",
+		// and a duplicate
+		"02c00000 addi.d2                DJSk12",
+	)
+
+	collisions := FindEncodingCollisions(descs)
+	if assert.Len(t, collisions, 2) {
+		assert.Equal(t, "add.w and foo both match 0x00100400", collisions[0].String())
+		assert.Equal(t, "addi.d and addi.d2 both match 0x02c00000", collisions[1].String())
+	}
+
+	assert.Empty(t, FindEncodingCollisions(Builtin()))
+}
@@ -0,0 +1,116 @@
+package common
+
+// InsnSpec is the JSON description of the legal operands of an insn, as
+// emitted by genoperandspec; see there for the meaning of the fields.
+type InsnSpec struct {
+	Mnemonic string        `json:"mnemonic"`
+	Word     uint32        `json:"word"`
+	Mask     uint32        `json:"mask"`
+	Format   string        `json:"format"`
+	Operands []OperandSpec `json:"operands"`
+	AsmOrder []int         `json:"asm_order"`
+	Outputs  []int         `json:"outputs,omitempty"`
+
+	ImplicitReads  []uint `json:"implicit_reads,omitempty"`
+	ImplicitWrites []uint `json:"implicit_writes,omitempty"`
+}
+
+type OperandSpec struct {
+	Name   string     `json:"name"`
+	Kind   string     `json:"kind"`
+	Role   string     `json:"role,omitempty"`
+	Signed bool       `json:"signed"`
+	Width  uint       `json:"width"`
+	Min    int64      `json:"min"`
+	Max    int64      `json:"max"`
+	Scale  int64      `json:"scale"`
+	Bias   int64      `json:"bias"`
+	AsmMin int64      `json:"asm_min"`
+	AsmMax int64      `json:"asm_max"`
+	Slots  []SlotSpec `json:"slots"`
+}
+
+type SlotSpec struct {
+	Offset uint `json:"offset"`
+	Width  uint `json:"width"`
+}
+
+func MakeInsnSpec(d *InsnDescription) InsnSpec {
+	asmOrder := d.ManualSyntaxArgIndices()
+
+	// the postprocess ops are only present in the manual syntax
+	manualArgs := make([]*Arg, len(d.Format.Args))
+	for i, a := range d.ManualSyntaxArgs() {
+		manualArgs[asmOrder[i]] = a
+	}
+
+	roles := d.ArgRoles()
+	operands := make([]OperandSpec, len(d.Format.Args))
+	for i, a := range d.Format.Args {
+		operands[i] = makeOperandSpec(a, manualArgs[i])
+		operands[i].Role = string(roles[i])
+	}
+
+	return InsnSpec{
+		Mnemonic: d.Mnemonic,
+		Word:     d.Word,
+		Mask:     d.FixedMask(),
+		Format:   d.Format.CanonicalRepr(),
+		Operands: operands,
+		AsmOrder: asmOrder,
+		Outputs:  d.OutputArgs(),
+
+		ImplicitReads:  d.ImplicitReads(),
+		ImplicitWrites: d.ImplicitWrites(),
+	}
+}
+
+func makeOperandSpec(a *Arg, manualArg *Arg) OperandSpec {
+	slots := make([]SlotSpec, len(a.Slots))
+	for i, s := range a.Slots {
+		slots[i] = SlotSpec{Offset: s.Offset, Width: s.Width}
+	}
+
+	// the manual syntax arg may be of a different kind, so take the
+	// encoded range from the canonical one
+	min, max := a.MinValue(), a.MaxValue()
+	scale, bias := manualArg.Scale(), manualArg.Bias()
+	return OperandSpec{
+		Name:   a.Name(),
+		Kind:   a.Kind.SpecName(),
+		Signed: a.Kind == ArgKindSignedImm,
+		Width:  a.TotalWidth(),
+		Min:    min,
+		Max:    max,
+		Scale:  scale,
+		Bias:   bias,
+		AsmMin: min*scale + bias,
+		AsmMax: max*scale + bias,
+		Slots:  slots,
+	}
+}
+
+// argKindSpecNames is indexed by ArgKind.
+var argKindSpecNames = [...]string{
+	ArgKindIntReg:      "gpr",
+	ArgKindFPReg:       "fpr",
+	ArgKindFCCReg:      "fcc",
+	ArgKindScratchReg:  "scr",
+	ArgKindVReg:        "vr",
+	ArgKindXReg:        "xr",
+	ArgKindSignedImm:   "simm",
+	ArgKindUnsignedImm: "uimm",
+}
+
+// SpecName returns the name of the kind in InsnSpec, like "gpr".
+func (k ArgKind) SpecName() string {
+	if k.Validate() != nil {
+		panic("unreachable")
+	}
+	return argKindSpecNames[k]
+}
+
+// ArgKindSpecNames returns the names of all valid kinds in InsnSpec.
+func ArgKindSpecNames() []string {
+	return append([]string(nil), argKindSpecNames[ArgKindIntReg:]...)
+}
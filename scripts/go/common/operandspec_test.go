@@ -0,0 +1,56 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMakeInsnSpec(t *testing.T) {
+	d, err := ParseInsnDescriptionLine("54000000 bl                     Sd10k16         @orig_fmt=Sd10k16ps2 @implicit-write=r1")
+	assert.NoError(t, err)
+
+	assert.Equal(
+		t,
+		InsnSpec{
+			Mnemonic: "bl",
+			Word:     0x54000000,
+			Mask:     0xfc000000,
+			Format:   "Sd10k16",
+			Operands: []OperandSpec{
+				{
+					Name:   "sd10k16",
+					Kind:   "simm",
+					Signed: true,
+					Width:  26,
+					Min:    -(1 << 25),
+					Max:    1<<25 - 1,
+					Scale:  4,
+					Bias:   0,
+					AsmMin: -(1 << 27),
+					AsmMax: (1<<25 - 1) * 4,
+					Slots:  []SlotSpec{{Offset: 0, Width: 10}, {Offset: 10, Width: 16}},
+				},
+			},
+			AsmOrder:       []int{0},
+			ImplicitWrites: []uint{1},
+		},
+		MakeInsnSpec(d),
+	)
+}
+
+func TestMakeInsnSpecReserved(t *testing.T) {
+	// the reserved slot is not matched by the decoders, so it is not part of
+	// the mask either
+	d, err := ParseInsnDescriptionLine("06498000 tlbclr                 EMPTY           @reserved=d5")
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0xffffffe0), MakeInsnSpec(d).Mask)
+	assert.Equal(t, d.FixedMask(), MakeInsnSpec(d).Mask)
+}
+
+func TestArgKindSpecNames(t *testing.T) {
+	names := ArgKindSpecNames()
+	assert.Equal(t, []string{"gpr", "fpr", "fcc", "scr", "vr", "xr", "simm", "uimm"}, names)
+	assert.Equal(t, "uimm", ArgKindUnsignedImm.SpecName())
+	assert.Panics(t, func() { ArgKindUnknown.SpecName() })
+}
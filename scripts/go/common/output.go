@@ -0,0 +1,317 @@
+package common
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const stampPrefix = "// Inputs fingerprint: "
+
+// stampSearchLines is how many lines from the start of an existing output are
+// searched for the stamp.
+const stampSearchLines = 16
+
+// InputsFingerprint returns the fingerprint of everything an output of the
+// generator depends on: the descriptions and the set flags of fs, except the
+// ones in ignoredFlags (like the output path). Changes to the generator
+// itself are not taken into account.
+func InputsFingerprint(generator string, descs []*InsnDescription, fs *flag.FlagSet, ignoredFlags ...string) string {
+	ignored := make(map[string]bool, len(ignoredFlags))
+	for _, name := range ignoredFlags {
+		ignored[name] = true
+	}
+
+	ignored[dryRunFlagName] = true
+	ignored[dryRunShortFlagName] = true
+
+	var flags []string
+	fs.Visit(func(f *flag.Flag) {
+		if !ignored[f.Name] {
+			flags = append(flags, f.Name+"="+f.Value.String())
+		}
+	})
+	sort.Strings(flags)
+
+	h := sha256.New()
+	io.WriteString(h, generator)
+	io.WriteString(h, "\n")
+	io.WriteString(h, CorpusHash(descs))
+	io.WriteString(h, "\n")
+	for _, f := range flags {
+		io.WriteString(h, f)
+		io.WriteString(h, "\n")
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// EmitStamp emits the fingerprint as a line comment, followed by a blank
+// line, for OutputUpToDate to find in a later run. It must be emitted within
+// the first few lines of the output.
+func (c *EmitterCtx) EmitStamp(fingerprint string) {
+	c.Emit("%s%s\n\n", stampPrefix, fingerprint)
+}
+
+// OutputUpToDate reports whether the output at path exists and has the stamp
+// of the fingerprint.
+func OutputUpToDate(path string, fingerprint string) (bool, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+	defer f.Close()
+
+	sc := bufio.NewScanner(f)
+	for i := 0; i < stampSearchLines && sc.Scan(); i++ {
+		if stamp, ok := cutPrefix(sc.Text(), stampPrefix); ok {
+			return stamp == fingerprint, nil
+		}
+	}
+
+	return false, sc.Err()
+}
+
+// cutPrefix is strings.CutPrefix, which is not available in Go 1.19.
+func cutPrefix(s string, prefix string) (string, bool) {
+	if !strings.HasPrefix(s, prefix) {
+		return s, false
+	}
+	return s[len(prefix):], true
+}
+
+// WriteOutput writes the output to path, or to stdout if path is empty. The
+// file is replaced atomically, so that an interrupted run never leaves a
+// truncated output behind.
+func WriteOutput(path string, content []byte) error {
+	if path == "" {
+		_, err := os.Stdout.Write(content)
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	_, err = tmp.Write(content)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	err = os.Chmod(tmp.Name(), 0644)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), path)
+}
+
+// GenerateOutput runs generate and writes the result to path, or to stdout if
+// path is empty. If incremental is set, path must be given; the fingerprint
+// is passed to generate for stamping the output, and if the existing output
+// already has the stamp, generate is skipped and "up to date." is printed.
+func GenerateOutput(path string, incremental bool, fingerprint string, generate func(stamp string) []byte) error {
+	plan, err := PlanOutput(path, incremental, fingerprint, generate)
+	if err != nil {
+		return err
+	}
+	return WriteOutputs([]OutputPlan{plan})
+}
+
+// OutputPlan tells what a generator run does to one of its outputs.
+type OutputPlan struct {
+	// Path is the path of the output, or "" for stdout.
+	Path string
+	// Content is the generated output, nil if UpToDate.
+	Content []byte
+	// UpToDate is set if the output already has the stamp of an
+	// incremental run, and is left alone.
+	UpToDate bool
+}
+
+// PlanOutput is like GenerateOutput, but only computes the output without
+// writing it.
+func PlanOutput(path string, incremental bool, fingerprint string, generate func(stamp string) []byte) (OutputPlan, error) {
+	if !incremental {
+		return OutputPlan{Path: path, Content: generate("")}, nil
+	}
+
+	if path == "" {
+		return OutputPlan{}, errors.New("incremental generation needs an output path")
+	}
+
+	upToDate, err := OutputUpToDate(path, fingerprint)
+	if err != nil {
+		return OutputPlan{}, err
+	}
+	if upToDate {
+		return OutputPlan{Path: path, UpToDate: true}, nil
+	}
+
+	return OutputPlan{Path: path, Content: generate(fingerprint)}, nil
+}
+
+// WriteOutputs writes the outputs of the plans, printing "up to date." if
+// they all are.
+func WriteOutputs(plans []OutputPlan) error {
+	var paths []string
+	upToDate := true
+	for _, p := range plans {
+		paths = append(paths, p.Path)
+		upToDate = upToDate && p.UpToDate
+	}
+	if upToDate {
+		fmt.Fprintf(os.Stderr, "%s: up to date.\n", strings.Join(paths, ", "))
+		return nil
+	}
+
+	for _, p := range plans {
+		err := WriteOutput(p.Path, p.Content)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const (
+	dryRunFlagName      = "dry-run"
+	dryRunShortFlagName = "n"
+)
+
+// DryRunFlag defines the -dry-run flag and its short form -n on fs, for
+// the generators to print what they would write with PrintOutputPlans
+// instead of writing it. The flag never affects the fingerprint of the
+// inputs.
+func DryRunFlag(fs *flag.FlagSet) *bool {
+	const usage = "only print the outputs that would be written, with their sizes, and the number of insns and formats emitted"
+	result := fs.Bool(dryRunFlagName, false, usage)
+	fs.BoolVar(result, dryRunShortFlagName, false, "short for -"+dryRunFlagName)
+	return result
+}
+
+// PrintOutputPlans prints what WriteOutputs would do with the plans, along
+// with how many insns and formats the generator emits.
+func PrintOutputPlans(w io.Writer, plans []OutputPlan, insns int, formats int) {
+	fmt.Fprintf(w, "would emit %d insns in %d formats:\n", insns, formats)
+	for _, p := range plans {
+		path := p.Path
+		if path == "" {
+			path = "<stdout>"
+		}
+
+		if p.UpToDate {
+			fmt.Fprintf(w, "  %s: up to date\n", path)
+		} else {
+			fmt.Fprintf(w, "  %s: %d bytes\n", path, len(p.Content))
+		}
+	}
+}
+
+// SplitOutputPaths returns the paths of the files an output at path is split
+// into: path itself, followed by n part files named after it with the suffixes
+// _1 to _n, e.g. insns.go, insns_1.go, insns_2.go.
+func SplitOutputPaths(path string, n int) []string {
+	stem := strings.TrimSuffix(path, filepath.Ext(path))
+	result := []string{path}
+	for i := 1; i <= n; i++ {
+		result = append(result, fmt.Sprintf("%s_%d%s", stem, i, filepath.Ext(path)))
+	}
+	return result
+}
+
+// StaleSplitOutputPaths returns the existing part files of an output at path
+// numbered beyond n, left over from an earlier run splitting the output into
+// more files.
+func StaleSplitOutputPaths(path string, n int) ([]string, error) {
+	ext := filepath.Ext(path)
+	stem := strings.TrimSuffix(path, ext)
+	matches, err := filepath.Glob(stem + "_*" + ext)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []string
+	for _, m := range matches {
+		suffix := strings.TrimSuffix(strings.TrimPrefix(m, stem+"_"), ext)
+		if i, err := strconv.Atoi(suffix); err == nil && i > n {
+			result = append(result, m)
+		}
+	}
+	return result, nil
+}
+
+// GenerateOutputs is like GenerateOutput, but for an output split across the
+// files at paths, which must all be given. generate returns the contents of
+// the files in the order of paths. With incremental, generate is skipped only
+// if all the files already have the stamp.
+func GenerateOutputs(paths []string, incremental bool, fingerprint string, generate func(stamp string) [][]byte) error {
+	plans, err := PlanOutputs(paths, incremental, fingerprint, generate)
+	if err != nil {
+		return err
+	}
+	return WriteOutputs(plans)
+}
+
+// PlanOutputs is like GenerateOutputs, but only computes the outputs without
+// writing them.
+func PlanOutputs(paths []string, incremental bool, fingerprint string, generate func(stamp string) [][]byte) ([]OutputPlan, error) {
+	for _, p := range paths {
+		if p == "" {
+			return nil, errors.New("split generation needs output paths")
+		}
+	}
+
+	stamp := ""
+	if incremental {
+		stamp = fingerprint
+
+		upToDate := true
+		for _, p := range paths {
+			ok, err := OutputUpToDate(p, fingerprint)
+			if err != nil {
+				return nil, err
+			}
+			if !ok {
+				upToDate = false
+				break
+			}
+		}
+		if upToDate {
+			result := make([]OutputPlan, len(paths))
+			for i, p := range paths {
+				result[i] = OutputPlan{Path: p, UpToDate: true}
+			}
+			return result, nil
+		}
+	}
+
+	contents := generate(stamp)
+	if len(contents) != len(paths) {
+		return nil, fmt.Errorf("generated %d outputs for %d paths", len(contents), len(paths))
+	}
+
+	result := make([]OutputPlan, len(paths))
+	for i, p := range paths {
+		result[i] = OutputPlan{Path: p, Content: contents[i]}
+	}
+	return result, nil
+}
@@ -0,0 +1,168 @@
+package common
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInputsFingerprint(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(t, "00100000 add.w                  DJK")
+
+	newFlagSet := func(args ...string) *flag.FlagSet {
+		fs := flag.NewFlagSet("gen", flag.PanicOnError)
+		fs.String("pkg", "foo", "")
+		fs.String("o", "", "")
+		assert.NoError(t, fs.Parse(args))
+		return fs
+	}
+
+	base := InputsFingerprint("gen", descs, newFlagSet(), "o")
+	assert.Equal(t, base, InputsFingerprint("gen", descs, newFlagSet("-o", "out.go"), "o"))
+	assert.NotEqual(t, base, InputsFingerprint("gen", descs, newFlagSet("-pkg", "bar"), "o"))
+	assert.NotEqual(t, base, InputsFingerprint("gen2", descs, newFlagSet(), "o"))
+	assert.NotEqual(t, base, InputsFingerprint("gen", nil, newFlagSet(), "o"))
+
+	// a dry run plans the same outputs as a real one
+	fs := newFlagSet()
+	DryRunFlag(fs)
+	assert.NoError(t, fs.Parse([]string{"-n", "-dry-run"}))
+	assert.Equal(t, base, InputsFingerprint("gen", descs, fs, "o"))
+}
+
+func TestGenerateOutputIncremental(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "out.go")
+
+	calls := 0
+	generate := func(stamp string) []byte {
+		calls++
+		var ectx EmitterCtx
+		ectx.Emit("// Code generated by test; DO NOT EDIT.\n\n")
+		ectx.EmitStamp(stamp)
+		ectx.Emit("package foo\n")
+		return ectx.Finalize()
+	}
+
+	upToDate, err := OutputUpToDate(path, "1234")
+	assert.NoError(t, err)
+	assert.False(t, upToDate)
+
+	assert.NoError(t, GenerateOutput(path, true, "1234", generate))
+	assert.Equal(t, 1, calls)
+	assert.NoError(t, GenerateOutput(path, true, "1234", generate))
+	assert.Equal(t, 1, calls)
+	assert.NoError(t, GenerateOutput(path, true, "5678", generate))
+	assert.Equal(t, 2, calls)
+
+	upToDate, err = OutputUpToDate(path, "5678")
+	assert.NoError(t, err)
+	assert.True(t, upToDate)
+
+	content, err := os.ReadFile(path)
+	assert.NoError(t, err)
+	assert.Contains(t, string(content), "// Inputs fingerprint: 5678\n")
+
+	// no temp files are left behind
+	entries, err := os.ReadDir(filepath.Dir(path))
+	assert.NoError(t, err)
+	assert.Len(t, entries, 1)
+
+	assert.Error(t, GenerateOutput("", true, "1234", generate))
+}
+
+func TestSplitOutputPaths(t *testing.T) {
+	assert.Equal(t, []string{"a/insns.go"}, SplitOutputPaths("a/insns.go", 0))
+	assert.Equal(
+		t,
+		[]string{"a/insns.go", "a/insns_1.go", "a/insns_2.go"},
+		SplitOutputPaths("a/insns.go", 2),
+	)
+}
+
+func TestGenerateOutputsIncremental(t *testing.T) {
+	dir := t.TempDir()
+	paths := SplitOutputPaths(filepath.Join(dir, "out.go"), 2)
+
+	calls := 0
+	generate := func(stamp string) [][]byte {
+		calls++
+		result := make([][]byte, len(paths))
+		for i := range result {
+			var ectx EmitterCtx
+			ectx.Emit("// Code generated by test; DO NOT EDIT.\n\n")
+			ectx.EmitStamp(stamp)
+			ectx.Emit("package foo\n")
+			result[i] = ectx.Finalize()
+		}
+		return result
+	}
+
+	assert.NoError(t, GenerateOutputs(paths, true, "1234", generate))
+	assert.Equal(t, 1, calls)
+	assert.NoError(t, GenerateOutputs(paths, true, "1234", generate))
+	assert.Equal(t, 1, calls)
+
+	// a missing part is regenerated
+	assert.NoError(t, os.Remove(paths[2]))
+	assert.NoError(t, GenerateOutputs(paths, true, "1234", generate))
+	assert.Equal(t, 2, calls)
+	assert.FileExists(t, paths[2])
+
+	// parts from a run with more parts are stale
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "out_3.go"), nil, 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "out_x.go"), nil, 0644))
+	stale, err := StaleSplitOutputPaths(paths[0], 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{filepath.Join(dir, "out_3.go")}, stale)
+
+	assert.Error(t, GenerateOutputs([]string{""}, false, "", generate))
+}
+
+func TestPlanOutputsDryRun(t *testing.T) {
+	dir := t.TempDir()
+	paths := SplitOutputPaths(filepath.Join(dir, "out.go"), 1)
+	generate := func(stamp string) [][]byte {
+		return [][]byte{[]byte("package foo\n"), []byte("package foo // part\n")}
+	}
+
+	plans, err := PlanOutputs(paths, false, "1234", generate)
+	assert.NoError(t, err)
+
+	var sb strings.Builder
+	PrintOutputPlans(&sb, plans, 3, 2)
+	assert.Equal(
+		t,
+		"would emit 3 insns in 2 formats:\n  "+paths[0]+": 12 bytes\n  "+paths[1]+": 20 bytes\n",
+		sb.String(),
+	)
+
+	// planning creates no files
+	entries, err := os.ReadDir(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, entries)
+
+	plan, err := PlanOutput("", false, "1234", func(string) []byte { return []byte("x") })
+	assert.NoError(t, err)
+	sb.Reset()
+	PrintOutputPlans(&sb, []OutputPlan{plan}, 1, 1)
+	assert.Equal(t, "would emit 1 insns in 1 formats:\n  <stdout>: 1 bytes\n", sb.String())
+
+	// and the outputs already up to date are reported as such
+	generateStamped := func(stamp string) []byte {
+		var ectx EmitterCtx
+		ectx.EmitStamp(stamp)
+		ectx.Emit("package foo\n")
+		return ectx.Finalize()
+	}
+	assert.NoError(t, GenerateOutput(paths[0], true, "1234", generateStamped))
+	plan, err = PlanOutput(paths[0], true, "1234", generateStamped)
+	assert.NoError(t, err)
+	assert.Equal(t, OutputPlan{Path: paths[0], UpToDate: true}, plan)
+	sb.Reset()
+	PrintOutputPlans(&sb, []OutputPlan{plan}, 1, 1)
+	assert.Equal(t, "would emit 1 insns in 1 formats:\n  "+paths[0]+": up to date\n", sb.String())
+}
@@ -8,12 +8,26 @@ import (
 	"strings"
 )
 
-var insnRE = regexp.MustCompile(`^([0-9a-f]{8}) ([a-z][0-9a-z_.]*) +(EMPTY|[0-9DJKACFVXSTUdjkamn]+)((?: *@[0-9A-Za-z_.=]+)*)$`)
-var attribRE = regexp.MustCompile(`@[0-9A-Za-z_.]+(?:=[0-9A-Za-z_.]*)?`)
+var insnRE = regexp.MustCompile(`^([0-9A-Za-z]+) ([A-Za-z][0-9A-Za-z_.]*) +(EMPTY|[0-9DJKACFVXSTUdjkamn]+)((?: *@[0-9A-Za-z_.,=-]+)*)$`)
+var bitPatternInsnRE = regexp.MustCompile(`^(0b[01djkafvxctsuSU_]+) +([A-Za-z][0-9A-Za-z_.]*)((?: *@[0-9A-Za-z_.,=-]+)*)$`)
+var attribRE = regexp.MustCompile(`@[0-9A-Za-z_.-]+(?:=[0-9A-Za-z_.,]*)?`)
 
 const origFmtKey = "orig_fmt"
-
+const reservedKey = "reserved"
+const roleKey = "role"
+const implicitReadKey = "implicit-read"
+const implicitWriteKey = "implicit-write"
+const relocKey = "reloc"
+const syntaxOrderKey = "syntax_order"
+
+// ParseInsnDescriptionLine parses an insn description line, with the insn
+// word given either as hex followed by the format, or in bit pattern notation
+// (see ParseInsnBitPattern).
 func ParseInsnDescriptionLine(line string) (*InsnDescription, error) {
+	if strings.HasPrefix(line, bitPatternPrefix) {
+		return parseBitPatternInsnDescriptionLine(line)
+	}
+
 	matches := insnRE.FindStringSubmatch(line)
 	if matches == nil {
 		return nil, errors.New("malformed insn description line")
@@ -24,22 +38,87 @@ func ParseInsnDescriptionLine(line string) (*InsnDescription, error) {
 	insnFmtStr := matches[3]
 	attribsStr := matches[4]
 
-	word64, err := strconv.ParseUint(wordStr, 16, 32)
+	word, err := parseInsnWord(wordStr)
 	if err != nil {
-		panic("should never happen")
+		return nil, err
 	}
-	word := uint32(word64)
 
 	insnFmt, err := ParseInsnFormat(insnFmtStr)
 	if err != nil {
 		return nil, err
 	}
 
+	return makeInsnDescription(word, mnemonic, insnFmt, attribsStr)
+}
+
+// parseInsnWord parses the hex insn word of a description line, that must
+// fit in 32 bits. Whether it is written with exactly 8 digits is only checked
+// in strict mode, see checkStrictInsnWord.
+func parseInsnWord(s string) (uint32, error) {
+	word, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		if errors.Is(err, strconv.ErrRange) {
+			return 0, fmt.Errorf("insn word %q does not fit in 32 bits", s)
+		}
+		return 0, fmt.Errorf("insn word %q is not a hex number", s)
+	}
+	return uint32(word), nil
+}
+
+var strictInsnWordRE = regexp.MustCompile(`^[0-9a-f]{8}$`)
+
+// checkStrictInsnWord checks that the hex insn word of a description line is
+// written with exactly 8 lower case digits, like the rest of the corpus.
+func checkStrictInsnWord(line string) error {
+	if strings.HasPrefix(line, bitPatternPrefix) {
+		return nil
+	}
+
+	wordStr, _, _ := strings.Cut(line, " ")
+	if !strictInsnWordRE.MatchString(wordStr) {
+		return fmt.Errorf("insn word %q is not written as 8 lower case hex digits", wordStr)
+	}
+	return nil
+}
+
+func parseBitPatternInsnDescriptionLine(line string) (*InsnDescription, error) {
+	matches := bitPatternInsnRE.FindStringSubmatch(line)
+	if matches == nil {
+		return nil, errors.New("malformed bit pattern insn description line")
+	}
+
+	word, insnFmt, err := ParseInsnBitPattern(matches[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return makeInsnDescription(word, matches[2], insnFmt, matches[3])
+}
+
+func makeInsnDescription(
+	word uint32,
+	mnemonic string,
+	insnFmt *InsnFormat,
+	attribsStr string,
+) (*InsnDescription, error) {
 	attribs, err := parseInsnAttribs(attribsStr)
 	if err != nil {
 		return nil, err
 	}
 
+	return makeInsnDescriptionWithAttribs(word, mnemonic, insnFmt, attribs)
+}
+
+// makeInsnDescriptionWithAttribs is like makeInsnDescription, but with the
+// attribs already parsed. The orig_fmt and reserved attribs are removed from
+// attribs.
+func makeInsnDescriptionWithAttribs(
+	word uint32,
+	mnemonic string,
+	insnFmt *InsnFormat,
+	attribs map[string]string,
+) (*InsnDescription, error) {
+	var err error
 	var origFmt *InsnFormat
 	if origFmtStr, ok := attribs[origFmtKey]; ok {
 		origFmt, err = ParseInsnFormat(origFmtStr)
@@ -49,12 +128,30 @@ func ParseInsnDescriptionLine(line string) (*InsnDescription, error) {
 		delete(attribs, origFmtKey)
 	}
 
+	var reserved []*Slot
+	if reservedStr, ok := attribs[reservedKey]; ok {
+		reserved, err = ParseSlots(reservedStr)
+		if err != nil {
+			return nil, err
+		}
+		delete(attribs, reservedKey)
+	}
+
+	// mnemonics are case-insensitive, and always handled in lower case
+	var sourceMnemonic string
+	if lower := strings.ToLower(mnemonic); lower != mnemonic {
+		sourceMnemonic = mnemonic
+		mnemonic = lower
+	}
+
 	result := InsnDescription{
-		Word:       word,
-		Mnemonic:   mnemonic,
-		Format:     insnFmt,
-		OrigFormat: origFmt,
-		Attribs:    attribs,
+		Word:           word,
+		Mnemonic:       mnemonic,
+		SourceMnemonic: sourceMnemonic,
+		Format:         insnFmt,
+		OrigFormat:     origFmt,
+		Reserved:       reserved,
+		Attribs:        attribs,
 	}
 
 	err = result.Validate()
@@ -119,6 +216,24 @@ func ParseInsnFormat(input string) (*InsnFormat, error) {
 	}, nil
 }
 
+// ParseSlots parses a non-empty sequence of slots, like "d5k16".
+func ParseSlots(input string) ([]*Slot, error) {
+	lexer := insnFormatLexer{
+		input: []rune(input),
+	}
+
+	slots, err := lexer.consumeAtLeastOneSlot()
+	if err != nil {
+		return nil, err
+	}
+
+	if !lexer.eof() {
+		return nil, fmt.Errorf("trailing garbage after slots: %s", string(lexer.input[lexer.curr:]))
+	}
+
+	return slots, nil
+}
+
 type insnFormatLexer struct {
 	input []rune
 
@@ -129,7 +244,12 @@ func (l *insnFormatLexer) eof() bool {
 	return l.curr >= len(l.input)
 }
 
+// eat consumes the next rune, returning 0 at EOF, which is then rejected as
+// any other unexpected rune.
 func (l *insnFormatLexer) eat() rune {
+	if l.eof() {
+		return 0
+	}
 	result := l.input[l.curr]
 	l.curr++
 	return result
@@ -264,7 +384,10 @@ func (l *insnFormatLexer) consumeSlot() (*Slot, error) {
 		return nil, err
 	}
 
-	width := l.consumeUint()
+	width, err := l.consumeUint()
+	if err != nil {
+		return nil, fmt.Errorf("width of slot %c: %w", offsetCh, err)
+	}
 
 	return &Slot{
 		Offset: offset,
@@ -272,8 +395,15 @@ func (l *insnFormatLexer) consumeSlot() (*Slot, error) {
 	}, nil
 }
 
-func (l *insnFormatLexer) consumeUint() uint {
-	firstCh := l.eat()
+func (l *insnFormatLexer) consumeUint() (uint, error) {
+	firstCh, wouldEOF := l.peek()
+	if wouldEOF {
+		return 0, errors.New("want a number, got end of input")
+	}
+	if firstCh < '0' || firstCh > '9' {
+		return 0, fmt.Errorf("want a number, got %s", strconv.QuoteRune(firstCh))
+	}
+	_ = l.eat()
 	result := uint(firstCh - '0')
 
 	for {
@@ -290,7 +420,7 @@ func (l *insnFormatLexer) consumeUint() uint {
 		result = 10*result + uint(nextCh-'0')
 	}
 
-	return result
+	return result, nil
 }
 
 func (l *insnFormatLexer) maybeConsumePostprocessOp() (PostprocessOp, error) {
@@ -307,7 +437,10 @@ func (l *insnFormatLexer) maybeConsumePostprocessOp() (PostprocessOp, error) {
 		return PostprocessOp{}, err
 	}
 
-	amt := l.consumeUint()
+	amt, err := l.consumeUint()
+	if err != nil {
+		return PostprocessOp{}, fmt.Errorf("amount of postprocess op: %w", err)
+	}
 
 	return PostprocessOp{
 		Kind:   kind,
@@ -371,3 +504,39 @@ func makeScratchRegArg(offset uint) *Arg {
 		},
 	}
 }
+
+// parseImplicitRegs parses comma-separated integer register names, like
+// "r1,r3".
+func parseImplicitRegs(input string) ([]uint, error) {
+	if input == "" {
+		return nil, nil
+	}
+
+	var result []uint
+	for _, name := range strings.Split(input, ",") {
+		if !strings.HasPrefix(name, "r") {
+			return nil, fmt.Errorf("invalid implicit register %q", name)
+		}
+
+		n, err := strconv.ParseUint(name[1:], 10, 8)
+		if err != nil || n > 31 {
+			return nil, fmt.Errorf("invalid implicit register %q", name)
+		}
+
+		result = append(result, uint(n))
+	}
+
+	return result, nil
+}
+
+// MnemonicCaseWarnings returns a warning for every insn whose mnemonic is not
+// written in lower case in the source.
+func MnemonicCaseWarnings(descs []*InsnDescription) []string {
+	var result []string
+	for _, d := range descs {
+		if d.SourceMnemonic != "" {
+			result = append(result, fmt.Sprintf("%08x: mnemonic %q is not in lower case, normalized to %q", d.Word, d.SourceMnemonic, d.Mnemonic))
+		}
+	}
+	return result
+}
@@ -162,6 +162,41 @@ func TestParseInsnDescriptionLine(t *testing.T) {
 				Attribs: map[string]string{},
 			},
 		},
+		{
+			x:  "00010000 asrtle                 JK              @reserved=d5",
+			ok: true,
+			expected: &InsnDescription{
+				Word:     0x00010000,
+				Mnemonic: "asrtle",
+				Format: &InsnFormat{
+					Args: []*Arg{
+						{Kind: ArgKindIntReg, Slots: []*Slot{{Offset: 5, Width: 5}}},
+						{Kind: ArgKindIntReg, Slots: []*Slot{{Offset: 10, Width: 5}}},
+					},
+				},
+				Reserved: []*Slot{{Offset: 0, Width: 5}},
+				Attribs:  map[string]string{},
+			},
+		},
+		{
+			// reserved slot overlapping with args
+			x:  "00010000 asrtle                 JK              @reserved=j5",
+			ok: false,
+		},
+		{
+			// reserved slot not zero in the insn word
+			x:  "00010001 asrtle                 JK              @reserved=d5",
+			ok: false,
+		},
+		{
+			x:  "00010000 asrtle                 JK              @reserved=d5x",
+			ok: false,
+		},
+		{
+			// slot without width
+			x:  "00010000 asrtle                 JK              @reserved=d",
+			ok: false,
+		},
 	}
 
 	for _, tc := range testcases {
@@ -175,3 +210,58 @@ func TestParseInsnDescriptionLine(t *testing.T) {
 		}
 	}
 }
+
+func TestParseInsnDescriptionLineMnemonicCase(t *testing.T) {
+	lower, err := ParseInsnDescriptionLine("02c00000 addi.d                 DJSk12          @qemu")
+	assert.NoError(t, err)
+	upper, err := ParseInsnDescriptionLine("02c00000 ADDI.D                 DJSk12          @qemu")
+	assert.NoError(t, err)
+
+	assert.Equal(t, "addi.d", lower.Mnemonic)
+	assert.Equal(t, "", lower.SourceMnemonic)
+	assert.Equal(t, "addi.d", upper.Mnemonic)
+	assert.Equal(t, "ADDI.D", upper.SourceMnemonic)
+
+	upper.SourceMnemonic = ""
+	assert.Equal(t, lower, upper)
+
+	assert.Empty(t, MnemonicCaseWarnings([]*InsnDescription{lower}))
+	upper.SourceMnemonic = "ADDI.D"
+	assert.Equal(
+		t,
+		[]string{`02c00000: mnemonic "ADDI.D" is not in lower case, normalized to "addi.d"`},
+		MnemonicCaseWarnings([]*InsnDescription{lower, upper}),
+	)
+
+	descs, err := ExpandInsnFamilyLine("family 28000000 LD.{B:0,H:400000}  DJSk12")
+	assert.NoError(t, err)
+	assert.Equal(t, "ld.h", descs[1].Mnemonic)
+	assert.Equal(t, "LD.H", descs[1].SourceMnemonic)
+
+	_, err = ExpandInsnFamilyLine("family 28000000 ld.{b:0,B:400000}  DJSk12")
+	assert.EqualError(t, err, `duplicate insn family variant "ld.B"`)
+}
+
+func TestParseSlots(t *testing.T) {
+	slots, err := ParseSlots("d5k16")
+	assert.NoError(t, err)
+	assert.Equal(t, []*Slot{{Offset: 0, Width: 5}, {Offset: 10, Width: 16}}, slots)
+
+	for input, errMsg := range map[string]string{
+		"":     "no slot was consumed",
+		"d":    "width of slot d: want a number, got end of input",
+		"d5k":  "width of slot k: want a number, got end of input",
+		"dx":   "width of slot d: want a number, got 'x'",
+		"d5x":  "trailing garbage after slots: x",
+		"d5ps": "trailing garbage after slots: ps",
+	} {
+		_, err := ParseSlots(input)
+		assert.EqualError(t, err, errMsg, input)
+	}
+
+	// truncated formats are rejected as well
+	for _, input := range []string{"F", "DJSk12ps", "DJSk12p"} {
+		_, err := ParseInsnFormat(input)
+		assert.Error(t, err, input)
+	}
+}
@@ -2,36 +2,240 @@ package common
 
 import (
 	"bufio"
+	"errors"
+	"fmt"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
 )
 
+var includeRE = regexp.MustCompile(`^include +(\S+)$`)
+
+// IncludeError is an error that happened in a file included by other files.
+type IncludeError struct {
+	// Chain is the list of files involved, from the outermost file to the
+	// file where the error happened.
+	Chain []string
+	Err   error
+}
+
+func (e *IncludeError) Error() string {
+	var sb strings.Builder
+	sb.WriteString(e.Chain[len(e.Chain)-1])
+	for i := len(e.Chain) - 2; i >= 0; i-- {
+		if i == len(e.Chain)-2 {
+			sb.WriteString(" (included from ")
+		} else {
+			sb.WriteString(", included from ")
+		}
+		sb.WriteString(e.Chain[i])
+	}
+	sb.WriteString("): ")
+	sb.WriteString(e.Err.Error())
+	return sb.String()
+}
+
+func (e *IncludeError) Unwrap() error {
+	return e.Err
+}
+
+// LineError is an error in a line of an insn description file.
+type LineError struct {
+	// Path is empty if the file is included by other files, in which case
+	// the error is wrapped in an IncludeError telling the path.
+	Path string
+	Line int
+	Err  error
+}
+
+func (e *LineError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+	}
+	return fmt.Sprintf("%s:%d: %v", e.Path, e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+// ErrorList is a list of errors, e.g. all the malformed lines of a file,
+// reported together instead of stopping at the first one.
+type ErrorList []error
+
+func (l ErrorList) Error() string {
+	msgs := make([]string, len(l))
+	for i, err := range l {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// Unwrap returns the errors of l, for errors.Is and errors.As to look into
+// each of them.
+func (l ErrorList) Unwrap() []error {
+	return l
+}
+
+// As finds the first error of l that matches target, like errors.As. It is
+// for Go before 1.20, whose errors.As doesn't know Unwrap() []error.
+func (l ErrorList) As(target interface{}) bool {
+	for _, err := range l {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns nil if l is empty, the only error if l has one, or l itself.
+func (l ErrorList) Err() error {
+	switch len(l) {
+	case 0:
+		return nil
+	case 1:
+		return l[0]
+	default:
+		return l
+	}
+}
+
+// add appends err to l, flattening it if err is an ErrorList itself.
+func (l *ErrorList) add(err error) {
+	if el, ok := err.(ErrorList); ok {
+		*l = append(*l, el...)
+		return
+	}
+	*l = append(*l, err)
+}
+
+// ReadInsnDescriptionFile reads all insn descriptions in the file at path.
+//
+// Besides insn descriptions, a line can also be an "include other.txt"
+// directive, causing the descriptions in other.txt to be read in place.
+// Relative include paths are resolved against the directory of the including
+// file. A "family ..." line is expanded into the descriptions of all its
+// variants (see ExpandInsnFamilyLine). "macro ..." lines are skipped, to be
+// read by ReadMacroDescs instead.
+//
+// Errors in the lines read are reported as LineError. Reading continues past
+// malformed lines, so all of them are reported, as an ErrorList if there is
+// more than one; errors.As finds the LineError through it either way.
 func ReadInsnDescriptionFile(path string) ([]*InsnDescription, error) {
-	f, err := os.Open(path)
+	return readInsnDescriptionFile(path, nil, false)
+}
+
+// ReadInsnDescriptionFileStrict is like ReadInsnDescriptionFile, but also
+// requires the insn words to be written as exactly 8 lower case hex digits,
+// instead of any hex number fitting in 32 bits.
+func ReadInsnDescriptionFileStrict(path string) ([]*InsnDescription, error) {
+	return readInsnDescriptionFile(path, nil, true)
+}
+
+func readInsnDescriptionFile(path string, chain []string, strict bool) ([]*InsnDescription, error) {
+	absPath, err := filepath.Abs(path)
 	if err != nil {
 		return nil, err
 	}
+
+	for i, p := range chain {
+		if p == absPath {
+			cycle := append(append([]string{}, chain[i:]...), absPath)
+			return nil, fmt.Errorf("include cycle: %s", strings.Join(cycle, " -> "))
+		}
+	}
+	chain = append(chain[:len(chain):len(chain)], absPath)
+
+	// wraps errors that happened in this very file with the include chain,
+	// if this file is included by others
+	wrapErr := func(err error) error {
+		if len(chain) == 1 {
+			return err
+		}
+		return &IncludeError{Chain: chain, Err: err}
+	}
+	wrapLineErr := func(lineno int, err error) error {
+		if len(chain) == 1 {
+			return &LineError{Path: path, Line: lineno, Err: err}
+		}
+		return wrapErr(&LineError{Line: lineno, Err: err})
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, wrapErr(err)
+	}
 	defer f.Close()
 
 	var result []*InsnDescription
+	var errs ErrorList
 
 	sc := bufio.NewScanner(f)
+	lineno := 0
 	for sc.Scan() {
+		lineno++
 		l := sc.Text()
 
 		// the line read has no newline suffix, ready for consumption
 
-		if len(l) == 0 {
+		if len(strings.TrimSpace(l)) == 0 {
 			// skip empty lines
 			continue
 		}
 
+		if matches := includeRE.FindStringSubmatch(l); matches != nil {
+			includedPath := matches[1]
+			if !filepath.IsAbs(includedPath) {
+				includedPath = filepath.Join(filepath.Dir(path), includedPath)
+			}
+
+			descs, err := readInsnDescriptionFile(includedPath, chain, strict)
+			if err != nil {
+				errs.add(err)
+				continue
+			}
+
+			result = append(result, descs...)
+			continue
+		}
+
+		if strings.HasPrefix(l, macroPrefix) {
+			continue
+		}
+
+		if strings.HasPrefix(l, familyPrefix) {
+			descs, err := ExpandInsnFamilyLine(l)
+			if err != nil {
+				errs.add(wrapLineErr(lineno, err))
+				continue
+			}
+
+			result = append(result, descs...)
+			continue
+		}
+
+		if strict {
+			if err := checkStrictInsnWord(l); err != nil {
+				errs.add(wrapLineErr(lineno, err))
+				continue
+			}
+		}
+
 		desc, err := ParseInsnDescriptionLine(l)
 		if err != nil {
-			return nil, err
+			errs.add(wrapLineErr(lineno, err))
+			continue
 		}
 
 		result = append(result, desc)
 	}
+	if err := sc.Err(); err != nil {
+		errs.add(wrapErr(err))
+	}
 
+	if err := errs.Err(); err != nil {
+		return nil, err
+	}
 	return result, nil
 }
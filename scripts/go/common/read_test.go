@@ -0,0 +1,196 @@
+package common
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writeTestFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		assert.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+	}
+	return dir
+}
+
+func TestReadInsnDescriptionFileInclude(t *testing.T) {
+	dir := writeTestFiles(t, map[string]string{
+		"all.txt":      "include base.txt\n\ninclude sub/fp.txt\n",
+		"base.txt":     "00100000 add.w                  DJK\n00110000 sub.w                  DJK\n",
+		"sub/fp.txt":   "01008000 fadd.s                 FdFjFk\ninclude more.txt\n",
+		"sub/more.txt": "01010000 fadd.d                 FdFjFk\n",
+	})
+
+	descs, err := ReadInsnDescriptionFile(filepath.Join(dir, "all.txt"))
+	assert.NoError(t, err)
+
+	mnemonics := make([]string, len(descs))
+	for i, d := range descs {
+		mnemonics[i] = d.Mnemonic
+	}
+	assert.Equal(t, []string{"add.w", "sub.w", "fadd.s", "fadd.d"}, mnemonics)
+}
+
+func TestReadInsnDescriptionFileIncludeErrors(t *testing.T) {
+	dir := writeTestFiles(t, map[string]string{
+		"all.txt":     "include mid.txt\n",
+		"mid.txt":     "00100000 add.w                  DJK\ninclude bad.txt\n",
+		"bad.txt":     "00110000 sub.w                  DJQ\n",
+		"cycle-a.txt": "include cycle-b.txt\n",
+		"cycle-b.txt": "include cycle-a.txt\n",
+		"missing.txt": "include nonexistent.txt\n",
+	})
+
+	_, err := ReadInsnDescriptionFile(filepath.Join(dir, "all.txt"))
+	var includeErr *IncludeError
+	assert.ErrorAs(t, err, &includeErr)
+	assert.Equal(
+		t,
+		[]string{
+			filepath.Join(dir, "all.txt"),
+			filepath.Join(dir, "mid.txt"),
+			filepath.Join(dir, "bad.txt"),
+		},
+		includeErr.Chain,
+	)
+	assert.Contains(
+		t,
+		err.Error(),
+		"bad.txt (included from "+filepath.Join(dir, "mid.txt")+", included from "+filepath.Join(dir, "all.txt")+"): ",
+	)
+
+	_, err = ReadInsnDescriptionFile(filepath.Join(dir, "cycle-a.txt"))
+	assert.EqualError(
+		t,
+		err,
+		"include cycle: "+filepath.Join(dir, "cycle-a.txt")+" -> "+filepath.Join(dir, "cycle-b.txt")+" -> "+filepath.Join(dir, "cycle-a.txt"),
+	)
+
+	_, err = ReadInsnDescriptionFile(filepath.Join(dir, "missing.txt"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+	assert.ErrorAs(t, err, &includeErr)
+}
+
+func TestReadInsnDescsFiltered(t *testing.T) {
+	dir := writeTestFiles(t, map[string]string{
+		"a.txt": "00100000 add.w                  DJK             @qemu\n00110000 sub.w                  DJK\n",
+		"b.txt": "01008000 fadd.s                 FdFjFk          @qemu\n",
+	})
+	paths := []string{filepath.Join(dir, "a.txt"), filepath.Join(dir, "b.txt")}
+
+	descs, err := ReadInsnDescsFiltered(paths, func(d *InsnDescription) bool {
+		return d.HasAttrib("qemu")
+	})
+	assert.NoError(t, err)
+	assert.Len(t, descs, 2)
+	assert.Equal(t, "add.w", descs[0].Mnemonic)
+	assert.Equal(t, "fadd.s", descs[1].Mnemonic)
+
+	descs, err = ReadInsnDescsFiltered(paths, nil)
+	assert.NoError(t, err)
+	assert.Len(t, descs, 3)
+
+	_, err = ReadInsnDescsFiltered([]string{filepath.Join(dir, "c.txt")}, nil)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestReadInsnDescriptionFileWordErrors(t *testing.T) {
+	dir := writeTestFiles(t, map[string]string{
+		"nine.txt":    "00100000 add.w                  DJK\n100110000 sub.w                  DJK\n",
+		"nonhex.txt":  "\n0010000g add.w                  DJK\n",
+		"short.txt":   "100000 add.w                  DJK\n",
+		"upper.txt":   "001A0000 add.w                  DJK\n",
+		"include.txt": "include nine.txt\n",
+	})
+
+	_, err := ReadInsnDescriptionFile(filepath.Join(dir, "nine.txt"))
+	var lineErr *LineError
+	if assert.ErrorAs(t, err, &lineErr) {
+		assert.Equal(t, 2, lineErr.Line)
+	}
+	assert.EqualError(t, err, filepath.Join(dir, "nine.txt")+`:2: insn word "100110000" does not fit in 32 bits`)
+
+	_, err = ReadInsnDescriptionFile(filepath.Join(dir, "nonhex.txt"))
+	assert.EqualError(t, err, filepath.Join(dir, "nonhex.txt")+`:2: insn word "0010000g" is not a hex number`)
+
+	_, err = ReadInsnDescriptionFile(filepath.Join(dir, "include.txt"))
+	assert.EqualError(
+		t,
+		err,
+		filepath.Join(dir, "nine.txt")+" (included from "+filepath.Join(dir, "include.txt")+`): line 2: insn word "100110000" does not fit in 32 bits`,
+	)
+
+	// words not written as 8 digits are only rejected in strict mode
+	descs, err := ReadInsnDescriptionFile(filepath.Join(dir, "short.txt"))
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0x00100000), descs[0].Word)
+	_, err = ReadInsnDescriptionFileStrict(filepath.Join(dir, "short.txt"))
+	assert.EqualError(t, err, filepath.Join(dir, "short.txt")+`:1: insn word "100000" is not written as 8 lower case hex digits`)
+
+	_, err = ReadInsnDescriptionFile(filepath.Join(dir, "upper.txt"))
+	assert.NoError(t, err)
+	_, err = ReadInsnDescsStrict([]string{filepath.Join(dir, "upper.txt")})
+	assert.EqualError(t, err, filepath.Join(dir, "upper.txt")+`:1: insn word "001A0000" is not written as 8 lower case hex digits`)
+
+	// the corpus is written strictly
+	paths, err := filepath.Glob("../../../*.txt")
+	assert.NoError(t, err)
+	_, err = ReadInsnDescsStrict(paths)
+	assert.NoError(t, err)
+}
+
+func TestReadInsnDescriptionFileMultipleErrors(t *testing.T) {
+	dir := writeTestFiles(t, map[string]string{
+		"bad.txt":   "00100000 add.w                  DJK\n100110000 sub.w                  DJK\n   \n0010000g add.d                  DJK\n",
+		"other.txt": "\t\n0010000g sub.d                  DJK\n",
+		"empty.txt": "",
+		"blank.txt": "00100000 add.w                  DJK\n \t\n\n",
+	})
+	bad := filepath.Join(dir, "bad.txt")
+	other := filepath.Join(dir, "other.txt")
+
+	// all malformed lines are reported
+	_, err := ReadInsnDescriptionFile(bad)
+	var errs ErrorList
+	if assert.ErrorAs(t, err, &errs) {
+		assert.Len(t, errs, 2)
+	}
+	assert.EqualError(
+		t,
+		err,
+		bad+`:2: insn word "100110000" does not fit in 32 bits`+"\n"+
+			bad+`:4: insn word "0010000g" is not a hex number`,
+	)
+
+	// and of all files
+	_, err = ReadInsnDescs([]string{bad, other})
+	if assert.ErrorAs(t, err, &errs) {
+		assert.Len(t, errs, 3)
+		var lineErr *LineError
+		if assert.ErrorAs(t, errs[2], &lineErr) {
+			assert.Equal(t, other, lineErr.Path)
+			assert.Equal(t, 2, lineErr.Line)
+		}
+	}
+
+	// the location of the first one is found through the list
+	var lineErr *LineError
+	if assert.ErrorAs(t, err, &lineErr) {
+		assert.Equal(t, bad, lineErr.Path)
+		assert.Equal(t, 2, lineErr.Line)
+	}
+	assert.Equal(t, []error(errs), errs.Unwrap())
+
+	// empty and blank lines are fine
+	descs, err := ReadInsnDescriptionFile(filepath.Join(dir, "empty.txt"))
+	assert.NoError(t, err)
+	assert.Empty(t, descs)
+	descs, err = ReadInsnDescriptionFile(filepath.Join(dir, "blank.txt"))
+	assert.NoError(t, err)
+	assert.Len(t, descs, 1)
+}
@@ -0,0 +1,107 @@
+package common
+
+import (
+	"fmt"
+	"strings"
+)
+
+// RegStyle is the naming style of the registers in RenderInstructionStyle.
+type RegStyle int
+
+const (
+	// RegStyleNumeric names the registers by number, like "$r4" or "$f0".
+	RegStyleNumeric RegStyle = iota
+	// RegStyleABI names the GPRs and FPRs by their ABI names, like "$a0" or
+	// "$fa0", and the others like RegStyleNumeric.
+	RegStyleABI
+)
+
+// gprABINames is indexed by register number; r21 has no ABI name.
+var gprABINames = [32]string{
+	"zero", "ra", "tp", "sp",
+	"a0", "a1", "a2", "a3", "a4", "a5", "a6", "a7",
+	"t0", "t1", "t2", "t3", "t4", "t5", "t6", "t7", "t8",
+	"",
+	"fp",
+	"s0", "s1", "s2", "s3", "s4", "s5", "s6", "s7", "s8",
+}
+
+// fprABINames is indexed by register number.
+var fprABINames = [32]string{
+	"fa0", "fa1", "fa2", "fa3", "fa4", "fa5", "fa6", "fa7",
+	"ft0", "ft1", "ft2", "ft3", "ft4", "ft5", "ft6", "ft7",
+	"ft8", "ft9", "ft10", "ft11", "ft12", "ft13", "ft14", "ft15",
+	"fs0", "fs1", "fs2", "fs3", "fs4", "fs5", "fs6", "fs7",
+}
+
+// ABIRegName returns the ABI name of the register numbered n of the register
+// arg kind, like "$a0", or the name by RegName if it has none.
+func ABIRegName(k ArgKind, n int64) string {
+	var names *[32]string
+	switch k {
+	case ArgKindIntReg:
+		names = &gprABINames
+	case ArgKindFPReg:
+		names = &fprABINames
+	}
+
+	if names != nil && n >= 0 && n < int64(len(names)) && names[n] != "" {
+		return "$" + names[n]
+	}
+	return RegName(k, n)
+}
+
+// RenderInstruction is RenderInstructionStyle with RegStyleNumeric.
+func RenderInstruction(d *InsnDescription, operands []int64) string {
+	return RenderInstructionStyle(d, operands, RegStyleNumeric)
+}
+
+// RenderInstructionStyle returns the insn with the given operands, in the
+// canonical order and as encoded like with InsnDescription.Encode, in the
+// syntax of GNU as: the operands are in the order of the manual, unless
+// overridden with @syntax_order, and the immediates are scaled back, e.g.
+// branch offsets are in bytes.
+func RenderInstructionStyle(d *InsnDescription, operands []int64, style RegStyle) string {
+	if len(operands) != d.Format.ArgCount() {
+		panic(fmt.Sprintf("%s: want %d operand(s), got %d", d.Mnemonic, d.Format.ArgCount(), len(operands)))
+	}
+
+	if len(operands) == 0 {
+		return d.Mnemonic
+	}
+
+	// the scales of the immediates are only known to the manual syntax
+	manualIndices := d.ManualSyntaxArgIndices()
+	manualArgs := make([]*Arg, len(d.Format.Args))
+	for i, a := range d.ManualSyntaxArgs() {
+		manualArgs[manualIndices[i]] = a
+	}
+
+	order := manualIndices
+	if _, ok := d.Attribs[syntaxOrderKey]; ok {
+		order = d.SyntaxArgIndices()
+	}
+
+	var sb strings.Builder
+	sb.WriteString(d.Mnemonic)
+	for j, i := range order {
+		if j == 0 {
+			sb.WriteRune(' ')
+		} else {
+			sb.WriteString(", ")
+		}
+
+		a := d.Format.Args[i]
+		v := operands[i]
+		switch {
+		case a.Kind.IsImm():
+			fmt.Fprintf(&sb, "%d", v*manualArgs[i].Scale()+manualArgs[i].Bias())
+		case style == RegStyleABI:
+			sb.WriteString(ABIRegName(a.Kind, v))
+		default:
+			sb.WriteString(RegName(a.Kind, v))
+		}
+	}
+
+	return sb.String()
+}
@@ -0,0 +1,31 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRenderInstruction(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"58000000 beq                    DJSk16          @orig_fmt=JDSk16ps2",
+		"00c00000 bstrpick.d             DJUk6Um6        @orig_fmt=DJUm6Uk6 @syntax_order=d,j,um6,uk6",
+		"0d000000 fsel                   FdFjFkCa",
+		"06482000 tlbclr                 EMPTY",
+	)
+
+	assert.Equal(t, "add.w $r4, $r5, $r21", RenderInstruction(descs[0], []int64{4, 5, 21}))
+	// operands in manual order, offset in bytes
+	assert.Equal(t, "beq $r5, $r4, -16", RenderInstruction(descs[1], []int64{4, 5, -4}))
+	assert.Equal(t, "bstrpick.d $r4, $r5, 31, 8", RenderInstruction(descs[2], []int64{4, 5, 8, 31}))
+	assert.Equal(t, "fsel $f0, $f1, $f2, $fcc3", RenderInstruction(descs[3], []int64{0, 1, 2, 3}))
+	assert.Equal(t, "tlbclr", RenderInstruction(descs[4], nil))
+
+	assert.Equal(t, "add.w $a0, $a1, $r21", RenderInstructionStyle(descs[0], []int64{4, 5, 21}, RegStyleABI))
+	assert.Equal(t, "add.w $zero, $fp, $s8", RenderInstructionStyle(descs[0], []int64{0, 22, 31}, RegStyleABI))
+	assert.Equal(t, "fsel $fa0, $fa1, $ft8, $fcc3", RenderInstructionStyle(descs[3], []int64{0, 1, 16, 3}, RegStyleABI))
+
+	assert.Panics(t, func() { RenderInstruction(descs[0], []int64{4, 5}) })
+}
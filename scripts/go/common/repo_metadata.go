@@ -1,7 +1,12 @@
 package common
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
 	"os/exec"
+	"sort"
 	"strings"
 )
 
@@ -15,3 +20,68 @@ func MustGetGitCommitHash() string {
 
 	return strings.TrimSpace(string(stdout))
 }
+
+// CorpusHash returns a stable hash of the descriptions' contents, that only
+// depends on the set of descriptions and not on their order or on the
+// formatting of the description files.
+func CorpusHash(descs []*InsnDescription) string {
+	lines := make([]string, len(descs))
+	for i, d := range descs {
+		lines[i] = canonicalLineForHash(d)
+	}
+	sort.Strings(lines)
+
+	h := sha256.New()
+	for _, l := range lines {
+		io.WriteString(h, l)
+		io.WriteString(h, "\n")
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func canonicalLineForHash(d *InsnDescription) string {
+	line := fmt.Sprintf("%08x %s %s", d.Word, d.Mnemonic, d.Format.CanonicalRepr())
+	if attribs := d.CanonicalAttribsRepr(); attribs != "" {
+		line += " " + attribs
+	}
+	return line
+}
+
+// CanonicalAttribsRepr returns the attributes of the insn, including orig_fmt
+// and reserved, as space-separated "@key=value" items sorted by key, with
+// orig_fmt and reserved first. Attributes with the value "true" are written
+// as "@key", the same as they are written in the description files, and
+// empty values as "@key=".
+func (d *InsnDescription) CanonicalAttribsRepr() string {
+	var items []string
+
+	if d.OrigFormat != nil {
+		items = append(items, fmt.Sprintf("@%s=%s", origFmtKey, d.OrigFormat.CanonicalRepr()))
+	}
+
+	if len(d.Reserved) > 0 {
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "@%s=", reservedKey)
+		for _, s := range d.Reserved {
+			sb.WriteString(s.CanonicalRepr())
+		}
+		items = append(items, sb.String())
+	}
+
+	keys := make([]string, 0, len(d.Attribs))
+	for k := range d.Attribs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		item := "@" + k
+		if v := d.Attribs[k]; v != "true" {
+			item += "=" + v
+		}
+		items = append(items, item)
+	}
+
+	return strings.Join(items, " ")
+}
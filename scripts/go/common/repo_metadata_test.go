@@ -0,0 +1,47 @@
+package common
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCorpusHash(t *testing.T) {
+	dir := writeTestFiles(t, map[string]string{
+		"a.txt":         "00100000 add.w                  DJK             @qemu @commutative\n00110000 sub.w                  DJK\n",
+		"b.txt":         "38600000 amswap.w               DJK             @orig_fmt=DKJ\n",
+		"b-changed.txt": "38600000 amswap.w               DJK\n",
+		// same as a.txt, but with lines and attribs reordered and
+		// formatted differently
+		"a-shuffled.txt": "00110000 sub.w  DJK\n00100000 add.w  DJK  @commutative @qemu\n",
+	})
+
+	read := func(names ...string) []*InsnDescription {
+		var paths []string
+		for _, n := range names {
+			paths = append(paths, filepath.Join(dir, n))
+		}
+		descs, err := ReadInsnDescs(paths)
+		assert.NoError(t, err)
+		return descs
+	}
+
+	h := CorpusHash(read("a.txt", "b.txt"))
+	assert.Len(t, h, 64)
+	assert.Equal(t, h, CorpusHash(read("b.txt", "a.txt")))
+	assert.Equal(t, h, CorpusHash(read("b.txt", "a-shuffled.txt")))
+	assert.NotEqual(t, h, CorpusHash(read("a.txt", "b-changed.txt")))
+	assert.NotEqual(t, h, CorpusHash(read("a.txt")))
+}
+
+func TestCorpusHashOverCorpus(t *testing.T) {
+	descs := Builtin()
+
+	reversed := make([]*InsnDescription, len(descs))
+	for i, d := range descs {
+		reversed[len(descs)-1-i] = d
+	}
+
+	assert.Equal(t, CorpusHash(descs), CorpusHash(reversed))
+}
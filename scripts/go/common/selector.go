@@ -0,0 +1,181 @@
+package common
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AttribSelector selects insns by an attrib, for generators to emit only
+// part of the insns. It is written as "key" for the presence of the attrib,
+// "key=value" for an exact value, or "key<=value", "key<value",
+// "key>=value" or "key>value" for comparing the value as a dotted version
+// number, e.g. "since<=1.0" for the insns of ISA v1.00 and before. Insns
+// without the attrib are never selected.
+//
+// The zero AttribSelector selects all insns. It implements flag.Value, for
+// the -select flags of the generators.
+type AttribSelector struct {
+	Key string
+	// Op is "" for presence, or one of "=", "<=", "<", ">=" and ">".
+	Op    string
+	Value string
+}
+
+var attribSelectorOps = []string{"<=", ">=", "<", ">", "="}
+
+// ParseAttribSelector parses the selector s. An empty s selects all insns.
+func ParseAttribSelector(s string) (*AttribSelector, error) {
+	var result AttribSelector
+	if err := result.Set(s); err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+func (x *AttribSelector) String() string {
+	return x.Key + x.Op + x.Value
+}
+
+func (x *AttribSelector) Set(s string) error {
+	*x = AttribSelector{}
+	if s == "" {
+		return nil
+	}
+
+	key, op, value := s, "", ""
+	if idx := strings.IndexAny(s, "<>="); idx != -1 {
+		key = s[:idx]
+		for _, o := range attribSelectorOps {
+			if strings.HasPrefix(s[idx:], o) {
+				op = o
+				value = s[idx+len(o):]
+				break
+			}
+		}
+		if value == "" {
+			return fmt.Errorf("attrib selector %q: missing value", s)
+		}
+	}
+	if key == "" {
+		return fmt.Errorf("attrib selector %q: missing attrib name", s)
+	}
+	if op != "" && op != "=" {
+		if _, ok := parseVersion(value); !ok {
+			return fmt.Errorf("attrib selector %q: %q is not a version number", s, value)
+		}
+	}
+
+	*x = AttribSelector{Key: key, Op: op, Value: value}
+	return nil
+}
+
+// Matches reports whether the insn is selected.
+func (x *AttribSelector) Matches(d *InsnDescription) bool {
+	if x.Key == "" {
+		return true
+	}
+
+	v, ok := d.Attribs[x.Key]
+	if !ok {
+		return false
+	}
+
+	switch x.Op {
+	case "":
+		return true
+	case "=":
+		return v == x.Value
+	}
+
+	cmp, ok := compareVersions(v, x.Value)
+	if !ok {
+		return false
+	}
+	switch x.Op {
+	case "<=":
+		return cmp <= 0
+	case "<":
+		return cmp < 0
+	case ">=":
+		return cmp >= 0
+	case ">":
+		return cmp > 0
+	default:
+		panic("unreachable")
+	}
+}
+
+// Filter returns the selected insns, in the order of descs.
+func (x *AttribSelector) Filter(descs []*InsnDescription) []*InsnDescription {
+	var result []*InsnDescription
+	for _, d := range descs {
+		if x.Matches(d) {
+			result = append(result, d)
+		}
+	}
+	return result
+}
+
+// AttribSelectorFlag defines the -select flag on fs, selecting the insns
+// for a generator to emit, by default those selected by def.
+func AttribSelectorFlag(fs *flag.FlagSet, def string) *AttribSelector {
+	result, err := ParseAttribSelector(def)
+	if err != nil {
+		panic(err)
+	}
+	fs.Var(result, "select", "emit only the insns with this attrib, written as key, key=value, or a version comparison like since<=1.0; empty to emit all insns")
+	return result
+}
+
+// FilterByAttrib returns the insns with the attrib key, in the order of
+// descs, or all of them if key is empty.
+func FilterByAttrib(descs []*InsnDescription, key string) []*InsnDescription {
+	return (&AttribSelector{Key: key}).Filter(descs)
+}
+
+// parseVersion parses a dotted version number like "1.10".
+func parseVersion(s string) ([]uint64, bool) {
+	parts := strings.Split(s, ".")
+	result := make([]uint64, len(parts))
+	for i, p := range parts {
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			return nil, false
+		}
+		result[i] = n
+	}
+	return result, true
+}
+
+// compareVersions compares the dotted version numbers component-wise, the
+// missing components counting as 0, so "1.1" is after "1.0" and equal to
+// "1.1.0". It returns false if either is not a version number.
+func compareVersions(a string, b string) (int, bool) {
+	va, ok := parseVersion(a)
+	if !ok {
+		return 0, false
+	}
+	vb, ok := parseVersion(b)
+	if !ok {
+		return 0, false
+	}
+
+	for i := 0; i < len(va) || i < len(vb); i++ {
+		var x, y uint64
+		if i < len(va) {
+			x = va[i]
+		}
+		if i < len(vb) {
+			y = vb[i]
+		}
+		switch {
+		case x < y:
+			return -1, true
+		case x > y:
+			return 1, true
+		}
+	}
+	return 0, true
+}
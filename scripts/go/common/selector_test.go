@@ -0,0 +1,58 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttribSelector(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK             @qemu @since=1.0",
+		"00108000 add.d                  DJK             @since=1.00",
+		"38580000 amcas.b                DJK             @since=1.1 @qemu",
+		"38590000 amcas.h                DJK             @since=foo",
+		"00110000 sub.w                  DJK",
+	)
+	mnemonics := func(ds []*InsnDescription) []string {
+		var result []string
+		for _, d := range ds {
+			result = append(result, d.Mnemonic)
+		}
+		return result
+	}
+
+	// both attrib forms on the same line
+	assert.Equal(t, map[string]string{"qemu": "true", "since": "1.1"}, descs[2].Attribs)
+
+	testcases := []struct {
+		sel      string
+		expected []string
+	}{
+		{sel: "", expected: []string{"add.w", "add.d", "amcas.b", "amcas.h", "sub.w"}},
+		{sel: "qemu", expected: []string{"add.w", "amcas.b"}},
+		{sel: "since", expected: []string{"add.w", "add.d", "amcas.b", "amcas.h"}},
+		{sel: "since=1.0", expected: []string{"add.w"}},
+		{sel: "since<=1.0", expected: []string{"add.w", "add.d"}},
+		{sel: "since<1.1", expected: []string{"add.w", "add.d"}},
+		{sel: "since>=1.1", expected: []string{"amcas.b"}},
+		{sel: "since>1.0.0", expected: []string{"amcas.b"}},
+		{sel: "nonexistent", expected: nil},
+	}
+	for _, tc := range testcases {
+		sel, err := ParseAttribSelector(tc.sel)
+		if assert.NoError(t, err, tc.sel) {
+			assert.Equal(t, tc.sel, sel.String())
+			assert.Equal(t, tc.expected, mnemonics(sel.Filter(descs)), tc.sel)
+		}
+	}
+
+	assert.Equal(t, []string{"add.w", "amcas.b"}, mnemonics(FilterByAttrib(descs, "qemu")))
+	assert.Len(t, FilterByAttrib(descs, ""), len(descs))
+
+	for _, s := range []string{"=1.0", "since=", "since<=", "since<=foo", "since<1.x"} {
+		_, err := ParseAttribSelector(s)
+		assert.Error(t, err, s)
+	}
+}
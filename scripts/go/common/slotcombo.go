@@ -0,0 +1,77 @@
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SlotCombination is the sorted offsets of all slots of a format. Formats
+// sharing a slot combination share the slot encoders of the generators.
+type SlotCombination []uint
+
+// the historical names of the slot offsets, kept so the generated slot
+// encoders don't churn
+var slotOffsetNames = map[uint]string{
+	0:  "D",
+	5:  "J",
+	10: "K",
+	15: "A",
+	16: "M",
+}
+
+// SlotOffsetName returns the name of a slot offset for use in generated
+// identifiers: "D", "J", "K", "A" or "M" for the common offsets, or "S"
+// followed by the offset, e.g. "S18", for any other.
+func SlotOffsetName(offset uint) string {
+	if name, ok := slotOffsetNames[offset]; ok {
+		return name
+	}
+	return fmt.Sprintf("S%d", offset)
+}
+
+// SlotCombinationForFormat returns the slot combination of f.
+func SlotCombinationForFormat(f *InsnFormat) SlotCombination {
+	var result SlotCombination
+	for _, a := range f.Args {
+		for _, s := range a.Slots {
+			result = append(result, s.Offset)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+// Names returns the names of the slot offsets of sc, in order.
+func (sc SlotCombination) Names() []string {
+	result := make([]string, len(sc))
+	for i, offset := range sc {
+		result[i] = SlotOffsetName(offset)
+	}
+	return result
+}
+
+// String returns the concatenated names of the slot offsets, e.g. "DJK".
+func (sc SlotCombination) String() string {
+	return strings.Join(sc.Names(), "")
+}
+
+// GatherSlotCombinations returns the distinct slot combinations of the
+// formats, sorted by their string representation. EMPTY is skipped.
+func GatherSlotCombinations(fmts []*InsnFormat) []SlotCombination {
+	seen := make(map[string]struct{})
+	var result []SlotCombination
+	for _, f := range fmts {
+		if len(f.Args) == 0 {
+			continue
+		}
+		sc := SlotCombinationForFormat(f)
+		if _, ok := seen[sc.String()]; ok {
+			continue
+		}
+		seen[sc.String()] = struct{}{}
+		result = append(result, sc)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].String() < result[j].String() })
+	return result
+}
@@ -0,0 +1,30 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSlotCombinations(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"02800000 addi.w                 DJSk12",
+		"14000000 lu12i.w                DSj20",
+		"00000000 foo                    DJUn5",
+		"06483800 eret                   EMPTY",
+	)
+	formats := GatherFormats(descs)
+
+	assert.Equal(t, SlotCombination{0, 5, 10}, SlotCombinationForFormat(descs[0].Format))
+	assert.Equal(t, SlotCombination{0, 5, 18}, SlotCombinationForFormat(descs[3].Format))
+	assert.Equal(t, "DJS18", SlotCombinationForFormat(descs[3].Format).String())
+	assert.Equal(t, []string{"D", "J", "S18"}, SlotCombinationForFormat(descs[3].Format).Names())
+
+	var reprs []string
+	for _, sc := range GatherSlotCombinations(formats) {
+		reprs = append(reprs, sc.String())
+	}
+	assert.Equal(t, []string{"DJ", "DJK", "DJS18"}, reprs)
+}
@@ -0,0 +1,85 @@
+package common
+
+import "fmt"
+
+const subfnKey = "subfn"
+
+// SubFunctionSlots returns the slots of the sub-function field of the insn,
+// as declared by the @subfn attrib, or nil if there's none.
+//
+// A sub-function field is a run of fixed opcode bits where sibling insns,
+// sharing the rest of their opcode, put the code of the specific operation,
+// typically where other insns of the family have an operand; e.g. fabs.s,
+// fclass.s and fsqrt.s differ only in the k5 slot (@subfn=k5). Like all bits
+// outside of the operands and the reserved slots, the field takes part in
+// opcode matching with the value given by the insn word; declaring it only
+// makes it known to the tools.
+func (d *InsnDescription) SubFunctionSlots() []*Slot {
+	slots, err := d.subFunctionSlots()
+	if err != nil {
+		panic(err)
+	}
+	return slots
+}
+
+func (d *InsnDescription) subFunctionSlots() ([]*Slot, error) {
+	s, ok := d.Attribs[subfnKey]
+	if !ok {
+		return nil, nil
+	}
+
+	slots, err := ParseSlots(s)
+	if err != nil {
+		return nil, fmt.Errorf("sub-function field: %w", err)
+	}
+
+	var seenMask uint32
+	for _, s := range slots {
+		mask := s.Bitmask()
+		if mask&^d.FixedMask() != 0 {
+			return nil, fmt.Errorf("sub-function slot %s is not within the fixed opcode bits", s.CanonicalRepr())
+		}
+		if mask&seenMask != 0 {
+			return nil, fmt.Errorf("sub-function slot %s overlapped with other sub-function slots", s.CanonicalRepr())
+		}
+		seenMask |= mask
+	}
+
+	return slots, nil
+}
+
+// SubFunctionMask returns the mask of the bits of the sub-function field of
+// the insn, or 0 if there's none.
+func (d *InsnDescription) SubFunctionMask() uint32 {
+	var result uint32
+	for _, s := range d.SubFunctionSlots() {
+		result |= s.Bitmask()
+	}
+	return result
+}
+
+// SubFunction returns the value of the sub-function field of the insn, with
+// the slots concatenated like those of an unsigned immediate, and whether the
+// insn has one.
+func (d *InsnDescription) SubFunction() (uint32, bool) {
+	slots := d.SubFunctionSlots()
+	if slots == nil {
+		return 0, false
+	}
+
+	a := Arg{Kind: ArgKindUnsignedImm, Slots: slots}
+	return uint32(a.Extract(d.Word)), true
+}
+
+// IsSubFunctionSiblingOf reports whether the insns have the same format and
+// sub-function field, and differ only in the value of the latter.
+func (d *InsnDescription) IsSubFunctionSiblingOf(other *InsnDescription) bool {
+	mask := d.SubFunctionMask()
+	if mask == 0 || mask != other.SubFunctionMask() {
+		return false
+	}
+	if d.Format.CanonicalRepr() != other.Format.CanonicalRepr() || d.FixedMask() != other.FixedMask() {
+		return false
+	}
+	return d.Word&^mask == other.Word&^mask && d.Word != other.Word
+}
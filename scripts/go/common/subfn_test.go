@@ -0,0 +1,62 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSubFunction(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"01140400 fabs.s                 FdFj            @subfn=k5",
+		"01143400 fclass.s               FdFj            @subfn=k5",
+		"01144400 fsqrt.s                FdFj            @subfn=k5",
+		"01148400 frecip.s               FdFj",
+	)
+
+	var values []uint32
+	for _, d := range descs[:3] {
+		v, ok := d.SubFunction()
+		assert.True(t, ok)
+		values = append(values, v)
+		assert.Equal(t, uint32(0x7c00), d.SubFunctionMask())
+	}
+	assert.Equal(t, []uint32{0x01, 0x0d, 0x11}, values)
+
+	_, ok := descs[3].SubFunction()
+	assert.False(t, ok)
+	assert.Nil(t, descs[3].SubFunctionSlots())
+
+	assert.True(t, descs[0].IsSubFunctionSiblingOf(descs[1]))
+	assert.True(t, descs[2].IsSubFunctionSiblingOf(descs[0]))
+	assert.False(t, descs[0].IsSubFunctionSiblingOf(descs[0]))
+	assert.False(t, descs[0].IsSubFunctionSiblingOf(descs[3]))
+
+	// the field tells the siblings apart when decoding
+	dec := NewDecoder(descs)
+	for _, d := range descs {
+		word := d.Word | 5<<5 | 6
+		x, ok := dec.Decode(word)
+		if assert.True(t, ok, d.Mnemonic) {
+			assert.Equal(t, d.Mnemonic, x.Desc.Mnemonic)
+			assert.Equal(t, []int64{6, 5}, x.Args)
+		}
+	}
+	_, ok = dec.Decode(0x01140000 | 0x1f<<10)
+	assert.False(t, ok)
+}
+
+func TestSubFunctionInvalid(t *testing.T) {
+	for _, l := range []string{
+		// overlapping an operand
+		"01140400 fabs.s                 FdFj            @subfn=j5",
+		// overlapping a reserved slot
+		"01140000 fabs.s                 FdFj            @subfn=k5 @reserved=k5",
+		"01140400 fabs.s                 FdFj            @subfn=k5k3",
+		"01140400 fabs.s                 FdFj            @subfn=x",
+	} {
+		_, err := ParseInsnDescriptionLine(l)
+		assert.Error(t, err, l)
+	}
+}
@@ -9,3 +9,14 @@ func GoAnameForInsn(mnemonic string) string {
 	tmp = strings.ToUpper(tmp)
 	return "A" + tmp
 }
+
+// ObjQualified returns the reference to the identifier name of the obj
+// package imported from importPath, e.g. "obj.AMask", or the bare name if
+// importPath is empty and the generated package defines the identifier
+// itself.
+func ObjQualified(importPath string, name string) string {
+	if importPath == "" {
+		return name
+	}
+	return "obj." + name
+}
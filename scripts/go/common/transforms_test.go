@@ -0,0 +1,12 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestObjQualified(t *testing.T) {
+	assert.Equal(t, "obj.AMask", ObjQualified("cmd/internal/obj", "AMask"))
+	assert.Equal(t, "AMask", ObjQualified("", "AMask"))
+}
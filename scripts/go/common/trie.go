@@ -0,0 +1,76 @@
+package common
+
+import "sort"
+
+// MnemonicTrie is a prefix tree of the mnemonics of insns, for completing
+// partially typed mnemonics.
+type MnemonicTrie struct {
+	root trieNode
+}
+
+type trieNode struct {
+	children map[byte]*trieNode
+	// terminal is set if the path to the node spells a whole mnemonic.
+	terminal bool
+}
+
+func NewMnemonicTrie(descs []*InsnDescription) *MnemonicTrie {
+	var t MnemonicTrie
+	for _, d := range descs {
+		t.Insert(d.Mnemonic)
+	}
+	return &t
+}
+
+func (t *MnemonicTrie) Insert(mnemonic string) {
+	n := &t.root
+	for i := 0; i < len(mnemonic); i++ {
+		if n.children == nil {
+			n.children = make(map[byte]*trieNode)
+		}
+
+		c, ok := n.children[mnemonic[i]]
+		if !ok {
+			c = &trieNode{}
+			n.children[mnemonic[i]] = c
+		}
+		n = c
+	}
+	n.terminal = true
+}
+
+// Complete returns the mnemonics starting with prefix, sorted.
+func (t *MnemonicTrie) Complete(prefix string) []string {
+	n := &t.root
+	for i := 0; i < len(prefix); i++ {
+		c, ok := n.children[prefix[i]]
+		if !ok {
+			return nil
+		}
+		n = c
+	}
+
+	var result []string
+	buf := []byte(prefix)
+	var walk func(n *trieNode)
+	walk = func(n *trieNode) {
+		if n.terminal {
+			result = append(result, string(buf))
+		}
+
+		keys := make([]byte, 0, len(n.children))
+		for k := range n.children {
+			keys = append(keys, k)
+		}
+		sort.Slice(keys, func(i int, j int) bool { return keys[i] < keys[j] })
+
+		for _, k := range keys {
+			buf = append(buf, k)
+			walk(n.children[k])
+			buf = buf[:len(buf)-1]
+		}
+	}
+	walk(n)
+
+	return result
+}
@@ -0,0 +1,35 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMnemonicTrie(t *testing.T) {
+	var tr MnemonicTrie
+	for _, m := range []string{"add.w", "add.d", "addi.w", "addi.d", "and", "andi", "b"} {
+		tr.Insert(m)
+	}
+
+	assert.Equal(t, []string{"add.d", "add.w", "addi.d", "addi.w"}, tr.Complete("add"))
+	assert.Equal(t, []string{"and", "andi"}, tr.Complete("and"))
+	assert.Equal(t, []string{"b"}, tr.Complete("b"))
+	assert.Len(t, tr.Complete(""), 7)
+	assert.Nil(t, tr.Complete("x"))
+}
+
+func TestMnemonicTrieCorpus(t *testing.T) {
+	descs := Builtin()
+	tr := NewMnemonicTrie(descs)
+
+	all := tr.Complete("")
+	seen := make(map[string]bool)
+	for _, d := range descs {
+		seen[d.Mnemonic] = true
+	}
+	assert.Len(t, all, len(seen))
+	for _, m := range all {
+		assert.True(t, seen[m], m)
+	}
+}
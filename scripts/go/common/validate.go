@@ -0,0 +1,118 @@
+package common
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// OperandError is returned when an operand value is out of the valid range of
+// its arg.
+type OperandError struct {
+	Mnemonic string
+	// Name is the operand's name, i.e. the arg's lowercased canonical
+	// representation, e.g. "sk12".
+	Name  string
+	Desc  string
+	Value int64
+	Min   int64
+	Max   int64
+}
+
+func (e *OperandError) Error() string {
+	return fmt.Sprintf(
+		"%s: operand %s: %s %d out of range [%d, %d]",
+		e.Mnemonic,
+		e.Name,
+		e.Desc,
+		e.Value,
+		e.Min,
+		e.Max,
+	)
+}
+
+// Name returns the name of the arg used for referring to operands, i.e. the
+// lowercased canonical representation.
+func (a *Arg) Name() string {
+	return strings.ToLower(a.CanonicalRepr())
+}
+
+// DescribeArg returns the human-readable description of what the arg is,
+// e.g. "integer register" or "element index".
+func DescribeArg(a *Arg, role ArgRole) string {
+	switch role {
+	case ArgRoleElemIdx:
+		return "element index"
+	case ArgRoleCacheOp:
+		return "cache operation code"
+	}
+
+	switch a.Kind {
+	case ArgKindIntReg:
+		return "integer register"
+	case ArgKindFPReg:
+		return "FP register"
+	case ArgKindFCCReg:
+		return "FCC register"
+	case ArgKindScratchReg:
+		return "scratch register"
+	case ArgKindVReg:
+		return "LSX register"
+	case ArgKindXReg:
+		return "LASX register"
+	case ArgKindSignedImm:
+		return "signed immediate"
+	case ArgKindUnsignedImm:
+		return "unsigned immediate"
+	default:
+		panic("unreachable")
+	}
+}
+
+// ValidateAll checks the operands, keyed by arg names (see Arg.Name), and
+// returns all problems found instead of stopping at the first one: missing
+// operands and out-of-range values in the order of the args, then unknown
+// operands in the order of their names. The result is nil if the operands are
+// all valid.
+func (d *InsnDescription) ValidateAll(operands map[string]int64) []error {
+	var result []error
+
+	roles := d.ArgRoles()
+	known := make(map[string]struct{}, len(d.Format.Args))
+	for i, a := range d.Format.Args {
+		name := a.Name()
+		known[name] = struct{}{}
+
+		v, ok := operands[name]
+		if !ok {
+			result = append(result, fmt.Errorf("%s: missing operand %s", d.Mnemonic, name))
+			continue
+		}
+
+		min, max := a.MinValue(), a.MaxValue()
+		if v < min || v > max {
+			result = append(result, &OperandError{
+				Mnemonic: d.Mnemonic,
+				Name:     name,
+				Desc:     DescribeArg(a, roles[i]),
+				Value:    v,
+				Min:      min,
+				Max:      max,
+			})
+		}
+	}
+
+	var unknown []string
+	for name := range operands {
+		if _, ok := known[name]; !ok {
+			unknown = append(unknown, name)
+		}
+	}
+	sort.Strings(unknown)
+
+	for _, name := range unknown {
+		result = append(result, fmt.Errorf("%s: unknown operand %s", d.Mnemonic, name))
+	}
+
+	return result
+}
@@ -0,0 +1,39 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInsnDescriptionValidateAll(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"02c00000 addi.d                 DJSk12",
+		"0d000000 fsel                   FdFjFkCa",
+		"72ef8000 vpickve2gr.b           DVjUk4          @role=elemidx",
+	)
+
+	assert.Nil(t, descs[0].ValidateAll(map[string]int64{"d": 4, "j": 5, "sk12": -2048}))
+
+	errs := descs[0].ValidateAll(map[string]int64{"d": 32, "j": 5, "sk12": 2048})
+	assert.Len(t, errs, 2)
+	assert.EqualError(t, errs[0], "addi.d: operand d: integer register 32 out of range [0, 31]")
+	assert.EqualError(t, errs[1], "addi.d: operand sk12: signed immediate 2048 out of range [-2048, 2047]")
+
+	errs = descs[1].ValidateAll(map[string]int64{"fd": -1, "fj": 1, "ca": 8, "foo": 0, "bar": 0})
+	assert.Len(t, errs, 5)
+	assert.EqualError(t, errs[0], "fsel: operand fd: FP register -1 out of range [0, 31]")
+	assert.EqualError(t, errs[1], "fsel: missing operand fk")
+	assert.EqualError(t, errs[2], "fsel: operand ca: FCC register 8 out of range [0, 7]")
+	assert.EqualError(t, errs[3], "fsel: unknown operand bar")
+	assert.EqualError(t, errs[4], "fsel: unknown operand foo")
+
+	errs = descs[2].ValidateAll(map[string]int64{"d": 4, "vj": 5, "uk4": 16})
+	assert.Len(t, errs, 1)
+	assert.EqualError(t, errs[0], "vpickve2gr.b: operand uk4: element index 16 out of range [0, 15]")
+
+	var oe *OperandError
+	assert.ErrorAs(t, errs[0], &oe)
+	assert.Equal(t, int64(15), oe.Max)
+}
@@ -0,0 +1,116 @@
+package common
+
+import (
+	"math/bits"
+	"sort"
+	"strings"
+)
+
+// variantSuffixPairs are the mnemonic suffixes of the insns that differ only
+// in the operand width, narrower first.
+var variantSuffixPairs = [][2]string{
+	{"b", "h"},
+	{"w", "d"},
+	{"bu", "hu"},
+	{"wu", "du"},
+	{"s", "d"},
+}
+
+// VariantPair is a pair of insns sharing a mnemonic stem, differing only in
+// the operand width, e.g. add.w and add.d.
+type VariantPair struct {
+	Narrow *InsnDescription
+	Wide   *InsnDescription
+}
+
+// Stem returns the mnemonic stem shared by the pair, e.g. "add" for add.w and
+// add.d.
+func (p *VariantPair) Stem() string {
+	stem, _ := splitVariantSuffix(p.Narrow.Mnemonic)
+	return stem
+}
+
+// DiffBits returns the positions of the fixed bits differing between the
+// words of the pair, in ascending order. Bits belonging to the args of either
+// insn are not compared, as the wider variant may have wider immediates.
+func (p *VariantPair) DiffBits() []uint {
+	mask := p.Narrow.FixedMask() & p.Wide.FixedMask()
+	var result []uint
+	for diff := (p.Narrow.Word ^ p.Wide.Word) & mask; diff != 0; diff &= diff - 1 {
+		result = append(result, uint(bits.TrailingZeros32(diff)))
+	}
+	return result
+}
+
+// IsAnomalous returns whether the words of the pair differ in other than a
+// single bit, or two adjacent bits as in the 2-bit width fields encoding the
+// narrower and wider variants as 0b01 and 0b10. This usually indicates a typo
+// in the description of either insn, but some genuine pairs are encoded
+// farther apart, so the pairs flagged need to be reviewed by hand.
+func (p *VariantPair) IsAnomalous() bool {
+	diffBits := p.DiffBits()
+	switch len(diffBits) {
+	case 1:
+		return false
+	case 2:
+		return diffBits[1] != diffBits[0]+1
+	default:
+		return true
+	}
+}
+
+func splitVariantSuffix(mnemonic string) (stem string, suffix string) {
+	idx := strings.LastIndexByte(mnemonic, '.')
+	if idx < 0 {
+		return mnemonic, ""
+	}
+	return mnemonic[:idx], mnemonic[idx+1:]
+}
+
+// FindVariantPairs returns all pairs of insns whose mnemonics share the stem
+// and carry the suffixes of a variant pair, like .w/.d or .bu/.hu, sorted by
+// the word of the narrower insn. The insns of a pair must take args of the
+// same kinds, so e.g. vftint.w.s and vftint.w.d, taking different numbers of
+// operands, are not paired; the widths of the immediates may differ.
+func FindVariantPairs(descs []*InsnDescription) []*VariantPair {
+	byMnemonic := make(map[string]*InsnDescription)
+	for _, d := range descs {
+		byMnemonic[d.Mnemonic] = d
+	}
+
+	var result []*VariantPair
+	for _, d := range descs {
+		stem, suffix := splitVariantSuffix(d.Mnemonic)
+		if suffix == "" {
+			continue
+		}
+
+		for _, sp := range variantSuffixPairs {
+			if suffix != sp[0] {
+				continue
+			}
+			if wide, ok := byMnemonic[stem+"."+sp[1]]; ok && sameArgKinds(d.Format, wide.Format) {
+				result = append(result, &VariantPair{Narrow: d, Wide: wide})
+			}
+		}
+	}
+
+	sort.SliceStable(result, func(i int, j int) bool {
+		return result[i].Narrow.Word < result[j].Narrow.Word
+	})
+
+	return result
+}
+
+func sameArgKinds(a *InsnFormat, b *InsnFormat) bool {
+	ka, kb := a.ArgKinds(), b.ArgKinds()
+	if len(ka) != len(kb) {
+		return false
+	}
+	for i := range ka {
+		if ka[i] != kb[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,73 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFindVariantPairs(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00110000 sub.w                  DJK",
+		"00118000 sub.d                  DJK",
+		"00408000 slli.w                 DJUk5",
+		"00410000 slli.d                 DJUk6",
+		"01008000 fadd.s                 FdFjFk",
+		"01010000 fadd.d                 FdFjFk",
+		"2a000000 ld.bu                  DJSk12",
+		"2a400000 ld.hu                  DJSk12",
+		// a typo: off by two bits from ld.b
+		"29400000 ld.h                   DJSk12",
+		"28000000 ld.b                   DJSk12",
+		// not a width variant of add.w, as it takes different args
+		"00100000 add.w                  DJK",
+		"02c00000 add.d                  DJSk12",
+	)
+
+	pairs := FindVariantPairs(descs)
+	var mnemonics [][2]string
+	for _, p := range pairs {
+		mnemonics = append(mnemonics, [2]string{p.Narrow.Mnemonic, p.Wide.Mnemonic})
+	}
+	assert.Equal(t, [][2]string{
+		{"sub.w", "sub.d"},
+		{"slli.w", "slli.d"},
+		{"fadd.s", "fadd.d"},
+		{"ld.b", "ld.h"},
+		{"ld.bu", "ld.hu"},
+	}, mnemonics)
+
+	assert.Equal(t, "sub", pairs[0].Stem())
+	assert.Equal(t, []uint{15}, pairs[0].DiffBits())
+	assert.False(t, pairs[0].IsAnomalous())
+
+	// the bit 15 is taken by the wider immediate of slli.d
+	assert.Equal(t, []uint{16}, pairs[1].DiffBits())
+	assert.False(t, pairs[1].IsAnomalous())
+
+	// 0b01 vs 0b10
+	assert.Equal(t, []uint{15, 16}, pairs[2].DiffBits())
+	assert.False(t, pairs[2].IsAnomalous())
+
+	assert.Equal(t, []uint{22, 24}, pairs[3].DiffBits())
+	assert.True(t, pairs[3].IsAnomalous())
+
+	assert.Equal(t, []uint{22}, pairs[4].DiffBits())
+	assert.False(t, pairs[4].IsAnomalous())
+}
+
+func TestFindVariantPairsOverCorpus(t *testing.T) {
+	pairs := FindVariantPairs(Builtin())
+
+	byStem := make(map[string]*VariantPair)
+	for _, p := range pairs {
+		byStem[p.Narrow.Mnemonic] = p
+	}
+	for _, m := range []string{"add.w", "ld.b", "ld.bu", "fadd.s", "vadd.b", "xvadd.w"} {
+		p, ok := byStem[m]
+		if assert.True(t, ok, m) {
+			assert.False(t, p.IsAnomalous(), m)
+		}
+	}
+}
@@ -0,0 +1,91 @@
+package common
+
+import (
+	"bufio"
+	"bytes"
+	_ "embed"
+	"fmt"
+	"io"
+	"strings"
+)
+
+//go:embed widthpairs.txt
+var defaultWidthPairsTxt []byte
+
+// WidthPair is a mnemonic expected in both a word and a doubleword form,
+// written with "?" for the width letter, e.g. "div.?u" for div.wu and div.du.
+type WidthPair string
+
+// Mnemonics returns the word and doubleword forms of the mnemonic.
+func (p WidthPair) Mnemonics() (string, string) {
+	return strings.Replace(string(p), "?", "w", 1), strings.Replace(string(p), "?", "d", 1)
+}
+
+// DefaultWidthPairs returns the width pairs embedded in widthpairs.txt,
+// mostly the integer arithmetic insns.
+func DefaultWidthPairs() []WidthPair {
+	result, err := ParseWidthPairs(bytes.NewReader(defaultWidthPairsTxt))
+	if err != nil {
+		panic(err)
+	}
+	return result
+}
+
+// ParseWidthPairs parses a list of width pairs, one per line, ignoring blank
+// lines and the comments starting with "#".
+func ParseWidthPairs(r io.Reader) ([]WidthPair, error) {
+	var result []WidthPair
+	sc := bufio.NewScanner(r)
+	for lineNum := 1; sc.Scan(); lineNum++ {
+		line, _, _ := strings.Cut(sc.Text(), "#")
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		p := WidthPair(line)
+		w, _ := p.Mnemonics()
+		if strings.Count(line, "?") != 1 || !mnemonicRE.MatchString(w) {
+			return nil, fmt.Errorf("line %d: malformed width pair %q", lineNum, line)
+		}
+		result = append(result, p)
+	}
+	return result, sc.Err()
+}
+
+// LintWidthPairs flags the insns of the pairs lacking the other form, in the
+// order of the pairs, unless suppressed on the insn present with
+// @nolint=missing_width_pair. Pairs with neither form present are not
+// flagged, as the family is not described at all. Unlike Lint, it is only
+// meaningful on the whole corpus.
+func LintWidthPairs(descs []*InsnDescription, pairs []WidthPair) []*LintWarning {
+	byMnemonic := make(map[string]*InsnDescription, len(descs))
+	for _, d := range descs {
+		if _, ok := byMnemonic[d.Mnemonic]; !ok {
+			byMnemonic[d.Mnemonic] = d
+		}
+	}
+
+	var result []*LintWarning
+	for _, p := range pairs {
+		w, dw := p.Mnemonics()
+		wDesc, dwDesc := byMnemonic[w], byMnemonic[dw]
+
+		var present *InsnDescription
+		var missing string
+		switch {
+		case wDesc != nil && dwDesc == nil:
+			present, missing = wDesc, dw
+		case wDesc == nil && dwDesc != nil:
+			present, missing = dwDesc, w
+		default:
+			continue
+		}
+
+		if present.suppressedLintChecks()[LintMissingWidthPair] {
+			continue
+		}
+		result = append(result, &LintWarning{Desc: present, Check: LintMissingWidthPair, Counterpart: missing})
+	}
+	return result
+}
@@ -0,0 +1,57 @@
+package common
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseWidthPairs(t *testing.T) {
+	pairs, err := ParseWidthPairs(strings.NewReader("# comment\n\nadd.?\ndiv.?u  # unsigned\n"))
+	assert.NoError(t, err)
+	assert.Equal(t, []WidthPair{"add.?", "div.?u"}, pairs)
+
+	w, dw := pairs[1].Mnemonics()
+	assert.Equal(t, "div.wu", w)
+	assert.Equal(t, "div.du", dw)
+
+	_, err = ParseWidthPairs(strings.NewReader("add.?\nadd.w\n"))
+	assert.EqualError(t, err, `line 2: malformed width pair "add.w"`)
+	_, err = ParseWidthPairs(strings.NewReader("add.??\n"))
+	assert.Error(t, err)
+
+	assert.NotEmpty(t, DefaultWidthPairs())
+}
+
+func TestLintWidthPairs(t *testing.T) {
+	descs := mustParseInsnDescriptionLines(
+		t,
+		"00100000 add.w                  DJK",
+		"00108000 add.d                  DJK",
+		"00110000 sub.w                  DJK",
+		"001d8000 mulh.d                 DJK",
+		"00200000 div.w                  DJK             @nolint=missing_width_pair",
+	)
+	pairs := []WidthPair{"add.?", "sub.?", "mulh.?", "div.?", "mod.?"}
+
+	warnings := LintWidthPairs(descs, pairs)
+	if assert.Len(t, warnings, 2) {
+		assert.Equal(t, "sub.w", warnings[0].Desc.Mnemonic)
+		assert.Equal(t, LintMissingWidthPair, warnings[0].Check)
+		assert.Equal(t, "sub.d", warnings[0].Counterpart)
+		assert.EqualError(t, warnings[0], "sub.w: counterpart sub.d not described (suppress with @nolint=missing_width_pair)")
+
+		assert.Equal(t, "mulh.d", warnings[1].Desc.Mnemonic)
+		assert.Equal(t, "mulh.w", warnings[1].Counterpart)
+	}
+
+	// the check is opt-in, apart from Lint
+	assert.Empty(t, Lint(descs))
+}
+
+func TestLintWidthPairsCorpus(t *testing.T) {
+	for _, w := range LintWidthPairs(Builtin(), DefaultWidthPairs()) {
+		t.Error(w)
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+// anamesReport is the result of checking a hand-maintained list of opcode
+// constants against the corpus.
+type anamesReport struct {
+	// Missing are the insns whose opcode constant is not declared in the
+	// iota block, so their encodings would be indexed by an undeclared or
+	// wrongly valued constant.
+	Missing []string
+	// Extra are the constants of the iota block naming no insn, e.g.
+	// pseudo-ops.
+	Extra []string
+}
+
+func (r *anamesReport) OK() bool {
+	return len(r.Missing) == 0
+}
+
+// checkAnames checks the opcode constants declared in src, the source of the
+// Go backend's cpu.go, against the insns described by descs.
+//
+// The constants are those of the const block starting with
+// obj.ABaseLoong + obj.A_ARCHSPECIFIC + iota, up to ALAST.
+func checkAnames(filename string, src []byte, descs []*common.InsnDescription) (*anamesReport, error) {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, filename, src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	declared, err := opcodeConstNames(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+
+	isDeclared := make(map[string]bool, len(declared))
+	for _, name := range declared {
+		isDeclared[name] = true
+	}
+
+	var result anamesReport
+	described := make(map[string]bool, len(descs))
+	for _, d := range descs {
+		aname := common.GoAnameForInsn(d.Mnemonic)
+		described[aname] = true
+		if !isDeclared[aname] {
+			result.Missing = append(result.Missing, fmt.Sprintf("%s (%s)", aname, d.Mnemonic))
+		}
+	}
+	for _, name := range declared {
+		if !described[name] {
+			result.Extra = append(result.Extra, name)
+		}
+	}
+
+	return &result, nil
+}
+
+// opcodeConstNames returns the names declared in the opcode iota block of f,
+// in order, excluding ALAST.
+func opcodeConstNames(f *ast.File) ([]string, error) {
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.CONST || len(gd.Specs) == 0 {
+			continue
+		}
+
+		first := gd.Specs[0].(*ast.ValueSpec)
+		if len(first.Values) != 1 || !isOpcodeBase(first.Values[0]) {
+			continue
+		}
+
+		var result []string
+		for _, spec := range gd.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if vs != first && len(vs.Values) != 0 {
+				return nil, fmt.Errorf("%s: explicit value breaks the iota sequence", vs.Names[0].Name)
+			}
+			for _, name := range vs.Names {
+				if name.Name == "ALAST" {
+					return result, nil
+				}
+				result = append(result, name.Name)
+			}
+		}
+		return nil, fmt.Errorf("no ALAST in the opcode const block")
+	}
+	return nil, fmt.Errorf("no const block starting with obj.ABaseLoong + obj.A_ARCHSPECIFIC + iota")
+}
+
+// isOpcodeBase tells whether e is obj.ABaseLoong + obj.A_ARCHSPECIFIC + iota,
+// in any order of the terms.
+func isOpcodeBase(e ast.Expr) bool {
+	var terms []string
+	var walk func(e ast.Expr) bool
+	walk = func(e ast.Expr) bool {
+		switch e := e.(type) {
+		case *ast.BinaryExpr:
+			return e.Op == token.ADD && walk(e.X) && walk(e.Y)
+		case *ast.ParenExpr:
+			return walk(e.X)
+		case *ast.SelectorExpr:
+			x, ok := e.X.(*ast.Ident)
+			if !ok {
+				return false
+			}
+			terms = append(terms, x.Name+"."+e.Sel.Name)
+			return true
+		case *ast.Ident:
+			terms = append(terms, e.Name)
+			return true
+		}
+		return false
+	}
+	if !walk(e) {
+		return false
+	}
+
+	s := "+" + strings.Join(terms, "+") + "+"
+	return len(terms) == 3 &&
+		strings.Contains(s, "+obj.A_ARCHSPECIFIC+") &&
+		strings.Contains(s, "+iota+") &&
+		strings.Contains(s, "ABaseLoong+")
+}
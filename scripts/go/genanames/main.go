@@ -1,14 +1,23 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"os"
 	"sort"
 
 	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
 )
 
+var (
+	pkgName  = flag.String("pkg", "loong", "package name of the generated file")
+	buildTag = flag.String("build-tag", "", "build constraint expression to gate the generated file behind, e.g. loong64")
+	check    = flag.String("check", "", "path of a hand-maintained cpu.go to check the opcode constants of against the descriptions, instead of generating")
+)
+
 func main() {
-	inputs := os.Args[1:]
+	flag.Parse()
+	inputs := flag.Args()
 
 	descs, err := common.ReadInsnDescs(inputs)
 	if err != nil {
@@ -19,9 +28,22 @@ func main() {
 		return descs[i].Word < descs[j].Word
 	})
 
+	if *check != "" {
+		if !runCheck(*check, descs) {
+			os.Exit(1)
+		}
+		return
+	}
+
 	var ectx common.EmitterCtx
 
-	ectx.Emit("package loong\n\n")
+	if *buildTag != "" {
+		err := ectx.EmitBuildConstraint(*buildTag)
+		if err != nil {
+			panic(err)
+		}
+	}
+	ectx.Emit("package %s\n\n", *pkgName)
 	ectx.Emit("// NOTE: Paste into cpu.go and adjust as necessary (add pseudo-ops, etc.)\n\n")
 
 	emitAnames(&ectx, descs)
@@ -52,3 +74,26 @@ const (
 	ectx.Emit("\n\t// End marker\n\tALAST\n")
 	ectx.Emit(")\n\n")
 }
+
+// runCheck reports the differences between the opcode constants declared in
+// path and the descriptions, telling whether every insn has its constant.
+func runCheck(path string, descs []*common.InsnDescription) bool {
+	src, err := os.ReadFile(path)
+	if err != nil {
+		panic(err)
+	}
+
+	report, err := checkAnames(path, src, descs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fatal: %v\n", err)
+		os.Exit(1)
+	}
+
+	for _, m := range report.Missing {
+		fmt.Fprintf(os.Stderr, "%s: missing opcode constant %s\n", path, m)
+	}
+	for _, name := range report.Extra {
+		fmt.Fprintf(os.Stderr, "%s: note: %s names no described insn\n", path, name)
+	}
+	return report.OK()
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func generateForTest(t *testing.T) ([]*common.InsnDescription, string) {
+	paths, err := filepath.Glob("../../../la-*.txt")
+	assert.NoError(t, err)
+	descs, err := common.ReadInsnDescs(paths)
+	assert.NoError(t, err)
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+
+	var ectx common.EmitterCtx
+	ectx.Emit("package loong\n\n")
+	emitAnames(&ectx, descs)
+	return descs, string(ectx.Finalize())
+}
+
+func TestCheckAnames(t *testing.T) {
+	descs, src := generateForTest(t)
+
+	report, err := checkAnames("cpu.go", []byte(src), descs)
+	assert.NoError(t, err)
+	assert.True(t, report.OK())
+	assert.Empty(t, report.Extra)
+
+	// a pseudo-op is only noted
+	withPseudo := strings.Replace(src, "\tALAST\n", "\tAMOVW\n\tALAST\n", 1)
+	report, err = checkAnames("cpu.go", []byte(withPseudo), descs)
+	assert.NoError(t, err)
+	assert.True(t, report.OK())
+	assert.Equal(t, []string{"AMOVW"}, report.Extra)
+
+	// while a deleted constant is caught
+	assert.Contains(t, src, "\tAADDIW\n")
+	dropped := strings.Replace(src, "\tAADDIW\n", "", 1)
+	report, err = checkAnames("cpu.go", []byte(dropped), descs)
+	assert.NoError(t, err)
+	assert.False(t, report.OK())
+	assert.Equal(t, []string{"AADDIW (addi.w)"}, report.Missing)
+
+	// as is a constant moved out of the iota sequence
+	explicit := strings.Replace(src, "\tAADDIW\n", "\tAADDIW = 42\n", 1)
+	_, err = checkAnames("cpu.go", []byte(explicit), descs)
+	assert.EqualError(t, err, "cpu.go: AADDIW: explicit value breaks the iota sequence")
+
+	_, err = checkAnames("cpu.go", []byte("package loong\n"), descs)
+	assert.Error(t, err)
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func main() {
+	inputs := os.Args[1:]
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	os.Stdout.Write(generate(descs, common.MustGetGitCommitHash()))
+}
+
+func generate(descs []*common.InsnDescription, commitHash string) []byte {
+	// PEG alternatives are ordered, so longer mnemonics must be tried first
+	// for a mnemonic to never shadow another one it's a prefix of (e.g.
+	// "ld.b" vs "ld.bu"); the mnemonic rules themselves are guarded by a
+	// negative lookahead too, but having a deterministic order is nice anyway.
+	sorted := make([]*common.InsnDescription, len(descs))
+	copy(sorted, descs)
+	sort.Slice(sorted, func(i int, j int) bool {
+		if len(sorted[i].Mnemonic) != len(sorted[j].Mnemonic) {
+			return len(sorted[i].Mnemonic) > len(sorted[j].Mnemonic)
+		}
+		return sorted[i].Mnemonic < sorted[j].Mnemonic
+	})
+
+	ectx := common.EmitterCtx{
+		DontGofmt: true,
+	}
+
+	emitHeader(&ectx, commitHash)
+	emitTopLevelRules(&ectx, sorted)
+
+	for _, d := range sorted {
+		emitInsnRule(&ectx, d)
+	}
+
+	emitTerminalRules(&ectx)
+
+	return ectx.Finalize()
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+func emitHeader(ectx *common.EmitterCtx, commitHash string) {
+	ectx.Emit(`# LoongArch assembly instruction grammar.
+#
+# This file is auto-generated by genasmgrammar from
+# https://github.com/loongson-community/loongarch-opcodes,
+# from commit %s.
+# DO NOT EDIT.
+#
+# The grammar is written in PEG notation:
+#
+# * "<-" defines a rule, "/" is ordered choice, "!" is negative lookahead,
+#   "*" "+" "?" are the usual repetition operators, [...] is a character
+#   class, and "..." is a literal string (all literals are case-sensitive);
+# * there is one rule per instruction, named "Insn_" followed by the
+#   mnemonic with "." replaced by "_", that matches the mnemonic and then
+#   the operands in assembly order, separated by commas;
+# * the assembly order is the canonical order unless noted in a comment
+#   after the rule, like "# syntax_order=d,k,j", listing the operands by
+#   their canonical names;
+# * operands are referenced by the terminal rule for their kind:
+#
+#   GPR  - general-purpose register, e.g. "$r4" or "$a0"
+#   FPR  - floating-point register, e.g. "$f0" or "$fa0"
+#   FCC  - floating-point condition code register, e.g. "$fcc0"
+#   SCR  - LBT scratch register, e.g. "$scr0"
+#   VR   - LSX vector register, e.g. "$vr0"
+#   XR   - LASX vector register, e.g. "$xr0"
+#   SIMM - signed integer literal, e.g. "-16" or "0x10"
+#   UIMM - unsigned integer literal, e.g. "16" or "0x10"
+#   CACHEOP - cacop operation code, either symbolic like "hit_wb_inv_leaf1"
+#             or a UIMM
+#
+# Range checking of register numbers and immediates is deliberately left to
+# the semantic actions, for better error messages.
+#
+# Assembler directives, labels, comments and expressions are not covered.
+
+`, commitHash)
+}
+
+func ruleNameForInsn(d *common.InsnDescription) string {
+	return "Insn_" + strings.ReplaceAll(d.Mnemonic, ".", "_")
+}
+
+func emitTopLevelRules(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit("Line <- _ Instruction _ EOL\n\n")
+
+	ectx.Emit("Instruction <-")
+	for i, d := range descs {
+		if i == 0 {
+			ectx.Emit(" %s\n", ruleNameForInsn(d))
+		} else {
+			ectx.Emit("    / %s\n", ruleNameForInsn(d))
+		}
+	}
+	ectx.Emit("\n")
+}
+
+func terminalForArg(a *common.Arg, role common.ArgRole) string {
+	if role == common.ArgRoleCacheOp {
+		return "CACHEOP"
+	}
+
+	switch a.Kind {
+	case common.ArgKindIntReg:
+		return "GPR"
+	case common.ArgKindFPReg:
+		return "FPR"
+	case common.ArgKindFCCReg:
+		return "FCC"
+	case common.ArgKindScratchReg:
+		return "SCR"
+	case common.ArgKindVReg:
+		return "VR"
+	case common.ArgKindXReg:
+		return "XR"
+	case common.ArgKindSignedImm:
+		return "SIMM"
+	case common.ArgKindUnsignedImm:
+		return "UIMM"
+	default:
+		panic("unreachable")
+	}
+}
+
+func emitInsnRule(ectx *common.EmitterCtx, d *common.InsnDescription) {
+	ectx.Emit("%s <- \"%s\" !MnemonicChar", ruleNameForInsn(d), d.Mnemonic)
+
+	roles := d.ArgRoles()
+	indices := d.SyntaxArgIndices()
+	for i, a := range d.SyntaxArgs() {
+		if i == 0 {
+			ectx.Emit(" __ ")
+		} else {
+			ectx.Emit(" _ \",\" _ ")
+		}
+
+		ectx.Emit("%s", terminalForArg(a, roles[indices[i]]))
+	}
+
+	if !isIdentityOrder(indices) {
+		names := make([]string, 0, len(d.Format.Args))
+		for _, a := range d.SyntaxArgs() {
+			names = append(names, a.Name())
+		}
+		ectx.Emit("  # syntax_order=%s", strings.Join(names, ","))
+	}
+
+	ectx.Emit("\n")
+}
+
+func isIdentityOrder(indices []int) bool {
+	for i, idx := range indices {
+		if i != idx {
+			return false
+		}
+	}
+	return true
+}
+
+var gprABINames = []string{
+	"zero", "ra", "tp", "sp",
+	"a0", "a1", "a2", "a3", "a4", "a5", "a6", "a7",
+	"t0", "t1", "t2", "t3", "t4", "t5", "t6", "t7", "t8",
+	// r21 has no ABI name
+	"fp", "s9",
+	"s0", "s1", "s2", "s3", "s4", "s5", "s6", "s7", "s8",
+}
+
+var fprABINames = []string{
+	"fa0", "fa1", "fa2", "fa3", "fa4", "fa5", "fa6", "fa7",
+	"ft0", "ft1", "ft2", "ft3", "ft4", "ft5", "ft6", "ft7",
+	"ft8", "ft9", "ft10", "ft11", "ft12", "ft13", "ft14", "ft15",
+	"fs0", "fs1", "fs2", "fs3", "fs4", "fs5", "fs6", "fs7",
+}
+
+func emitAlternatives(ectx *common.EmitterCtx, names []string) {
+	// longest first, for the same reason as with the mnemonics
+	sorted := make([]string, len(names))
+	copy(sorted, names)
+	sort.SliceStable(sorted, func(i int, j int) bool {
+		return len(sorted[i]) > len(sorted[j])
+	})
+
+	quoted := make([]string, len(sorted))
+	for i, n := range sorted {
+		quoted[i] = fmt.Sprintf("%q", n)
+	}
+
+	ectx.Emit("%s", strings.Join(quoted, " / "))
+}
+
+// cacheOpNames returns the symbolic names of the cacop codes, in the order
+// of the codes.
+func cacheOpNames() []string {
+	var result []string
+	// the cacop codes are 5 bits wide
+	for code := int64(0); code < 1<<5; code++ {
+		if name, ok := common.CacheOpName(code); ok {
+			result = append(result, name)
+		}
+	}
+	return result
+}
+
+func emitTerminalRules(ectx *common.EmitterCtx) {
+	ectx.Emit("\n")
+
+	ectx.Emit("GPR <- \"$\" (\"r\" RegNum / (")
+	emitAlternatives(ectx, gprABINames)
+	ectx.Emit(")) !IdentChar\n")
+
+	ectx.Emit("FPR <- \"$\" (\"f\" RegNum / (")
+	emitAlternatives(ectx, fprABINames)
+	ectx.Emit(")) !IdentChar\n")
+
+	// the symbolic names are tried first, as UIMM would match none of them
+	ectx.Emit("CACHEOP <- (")
+	emitAlternatives(ectx, cacheOpNames())
+	ectx.Emit(") !IdentChar / UIMM\n")
+
+	ectx.Emit(`FCC <- "$fcc" RegNum !IdentChar
+SCR <- "$scr" RegNum !IdentChar
+VR <- "$vr" RegNum !IdentChar
+XR <- "$xr" RegNum !IdentChar
+RegNum <- [0-9]+
+
+SIMM <- "-"? UIMM
+UIMM <- ("0x" / "0X") [0-9a-fA-F]+ / [0-9]+
+
+MnemonicChar <- [0-9a-z_.]
+IdentChar <- [0-9A-Za-z_]
+_ <- [ \t]*
+__ <- [ \t]+
+EOL <- !.
+`)
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestGenerate(t *testing.T) {
+	var descs []*common.InsnDescription
+	for _, l := range []string{
+		"00100000 add.w                  DJK",
+		"29c00000 st.d                   DJSk12          @writes=",
+		"2ac00000 preld                  JUd5Sk12        @orig_fmt=Ud5JSk12 @syntax_order=ud5,j,sk12",
+		"06000000 cacop                  JUd5Sk12        @orig_fmt=Ud5JSk12 @syntax_order=ud5,j,sk12 @role=cacheop",
+		"50000000 b                      Sd10k16         @orig_fmt=Sd10k16ps2",
+	} {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		descs = append(descs, d)
+	}
+
+	result := string(generate(descs, "0000000000000000000000000000000000000000"))
+	assert.Contains(t, result, "# from commit 0000000000000000000000000000000000000000.\n")
+	assert.Contains(t, result, "#   CACHEOP - cacop operation code")
+
+	// longest mnemonics first
+	assert.Contains(t, result, `Line <- _ Instruction _ EOL
+
+Instruction <- Insn_add_w
+    / Insn_cacop
+    / Insn_preld
+    / Insn_st_d
+    / Insn_b
+
+`)
+
+	assert.Contains(t, result, "Insn_add_w <- \"add.w\" !MnemonicChar __ GPR _ \",\" _ GPR _ \",\" _ GPR\n")
+	assert.Contains(t, result, "Insn_st_d <- \"st.d\" !MnemonicChar __ GPR _ \",\" _ GPR _ \",\" _ SIMM\n")
+	assert.Contains(t, result, "Insn_b <- \"b\" !MnemonicChar __ SIMM\n")
+	// in assembly order, noted if not the canonical one
+	assert.Contains(t, result, "Insn_preld <- \"preld\" !MnemonicChar __ UIMM _ \",\" _ GPR _ \",\" _ SIMM  # syntax_order=ud5,j,sk12\n")
+	assert.Contains(t, result, "Insn_cacop <- \"cacop\" !MnemonicChar __ CACHEOP _ \",\" _ GPR _ \",\" _ SIMM  # syntax_order=ud5,j,sk12\n")
+
+	assert.Contains(t, result, "\nGPR <- \"$\" (\"r\" RegNum / (\"zero\" / ")
+	// longest ABI names first
+	assert.Contains(t, result, "\nFPR <- \"$\" (\"f\" RegNum / (\"ft10\" / ")
+	assert.Contains(t, result, "\nCACHEOP <- (\"index_wb_inv_leaf0\" / ")
+	assert.Contains(t, result, " / \"store_tag_leaf7\") !IdentChar / UIMM\n")
+	assert.Contains(t, result, "\nSIMM <- \"-\"? UIMM\n")
+	assert.Contains(t, result, "\nUIMM <- (\"0x\" / \"0X\") [0-9a-fA-F]+ / [0-9]+\n")
+	assert.Contains(t, result, "\nEOL <- !.\n")
+}
+
+// TestCacheOpNames checks that the CACHEOP terminal covers exactly the names
+// the assembler accepts.
+func TestCacheOpNames(t *testing.T) {
+	names := cacheOpNames()
+	assert.Len(t, names, 24)
+	for i, name := range names {
+		code, ok := common.ParseCacheOpName(name)
+		assert.True(t, ok, name)
+		assert.EqualValues(t, i, code)
+	}
+}
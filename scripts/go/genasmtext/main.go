@@ -0,0 +1,83 @@
+// Command genasmtext renders sample insns as GNU as source, with the words
+// they are expected to assemble to in comments, for cross-checking the
+// encoders against GNU as.
+package main
+
+import (
+	"flag"
+	"os"
+	"sort"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+var abiNames = flag.Bool("abi-names", false, "name the GPRs and FPRs by their ABI names, like $a0 and $fa0")
+
+func main() {
+	flag.Parse()
+	inputs := flag.Args()
+
+	// the descriptions built into the common package if no files are given
+	descs := common.Builtin()
+	if len(inputs) > 0 {
+		var err error
+		descs, err = common.ReadInsnDescs(inputs)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+
+	style := common.RegStyleNumeric
+	if *abiNames {
+		style = common.RegStyleABI
+	}
+
+	os.Stdout.Write(generate(descs, style, common.MustGetGitCommitHash()))
+}
+
+func generate(descs []*common.InsnDescription, style common.RegStyle, commitHash string) []byte {
+	ectx := common.EmitterCtx{
+		DontGofmt: true,
+	}
+
+	ectx.Emit("# This file is auto-generated by genasmtext from\n")
+	ectx.Emit("# https://github.com/loongson-community/loongarch-opcodes,\n")
+	ectx.Emit("# from commit %s.\n", commitHash)
+	ectx.Emit("# DO NOT EDIT.\n\n")
+	ectx.Emit("\t.text\n")
+
+	for _, d := range descs {
+		for _, args := range sampleOperands(d) {
+			ectx.Emit("\t%s\t# 0x%08x\n", common.RenderInstructionStyle(d, args, style), d.Encode(args))
+		}
+	}
+
+	return ectx.Finalize()
+}
+
+// sampleOperands returns as many operand lists as the largest number of
+// sample values among the args, taking the sample values of the args in
+// lockstep and wrapping around.
+func sampleOperands(d *common.InsnDescription) [][]int64 {
+	samples := make([][]int64, len(d.Format.Args))
+	n := 1
+	for i, a := range d.Format.Args {
+		samples[i] = a.SampleValues()
+		if len(samples[i]) > n {
+			n = len(samples[i])
+		}
+	}
+
+	result := make([][]int64, n)
+	for k := range result {
+		result[k] = make([]int64, len(d.Format.Args))
+		for i := range d.Format.Args {
+			result[k][i] = samples[i][k%len(samples[i])]
+		}
+	}
+	return result
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestGenerate(t *testing.T) {
+	var descs []*common.InsnDescription
+	for _, l := range []string{
+		"06482000 tlbclr                 EMPTY",
+		"00100000 add.w                  DJK",
+		"58000000 beq                    DJSk16          @orig_fmt=JDSk16ps2",
+	} {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		descs = append(descs, d)
+	}
+
+	result := string(generate(descs, common.RegStyleNumeric, "0000000000000000000000000000000000000000"))
+	assert.Contains(t, result, "\t.text\n")
+	assert.Contains(t, result, "\ttlbclr\t# 0x06482000\n")
+	assert.Contains(t, result, "\tadd.w $r0, $r0, $r0\t# 0x00100000\n")
+	// the largest offset in bytes
+	assert.Contains(t, result, "\tbeq $r1, $r1, 131068\t# 0x59fffc21\n")
+
+	result = string(generate(descs, common.RegStyleABI, "0000000000000000000000000000000000000000"))
+	assert.Contains(t, result, "\tadd.w $zero, $zero, $zero\t# 0x00100000\n")
+}
+
+func TestSampleOperands(t *testing.T) {
+	d, err := common.ParseInsnDescriptionLine("02800000 addi.w                 DJSk12")
+	assert.NoError(t, err)
+
+	ops := sampleOperands(d)
+	assert.Len(t, ops, len(d.Format.Args[2].SampleValues()))
+	for _, args := range ops {
+		assert.Len(t, args, 3)
+	}
+
+	// every line is an insn and its expected word
+	result := string(generate([]*common.InsnDescription{d}, common.RegStyleNumeric, "0000000000000000000000000000000000000000"))
+	n := 0
+	for _, l := range strings.Split(result, "\n") {
+		if strings.HasPrefix(l, "\taddi.w ") {
+			n++
+			assert.Contains(t, l, "\t# 0x")
+		}
+	}
+	assert.Equal(t, len(ops), n)
+}
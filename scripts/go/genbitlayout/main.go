@@ -0,0 +1,238 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"html"
+	"os"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func main() {
+	emitHTML := flag.Bool("html", false, "emit an HTML table per insn instead of plain text")
+	flag.Parse()
+	inputs := flag.Args()
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	ectx := common.EmitterCtx{
+		DontGofmt: true,
+	}
+
+	if *emitHTML {
+		emitHTMLHeader(&ectx)
+	}
+
+	for _, d := range descs {
+		fields := layoutInsn(d)
+		if *emitHTML {
+			emitHTMLTable(&ectx, d, fields)
+		} else {
+			emitTextDiagram(&ectx, d, fields)
+		}
+	}
+
+	if *emitHTML {
+		emitHTMLFooter(&ectx)
+	}
+
+	result := ectx.Finalize()
+	os.Stdout.Write(result)
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+// bitField is a run of adjacent bits in the insn word, that are either all
+// fixed opcode bits, or all belong to the same slot of an arg or reserved
+// field.
+type bitField struct {
+	msb   uint
+	lsb   uint
+	label string
+	fixed bool
+}
+
+func (f *bitField) bitRange() string {
+	if f.msb == f.lsb {
+		return fmt.Sprintf("%d", f.msb)
+	}
+	return fmt.Sprintf("%d:%d", f.msb, f.lsb)
+}
+
+func (f *bitField) width() uint {
+	return f.msb - f.lsb + 1
+}
+
+// layoutInsn returns the fields of the insn word, from the MSB to the LSB.
+func layoutInsn(d *common.InsnDescription) []bitField {
+	// label of every bit position that is not a fixed opcode bit, and the
+	// slot it belongs to, for telling adjacent slots apart even if labeled
+	// the same
+	var labels [32]string
+	var owners [32]*common.Slot
+
+	names := argNames(d.Format.Args)
+	for argIdx, a := range d.Format.Args {
+		valueOffsets := a.SlotValueOffsets()
+		for i, s := range a.Slots {
+			label := fmt.Sprintf("%s[%d:%d]", names[argIdx], valueOffsets[i]+s.Width-1, valueOffsets[i])
+			for i := s.Offset; i <= s.MSB(); i++ {
+				labels[i] = label
+				owners[i] = s
+			}
+		}
+	}
+
+	for _, s := range d.Reserved {
+		label := fmt.Sprintf("rsv[%d:0]", s.Width-1)
+		for i := s.Offset; i <= s.MSB(); i++ {
+			labels[i] = label
+			owners[i] = s
+		}
+	}
+
+	var result []bitField
+	for i := 31; i >= 0; i-- {
+		bit := uint(i)
+		label := labels[bit]
+		fixed := label == ""
+		if fixed {
+			label = fmt.Sprintf("%d", (d.Word>>bit)&1)
+		}
+
+		last := len(result) - 1
+		if last >= 0 && fixed && result[last].fixed {
+			// fixed bits are merged into one field, with the values spaced out
+			result[last].lsb = bit
+			result[last].label += " " + label
+			continue
+		}
+		if last >= 0 && !fixed && owners[bit] == owners[bit+1] {
+			result[last].lsb = bit
+			continue
+		}
+
+		result = append(result, bitField{msb: bit, lsb: bit, label: label, fixed: fixed})
+	}
+
+	return result
+}
+
+// argNames returns the names of the args by argName, falling back to the
+// canonical names for args named the same as another, like the two ui6 of
+// bstrpick.d, which become um6 and uk6.
+func argNames(args []*common.Arg) []string {
+	result := make([]string, len(args))
+	counts := make(map[string]int)
+	for i, a := range args {
+		result[i] = argName(a)
+		counts[result[i]]++
+	}
+	for i, a := range args {
+		if counts[result[i]] > 1 {
+			result[i] = a.Name()
+		}
+	}
+	return result
+}
+
+// argName returns the operand name in the style of the LoongArch manual,
+// e.g. "rd", "fj", "si12" or "ui5".
+func argName(a *common.Arg) string {
+	switch a.Kind {
+	case common.ArgKindIntReg:
+		return "r" + a.Slots[0].Letter()
+	case common.ArgKindFPReg:
+		return "f" + a.Slots[0].Letter()
+	case common.ArgKindFCCReg:
+		return "c" + a.Slots[0].Letter()
+	case common.ArgKindScratchReg:
+		return "t" + a.Slots[0].Letter()
+	case common.ArgKindVReg:
+		return "v" + a.Slots[0].Letter()
+	case common.ArgKindXReg:
+		return "x" + a.Slots[0].Letter()
+	case common.ArgKindSignedImm:
+		return fmt.Sprintf("si%d", a.TotalWidth())
+	case common.ArgKindUnsignedImm:
+		return fmt.Sprintf("ui%d", a.TotalWidth())
+	default:
+		panic("unreachable")
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+func emitTextDiagram(ectx *common.EmitterCtx, d *common.InsnDescription, fields []bitField) {
+	var ranges, labels strings.Builder
+	for _, f := range fields {
+		r := f.bitRange()
+		w := len(r)
+		if len(f.label) > w {
+			w = len(f.label)
+		}
+
+		fmt.Fprintf(&ranges, "  %-*s", w+1, r)
+		fmt.Fprintf(&labels, "| %-*s ", w, f.label)
+	}
+	labels.WriteString("|")
+
+	ectx.Emit("%08x %s %s\n", d.Word, d.Mnemonic, d.Format.CanonicalRepr())
+	ectx.Emit("%s\n", strings.TrimRight(ranges.String(), " "))
+	ectx.Emit("%s\n\n", labels.String())
+}
+
+func emitHTMLHeader(ectx *common.EmitterCtx) {
+	ectx.Emit(`<!DOCTYPE html>
+<!-- auto-generated by genbitlayout, DO NOT EDIT -->
+<html>
+<head>
+<meta charset="utf-8">
+<title>LoongArch instruction bit layouts</title>
+<style>
+table.insn { border-collapse: collapse; margin-bottom: 1em; font-family: monospace; }
+table.insn td, table.insn th { border: 1px solid #888; padding: 0 0.3em; text-align: center; }
+table.insn th { font-weight: normal; font-size: smaller; }
+table.insn td.opcode { background: #eee; }
+</style>
+</head>
+<body>
+`)
+}
+
+func emitHTMLTable(ectx *common.EmitterCtx, d *common.InsnDescription, fields []bitField) {
+	ectx.Emit("<table class=\"insn\" id=\"%s\">\n", html.EscapeString(d.Mnemonic))
+	ectx.Emit(
+		"<caption><code>%s</code> %s (<code>0x%08x</code>)</caption>\n",
+		html.EscapeString(d.Mnemonic),
+		html.EscapeString(d.Format.CanonicalRepr()),
+		d.Word,
+	)
+
+	ectx.Emit("<tr>")
+	for i := 31; i >= 0; i-- {
+		ectx.Emit("<th>%d</th>", i)
+	}
+	ectx.Emit("</tr>\n")
+
+	ectx.Emit("<tr>")
+	for _, f := range fields {
+		class := "operand"
+		if f.fixed {
+			class = "opcode"
+		}
+		ectx.Emit("<td colspan=\"%d\" class=\"%s\">%s</td>", f.width(), class, html.EscapeString(f.label))
+	}
+	ectx.Emit("</tr>\n")
+
+	ectx.Emit("</table>\n")
+}
+
+func emitHTMLFooter(ectx *common.EmitterCtx) {
+	ectx.Emit("</body>\n</html>\n")
+}
@@ -0,0 +1,37 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestLayoutInsn(t *testing.T) {
+	labels := func(l string) []string {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		var result []string
+		for _, f := range layoutInsn(d) {
+			result = append(result, f.bitRange()+" "+f.label)
+		}
+		return result
+	}
+
+	// adjacent args of the same kind and width stay apart
+	assert.Equal(t, []string{
+		"31:22 0 0 0 0 0 0 0 0 1 1",
+		"21:16 um6[5:0]",
+		"15:10 uk6[5:0]",
+		"9:5 rj[4:0]",
+		"4:0 rd[4:0]",
+	}, labels("00c00000 bstrpick.d             DJUk6Um6"))
+
+	assert.Equal(t, []string{
+		"31:26 0 1 0 0 0 0",
+		"25:10 si21[15:0]",
+		"9:5 rj[4:0]",
+		"4:0 si21[20:16]",
+	}, labels("40000000 beqz                   JSd5k16"))
+}
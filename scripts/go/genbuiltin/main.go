@@ -0,0 +1,25 @@
+// Command genbuiltin emits the JSON representation of the insn descriptions,
+// for embedding into the common package as the data behind common.Builtin.
+package main
+
+import (
+	"os"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func main() {
+	inputs := os.Args[1:]
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	result, err := common.MarshalInsnDescsJSON(descs)
+	if err != nil {
+		panic(err)
+	}
+
+	os.Stdout.Write(result)
+}
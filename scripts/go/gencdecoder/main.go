@@ -0,0 +1,121 @@
+package main
+
+import (
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func main() {
+	inputs := os.Args[1:]
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+
+	os.Stdout.Write(generate(descs, common.MustGetGitCommitHash()))
+}
+
+func generate(descs []*common.InsnDescription, commitHash string) []byte {
+	tree := common.BuildDecodeTree(descs)
+
+	ectx := common.EmitterCtx{
+		DontGofmt: true,
+	}
+
+	ectx.Emit("/* SPDX-License-Identifier: MIT */\n")
+	ectx.Emit("/*\n")
+	ectx.Emit(" * LoongArch instruction decoder.\n")
+	ectx.Emit(" *\n")
+	ectx.Emit(" * This file is auto-generated by gencdecoder from\n")
+	ectx.Emit(" * https://github.com/loongson-community/loongarch-opcodes,\n")
+	ectx.Emit(" * from commit %s.\n", commitHash)
+	ectx.Emit(" * DO NOT EDIT.\n")
+	ectx.Emit(" */\n\n")
+	ectx.Emit("#include <stdint.h>\n")
+
+	emitInsnIDEnum(&ectx, descs)
+	emitMnemonicTable(&ectx, descs)
+	emitDecoderFn(&ectx, tree)
+
+	return ectx.Finalize()
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+// e.g. "amadd_db.w" -> "LA_INSN_AMADD_DB_W"
+func insnIDForInsn(d *common.InsnDescription) string {
+	return "LA_INSN_" + strings.ToUpper(strings.ReplaceAll(d.Mnemonic, ".", "_"))
+}
+
+func emitInsnIDEnum(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit("\ntypedef enum {\n")
+	ectx.Emit("    LA_INSN_INVALID = 0,\n")
+	for _, d := range descs {
+		ectx.Emit("    %s,\n", insnIDForInsn(d))
+	}
+	ectx.Emit("    LA_INSN_COUNT,\n")
+	ectx.Emit("} LoongArchInsnID;\n")
+}
+
+func emitMnemonicTable(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit("\nstatic const char *const la_insn_mnemonics[LA_INSN_COUNT] = {\n")
+	ectx.Emit("    [LA_INSN_INVALID] = \"<invalid>\",\n")
+	for _, d := range descs {
+		ectx.Emit("    [%s] = \"%s\",\n", insnIDForInsn(d), d.Mnemonic)
+	}
+	ectx.Emit("};\n")
+}
+
+func emitDecoderFn(ectx *common.EmitterCtx, tree *common.DecodeTree) {
+	ectx.Emit("\n/*\n")
+	ectx.Emit(" * Returns the instruction encoded by insn, or LA_INSN_INVALID if there is\n")
+	ectx.Emit(" * none. Reserved fields are not checked.\n")
+	ectx.Emit(" */\n")
+	ectx.Emit("static LoongArchInsnID __attribute__((unused))\n")
+	ectx.Emit("la_decode_insn(uint32_t insn)\n{\n")
+	emitDecodeTreeNode(ectx, tree, 1)
+	ectx.Emit("    return LA_INSN_INVALID;\n")
+	ectx.Emit("}\n")
+}
+
+func emitDecodeTreeNode(ectx *common.EmitterCtx, t *common.DecodeTree, depth int) {
+	indent := strings.Repeat("    ", depth)
+
+	if t.IsLeaf() {
+		for _, d := range t.Candidates {
+			ectx.Emit(
+				"%sif ((insn & 0x%08x) == 0x%08x) {\n%s    return %s;\n%s}\n",
+				indent,
+				d.FixedMask(),
+				d.Word,
+				indent,
+				insnIDForInsn(d),
+				indent,
+			)
+		}
+		return
+	}
+
+	fieldMask := (uint64(1) << t.Len) - 1
+	if t.Start > 0 {
+		ectx.Emit("%sswitch ((insn >> %d) & 0x%x) {\n", indent, t.Start, fieldMask)
+	} else {
+		ectx.Emit("%sswitch (insn & 0x%x) {\n", indent, fieldMask)
+	}
+
+	for _, v := range t.Cases() {
+		ectx.Emit("%scase 0x%x:\n", indent, v)
+		emitDecodeTreeNode(ectx, t.Children[v], depth+1)
+		ectx.Emit("%s    break;\n", indent)
+	}
+
+	ectx.Emit("%s}\n", indent)
+}
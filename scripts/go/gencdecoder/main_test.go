@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+// TestDecoderMatchesInterpretiveDecoder compiles the generated decoder for the
+// host, and checks it against common.Decoder over the corpus.
+func TestDecoderMatchesInterpretiveDecoder(t *testing.T) {
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler found")
+	}
+
+	descs := common.Builtin()
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+	ref := common.NewDecoder(descs)
+
+	// valid encodings with random operands, and random words mostly encoding
+	// nothing
+	var words []uint32
+	rng := rand.New(rand.NewSource(1))
+	for _, d := range descs {
+		args := make([]int64, len(d.Format.Args))
+		for i, a := range d.Format.Args {
+			args[i] = a.MinValue() + rng.Int63n(a.MaxValue()-a.MinValue()+1)
+		}
+		words = append(words, d.Encode(args))
+	}
+	for i := 0; i < 2000; i++ {
+		words = append(words, rng.Uint32())
+	}
+
+	var sb strings.Builder
+	sb.WriteString("#include <stdio.h>\n#include <string.h>\n#include \"decoder.h\"\n\n")
+	sb.WriteString("static const struct {\n    uint32_t insn;\n    const char *mnemonic;\n} tests[] = {\n")
+	for _, w := range words {
+		mnemonic := "<invalid>"
+		if x, ok := ref.Decode(w); ok {
+			mnemonic = x.Desc.Mnemonic
+		}
+		fmt.Fprintf(&sb, "    { 0x%08x, %q },\n", w, mnemonic)
+	}
+	sb.WriteString(`};
+
+int main(void)
+{
+    unsigned int i;
+    int failed = 0;
+
+    for (i = 0; i < sizeof(tests) / sizeof(tests[0]); i++) {
+        const char *actual = la_insn_mnemonics[la_decode_insn(tests[i].insn)];
+
+        if (strcmp(actual, tests[i].mnemonic) != 0) {
+            printf("%08x: got %s, want %s\n", (unsigned)tests[i].insn, actual, tests[i].mnemonic);
+            failed++;
+        }
+    }
+
+    printf("%u tests, %d failed\n", i, failed);
+    return failed != 0;
+}
+`)
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "decoder.h"), generate(descs, "0000000000000000000000000000000000000000"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "test.c"), []byte(sb.String()), 0644))
+
+	exe := filepath.Join(dir, "test")
+	out, err := exec.Command(cc, "-std=c99", "-Wall", "-Werror", "-o", exe, filepath.Join(dir, "test.c")).CombinedOutput()
+	if !assert.NoError(t, err, string(out)) {
+		t.FailNow()
+	}
+
+	out, err = exec.Command(exe).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	assert.Contains(t, string(out), fmt.Sprintf("%d tests, 0 failed\n", len(words)))
+}
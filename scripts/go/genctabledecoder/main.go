@@ -0,0 +1,538 @@
+// Command genctabledecoder emits a table-driven LoongArch instruction decoder
+// in C, for environments like kernels and firmware: it depends on nothing but
+// <stdint.h>, uses only fixed-size constant tables, and never allocates.
+//
+// Decoding linearly scans a table of (match, mask, format, insn ID) entries,
+// ordered so that more specific encodings are tried first, then extracts the
+// operands with a switch on the format. This is smaller but slower than the
+// decode tree emitted by gencdecoder.
+//
+// For consumers iterating over the operands generically, like printers,
+// la_fill_operands also extracts them as LoongArchOperand, tagged unions of
+// the register number or the immediate value with the operand kind.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/bits"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+var constantTime = flag.Bool("constant-time", false, "also emit la_decode_insn_ct, a decoder taking the same time whatever the insn decoded")
+
+func main() {
+	flag.Parse()
+	inputs := flag.Args()
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+
+	os.Stdout.Write(generate(descs, common.MustGetGitCommitHash()))
+}
+
+func generate(descs []*common.InsnDescription, commitHash string) []byte {
+	formats := common.GatherFormats(descs)
+
+	// the decode table entries have 8-bit format and 16-bit insn IDs, with 0
+	// reserved for the invalid ones
+	if len(formats) > 0xff || len(descs) > 0xffff {
+		panic(fmt.Sprintf("%d formats or %d insns are too many for the decode table", len(formats), len(descs)))
+	}
+
+	ectx := common.EmitterCtx{
+		DontGofmt: true,
+	}
+
+	ectx.Emit("/* SPDX-License-Identifier: MIT */\n")
+	ectx.Emit("/*\n")
+	ectx.Emit(" * LoongArch table-driven instruction decoder.\n")
+	ectx.Emit(" *\n")
+	ectx.Emit(" * This file is auto-generated by genctabledecoder from\n")
+	ectx.Emit(" * https://github.com/loongson-community/loongarch-opcodes,\n")
+	ectx.Emit(" * from commit %s.\n", commitHash)
+	ectx.Emit(" * DO NOT EDIT.\n")
+	ectx.Emit(" */\n\n")
+	ectx.Emit("#include <stdint.h>\n")
+
+	emitInsnIDEnum(&ectx, descs)
+	emitFormatEnum(&ectx, formats)
+	emitMnemonicTable(&ectx, descs)
+	emitInsnArityTable(&ectx, descs)
+	emitDecodeTable(&ectx, descs)
+	emitExtractHelpers(&ectx, formats)
+	emitExtractFn(&ectx, formats)
+	emitDecoderFn(&ectx)
+	if *constantTime {
+		emitConstantTimeDecoderFn(&ectx, formats)
+	}
+	emitOperandTypes(&ectx)
+	emitFormatArityTable(&ectx, formats)
+	emitFillOperandsFns(&ectx, formats)
+
+	return ectx.Finalize()
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+// e.g. "amadd_db.w" -> "LA_INSN_AMADD_DB_W"
+func insnIDForInsn(d *common.InsnDescription) string {
+	return "LA_INSN_" + strings.ToUpper(strings.ReplaceAll(d.Mnemonic, ".", "_"))
+}
+
+// e.g. "DJSk12" -> "LA_FMT_DJSK12"
+func formatIDForFormat(f *common.InsnFormat) string {
+	return "LA_FMT_" + strings.ToUpper(f.CanonicalRepr())
+}
+
+func maxArity(fmts []*common.InsnFormat) int {
+	result := 0
+	for _, f := range fmts {
+		if len(f.Args) > result {
+			result = len(f.Args)
+		}
+	}
+	return result
+}
+
+func emitInsnIDEnum(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit("\ntypedef enum {\n")
+	ectx.Emit("    LA_INSN_INVALID = 0,\n")
+	for _, d := range descs {
+		ectx.Emit("    %s,\n", insnIDForInsn(d))
+	}
+	ectx.Emit("    LA_INSN_COUNT,\n")
+	ectx.Emit("} LoongArchInsnID;\n")
+}
+
+func emitFormatEnum(ectx *common.EmitterCtx, fmts []*common.InsnFormat) {
+	ectx.Emit("\ntypedef enum {\n")
+	ectx.Emit("    LA_FMT_INVALID = 0,\n")
+	for _, f := range fmts {
+		ectx.Emit("    %s,\n", formatIDForFormat(f))
+	}
+	ectx.Emit("    LA_FMT_COUNT,\n")
+	ectx.Emit("} LoongArchInsnFormat;\n")
+
+	ectx.Emit("\n#define LA_MAX_ARGS %d\n", maxArity(fmts))
+}
+
+func emitMnemonicTable(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit("\nstatic const char *const la_insn_mnemonics[LA_INSN_COUNT] = {\n")
+	ectx.Emit("    [LA_INSN_INVALID] = \"<invalid>\",\n")
+	for _, d := range descs {
+		ectx.Emit("    [%s] = \"%s\",\n", insnIDForInsn(d), d.Mnemonic)
+	}
+	ectx.Emit("};\n")
+}
+
+func emitDecodeTable(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	// more specific encodings (more fixed bits) first, so that special-cased
+	// sub-encodings of other instructions take precedence
+	sorted := append([]*common.InsnDescription{}, descs...)
+	sort.SliceStable(sorted, func(i int, j int) bool {
+		ni := bits.OnesCount32(sorted[i].FixedMask())
+		nj := bits.OnesCount32(sorted[j].FixedMask())
+		if ni != nj {
+			return ni > nj
+		}
+		return sorted[i].Word < sorted[j].Word
+	})
+
+	ectx.Emit("\ntypedef struct {\n")
+	ectx.Emit("    uint32_t match;\n")
+	ectx.Emit("    uint32_t mask;\n")
+	ectx.Emit("    uint8_t fmt;\n")
+	ectx.Emit("    uint16_t insn_id;\n")
+	ectx.Emit("} LoongArchDecodeEntry;\n")
+
+	ectx.Emit("\nstatic const LoongArchDecodeEntry la_decode_table[%d] = {\n", len(sorted))
+	for _, d := range sorted {
+		ectx.Emit(
+			"    { 0x%08x, 0x%08x, %s, %s },\n",
+			d.Word,
+			d.FixedMask(),
+			formatIDForFormat(d.Format),
+			insnIDForInsn(d),
+		)
+	}
+	ectx.Emit("};\n")
+}
+
+// slotVarName returns the name of the variable holding the value of the slot
+// in the extraction helpers, e.g. "slot_k".
+func slotVarName(s *common.Slot) string {
+	return "slot_" + s.CanonicalRepr()[:1]
+}
+
+// sortedSlotsForFormat returns the slots of all args of the format, from LSB
+// to MSB.
+func sortedSlotsForFormat(f *common.InsnFormat) []*common.Slot {
+	var result []*common.Slot
+	for _, a := range f.Args {
+		result = append(result, a.Slots...)
+	}
+	sort.Slice(result, func(i int, j int) bool {
+		return result[i].Offset < result[j].Offset
+	})
+	return result
+}
+
+// slotCombinationForFormat returns the slots of the format, from LSB to MSB,
+// e.g. "d5j5k12" for DJSk12. Formats with the same slot combination share the
+// slot extraction helper.
+func slotCombinationForFormat(f *common.InsnFormat) string {
+	var sb strings.Builder
+	for _, s := range sortedSlotsForFormat(f) {
+		sb.WriteString(s.CanonicalRepr())
+	}
+	return sb.String()
+}
+
+func slotsExtractFnNameForFormat(f *common.InsnFormat) string {
+	return fmt.Sprintf("la_extract_%s_slots", slotCombinationForFormat(f))
+}
+
+// e.g. "DJSk12" -> "la_extract_djsk12_args"
+func argsExtractFnNameForFormat(f *common.InsnFormat) string {
+	return "la_extract_" + strings.ToLower(f.CanonicalRepr()) + "_args"
+}
+
+// argFieldExpr returns the C expression of the unsigned value of the arg, with
+// the slot values extracted by the slot extraction helper concatenated from
+// MSB to LSB.
+func argFieldExpr(a *common.Arg) string {
+	var parts []string
+	valueOffsets := a.SlotValueOffsets()
+	for i, s := range a.Slots {
+		part := slotVarName(s)
+		if valueOffsets[i] > 0 {
+			part = fmt.Sprintf("%s << %d", part, valueOffsets[i])
+		}
+
+		parts = append(parts, part)
+	}
+
+	expr := parts[0]
+	if len(parts) > 1 {
+		expr = "(" + strings.Join(parts, " | ") + ")"
+	}
+	return expr
+}
+
+// signedArgExpr returns the C expression of the value of the signed imm arg
+// in insn, sign-extended to int32_t.
+func signedArgExpr(a *common.Arg) string {
+	// portable sign extension: flip the sign bit, then subtract it
+	signBit := uint64(1) << (a.TotalWidth() - 1)
+	return fmt.Sprintf("(int32_t)(%s ^ 0x%x) - 0x%x", argFieldExpr(a), signBit, signBit)
+}
+
+// argExtractExpr returns the C expression extracting the arg from insn, with
+// the slots concatenated from MSB to LSB, and sign-extended if signed.
+func argExtractExpr(a *common.Arg) string {
+	if a.Kind != common.ArgKindSignedImm {
+		return "(int32_t)" + argFieldExpr(a)
+	}
+	return signedArgExpr(a)
+}
+
+// emitExtractHelpers emits the helpers extracting the operands of insns, that
+// all the decoders and la_fill_operands call, so they agree on the operand
+// values: one per slot combination extracting the slot values, like the
+// encoders share encode_<sc>_slots in genqemutcgdefs, and one per format
+// making the operand values from them.
+func emitExtractHelpers(ectx *common.EmitterCtx, fmts []*common.InsnFormat) {
+	emitted := make(map[string]bool)
+	for _, f := range fmts {
+		sc := slotCombinationForFormat(f)
+		if sc == "" || emitted[sc] {
+			continue
+		}
+		emitted[sc] = true
+
+		slots := sortedSlotsForFormat(f)
+		ectx.Emit("\nstatic inline void\n%s(uint32_t insn", slotsExtractFnNameForFormat(f))
+		for _, s := range slots {
+			ectx.Emit(", uint32_t *%s", slotVarName(s))
+		}
+		ectx.Emit(")\n{\n")
+		for _, s := range slots {
+			field := "insn"
+			if s.Offset > 0 {
+				field = fmt.Sprintf("(insn >> %d)", s.Offset)
+			}
+			ectx.Emit("    *%s = %s & 0x%x;\n", slotVarName(s), field, (uint64(1)<<s.Width)-1)
+		}
+		ectx.Emit("}\n")
+	}
+
+	for _, f := range fmts {
+		ectx.Emit("\nstatic inline void\n%s(uint32_t insn, int32_t args[LA_MAX_ARGS])\n{\n", argsExtractFnNameForFormat(f))
+		if len(f.Args) == 0 {
+			ectx.Emit("    (void)insn;\n")
+			ectx.Emit("    (void)args;\n")
+			ectx.Emit("}\n")
+			continue
+		}
+
+		slots := sortedSlotsForFormat(f)
+		varNames := make([]string, len(slots))
+		for i, s := range slots {
+			varNames[i] = slotVarName(s)
+		}
+		ectx.Emit("    uint32_t %s;\n\n", strings.Join(varNames, ", "))
+		ectx.Emit("    %s(insn, &%s);\n", slotsExtractFnNameForFormat(f), strings.Join(varNames, ", &"))
+		for i, a := range f.Args {
+			ectx.Emit("    args[%d] = %s;\n", i, argExtractExpr(a))
+		}
+		ectx.Emit("}\n")
+	}
+}
+
+func emitExtractFn(ectx *common.EmitterCtx, fmts []*common.InsnFormat) {
+	ectx.Emit("\n/*\n")
+	ectx.Emit(" * Extracts the operands of insn in the given format into args, in the\n")
+	ectx.Emit(" * canonical order, returning the number of operands.\n")
+	ectx.Emit(" */\n")
+	ectx.Emit("static int __attribute__((unused))\n")
+	ectx.Emit("la_extract_args(LoongArchInsnFormat fmt, uint32_t insn, int32_t args[LA_MAX_ARGS])\n{\n")
+	ectx.Emit("    switch (fmt) {\n")
+	for _, f := range fmts {
+		ectx.Emit("    case %s:\n", formatIDForFormat(f))
+		ectx.Emit("        %s(insn, args);\n", argsExtractFnNameForFormat(f))
+		ectx.Emit("        return %d;\n", len(f.Args))
+	}
+	ectx.Emit("    default:\n")
+	ectx.Emit("        return 0;\n")
+	ectx.Emit("    }\n")
+	ectx.Emit("}\n")
+}
+
+func emitDecoderFn(ectx *common.EmitterCtx) {
+	ectx.Emit("\ntypedef struct {\n")
+	ectx.Emit("    LoongArchInsnID id;\n")
+	ectx.Emit("    LoongArchInsnFormat fmt;\n")
+	ectx.Emit("    int nargs;\n")
+	ectx.Emit("    int32_t args[LA_MAX_ARGS];\n")
+	ectx.Emit("} LoongArchDecodedInsn;\n")
+
+	ectx.Emit("\n/*\n")
+	ectx.Emit(" * Decodes insn into out, returning 1 on success, or 0 with out->id set to\n")
+	ectx.Emit(" * LA_INSN_INVALID if insn encodes no known instruction. Reserved fields are\n")
+	ectx.Emit(" * not checked.\n")
+	ectx.Emit(" */\n")
+	ectx.Emit("static int __attribute__((unused))\n")
+	ectx.Emit("la_decode_insn(uint32_t insn, LoongArchDecodedInsn *out)\n{\n")
+	ectx.Emit("    unsigned int i;\n\n")
+	ectx.Emit("    for (i = 0; i < sizeof(la_decode_table) / sizeof(la_decode_table[0]); i++) {\n")
+	ectx.Emit("        const LoongArchDecodeEntry *e = &la_decode_table[i];\n")
+	ectx.Emit("        if ((insn & e->mask) == e->match) {\n")
+	ectx.Emit("            out->id = (LoongArchInsnID)e->insn_id;\n")
+	ectx.Emit("            out->fmt = (LoongArchInsnFormat)e->fmt;\n")
+	ectx.Emit("            out->nargs = la_extract_args(out->fmt, insn, out->args);\n")
+	ectx.Emit("            return 1;\n")
+	ectx.Emit("        }\n")
+	ectx.Emit("    }\n\n")
+	ectx.Emit("    out->id = LA_INSN_INVALID;\n")
+	ectx.Emit("    out->fmt = LA_FMT_INVALID;\n")
+	ectx.Emit("    out->nargs = 0;\n")
+	ectx.Emit("    return 0;\n")
+	ectx.Emit("}\n")
+}
+
+// emitConstantTimeDecoderFn emits a decoder without branches depending on the
+// insn decoded: every entry of the decode table is compared, and the operands
+// are extracted in every format, with the results selected by masks.
+func emitConstantTimeDecoderFn(ectx *common.EmitterCtx, fmts []*common.InsnFormat) {
+	ectx.Emit("\n/* Returns all ones if a == b, or 0 otherwise, without branching.  */\n")
+	ectx.Emit("static inline uint32_t\n")
+	ectx.Emit("la_ct_eq_mask(uint32_t a, uint32_t b)\n{\n")
+	ectx.Emit("    uint32_t diff = a ^ b;\n\n")
+	ectx.Emit("    /* the MSB of diff | -diff is set iff diff is non-zero */\n")
+	ectx.Emit("    return ((diff | (0u - diff)) >> 31) - 1;\n")
+	ectx.Emit("}\n")
+
+	ectx.Emit("\n/*\n")
+	ectx.Emit(" * Like la_decode_insn, but the sequence of operations executed, hence the\n")
+	ectx.Emit(" * time taken, does not depend on insn: all the entries of the decode table\n")
+	ectx.Emit(" * are compared with no early exit, the operands are extracted in every\n")
+	ectx.Emit(" * format, and the results are selected by masking. It is several times\n")
+	ectx.Emit(" * slower than la_decode_insn.\n")
+	ectx.Emit(" *\n")
+	ectx.Emit(" * The guarantee only holds for the C code as written; check the code\n")
+	ectx.Emit(" * generated by the compiler for branches it may introduce.\n")
+	ectx.Emit(" */\n")
+	ectx.Emit("static int __attribute__((unused))\n")
+	ectx.Emit("la_decode_insn_ct(uint32_t insn, LoongArchDecodedInsn *out)\n{\n")
+	ectx.Emit("    uint32_t found = 0, id = 0, fmt = 0, nargs = 0;\n")
+	ectx.Emit("    uint32_t args[LA_MAX_ARGS] = { 0 }, args_sel[LA_MAX_ARGS] = { 0 };\n")
+	ectx.Emit("    int32_t fmt_args[LA_MAX_ARGS];\n")
+	ectx.Emit("    uint32_t sel;\n")
+	ectx.Emit("    unsigned int i;\n\n")
+	ectx.Emit("    for (i = 0; i < sizeof(la_decode_table) / sizeof(la_decode_table[0]); i++) {\n")
+	ectx.Emit("        const LoongArchDecodeEntry *e = &la_decode_table[i];\n")
+	ectx.Emit("        /* only the first match counts, like in la_decode_insn */\n")
+	ectx.Emit("        sel = la_ct_eq_mask(insn & e->mask, e->match) & ~found;\n")
+	ectx.Emit("        id |= sel & e->insn_id;\n")
+	ectx.Emit("        fmt |= sel & e->fmt;\n")
+	ectx.Emit("        found |= sel;\n")
+	ectx.Emit("    }\n")
+
+	for _, f := range fmts {
+		ectx.Emit("\n    sel = la_ct_eq_mask(fmt, %s);\n", formatIDForFormat(f))
+		ectx.Emit("    nargs |= sel & %d;\n", f.ArgCount())
+		if len(f.Args) == 0 {
+			continue
+		}
+		ectx.Emit("    %s(insn, fmt_args);\n", argsExtractFnNameForFormat(f))
+		for i := range f.Args {
+			ectx.Emit("    args[%d] |= sel & (uint32_t)fmt_args[%d];\n", i, i)
+			ectx.Emit("    args_sel[%d] |= sel;\n", i)
+		}
+	}
+
+	// leave out as la_decode_insn does: with no match LA_INSN_INVALID,
+	// LA_FMT_INVALID and no args, and the args past nargs untouched
+	ectx.Emit("\n    /* LA_INSN_INVALID and LA_FMT_INVALID are 0; args_sel is 0 past nargs */\n")
+	ectx.Emit("    out->id = (LoongArchInsnID)(id & found);\n")
+	ectx.Emit("    out->fmt = (LoongArchInsnFormat)(fmt & found);\n")
+	ectx.Emit("    out->nargs = (int)(nargs & found);\n")
+	ectx.Emit("    for (i = 0; i < LA_MAX_ARGS; i++) {\n")
+	ectx.Emit("        out->args[i] = (int32_t)((args[i] & args_sel[i]) | ((uint32_t)out->args[i] & ~args_sel[i]));\n")
+	ectx.Emit("    }\n")
+	ectx.Emit("    return (int)(found & 1);\n")
+	ectx.Emit("}\n")
+}
+
+// operandKinds are the arg kinds in the order of the LoongArchOperandKind enum,
+// with their enumerator names.
+var operandKinds = []struct {
+	kind common.ArgKind
+	name string
+}{
+	{common.ArgKindIntReg, "LA_OPERAND_GPR"},
+	{common.ArgKindFPReg, "LA_OPERAND_FPR"},
+	{common.ArgKindFCCReg, "LA_OPERAND_FCC"},
+	{common.ArgKindScratchReg, "LA_OPERAND_SCR"},
+	{common.ArgKindVReg, "LA_OPERAND_VR"},
+	{common.ArgKindXReg, "LA_OPERAND_XR"},
+	{common.ArgKindSignedImm, "LA_OPERAND_SIMM"},
+	{common.ArgKindUnsignedImm, "LA_OPERAND_UIMM"},
+}
+
+func operandKindName(k common.ArgKind) string {
+	for _, ok := range operandKinds {
+		if ok.kind == k {
+			return ok.name
+		}
+	}
+	panic("unreachable")
+}
+
+func emitOperandTypes(ectx *common.EmitterCtx) {
+	ectx.Emit("\ntypedef enum {\n")
+	ectx.Emit("    LA_OPERAND_NONE = 0,\n")
+	for _, ok := range operandKinds {
+		ectx.Emit("    %s,\n", ok.name)
+	}
+	ectx.Emit("} LoongArchOperandKind;\n")
+
+	ectx.Emit("\n/*\n")
+	ectx.Emit(" * An operand tagged with its kind: reg is valid for the register kinds,\n")
+	ectx.Emit(" * simm for LA_OPERAND_SIMM and uimm for LA_OPERAND_UIMM.\n")
+	ectx.Emit(" */\n")
+	ectx.Emit("typedef struct {\n")
+	ectx.Emit("    LoongArchOperandKind kind;\n")
+	ectx.Emit("    union {\n")
+	ectx.Emit("        uint32_t reg;\n")
+	ectx.Emit("        int32_t simm;\n")
+	ectx.Emit("        uint32_t uimm;\n")
+	ectx.Emit("    } u;\n")
+	ectx.Emit("} LoongArchOperand;\n")
+}
+
+func emitFormatArityTable(ectx *common.EmitterCtx, fmts []*common.InsnFormat) {
+	ectx.Emit("\nstatic const uint8_t la_format_nargs[LA_FMT_COUNT] = {\n")
+	ectx.Emit("    [LA_FMT_INVALID] = 0,\n")
+	for _, f := range fmts {
+		ectx.Emit("    [%s] = %d,\n", formatIDForFormat(f), f.ArgCount())
+	}
+	ectx.Emit("};\n")
+}
+
+// emitInsnArityTable emits the number of operands of every insn, for
+// assemblers to check the operand count before parsing the operands.
+func emitInsnArityTable(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit("\nstatic const uint8_t la_insn_nargs[LA_INSN_COUNT] = {\n")
+	ectx.Emit("    [LA_INSN_INVALID] = 0,\n")
+	for _, d := range descs {
+		ectx.Emit("    [%s] = %d,\n", insnIDForInsn(d), d.Format.ArgCount())
+	}
+	ectx.Emit("};\n")
+}
+
+// e.g. "DJSk12" -> "la_fill_operands_djsk12"
+func fillOperandsFnNameForFormat(f *common.InsnFormat) string {
+	return "la_fill_operands_" + strings.ToLower(f.CanonicalRepr())
+}
+
+func emitFillOperandsFns(ectx *common.EmitterCtx, fmts []*common.InsnFormat) {
+	for _, f := range fmts {
+		ectx.Emit("\nstatic void\n")
+		ectx.Emit("%s(uint32_t insn, LoongArchOperand operands[LA_MAX_ARGS])\n{\n", fillOperandsFnNameForFormat(f))
+		if len(f.Args) == 0 {
+			ectx.Emit("    (void)insn;\n")
+			ectx.Emit("    (void)operands;\n")
+		} else {
+			ectx.Emit("    int32_t args[LA_MAX_ARGS];\n\n")
+			ectx.Emit("    %s(insn, args);\n", argsExtractFnNameForFormat(f))
+		}
+		for i, a := range f.Args {
+			ectx.Emit("    operands[%d].kind = %s;\n", i, operandKindName(a.Kind))
+			switch a.Kind {
+			case common.ArgKindSignedImm:
+				ectx.Emit("    operands[%d].u.simm = args[%d];\n", i, i)
+			case common.ArgKindUnsignedImm:
+				ectx.Emit("    operands[%d].u.uimm = (uint32_t)args[%d];\n", i, i)
+			default:
+				ectx.Emit("    operands[%d].u.reg = (uint32_t)args[%d];\n", i, i)
+			}
+		}
+		ectx.Emit("}\n")
+	}
+
+	ectx.Emit("\n/*\n")
+	ectx.Emit(" * Fills the operands of insn in the given format into operands, in the\n")
+	ectx.Emit(" * canonical order, returning the number of operands; the entries past them\n")
+	ectx.Emit(" * are of kind LA_OPERAND_NONE.\n")
+	ectx.Emit(" */\n")
+	ectx.Emit("static int __attribute__((unused))\n")
+	ectx.Emit("la_fill_operands(LoongArchInsnFormat fmt, uint32_t insn, LoongArchOperand operands[LA_MAX_ARGS])\n{\n")
+	ectx.Emit("    int i;\n\n")
+	ectx.Emit("    for (i = 0; i < LA_MAX_ARGS; i++) {\n")
+	ectx.Emit("        operands[i].kind = LA_OPERAND_NONE;\n")
+	ectx.Emit("        operands[i].u.uimm = 0;\n")
+	ectx.Emit("    }\n\n")
+	ectx.Emit("    switch (fmt) {\n")
+	for _, f := range fmts {
+		ectx.Emit("    case %s:\n", formatIDForFormat(f))
+		ectx.Emit("        %s(insn, operands);\n", fillOperandsFnNameForFormat(f))
+		ectx.Emit("        break;\n")
+	}
+	ectx.Emit("    default:\n")
+	ectx.Emit("        return 0;\n")
+	ectx.Emit("    }\n")
+	ectx.Emit("    return la_format_nargs[fmt];\n")
+	ectx.Emit("}\n")
+}
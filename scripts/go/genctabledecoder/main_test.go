@@ -0,0 +1,377 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+// wordsForTest returns valid encodings with random operands of the insns,
+// and random words mostly encoding nothing.
+func wordsForTest(descs []*common.InsnDescription) []uint32 {
+	var words []uint32
+	rng := rand.New(rand.NewSource(1))
+	for _, d := range descs {
+		args := make([]int64, len(d.Format.Args))
+		for i, a := range d.Format.Args {
+			args[i] = a.MinValue() + rng.Int63n(a.MaxValue()-a.MinValue()+1)
+		}
+		words = append(words, d.Encode(args))
+	}
+	for i := 0; i < 2000; i++ {
+		words = append(words, rng.Uint32())
+	}
+	return words
+}
+
+// compileAndRun compiles the C test program with the generated decoder for
+// the host and runs it, returning its output, or skips the test if there's no
+// C compiler.
+func compileAndRun(t *testing.T, descs []*common.InsnDescription, testSrc string) string {
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler found")
+	}
+
+	dir := t.TempDir()
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "decoder.h"), generate(descs, "0000000000000000000000000000000000000000"), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "test.c"), []byte(testSrc), 0644))
+
+	exe := filepath.Join(dir, "test")
+	out, err := exec.Command(cc, "-std=c99", "-Wall", "-Werror", "-o", exe, filepath.Join(dir, "test.c")).CombinedOutput()
+	if !assert.NoError(t, err, string(out)) {
+		t.FailNow()
+	}
+
+	out, err = exec.Command(exe).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	return string(out)
+}
+
+// TestDecoderMatchesInterpretiveDecoder compiles the generated decoder for the
+// host, and checks it against common.Decoder over the corpus.
+func TestDecoderMatchesInterpretiveDecoder(t *testing.T) {
+	descs := common.Builtin()
+	ref := common.NewDecoder(descs)
+	words := wordsForTest(descs)
+
+	var sb strings.Builder
+	sb.WriteString("#include <stdio.h>\n#include \"decoder.h\"\n\n")
+	sb.WriteString("static const struct {\n    uint32_t insn;\n    LoongArchInsnID id;\n    int nargs;\n    int32_t args[LA_MAX_ARGS];\n} tests[] = {\n")
+	for _, w := range words {
+		x, ok := ref.Decode(w)
+		if !ok {
+			fmt.Fprintf(&sb, "    { 0x%08x, LA_INSN_INVALID, 0, { 0 } },\n", w)
+			continue
+		}
+
+		args := make([]string, len(x.Args))
+		for i, v := range x.Args {
+			args[i] = fmt.Sprintf("%d", v)
+		}
+		if len(args) == 0 {
+			args = []string{"0"}
+		}
+		fmt.Fprintf(
+			&sb,
+			"    { 0x%08x, %s, %d, { %s } },\n",
+			w,
+			insnIDForInsn(x.Desc),
+			len(x.Args),
+			strings.Join(args, ", "),
+		)
+	}
+	sb.WriteString(`};
+
+int main(void)
+{
+    unsigned int i;
+    int j, failed = 0;
+
+    for (i = 0; i < sizeof(tests) / sizeof(tests[0]); i++) {
+        LoongArchDecodedInsn x;
+        int ok = la_decode_insn(tests[i].insn, &x);
+        int bad = ok != (tests[i].id != LA_INSN_INVALID) || x.id != tests[i].id || x.nargs != tests[i].nargs;
+        for (j = 0; !bad && j < x.nargs; j++) {
+            bad = x.args[j] != tests[i].args[j];
+        }
+        if (bad) {
+            printf("%08x: got %s, want %s\n", (unsigned)tests[i].insn,
+                   la_insn_mnemonics[x.id], la_insn_mnemonics[tests[i].id]);
+            failed++;
+        }
+    }
+
+    printf("%u tests, %d failed\n", i, failed);
+    return failed != 0;
+}
+`)
+
+	out := compileAndRun(t, descs, sb.String())
+	assert.Contains(t, out, fmt.Sprintf("%d tests, 0 failed", len(words)))
+}
+
+// TestFillOperandsMatchesInterpretiveDecoder checks the tagged operands of
+// the generated decoder against common.Decoder over the corpus.
+func TestFillOperandsMatchesInterpretiveDecoder(t *testing.T) {
+	descs := common.Builtin()
+	ref := common.NewDecoder(descs)
+	words := wordsForTest(descs)
+
+	n := 0
+	var sb strings.Builder
+	sb.WriteString("#include <stdio.h>\n#include \"decoder.h\"\n\n")
+	sb.WriteString("static const struct {\n    uint32_t insn;\n    int nargs;\n    LoongArchOperandKind kinds[LA_MAX_ARGS];\n    int64_t values[LA_MAX_ARGS];\n} tests[] = {\n")
+	for _, w := range words {
+		x, ok := ref.Decode(w)
+		if !ok {
+			continue
+		}
+		n++
+
+		kinds := []string{"LA_OPERAND_NONE"}
+		values := []string{"0"}
+		if len(x.Args) > 0 {
+			kinds = make([]string, len(x.Args))
+			values = make([]string, len(x.Args))
+			for i, a := range x.Desc.Format.Args {
+				kinds[i] = operandKindName(a.Kind)
+				values[i] = fmt.Sprintf("%d", x.Args[i])
+			}
+		}
+		fmt.Fprintf(
+			&sb,
+			"    { 0x%08x, %d, { %s }, { %s } },\n",
+			w,
+			len(x.Args),
+			strings.Join(kinds, ", "),
+			strings.Join(values, ", "),
+		)
+	}
+	sb.WriteString(`};
+
+int main(void)
+{
+    unsigned int i;
+    int j, failed = 0;
+
+    for (i = 0; i < sizeof(tests) / sizeof(tests[0]); i++) {
+        LoongArchDecodedInsn x;
+        LoongArchOperand ops[LA_MAX_ARGS];
+        int nargs, bad;
+
+        la_decode_insn(tests[i].insn, &x);
+        nargs = la_fill_operands(x.fmt, tests[i].insn, ops);
+        bad = nargs != tests[i].nargs;
+        for (j = 0; !bad && j < LA_MAX_ARGS; j++) {
+            int64_t v;
+
+            if (j >= nargs) {
+                bad = ops[j].kind != LA_OPERAND_NONE;
+                continue;
+            }
+            switch (ops[j].kind) {
+            case LA_OPERAND_SIMM:
+                v = ops[j].u.simm;
+                break;
+            case LA_OPERAND_UIMM:
+                v = ops[j].u.uimm;
+                break;
+            default:
+                v = ops[j].u.reg;
+                break;
+            }
+            bad = ops[j].kind != tests[i].kinds[j] || v != tests[i].values[j];
+        }
+        if (bad) {
+            printf("%08x: %s: operands mismatch\n", (unsigned)tests[i].insn, la_insn_mnemonics[x.id]);
+            failed++;
+        }
+    }
+
+    printf("%u tests, %d failed\n", i, failed);
+    return failed != 0;
+}
+`)
+
+	out := compileAndRun(t, descs, sb.String())
+	assert.Contains(t, out, fmt.Sprintf("%d tests, 0 failed", n))
+}
+
+// TestDecodeAndFillOperandsAgree checks that the decoder and la_fill_operands
+// produce the same operand values, as they share the extraction helpers,
+// which hold all the slot extraction.
+func TestDecodeAndFillOperandsAgree(t *testing.T) {
+	descs := common.Builtin()
+
+	src := string(generate(descs, "0000000000000000000000000000000000000000"))
+	for _, line := range strings.Split(src, "\n") {
+		if strings.Contains(line, "insn >> ") || strings.Contains(line, "insn & 0x") {
+			assert.True(t, strings.HasPrefix(line, "    *slot_"), line)
+		}
+	}
+
+	ref := common.NewDecoder(descs)
+	valid := 0
+	var sb strings.Builder
+	sb.WriteString("#include <stdio.h>\n#include \"decoder.h\"\n\nstatic const uint32_t words[] = {\n")
+	for _, w := range wordsForTest(descs) {
+		if _, ok := ref.Decode(w); ok {
+			valid++
+		}
+		fmt.Fprintf(&sb, "    0x%08x,\n", w)
+	}
+	sb.WriteString(`};
+
+int main(void)
+{
+    unsigned int i;
+    int j, decoded = 0, failed = 0;
+
+    for (i = 0; i < sizeof(words) / sizeof(words[0]); i++) {
+        LoongArchDecodedInsn x;
+        LoongArchOperand ops[LA_MAX_ARGS];
+        int bad;
+
+        if (!la_decode_insn(words[i], &x)) {
+            continue;
+        }
+        decoded++;
+
+        bad = la_fill_operands(x.fmt, words[i], ops) != x.nargs;
+        for (j = 0; !bad && j < x.nargs; j++) {
+            switch (ops[j].kind) {
+            case LA_OPERAND_SIMM:
+                bad = ops[j].u.simm != x.args[j];
+                break;
+            case LA_OPERAND_UIMM:
+                bad = ops[j].u.uimm != (uint32_t)x.args[j];
+                break;
+            default:
+                bad = ops[j].u.reg != (uint32_t)x.args[j];
+                break;
+            }
+        }
+        if (bad) {
+            printf("%08x: %s: operands differ\n", (unsigned)words[i], la_insn_mnemonics[x.id]);
+            failed++;
+        }
+    }
+
+    printf("%d decoded, %d failed\n", decoded, failed);
+    return failed != 0;
+}
+`)
+
+	out := compileAndRun(t, descs, sb.String())
+	assert.Contains(t, out, fmt.Sprintf("%d decoded, 0 failed", valid))
+}
+
+func TestInsnArityTable(t *testing.T) {
+	descs := common.Builtin()
+
+	var sb strings.Builder
+	sb.WriteString("#include <stdio.h>\n#include \"decoder.h\"\n\n")
+	sb.WriteString("static const struct {\n    LoongArchInsnID id;\n    int nargs;\n} tests[] = {\n")
+	numEmpty := 0
+	for _, d := range descs {
+		if d.Format.ArgCount() == 0 {
+			numEmpty++
+		}
+		fmt.Fprintf(&sb, "    { %s, %d },\n", insnIDForInsn(d), d.Format.ArgCount())
+	}
+	sb.WriteString(`};
+
+int main(void)
+{
+    unsigned int i;
+    int failed = 0, empty = 0;
+
+    for (i = 0; i < sizeof(tests) / sizeof(tests[0]); i++) {
+        if (la_insn_nargs[tests[i].id] != tests[i].nargs) {
+            printf("%s: got %d, want %d\n", la_insn_mnemonics[tests[i].id],
+                   la_insn_nargs[tests[i].id], tests[i].nargs);
+            failed++;
+        }
+        if (la_insn_nargs[tests[i].id] == 0) {
+            empty++;
+        }
+    }
+
+    printf("%u tests, %d failed, %d empty\n", i, failed, empty);
+    return failed != 0;
+}
+`)
+
+	// the EMPTY insns like tlbclr take no operands
+	assert.NotZero(t, numEmpty)
+	out := compileAndRun(t, descs, sb.String())
+	assert.Contains(t, out, fmt.Sprintf("%d tests, 0 failed, %d empty", len(descs), numEmpty))
+}
+
+// TestConstantTimeDecoder checks that la_decode_insn_ct decodes like
+// la_decode_insn over the corpus.
+func TestConstantTimeDecoder(t *testing.T) {
+	*constantTime = true
+	defer func() { *constantTime = false }()
+
+	descs := common.Builtin()
+	words := wordsForTest(descs)
+
+	var sb strings.Builder
+	sb.WriteString("#include <stdio.h>\n#include \"decoder.h\"\n\n")
+	sb.WriteString("static const uint32_t words[] = {\n")
+	for _, w := range words {
+		fmt.Fprintf(&sb, "    0x%08x,\n", w)
+	}
+	sb.WriteString(`};
+
+int main(void)
+{
+    unsigned int i;
+    int j, failed = 0, invalid = 0;
+
+    for (i = 0; i < sizeof(words) / sizeof(words[0]); i++) {
+        LoongArchDecodedInsn x, y;
+        int ok, ok_ct, bad;
+
+        /* the args past nargs are left as they were, so compare them all */
+        for (j = 0; j < LA_MAX_ARGS; j++) {
+            x.args[j] = y.args[j] = -1 - j;
+        }
+        ok = la_decode_insn(words[i], &x);
+        ok_ct = la_decode_insn_ct(words[i], &y);
+        bad = ok != ok_ct || x.id != y.id || x.fmt != y.fmt || x.nargs != y.nargs;
+        for (j = 0; !bad && j < LA_MAX_ARGS; j++) {
+            bad = x.args[j] != y.args[j];
+        }
+        invalid += !ok;
+        if (bad) {
+            printf("%08x: got %s, want %s\n", (unsigned)words[i],
+                   la_insn_mnemonics[y.id], la_insn_mnemonics[x.id]);
+            failed++;
+        }
+    }
+
+    printf("%u tests, %d failed, %d invalid\n", i, failed, invalid);
+    return failed != 0;
+}
+`)
+
+	out := compileAndRun(t, descs, sb.String())
+	assert.Contains(t, out, fmt.Sprintf("%d tests, 0 failed", len(words)))
+	// some of the random words must fail to decode
+	assert.NotContains(t, out, "failed, 0 invalid")
+
+	// not emitted by default
+	*constantTime = false
+	assert.NotContains(t, string(generate(descs, "0000000000000000000000000000000000000000")), "la_decode_insn_ct")
+}
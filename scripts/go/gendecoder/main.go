@@ -0,0 +1,210 @@
+// Command gendecoder emits a standalone Go decoder of the insns, for tools
+// like tracers that need to go from an insn word back to the mnemonic and
+// operands without depending on this repo:
+//
+//	$ gendecoder -pkg decoder -o decoder.go ../../*.txt
+//
+// The generated Decode(word uint32) (mnemonic string, args []DecodedArg, ok
+// bool) matches the word against a table of (mask, match) pairs, the mask
+// covering every bit outside the arg and reserved slots, most specific first,
+// like common.Decoder. See the generated doc comment for an example.
+//
+// For a faster decoder sharing more code with this repo, see ladec.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/bits"
+	"sort"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+var (
+	pkgName     = flag.String("pkg", "decoder", "package name of the generated file")
+	outputPath  = flag.String("o", "", "write the output to this file instead of stdout; the file is replaced atomically")
+	incremental = flag.Bool("incremental", false, "with -o, skip regenerating if the output is stamped with the same inputs; stamps the output")
+)
+
+func main() {
+	flag.Parse()
+
+	var descs []*common.InsnDescription
+	for _, path := range flag.Args() {
+		fileDescs, err := common.ReadInsnDescriptionFile(path)
+		if err != nil {
+			panic(err)
+		}
+		descs = append(descs, fileDescs...)
+	}
+
+	fingerprint := common.InputsFingerprint("gendecoder", descs, flag.CommandLine, "o", "incremental")
+	plan, err := common.PlanOutput(*outputPath, *incremental, fingerprint, func(stamp string) []byte {
+		return generate(descs, stamp)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = common.WriteOutputs([]common.OutputPlan{plan})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// sortBySpecificity sorts the insns from the most specific encoding to the
+// least, i.e. by the number of fixed bits in descending order, so that the
+// insns special-casing sub-encodings of others are tried first. Ties are
+// broken by the insn word for a stable output.
+func sortBySpecificity(descs []*common.InsnDescription) []*common.InsnDescription {
+	result := make([]*common.InsnDescription, len(descs))
+	copy(result, descs)
+	sort.SliceStable(result, func(i int, j int) bool {
+		ni := bits.OnesCount32(result[i].FixedMask())
+		nj := bits.OnesCount32(result[j].FixedMask())
+		if ni != nj {
+			return ni > nj
+		}
+		return result[i].Word < result[j].Word
+	})
+	return result
+}
+
+func generate(descs []*common.InsnDescription, stamp string) []byte {
+	var ectx common.EmitterCtx
+
+	ectx.Emit("// Code generated by gendecoder from loongson-community/loongarch-opcodes; DO NOT EDIT.\n\n")
+	if stamp != "" {
+		ectx.EmitStamp(stamp)
+	}
+	ectx.Emit("package %s\n\n", *pkgName)
+	ectx.Emit("import \"strconv\"\n\n")
+
+	emitPrelude(&ectx)
+	emitTable(&ectx, sortBySpecificity(descs))
+	for _, f := range common.GatherFormats(descs) {
+		emitArgsDecoderFn(&ectx, f)
+	}
+
+	return ectx.Finalize()
+}
+
+func emitPrelude(ectx *common.EmitterCtx) {
+	ectx.Emit(`// DecodedArg is an operand of a decoded insn.
+type DecodedArg struct {
+	// Name is the canonical name of the arg, e.g. "d" or "sk12".
+	Name string
+	// RegPrefix is the prefix of the register names, e.g. "r" or "fcc", or
+	// empty if the arg is an immediate.
+	RegPrefix string
+	// Value is the register number, or the immediate as encoded, sign
+	// extended if signed.
+	Value int64
+}
+
+// String returns the register name like "$r4", or the immediate in decimal.
+func (a DecodedArg) String() string {
+	if a.RegPrefix != "" {
+		return "$" + a.RegPrefix + strconv.FormatInt(a.Value, 10)
+	}
+	return strconv.FormatInt(a.Value, 10)
+}
+
+type decoderEntry struct {
+	mask       uint32
+	match      uint32
+	mnemonic   string
+	decodeArgs func(word uint32) []DecodedArg
+}
+
+// Decode decodes the insn word into the mnemonic and the args, in the
+// canonical order of the args. ok is false if the word matches no insn.
+//
+// For example, 0x02ffc0a4 decodes to "addi.d" with the args
+// {Name: "d", RegPrefix: "r", Value: 4}, {Name: "j", RegPrefix: "r", Value: 5}
+// and {Name: "sk12", Value: -16}, i.e. addi.d $r4, $r5, -16.
+func Decode(word uint32) (mnemonic string, args []DecodedArg, ok bool) {
+	for i := range decoderTable {
+		e := &decoderTable[i]
+		if word&e.mask == e.match {
+			return e.mnemonic, e.decodeArgs(word), true
+		}
+	}
+	return "", nil, false
+}
+
+func signExtend(v uint64, width uint) int64 {
+	return int64(v<<(64-width)) >> (64 - width)
+}
+`)
+}
+
+func argsDecoderFnName(f *common.InsnFormat) string {
+	return "decodeArgs" + f.CanonicalRepr()
+}
+
+// emitTable emits the table of the insns, in the order of descs.
+func emitTable(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit("\n// decoderTable is ordered from the most specific encodings to the least,\n")
+	ectx.Emit("// so sub-encodings are tried before the encodings they special-case.\n")
+	ectx.Emit("var decoderTable = [...]decoderEntry{\n")
+	for _, d := range descs {
+		ectx.Emit(
+			"{mask: 0x%08x, match: 0x%08x, mnemonic: %q, decodeArgs: %s},\n",
+			d.FixedMask(),
+			d.Word,
+			d.Mnemonic,
+			argsDecoderFnName(d.Format),
+		)
+	}
+	ectx.Emit("}\n")
+}
+
+// regPrefix returns the prefix of the register names of the arg kind, e.g.
+// "r", or "" for immediates.
+func regPrefix(k common.ArgKind) string {
+	if k.IsImm() {
+		return ""
+	}
+	return strings.TrimPrefix(strings.TrimSuffix(common.RegName(k, 0), "0"), "$")
+}
+
+// argValueExpr returns the expression of the arg value in the insn word,
+// the slots put back at their value offsets like common.Arg.Extract.
+func argValueExpr(a *common.Arg) string {
+	var terms []string
+	valueOffsets := a.SlotValueOffsets()
+	for i, s := range a.Slots {
+		term := fmt.Sprintf("uint64(word>>%d&0x%x)", s.Offset, uint64(1)<<s.Width-1)
+		if valueOffsets[i] > 0 {
+			term = fmt.Sprintf("%s<<%d", term, valueOffsets[i])
+		}
+		terms = append(terms, term)
+	}
+	expr := strings.Join(terms, " | ")
+
+	if a.Kind == common.ArgKindSignedImm {
+		return fmt.Sprintf("signExtend(%s, %d)", expr, a.TotalWidth())
+	}
+	return fmt.Sprintf("int64(%s)", expr)
+}
+
+func emitArgsDecoderFn(ectx *common.EmitterCtx, f *common.InsnFormat) {
+	ectx.Emit("\nfunc %s(word uint32) []DecodedArg {\n", argsDecoderFnName(f))
+	if len(f.Args) == 0 {
+		ectx.Emit("return nil\n}\n")
+		return
+	}
+
+	ectx.Emit("return []DecodedArg{\n")
+	for _, a := range f.Args {
+		ectx.Emit("{Name: %q", a.Name())
+		if prefix := regPrefix(a.Kind); prefix != "" {
+			ectx.Emit(", RegPrefix: %q", prefix)
+		}
+		ectx.Emit(", Value: %s},\n", argValueExpr(a))
+	}
+	ectx.Emit("}\n}\n")
+}
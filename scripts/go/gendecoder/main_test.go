@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestSortBySpecificity(t *testing.T) {
+	var descs []*common.InsnDescription
+	for _, l := range []string{
+		"02c00000 addi.d                 DJSk12",
+		"03400000 andi                   DJUk12",
+		"03400000 nop                    EMPTY",
+	} {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		descs = append(descs, d)
+	}
+
+	var mnemonics []string
+	for _, d := range sortBySpecificity(descs) {
+		mnemonics = append(mnemonics, d.Mnemonic)
+	}
+	assert.Equal(t, []string{"nop", "addi.d", "andi"}, mnemonics)
+}
+
+const exampleTestSrc = `package decoder
+
+import "fmt"
+
+func ExampleDecode() {
+	mnemonic, args, ok := Decode(0x02ffc0a4)
+	fmt.Println(mnemonic, args, ok)
+	// Output: addi.d [$r4 $r5 -16] true
+}
+`
+
+// TestGenerate builds the decoder generated from the corpus, and checks it
+// against common.Decoder over the corpus.
+func TestGenerate(t *testing.T) {
+	goCmd, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("no go command found")
+	}
+
+	descs := common.Builtin()
+	dec := common.NewDecoder(descs)
+
+	var cases strings.Builder
+	cases.WriteString("package decoder\n\nvar cases = []struct {\n\tword     uint32\n\texpected string\n}{\n")
+	for _, d := range descs {
+		for _, pick := range []func(a *common.Arg) int64{(*common.Arg).MinValue, (*common.Arg).MaxValue} {
+			args := make([]int64, len(d.Format.Args))
+			for i, a := range d.Format.Args {
+				args[i] = pick(a)
+			}
+			word := d.Encode(args)
+
+			x, ok := dec.Decode(word)
+			if !assert.True(t, ok, d.Mnemonic) {
+				continue
+			}
+			fmt.Fprintf(&cases, "\t{0x%08x, %q},\n", word, fmt.Sprint(x.Desc.Mnemonic, " ", x.Args))
+		}
+	}
+	cases.WriteString("}\n")
+
+	dir := t.TempDir()
+	*pkgName = "decoder"
+	files := map[string]string{
+		"go.mod":          "module decoder\n\ngo 1.19\n",
+		"decoder.go":      string(generate(descs, "")),
+		"cases_test.go":   cases.String(),
+		"example_test.go": exampleTestSrc,
+		"decoder_test.go": `package decoder
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	for _, c := range cases {
+		mnemonic, args, ok := Decode(c.word)
+		values := make([]int64, len(args))
+		for i, a := range args {
+			values[i] = a.Value
+		}
+		if actual := fmt.Sprint(mnemonic, " ", values); !ok || actual != c.expected {
+			t.Errorf("0x%08x: got %q, want %q", c.word, actual, c.expected)
+		}
+	}
+
+	if _, _, ok := Decode(0xffffffff); ok {
+		t.Errorf("0xffffffff decoded")
+	}
+}
+`,
+	}
+	for name, content := range files {
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0644))
+	}
+
+	cmd := exec.Command(goCmd, "test", ".")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(out))
+}
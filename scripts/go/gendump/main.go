@@ -0,0 +1,53 @@
+// Command gendump emits a flat dump of the insns for reviewing changes to the
+// descriptions with git diff, free of the formatting noise of the description
+// files. Every insn is on its own line:
+//
+//	mnemonic<TAB>word<TAB>format<TAB>attribs
+//
+// sorted by mnemonic, then by word. The word is in hex, the format is the
+// canonical repr, and the attributes are sorted by key.
+package main
+
+import (
+	"os"
+	"sort"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func main() {
+	inputs := os.Args[1:]
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	os.Stdout.Write(generate(descs))
+}
+
+func generate(descs []*common.InsnDescription) []byte {
+	sorted := append([]*common.InsnDescription{}, descs...)
+	sort.Slice(sorted, func(i int, j int) bool {
+		if sorted[i].Mnemonic != sorted[j].Mnemonic {
+			return sorted[i].Mnemonic < sorted[j].Mnemonic
+		}
+		return sorted[i].Word < sorted[j].Word
+	})
+
+	ectx := common.EmitterCtx{
+		DontGofmt: true,
+	}
+
+	for _, d := range sorted {
+		ectx.Emit(
+			"%s\t%08x\t%s\t%s\n",
+			d.Mnemonic,
+			d.Word,
+			d.Format.CanonicalRepr(),
+			d.CanonicalAttribsRepr(),
+		)
+	}
+
+	return ectx.Finalize()
+}
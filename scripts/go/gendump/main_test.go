@@ -0,0 +1,47 @@
+package main
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestGenerate(t *testing.T) {
+	var descs []*common.InsnDescription
+	for _, l := range []string{
+		"02c00000 addi.d                 DJSk12          @qemu @la64",
+		"00100000 add.w                  DJK             @la32 @primary",
+		"60000000 bgt                    DJSk16          @orig_name=blt @orig_fmt=JDSk16ps2",
+		"00010000 asrtle                 JK              @reserved=d5",
+		"06483800 eret                   EMPTY",
+	} {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		descs = append(descs, d)
+	}
+
+	expected := "add.w\t00100000\tDJK\t@la32 @primary\n" +
+		"addi.d\t02c00000\tDJSk12\t@la64 @qemu\n" +
+		"asrtle\t00010000\tJK\t@reserved=d5\n" +
+		"bgt\t60000000\tDJSk16\t@orig_fmt=JDSk16ps2 @orig_name=blt\n" +
+		"eret\t06483800\tEMPTY\t\n"
+	assert.Equal(t, expected, string(generate(descs)))
+}
+
+func TestGenerateDeterministic(t *testing.T) {
+	descs := common.Builtin()
+
+	expected := generate(descs)
+
+	// the order of the descriptions must not matter
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 4; i++ {
+		rng.Shuffle(len(descs), func(i int, j int) {
+			descs[i], descs[j] = descs[j], descs[i]
+		})
+		assert.Equal(t, expected, generate(descs))
+	}
+}
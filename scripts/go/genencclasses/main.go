@@ -0,0 +1,130 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+var (
+	pkgName    = flag.String("pkg", "loong", "package name of the generated file")
+	importPath = flag.String("import", "cmd/internal/obj", "import path of the obj package; if empty, the import is omitted and the generated package must define AMask itself")
+	buildTag   = flag.String("build-tag", "", "build constraint expression to gate the generated file behind, e.g. loong64")
+)
+
+func main() {
+	flag.Parse()
+	inputs := flag.Args()
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+
+	var ectx common.EmitterCtx
+
+	ectx.Emit("// Code generated by genencclasses from loongson-community/loongarch-opcodes; DO NOT EDIT.\n\n")
+	if *buildTag != "" {
+		err := ectx.EmitBuildConstraint(*buildTag)
+		if err != nil {
+			panic(err)
+		}
+	}
+	ectx.Emit("package %s\n\n", *pkgName)
+	if *importPath != "" {
+		ectx.Emit("import \"%s\"\n\n", *importPath)
+	}
+
+	emitOperandKindTypes(&ectx)
+	emitEncodingClasses(&ectx, descs)
+
+	result := ectx.Finalize()
+	os.Stdout.Write(result)
+}
+
+var operandKinds = []common.ArgKind{
+	common.ArgKindIntReg,
+	common.ArgKindFPReg,
+	common.ArgKindFCCReg,
+	common.ArgKindScratchReg,
+	common.ArgKindVReg,
+	common.ArgKindXReg,
+	common.ArgKindSignedImm,
+	common.ArgKindUnsignedImm,
+}
+
+func operandKindName(k common.ArgKind) string {
+	switch k {
+	case common.ArgKindIntReg:
+		return "operandKindIntReg"
+	case common.ArgKindFPReg:
+		return "operandKindFPReg"
+	case common.ArgKindFCCReg:
+		return "operandKindFCCReg"
+	case common.ArgKindScratchReg:
+		return "operandKindScratchReg"
+	case common.ArgKindVReg:
+		return "operandKindVReg"
+	case common.ArgKindXReg:
+		return "operandKindXReg"
+	case common.ArgKindSignedImm:
+		return "operandKindSignedImm"
+	case common.ArgKindUnsignedImm:
+		return "operandKindUnsignedImm"
+	default:
+		panic("unreachable")
+	}
+}
+
+func emitOperandKindTypes(ectx *common.EmitterCtx) {
+	ectx.Emit("type operandKind uint8\n\nconst (\n")
+	ectx.Emit("\toperandKindUnknown operandKind = iota\n")
+	for _, k := range operandKinds {
+		ectx.Emit("\t%s\n", operandKindName(k))
+	}
+	ectx.Emit(")\n\n")
+
+	ectx.Emit(`// encodingClass describes the operand handling of an instruction.
+//
+// Instructions with equal encoding classes are interchangeable as far as
+// operands are concerned. For commutative instructions, the two source
+// operands following the destination operand can be swapped without changing
+// the semantics.
+type encodingClass struct {
+	commutative  bool
+	operandKinds []operandKind
+}
+
+`)
+}
+
+func emitEncodingClasses(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	amask := common.ObjQualified(*importPath, "AMask")
+
+	ectx.Emit("var encodingClasses = [ALAST & %s]encodingClass{\n", amask)
+
+	for _, d := range descs {
+		argKinds := d.Format.ArgKinds()
+		kinds := make([]string, len(argKinds))
+		for i, k := range argKinds {
+			kinds[i] = operandKindName(k)
+		}
+
+		ectx.Emit(
+			"\t%s & %s: {commutative: %v, operandKinds: []operandKind{%s}},\n",
+			common.GoAnameForInsn(d.Mnemonic),
+			amask,
+			d.IsCommutative(),
+			strings.Join(kinds, ", "),
+		)
+	}
+
+	ectx.Emit("}\n")
+}
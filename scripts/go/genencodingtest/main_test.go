@@ -0,0 +1,30 @@
+package main
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestSupportedArgKinds(t *testing.T) {
+	var descs []*common.InsnDescription
+	for _, l := range []string{
+		"00100000 add.w                  DJK",
+		"700a0000 vadd.b                 VdVjVk",
+	} {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		descs = append(descs, d)
+	}
+
+	kept, errs := common.FilterArgKinds(descs, supportedArgKinds())
+	assert.Equal(t, descs[:1], kept)
+	if assert.Len(t, errs, 1) {
+		assert.EqualError(t, errs[0], "vadd.b: arg vd (LSX register) is not supported")
+	}
+
+	tc := generateTestCase(kept[0])
+	assert.Len(t, tc.args, 3)
+}
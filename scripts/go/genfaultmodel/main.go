@@ -0,0 +1,144 @@
+package main
+
+import (
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func main() {
+	inputs := os.Args[1:]
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	os.Stdout.Write(generate(descs, common.MustGetGitCommitHash()))
+}
+
+func generate(descs []*common.InsnDescription, commitHash string) []byte {
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+
+	ectx := common.EmitterCtx{
+		DontGofmt: true,
+	}
+
+	ectx.Emit("/* SPDX-License-Identifier: MIT */\n")
+	ectx.Emit("/*\n")
+	ectx.Emit(" * LoongArch per-bit fault model: what flipping each bit of an\n")
+	ectx.Emit(" * instruction word changes.\n")
+	ectx.Emit(" *\n")
+	ectx.Emit(" * This file is auto-generated by genfaultmodel from\n")
+	ectx.Emit(" * https://github.com/loongson-community/loongarch-opcodes,\n")
+	ectx.Emit(" * from commit %s.\n", commitHash)
+	ectx.Emit(" * DO NOT EDIT.\n")
+	ectx.Emit(" */\n\n")
+	ectx.Emit("#include <stddef.h>\n")
+	ectx.Emit("#include <stdint.h>\n")
+
+	emitFaultInfoStruct(&ectx)
+	emitFaultTable(&ectx, descs)
+	emitLookupFn(&ectx)
+
+	return ectx.Finalize()
+}
+
+func emitFaultInfoStruct(ectx *common.EmitterCtx) {
+	ectx.Emit(`
+/*
+ * The operands are numbered in the order of the instruction format, e.g.
+ * rd, rj, sk12 for DJSk12.
+ */
+struct la_fault_info {
+    const char *mnemonic;
+    /* the instruction is insn if (insn & opcode_bits) == match */
+    uint32_t match;
+    /* flipping any of these makes a different or undefined instruction */
+    uint32_t opcode_bits;
+    /* flipping any of these sets reserved bits, making insn illegal */
+    uint32_t reserved_bits;
+    /* bitmasks of the register operands read and written */
+    uint8_t reads;
+    uint8_t writes;
+    /*
+     * the operand each bit, from the LSB, belongs to, or -1 for the opcode
+     * and reserved bits; flipping it changes the operand only
+     */
+    int8_t operand[32];
+};
+`)
+}
+
+// operandIndexByBit returns the index of the arg each bit of the insn word,
+// from the LSB, belongs to, or -1 if the bit is not part of any arg.
+func operandIndexByBit(d *common.InsnDescription) [32]int {
+	var result [32]int
+	for i := range result {
+		result[i] = -1
+	}
+	for j, a := range d.Format.Args {
+		for _, s := range a.Slots {
+			for i := s.Offset; i <= s.MSB(); i++ {
+				result[i] = j
+			}
+		}
+	}
+	return result
+}
+
+// argsBitmask returns the bitmask of the arg indices.
+func argsBitmask(indices []int) uint8 {
+	var result uint8
+	for _, i := range indices {
+		result |= 1 << i
+	}
+	return result
+}
+
+func emitFaultTable(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit("\n#define LA_FAULT_COUNT %d\n\n", len(descs))
+	ectx.Emit("static const struct la_fault_info la_fault_table[LA_FAULT_COUNT] = {\n")
+	for _, d := range descs {
+		indices := operandIndexByBit(d)
+		operands := make([]string, len(indices))
+		for i, x := range indices {
+			operands[i] = strconv.Itoa(x)
+		}
+
+		ectx.Emit(
+			"    { \"%s\", 0x%08x, 0x%08x, 0x%08x, 0x%02x, 0x%02x,\n      { %s } },\n",
+			d.Mnemonic,
+			d.Word,
+			d.FixedMask(),
+			d.ReservedMask(),
+			argsBitmask(d.InputArgs()),
+			argsBitmask(d.OutputArgs()),
+			strings.Join(operands, ", "),
+		)
+	}
+	ectx.Emit("};\n")
+}
+
+func emitLookupFn(ectx *common.EmitterCtx) {
+	ectx.Emit(`
+/* Returns the entry of the instruction encoded by insn, or NULL if none.  */
+static const struct la_fault_info *__attribute__((unused))
+la_fault_lookup(uint32_t insn)
+{
+    size_t i;
+
+    for (i = 0; i < LA_FAULT_COUNT; i++) {
+        if ((insn & la_fault_table[i].opcode_bits) == la_fault_table[i].match) {
+            return &la_fault_table[i];
+        }
+    }
+    return NULL;
+}
+`)
+}
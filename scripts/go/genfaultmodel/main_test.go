@@ -0,0 +1,110 @@
+package main
+
+import (
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func parseForTest(t *testing.T, lines ...string) []*common.InsnDescription {
+	result := make([]*common.InsnDescription, len(lines))
+	for i, l := range lines {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		result[i] = d
+	}
+	return result
+}
+
+func TestOperandIndexByBit(t *testing.T) {
+	descs := parseForTest(
+		t,
+		"28800000 ld.w                   DJSk12",
+		"00010000 asrtle                 JK              @reserved=d5",
+	)
+
+	// ld.w: rd in 0..4, rj in 5..9, si12 in 10..21, opcode above
+	ldw := operandIndexByBit(descs[0])
+	for i, x := range ldw {
+		switch {
+		case i < 5:
+			assert.Equal(t, 0, x, i)
+		case i < 10:
+			assert.Equal(t, 1, x, i)
+		case i < 22:
+			assert.Equal(t, 2, x, i)
+		default:
+			assert.Equal(t, -1, x, i)
+		}
+	}
+	assert.Equal(t, uint32(0xffc00000), descs[0].FixedMask())
+
+	// the reserved bits belong to no operand
+	asrtle := operandIndexByBit(descs[1])
+	for i := 0; i < 5; i++ {
+		assert.Equal(t, -1, asrtle[i], i)
+	}
+	assert.Equal(t, 0, asrtle[5])
+	assert.Equal(t, uint32(0x0000001f), descs[1].ReservedMask())
+
+	// every bit is either an opcode, reserved or operand bit
+	for _, d := range common.Builtin() {
+		indices := operandIndexByBit(d)
+		var operandBits uint32
+		for i, x := range indices {
+			if x >= 0 {
+				operandBits |= 1 << i
+				assert.NotZero(t, d.Format.Args[x].Bitmask()&(1<<i), d.Mnemonic)
+			}
+		}
+		assert.Equal(t, ^uint32(0), operandBits|d.FixedMask()|d.ReservedMask(), d.Mnemonic)
+		assert.Zero(t, operandBits&(d.FixedMask()|d.ReservedMask()), d.Mnemonic)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	descs := parseForTest(
+		t,
+		"28800000 ld.w                   DJSk12",
+		"00010000 asrtle                 JK              @reserved=d5",
+	)
+	result := string(generate(descs, "0000000000000000000000000000000000000000"))
+
+	assert.Contains(t, result, "#define LA_FAULT_COUNT 2\n")
+	assert.Contains(t, result, "    { \"ld.w\", 0x28800000, 0xffc00000, 0x00000000, 0x02, 0x01,\n"+
+		"      { 0, 0, 0, 0, 0, 1, 1, 1, 1, 1, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, -1, -1, -1, -1, -1, -1, -1, -1, -1, -1 } },\n")
+
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler found")
+	}
+
+	dir := t.TempDir()
+	src := result + `
+#include <stdio.h>
+
+int main(void)
+{
+    const struct la_fault_info *x = la_fault_lookup(0x28bff0a4); /* ld.w $r4, $r5, -4 */
+    printf("%s %d %d %d\n", x->mnemonic, x->operand[0], x->operand[21], x->operand[22]);
+    x = la_fault_lookup(0x00010001); /* asrtle with reserved bits set */
+    printf("%s 0x%08x\n", x->mnemonic, (unsigned)x->reserved_bits);
+    return la_fault_lookup(0xffffffff) != NULL;
+}
+`
+	srcPath := filepath.Join(dir, "fault.c")
+	assert.NoError(t, ioutil.WriteFile(srcPath, []byte(src), 0644))
+	exe := filepath.Join(dir, "fault")
+	out, err := exec.Command(cc, "-Wall", "-Werror", "-o", exe, srcPath).CombinedOutput()
+	if !assert.NoError(t, err, string(out)) {
+		return
+	}
+	out, err = exec.Command(exe).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	assert.Equal(t, "ld.w 0 2 -1\nasrtle 0x0000001f\n", string(out))
+}
@@ -1,7 +1,9 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"math/bits"
 	"os"
 	"sort"
 	"strconv"
@@ -10,142 +12,204 @@ import (
 	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
 )
 
+var (
+	pkgName        = flag.String("pkg", "loong", "package name of the generated file")
+	importPath     = flag.String("import", "cmd/internal/obj", "import path of the obj package; if empty, the import is omitted and the generated package must define AMask (and As, with -opcode-names) itself")
+	buildTag       = flag.String("build-tag", "", "build constraint expression to gate the generated file behind, e.g. loong64")
+	inlineBounds   = flag.Bool("inline-bounds", false, "check immediates against inline constant bounds returning errBadImm, instead of calling want[Un]signedImm")
+	corpusHash     = flag.Bool("corpus-hash", false, "emit the hash of the insn descriptions as a comment, for skipping regeneration when unchanged")
+	outputPath     = flag.String("o", "", "write the output to this file instead of stdout; the file is replaced atomically")
+	incremental    = flag.Bool("incremental", false, "with -o, skip regenerating if the output is stamped with the same inputs; stamps the output")
+	opcodeNames    = flag.Bool("opcode-names", false, "emit a table of mnemonics indexed by opcode, and register it with the obj package so opcodes render as mnemonics; replaces the registration of Anames")
+	archSpecific   = flag.Int("arch-specific", defaultArchSpecific, "value of obj.A_ARCHSPECIFIC in the target Go tree, i.e. the number of generic opcodes preceding the arch-specific ones; 14 before Go 1.23")
+	maxInsns       = flag.Int("max-insns", 0, "maximum number of insns the opcode range under obj.AMask can hold, after the generic opcodes; 0 to derive it from -arch-specific")
+	strict         = flag.Bool("strict", false, "warn about mnemonics not written in lower case in the insn descriptions, and require the insn words to be written as 8 hex digits")
+	lint           = flag.Bool("lint", false, "warn about likely mistakes in the insn descriptions, like narrow signed immediates; advisory only")
+	widthPairsPath = flag.String("width-pairs", "", "with -lint, check the mnemonics listed in this file, one per line with \"?\" for the w or d, for missing word or doubleword counterparts, instead of the built-in list")
+	nameMap        = flag.Bool("name-map", false, "emit asForName, a map of mnemonics to opcodes, for string-driven assembler front-ends")
+	compact        = flag.Bool("compact-encodings", false, "emit the encodings table packed into 4 bytes per insn, as a delta from a base word per format, and fill the table from it at init time")
+	dryRun         = common.DryRunFlag(flag.CommandLine)
+	shiftedImms    = flag.Bool("shifted-imms", false, "take the immediates the manual shifts implicitly, like the branch offsets, as written in assembly instead of as encoded, checking their alignment with errUnalignedImm and shifting them when encoding")
+	insnSelector   = common.AttribSelectorFlag(flag.CommandLine, "")
+	noComments     = flag.Bool("no-comments", false, "omit the comments naming the ISA extension of the insns outside the base ISA from the encodings table, for byte-stable diffs")
+)
+
+const (
+	// objAMask is obj.AMask, the mask of the per-arch part of opcodes.
+	objAMask = 1<<11 - 1
+	// defaultArchSpecific is obj.A_ARCHSPECIFIC as of Go 1.23, the number of
+	// generic opcodes preceding the arch-specific ones.
+	defaultArchSpecific = 15
+)
+
+// maxInsnsFor returns the number of insns fitting under obj.AMask after
+// archSpecific generic opcodes. The A... constants run from
+// obj.A_ARCHSPECIFIC to ALAST, all of which must stay within obj.AMask for
+// the encodings table not to wrap around.
+func maxInsnsFor(archSpecific int) int {
+	return objAMask - archSpecific
+}
+
 func main() {
-	inputs := os.Args[1:]
+	flag.Parse()
+	inputs := flag.Args()
 
-	descs, err := common.ReadInsnDescs(inputs)
+	readInsnDescs := common.ReadInsnDescs
+	if *strict {
+		readInsnDescs = common.ReadInsnDescsStrict
+	}
+	descs, err := readInsnDescs(inputs)
 	if err != nil {
 		panic(err)
 	}
+	descs = insnSelector.Filter(descs)
 
-	formats := gatherFormats(descs)
-	scs := gatherDistinctSlotCombinations(formats)
-
-	sort.Slice(descs, func(i int, j int) bool {
-		return descs[i].Word < descs[j].Word
-	})
-
-	sort.Slice(formats, func(i int, j int) bool {
-		return formats[i].CanonicalRepr() < formats[j].CanonicalRepr()
-	})
-
-	var ectx common.EmitterCtx
+	if *strict {
+		for _, w := range common.MnemonicCaseWarnings(descs) {
+			fmt.Fprintf(os.Stderr, "warning: %s\n", w)
+		}
+	}
+	if *lint {
+		pairs := common.DefaultWidthPairs()
+		if *widthPairsPath != "" {
+			pairs, err = readWidthPairs(*widthPairsPath)
+			if err != nil {
+				panic(err)
+			}
+		}
 
-	ectx.Emit("// Code generated by geninsndata from loongson-community/loongarch-opcodes; DO NOT EDIT.\n\n")
-	ectx.Emit("package loong\n\n")
-	ectx.Emit("import \"cmd/internal/obj\"\n\n")
+		warnings := append(common.Lint(descs), common.LintWidthPairs(descs, pairs)...)
+		for _, w := range warnings {
+			fmt.Fprintf(os.Stderr, "lint: %v\n", w)
+		}
+	}
 
-	emitInsnFormatTypes(&ectx, formats)
+	max := *maxInsns
+	if max == 0 {
+		max = maxInsnsFor(*archSpecific)
+	}
+	err = checkInsnCount(len(descs), max)
+	if err != nil {
+		panic(err)
+	}
 
-	for _, f := range formats {
-		emitValidatorForFormat(&ectx, f)
+	err = common.CheckArgKinds(descs, supportedArgKinds())
+	if err != nil {
+		panic(err)
 	}
 
-	emitValidatorMapping(&ectx, formats)
-	emitSlotEncoders(&ectx, scs)
-	emitBigEncoderFn(&ectx, formats)
-	emitInsnEncodings(&ectx, descs)
+	formats := common.GatherFormats(descs)
+	err = common.CheckFormats(descs, formats)
+	if err != nil {
+		panic(err)
+	}
 
-	result := ectx.Finalize()
-	os.Stdout.Write(result)
-}
+	err = common.CheckAliasNames(descs)
+	if err != nil {
+		panic(err)
+	}
+	scs := common.GatherSlotCombinations(formats)
 
-////////////////////////////////////////////////////////////////////////////
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
 
-func gatherFormats(descs []*common.InsnDescription) []*common.InsnFormat {
-	formatsSet := make(map[string]*common.InsnFormat)
-	for _, d := range descs {
-		canonicalFormatName := d.Format.CanonicalRepr()
-		if _, ok := formatsSet[canonicalFormatName]; !ok {
-			formatsSet[canonicalFormatName] = d.Format
-		}
+	fingerprint := common.InputsFingerprint("geninsndata", descs, flag.CommandLine, "o", "incremental")
+	plan, err := common.PlanOutput(*outputPath, *incremental, fingerprint, func(stamp string) []byte {
+		return generate(descs, formats, scs, stamp)
+	})
+	if err != nil {
+		panic(err)
 	}
 
-	result := make([]*common.InsnFormat, 0, len(formatsSet))
-	for _, f := range formatsSet {
-		result = append(result, f)
+	if *dryRun {
+		common.PrintOutputPlans(os.Stdout, []common.OutputPlan{plan}, len(descs), len(formats))
+		return
 	}
 
-	return result
+	err = common.WriteOutputs([]common.OutputPlan{plan})
+	if err != nil {
+		panic(err)
+	}
 }
 
-const (
-	slotD = 0
-	slotJ = 5
-	slotK = 10
-	slotA = 15
-	slotM = 16
-)
-
-func gatherDistinctSlotCombinations(fmts []*common.InsnFormat) []string {
-	slotCombinationsSet := make(map[string]struct{})
-	for _, f := range fmts {
-		// skip EMPTY
-		if len(f.Args) == 0 {
-			continue
-		}
-		slotCombinationsSet[slotCombinationForFmt(f)] = struct{}{}
+// supportedArgKinds returns the kinds of args the generated validators and
+// encoders handle.
+func readWidthPairs(path string) ([]common.WidthPair, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
 	}
+	defer f.Close()
 
-	result := make([]string, 0, len(slotCombinationsSet))
-	for sc := range slotCombinationsSet {
-		result = append(result, sc)
+	result, err := common.ParseWidthPairs(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
 	}
-	sort.Strings(result)
+	return result, nil
+}
 
-	return result
+func supportedArgKinds() []common.ArgKind {
+	return []common.ArgKind{
+		common.ArgKindIntReg,
+		common.ArgKindFPReg,
+		common.ArgKindFCCReg,
+		common.ArgKindVReg,
+		common.ArgKindXReg,
+		common.ArgKindSignedImm,
+		common.ArgKindUnsignedImm,
+	}
 }
 
-// slot combination looks like "DJKM"
-func slotCombinationForFmt(f *common.InsnFormat) string {
+func generate(descs []*common.InsnDescription, formats []*common.InsnFormat, scs []common.SlotCombination, stamp string) []byte {
+	var ectx common.EmitterCtx
 
-	var slots []int
-	for _, a := range f.Args {
-		for _, s := range a.Slots {
-			slots = append(slots, int(s.Offset))
-		}
+	ectx.Emit("// Code generated by geninsndata from loongson-community/loongarch-opcodes; DO NOT EDIT.\n\n")
+	if stamp != "" {
+		ectx.EmitStamp(stamp)
+	}
+	if *corpusHash {
+		ectx.Emit("// Corpus hash: %s\n\n", common.CorpusHash(descs))
 	}
-	sort.Ints(slots)
-
-	var sb strings.Builder
-	for _, s := range slots {
-		switch s {
-		case slotD:
-			sb.WriteRune('D')
-		case slotJ:
-			sb.WriteRune('J')
-		case slotK:
-			sb.WriteRune('K')
-		case slotA:
-			sb.WriteRune('A')
-		case slotM:
-			sb.WriteRune('M')
-		default:
-			panic("should never happen")
+	if *buildTag != "" {
+		err := ectx.EmitBuildConstraint(*buildTag)
+		if err != nil {
+			panic(err)
 		}
 	}
+	ectx.Emit("package %s\n\n", *pkgName)
+	if *importPath != "" {
+		ectx.Emit("import \"%s\"\n\n", *importPath)
+	}
 
-	return sb.String()
-}
+	emitInsnFormatTypes(&ectx, formats)
 
-func slotOffsetFromRune(s rune) int {
-	switch s {
-	case 'D', 'd':
-		return slotD
-	case 'J', 'j':
-		return slotJ
-	case 'K', 'k':
-		return slotK
-	case 'A', 'a':
-		return slotA
-	case 'M', 'm':
-		return slotM
-	default:
-		panic("should never happen")
+	descsByFormat := make(map[string][]*common.InsnDescription)
+	for _, d := range descs {
+		repr := d.Format.CanonicalRepr()
+		descsByFormat[repr] = append(descsByFormat[repr], d)
+	}
+	for _, f := range formats {
+		emitValidatorForFormat(&ectx, f, descsByFormat[f.CanonicalRepr()])
 	}
-}
 
-////////////////////////////////////////////////////////////////////////////
+	emitValidatorMapping(&ectx, formats)
+	emitSlotEncoders(&ectx, scs)
+	emitBigEncoderFn(&ectx, formats, descsByFormat)
+	if *compact {
+		emitCompactInsnEncodings(&ectx, descs, formats)
+	} else {
+		emitInsnEncodings(&ectx, descs)
+	}
+	if *opcodeNames {
+		emitOpcodeNames(&ectx, descs)
+	}
+	if *nameMap {
+		emitNameMap(&ectx, descs)
+	}
+
+	return ectx.Finalize()
+}
 
 ////////////////////////////////////////////////////////////////////////////
 
@@ -176,6 +240,9 @@ func emitInsnFormatArityFn(
 	ectx.Emit("\tswitch f {\n")
 	for arity := 0; arity < 5; arity++ {
 		cases := arityMap[arity]
+		if len(cases) == 0 {
+			continue
+		}
 
 		ectx.Emit("\tcase ")
 		for i, f := range cases {
@@ -191,41 +258,219 @@ func emitInsnFormatArityFn(
 	ectx.Emit("}\n\n")
 }
 
+// checkInsnCount returns an error if there are more insns than opcodes
+// available, in which case the opcodes would collide in the tables indexed by
+// the masked opcode.
+func checkInsnCount(n int, max int) error {
+	if n <= max {
+		return nil
+	}
+
+	return fmt.Errorf(
+		"%d insns exceed the %d opcodes available under obj.AMask; the tables indexed by opcode & obj.AMask would silently collide. Filter the corpus, or switch the encodings table to a sparse slice searched by opcode",
+		n,
+		max,
+	)
+}
+
 func emitInsnEncodings(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
 	ectx.Emit("type encoding struct {\n")
 	ectx.Emit("\tbits uint32\n")
 	ectx.Emit("\tfmt  insnFormat\n")
 	ectx.Emit("}\n\n")
-	ectx.Emit("var encodings = [ALAST & obj.AMask]encoding{\n")
+	ectx.Emit("var encodings = [ALAST & %s]encoding{\n", common.ObjQualified(*importPath, "AMask"))
 
 	for _, d := range descs {
 		goOpcodeName := common.GoAnameForInsn(d.Mnemonic)
 		formatName := "insnFormat" + d.Format.CanonicalRepr()
 
 		ectx.Emit(
-			"\t%s & obj.AMask: {bits: 0x%08x, fmt: %s},\n",
+			"\t%s & %s: {bits: 0x%08x, fmt: %s},",
 			goOpcodeName,
+			common.ObjQualified(*importPath, "AMask"),
 			d.Word,
 			formatName,
 		)
+		if ext := d.Extension(); ext != "" && !*noComments {
+			ectx.Emit(" // %s", ext)
+		}
+		ectx.Emit("\n")
 	}
 
 	ectx.Emit("}\n")
 }
 
-func insnFieldNameForRegArg(a *common.Arg) string {
-	switch a.Slots[0].Offset {
-	case slotD:
-		return "rd"
-	case slotJ:
-		return "rj"
-	case slotK:
-		return "rk"
-	case slotA:
-		return "ra"
-	default:
-		panic("should never happen")
+// formatBase is the base word of the insns of a format in the compact
+// encodings table, and the number of low bits of their words that are always
+// zero, which are dropped from the deltas.
+type formatBase struct {
+	base  uint32
+	shift uint
+}
+
+// compactDeltaBits is the width of the deltas in the compact encodings table,
+// the format index taking the remaining bits.
+const compactDeltaBits = 24
+
+// computeFormatBases returns the base of every format, in the order of
+// formats.
+func computeFormatBases(descs []*common.InsnDescription, formats []*common.InsnFormat) []formatBase {
+	idx := make(map[string]int, len(formats))
+	for i, f := range formats {
+		idx[f.CanonicalRepr()] = i
+	}
+
+	result := make([]formatBase, len(formats))
+	seen := make([]bool, len(formats))
+	ors := make([]uint32, len(formats))
+	for _, d := range descs {
+		i := idx[d.Format.CanonicalRepr()]
+		if !seen[i] || d.Word < result[i].base {
+			result[i].base = d.Word
+		}
+		seen[i] = true
+		ors[i] |= d.Word
+	}
+	for i := range result {
+		if ors[i] != 0 {
+			result[i].shift = uint(bits.TrailingZeros32(ors[i]))
+		}
+	}
+	return result
+}
+
+// packEncoding returns the entry of the insn in the compact encodings table,
+// given the 1-based index of its format and the base of the latter.
+func packEncoding(d *common.InsnDescription, fmtIdx int, fb formatBase) (uint32, error) {
+	delta := (d.Word - fb.base) >> fb.shift
+	if delta >= 1<<compactDeltaBits || fmtIdx >= 1<<(32-compactDeltaBits) {
+		return 0, fmt.Errorf(
+			"%s: delta 0x%x from the base of format %s, or the format index %d, too large for the compact encodings table",
+			d.Mnemonic,
+			delta,
+			d.Format.CanonicalRepr(),
+			fmtIdx,
+		)
+	}
+	return uint32(fmtIdx)<<compactDeltaBits | delta, nil
+}
+
+// unpackEncoding returns the bits of the insn from its entry in the compact
+// encodings table, as the generated init function does.
+func unpackEncoding(c uint32, bases []formatBase) uint32 {
+	fb := bases[c>>compactDeltaBits-1]
+	return fb.base + (c&(1<<compactDeltaBits-1))<<fb.shift
+}
+
+// emitCompactInsnEncodings emits the encodings table like
+// emitInsnEncodings, but filled at init time from a table of 4-byte entries
+// instead of 16-byte ones, for binaries where size matters more than a
+// little init time. The entries pack the format index in the top bits, and
+// the delta of the bits from the base of the format in the others.
+func emitCompactInsnEncodings(ectx *common.EmitterCtx, descs []*common.InsnDescription, formats []*common.InsnFormat) {
+	idx := make(map[string]int, len(formats))
+	for i, f := range formats {
+		idx[f.CanonicalRepr()] = i
+	}
+	bases := computeFormatBases(descs, formats)
+
+	ectx.Emit("type encoding struct {\n")
+	ectx.Emit("\tbits uint32\n")
+	ectx.Emit("\tfmt  insnFormat\n")
+	ectx.Emit("}\n\n")
+	ectx.Emit("var encodings [ALAST & %s]encoding\n\n", common.ObjQualified(*importPath, "AMask"))
+
+	ectx.Emit("// formatBases are the base words of the insns of every format, and the\n")
+	ectx.Emit("// numbers of bits their deltas are shifted by.\n")
+	ectx.Emit("var formatBases = [...]struct {\n\tbase  uint32\n\tshift uint8\n}{\n")
+	for i, f := range formats {
+		ectx.Emit("\tinsnFormat%s: {0x%08x, %d},\n", f.CanonicalRepr(), bases[i].base, bases[i].shift)
+	}
+	ectx.Emit("}\n\n")
+
+	ectx.Emit("// compactEncodings packs the format of every insn in the top %d bits, and\n", 32-compactDeltaBits)
+	ectx.Emit("// the delta of its bits from the base of the format in the others.\n")
+	ectx.Emit("var compactEncodings = [ALAST & %s]uint32{\n", common.ObjQualified(*importPath, "AMask"))
+	for _, d := range descs {
+		i := idx[d.Format.CanonicalRepr()]
+		c, err := packEncoding(d, i+1, bases[i])
+		if err != nil {
+			panic(err)
+		}
+		ectx.Emit("\t%s & %s: 0x%08x,", common.GoAnameForInsn(d.Mnemonic), common.ObjQualified(*importPath, "AMask"), c)
+		if ext := d.Extension(); ext != "" && !*noComments {
+			ectx.Emit(" // %s", ext)
+		}
+		ectx.Emit("\n")
+	}
+	ectx.Emit("}\n\n")
+
+	ectx.Emit("func init() {\n")
+	ectx.Emit("\tfor i, c := range compactEncodings {\n")
+	ectx.Emit("\t\t// the slots of the generic opcodes are left empty\n")
+	ectx.Emit("\t\tif c == 0 {\n\t\t\tcontinue\n\t\t}\n")
+	ectx.Emit("\t\tf := insnFormat(c >> %d)\n", compactDeltaBits)
+	ectx.Emit("\t\tencodings[i] = encoding{\n")
+	ectx.Emit("\t\t\tbits: formatBases[f].base + (c&0x%x)<<formatBases[f].shift,\n", uint32(1)<<compactDeltaBits-1)
+	ectx.Emit("\t\t\tfmt:  f,\n")
+	ectx.Emit("\t\t}\n")
+	ectx.Emit("\t}\n")
+	ectx.Emit("}\n")
+}
+
+func emitOpcodeNames(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	// the A... constants start at ABaseLoong + A_ARCHSPECIFIC, so index by
+	// the masked opcode like the encodings table, leaving the slots of the
+	// generic opcodes empty
+	ectx.Emit("\n// opcodeMnemonics maps opcodes to their mnemonics.\n")
+	ectx.Emit("var opcodeMnemonics = [ALAST & %s]string{\n", common.ObjQualified(*importPath, "AMask"))
+
+	for _, d := range descs {
+		ectx.Emit(
+			"\t%s & %s: %q,\n",
+			common.GoAnameForInsn(d.Mnemonic),
+			common.ObjQualified(*importPath, "AMask"),
+			d.Mnemonic,
+		)
 	}
+
+	ectx.Emit("}\n\n")
+
+	ectx.Emit("// opcodeMnemonic returns the mnemonic of the opcode, or the empty string if\n")
+	ectx.Emit("// the opcode doesn't correspond to an instruction.\n")
+	ectx.Emit("func opcodeMnemonic(a %s) string {\n", common.ObjQualified(*importPath, "As"))
+	ectx.Emit("\tif a < ALAST && a&^%s == ALAST&^%s {\n", common.ObjQualified(*importPath, "AMask"), common.ObjQualified(*importPath, "AMask"))
+	ectx.Emit("\t\treturn opcodeMnemonics[a&%s]\n", common.ObjQualified(*importPath, "AMask"))
+	ectx.Emit("\t}\n")
+	ectx.Emit("\treturn \"\"\n")
+	ectx.Emit("}\n")
+
+	if *importPath != "" {
+		ectx.Emit("\nfunc init() {\n")
+		ectx.Emit("\tobj.RegisterOpcode(obj.ABaseLoong, opcodeMnemonics[:])\n")
+		ectx.Emit("}\n")
+	}
+}
+
+func emitNameMap(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit("\n// asForName maps mnemonics, and their alias names, to their opcodes.\n")
+	ectx.Emit("var asForName = map[string]%s{\n", common.ObjQualified(*importPath, "As"))
+	for _, d := range descs {
+		ectx.Emit("\t%q: %s,\n", d.Mnemonic, common.GoAnameForInsn(d.Mnemonic))
+	}
+	for _, d := range descs {
+		for _, name := range d.AliasNames() {
+			ectx.Emit("\t%q: %s, // alias of %s\n", name, common.GoAnameForInsn(d.Mnemonic), d.Mnemonic)
+		}
+	}
+	ectx.Emit("}\n")
+}
+
+// insnFieldNameForRegArg returns the field of the backend's instruction
+// holding the register arg, named after its slot: rd, rj, rk and ra for the
+// common offsets, or e.g. rm or rs18 for the uncommon ones.
+func insnFieldNameForRegArg(a *common.Arg) string {
+	return "r" + strings.ToLower(common.SlotOffsetName(a.Slots[0].Offset))
 }
 
 func fieldNamesForArgs(args []*common.Arg) []string {
@@ -259,60 +504,184 @@ func emitValidatorMapping(ectx *common.EmitterCtx, fmts []*common.InsnFormat) {
 	ectx.Emit("\t}\n\n")
 }
 
-func emitValidatorForFormat(ectx *common.EmitterCtx, f *common.InsnFormat) {
+func emitValidatorForFormat(ectx *common.EmitterCtx, f *common.InsnFormat, descs []*common.InsnDescription) {
 	funcName := verifierFnNameForFormat(f)
 
 	argFieldNames := fieldNamesForArgs(f.Args)
 
 	ectx.Emit("func %s(insn *instruction) error {\n", funcName)
 
-	// things to emit:
-	//
-	// for every arg X:
-	//     if err := want<arg type>("argX", argX); err != nil {
-	//         return err
-	//     }
+	// for every arg, its check, or with -shifted-imms a switch between the
+	// checks of the insns taking it as written in assembly and the others
+	shifted := shiftedInsnsByArg(f, descs)
 	for argIdx, a := range f.Args {
 		argParamName := "insn." + argFieldNames[argIdx]
 
-		ectx.Emit("\tif err := ")
+		if len(shifted[argIdx]) == 0 {
+			emitArgCheck(ectx, a, argParamName, 0)
+			continue
+		}
 
-		switch a.Kind {
-		case common.ArgKindIntReg:
-			ectx.Emit("wantIntReg(insn.as, %s)", argParamName)
+		ectx.Emit("\tswitch insn.as {\n")
+		for _, g := range shifted[argIdx] {
+			ectx.Emit("\tcase %s:\n", strings.Join(g.anames, ", "))
+			emitArgCheck(ectx, a, argParamName, g.shift)
+		}
+		ectx.Emit("\tdefault:\n")
+		emitArgCheck(ectx, a, argParamName, 0)
+		ectx.Emit("\t}\n")
+	}
 
-		case common.ArgKindFPReg:
-			ectx.Emit("wantFPReg(insn.as, %s)", argParamName)
+	emitEvenRegChecks(ectx, descs, argFieldNames)
 
-		case common.ArgKindFCCReg:
-			ectx.Emit("wantFCCReg(insn.as, %s)", argParamName)
+	ectx.Emit("\treturn nil\n}\n\n")
+}
 
-		case common.ArgKindSignedImm,
-			common.ArgKindUnsignedImm:
-			// want[Un]signedImm(argX, width)
-			var wantFuncName string
-			if a.Kind == common.ArgKindSignedImm {
-				wantFuncName = "wantSignedImm"
-			} else {
-				wantFuncName = "wantUnsignedImm"
+// emitArgCheck emits the check of the value of an arg:
+//
+//	if err := want<arg type>(insn.as, argX); err != nil {
+//	    return err
+//	}
+//
+// or for immediates with -inline-bounds:
+//
+//	if argX < min || argX > max {
+//	    return errBadImm(insn.as, argX, min, max)
+//	}
+//
+// An imm taken as written in assembly with -shifted-imms is checked to be a
+// multiple of 1<<shift first, and its bounds are shifted accordingly:
+//
+//	if argX&0x3 != 0 {
+//	    return errUnalignedImm(insn.as, argX, 4)
+//	}
+//
+// errUnalignedImm is to be provided by the backend, like errBadImm.
+func emitArgCheck(ectx *common.EmitterCtx, a *common.Arg, argParamName string, shift uint) {
+	if shift > 0 {
+		ectx.Emit("\tif %s&0x%x != 0 {\n", argParamName, 1<<shift-1)
+		ectx.Emit("\t\treturn errUnalignedImm(insn.as, %s, %d)\n\t}\n", argParamName, 1<<shift)
+	}
+
+	if *inlineBounds && a.Kind.IsImm() {
+		min, max := a.MinValue()<<shift, a.MaxValue()<<shift
+		ectx.Emit("\tif %s < %d || %s > %d {\n", argParamName, min, argParamName, max)
+		ectx.Emit("\t\treturn errBadImm(insn.as, %s, %d, %d)\n\t}\n", argParamName, min, max)
+		return
+	}
+
+	ectx.Emit("\tif err := ")
+
+	switch a.Kind {
+	case common.ArgKindIntReg:
+		ectx.Emit("wantIntReg(insn.as, %s)", argParamName)
+
+	case common.ArgKindFPReg:
+		ectx.Emit("wantFPReg(insn.as, %s)", argParamName)
+
+	case common.ArgKindFCCReg:
+		ectx.Emit("wantFCCReg(insn.as, %s)", argParamName)
+
+	case common.ArgKindVReg:
+		ectx.Emit("wantVReg(insn.as, %s)", argParamName)
+
+	case common.ArgKindXReg:
+		ectx.Emit("wantXReg(insn.as, %s)", argParamName)
+
+	case common.ArgKindSignedImm,
+		common.ArgKindUnsignedImm:
+		// want[Un]signedImm(argX, width)
+		var wantFuncName string
+		if a.Kind == common.ArgKindSignedImm {
+			wantFuncName = "wantSignedImm"
+		} else {
+			wantFuncName = "wantUnsignedImm"
+		}
+
+		ectx.Emit("%s(insn.as, %s, %d)", wantFuncName, argParamName, a.TotalWidth()+shift)
+	}
+
+	ectx.Emit("; err != nil {\n\t\treturn err\n\t}\n")
+}
+
+// shiftedInsns is a group of insns shifting an arg by the same amount.
+type shiftedInsns struct {
+	shift  uint
+	anames []string
+}
+
+// shiftedInsnsByArg returns, for every arg of the format, the insns of the
+// format taking it as written in assembly with -shifted-imms, grouped by the
+// amount the manual shifts it by; nil without -shifted-imms.
+func shiftedInsnsByArg(f *common.InsnFormat, descs []*common.InsnDescription) [][]shiftedInsns {
+	result := make([][]shiftedInsns, len(f.Args))
+	if !*shiftedImms {
+		return result
+	}
+
+	for _, d := range descs {
+		for i, shift := range d.ArgShifts() {
+			if shift == 0 {
+				continue
 			}
 
-			ectx.Emit("%s(insn.as, %s, %d)", wantFuncName, argParamName, a.TotalWidth())
+			aname := common.GoAnameForInsn(d.Mnemonic)
+			found := false
+			for j := range result[i] {
+				if result[i][j].shift == shift {
+					result[i][j].anames = append(result[i][j].anames, aname)
+					found = true
+				}
+			}
+			if !found {
+				result[i] = append(result[i], shiftedInsns{shift: shift, anames: []string{aname}})
+			}
 		}
+	}
+	return result
+}
 
-		ectx.Emit("; err != nil {\n\t\treturn err\n\t}\n")
+// emitEvenRegChecks emits the checks of the register args of the insns of
+// the format constrained to be even-numbered by @even-reg, if any:
+//
+//	switch insn.as {
+//	case AFOO:
+//	    if insn.rd%2 != 0 {
+//	        return errUnalignedReg(insn.as, insn.rd)
+//	    }
+//	}
+//
+// errUnalignedReg is to be provided by the backend, like errBadImm.
+func emitEvenRegChecks(ectx *common.EmitterCtx, descs []*common.InsnDescription, argFieldNames []string) {
+	var constrained []*common.InsnDescription
+	for _, d := range descs {
+		if len(d.EvenRegArgs()) > 0 {
+			constrained = append(constrained, d)
+		}
+	}
+	if len(constrained) == 0 {
+		return
 	}
 
-	ectx.Emit("\treturn nil\n}\n\n")
+	ectx.Emit("\tswitch insn.as {\n")
+	for _, d := range constrained {
+		ectx.Emit("\tcase %s:\n", common.GoAnameForInsn(d.Mnemonic))
+		for _, i := range d.EvenRegArgs() {
+			field := "insn." + argFieldNames[i]
+			ectx.Emit("\t\tif %s%%2 != 0 {\n", field)
+			ectx.Emit("\t\t\treturn errUnalignedReg(insn.as, %s)\n\t\t}\n", field)
+		}
+	}
+	ectx.Emit("\t}\n")
 }
 
-func emitSlotEncoders(ectx *common.EmitterCtx, scs []string) {
+func emitSlotEncoders(ectx *common.EmitterCtx, scs []common.SlotCombination) {
 	for _, sc := range scs {
 		emitSlotEncoderFn(ectx, sc)
 	}
 }
 
-func slotEncoderFnNameForSc(sc string) string {
+func slotEncoderFnNameForSc(sc common.SlotCombination) string {
 	plural := ""
 	if len(sc) > 1 {
 		plural = "s"
@@ -321,22 +690,20 @@ func slotEncoderFnNameForSc(sc string) string {
 	return fmt.Sprintf("encode%sSlot%s", sc, plural)
 }
 
-func emitSlotEncoderFn(ectx *common.EmitterCtx, sc string) {
+func emitSlotEncoderFn(ectx *common.EmitterCtx, sc common.SlotCombination) {
 	funcName := slotEncoderFnNameForSc(sc)
-	scLower := strings.ToLower(sc)
+	names := sc.Names()
 
 	ectx.Emit("func %s(bits uint32", funcName)
-	for _, s := range scLower {
-		ectx.Emit(", %c uint32", s)
+	for _, name := range names {
+		ectx.Emit(", %s uint32", strings.ToLower(name))
 	}
 	ectx.Emit(") uint32 {\n")
 
 	ectx.Emit("return bits")
 
-	for _, s := range scLower {
-		offset := slotOffsetFromRune(s)
-
-		ectx.Emit(" | %c", s)
+	for i, offset := range sc {
+		ectx.Emit(" | %s", strings.ToLower(names[i]))
 		if offset > 0 {
 			ectx.Emit("<<%d", offset)
 		}
@@ -345,7 +712,7 @@ func emitSlotEncoderFn(ectx *common.EmitterCtx, sc string) {
 	ectx.Emit("\n}\n\n")
 }
 
-func emitBigEncoderFn(ectx *common.EmitterCtx, fmts []*common.InsnFormat) {
+func emitBigEncoderFn(ectx *common.EmitterCtx, fmts []*common.InsnFormat, descsByFormat map[string][]*common.InsnDescription) {
 	ectx.Emit(`func (insn *instruction) encodeReal() (uint32, error) {
 	enc, err := encodingForAs(insn.as)
 	if err != nil {
@@ -372,10 +739,23 @@ func emitBigEncoderFn(ectx *common.EmitterCtx, fmts []*common.InsnFormat) {
 			argVarNames[i] = strings.ToLower(a.CanonicalRepr())
 		}
 
+		shifted := shiftedInsnsByArg(f, descsByFormat[formatName])
 		for i, a := range f.Args {
 			varName := argVarNames[i]
 			fieldExpr := "insn." + argFieldNames[i]
 
+			// the shifted imms are encoded divided by 1<<shift
+			if len(shifted[i]) > 0 {
+				ectx.Emit("%sImm := %s\n", varName, fieldExpr)
+				ectx.Emit("switch insn.as {\n")
+				for _, g := range shifted[i] {
+					ectx.Emit("case %s:\n", strings.Join(g.anames, ", "))
+					ectx.Emit("%sImm >>= %d\n", varName, g.shift)
+				}
+				ectx.Emit("}\n")
+				fieldExpr = varName + "Imm"
+			}
+
 			ectx.Emit("%s :=", varName)
 
 			switch a.Kind {
@@ -385,6 +765,10 @@ func emitBigEncoderFn(ectx *common.EmitterCtx, fmts []*common.InsnFormat) {
 				ectx.Emit("regFP(%s)", fieldExpr)
 			case common.ArgKindFCCReg:
 				ectx.Emit("regFCC(%s)", fieldExpr)
+			case common.ArgKindVReg:
+				ectx.Emit("regV(%s)", fieldExpr)
+			case common.ArgKindXReg:
+				ectx.Emit("regX(%s)", fieldExpr)
 			case common.ArgKindSignedImm, common.ArgKindUnsignedImm:
 				widthMask := (1 << a.TotalWidth()) - 1
 				ectx.Emit("uint32(%s) & 0x%x", fieldExpr, widthMask)
@@ -403,35 +787,24 @@ func emitBigEncoderFn(ectx *common.EmitterCtx, fmts []*common.InsnFormat) {
 			if len(a.Slots) == 1 {
 				slotExprs[a.Slots[0].Offset] = argVarName
 			} else {
-				// remainingBits is shift amount to extract the current slot from arg
-				//
 				// take example of Sd5k16:
 				//
 				// Sd5k16 = (MSB) DDDDDKKKKKKKKKKKKKKKK (LSB)
 				//
-				// initially remainingBits = 5+16
-				//
-				// consume from left to right:
-				//
-				// slot d5: remainingBits = 16
+				// the value offsets of the slots are 16 for d5 and 0 for k16,
 				// thus d5 = (sd5k16 >> 16) & 0b11111
-				// emit (d5 expr above)
-				//
-				// slot k16: remainingBits = 0
-				// thus k16 = (sd5k16 >> 0) & 0b1111111111111111
-				//          = sd5k16 & 0b1111111111111111
-				// emit (k16 expr above)
-				remainingBits := int(a.TotalWidth())
-				for _, s := range a.Slots {
-					remainingBits -= int(s.Width)
+				// and k16 = (sd5k16 >> 0) & 0b1111111111111111
+				//         = sd5k16 & 0b1111111111111111
+				valueOffsets := a.SlotValueOffsets()
+				for i, s := range a.Slots {
 					mask := int((1 << s.Width) - 1)
 
 					var sb strings.Builder
 					sb.WriteString(argVarName)
 
-					if remainingBits > 0 {
+					if valueOffsets[i] > 0 {
 						sb.WriteString(">>")
-						sb.WriteString(strconv.Itoa(remainingBits))
+						sb.WriteString(strconv.Itoa(int(valueOffsets[i])))
 					}
 
 					sb.WriteString("&0x")
@@ -442,12 +815,11 @@ func emitBigEncoderFn(ectx *common.EmitterCtx, fmts []*common.InsnFormat) {
 			}
 		}
 
-		sc := slotCombinationForFmt(f)
+		sc := common.SlotCombinationForFormat(f)
 		encFnName := slotEncoderFnNameForSc(sc)
 		ectx.Emit("return %s(enc.bits", encFnName)
 
-		for _, s := range sc {
-			offset := uint(slotOffsetFromRune(s))
+		for _, offset := range sc {
 			slotExpr, ok := slotExprs[offset]
 			if !ok {
 				panic("should never happen")
@@ -4,10 +4,11 @@
 package main
 
 import (
+	"flag"
+	"fmt"
 	"go/token"
 	"go/types"
 	"os"
-	"sort"
 	"strings"
 
 	"github.com/goplus/gox"
@@ -15,54 +16,48 @@ import (
 	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
 )
 
+var (
+	pkgName  = flag.String("pkg", "loong", "package name of the generated file")
+	buildTag = flag.String("build-tag", "", "build constraint expression to gate the generated file behind, e.g. loong64")
+)
+
 func main() {
-	inputs := os.Args[1:]
+	flag.Parse()
+	inputs := flag.Args()
 
-	formats, err := gatherFormats(inputs)
+	descs, err := common.ReadInsnDescs(inputs)
 	if err != nil {
 		panic(err)
 	}
 
-	sort.Slice(formats, func(i int, j int) bool {
-		return formats[i].CanonicalRepr() < formats[j].CanonicalRepr()
-	})
+	formats := common.GatherFormats(descs)
 
 	gox.SetDebug(true)
-	pkg := gox.NewPackage("", "loong", nil)
+	pkg := gox.NewPackage("", *pkgName, nil)
 	prepareScope(pkg)
 	for _, f := range formats {
 		emitValidatorForFormat(pkg, f)
 		emitEncoderForFormat(pkg, f)
 	}
 
-	err = gox.WriteTo(os.Stdout, pkg)
-	if err != nil {
-		panic(err)
-	}
-}
-
-func gatherFormats(paths []string) ([]*common.InsnFormat, error) {
-	formatsSet := make(map[string]*common.InsnFormat)
-	for _, path := range paths {
-		descs, err := common.ReadInsnDescriptionFile(path)
+	// the build constraint lines must precede the package clause written by
+	// gox
+	if *buildTag != "" {
+		lines, err := common.BuildConstraintLines(*buildTag)
 		if err != nil {
-			return nil, err
+			panic(err)
 		}
 
-		for _, d := range descs {
-			canonicalFormatName := d.Format.CanonicalRepr()
-			if _, ok := formatsSet[canonicalFormatName]; !ok {
-				formatsSet[canonicalFormatName] = d.Format
-			}
+		for _, l := range lines {
+			fmt.Println(l)
 		}
+		fmt.Println()
 	}
 
-	result := make([]*common.InsnFormat, 0, len(formatsSet))
-	for _, f := range formatsSet {
-		result = append(result, f)
+	err = gox.WriteTo(os.Stdout, pkg)
+	if err != nil {
+		panic(err)
 	}
-
-	return result, nil
 }
 
 var (
@@ -0,0 +1,609 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestCheckInsnCount(t *testing.T) {
+	// the last insn gets opcode ALAST-1 = A_ARCHSPECIFIC+n-1, and ALAST
+	// itself must not wrap around to 0 when masked
+	max := maxInsnsFor(defaultArchSpecific)
+	assert.Equal(t, objAMask, defaultArchSpecific+max)
+	assert.Equal(t, max+1, maxInsnsFor(14))
+
+	assert.NoError(t, checkInsnCount(max, max))
+
+	err := checkInsnCount(max+1, max)
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "2033 insns exceed the 2032 opcodes available under obj.AMask")
+		assert.Contains(t, err.Error(), "sparse slice")
+	}
+
+	assert.NoError(t, checkInsnCount(len(common.Builtin()), max))
+}
+
+func TestSupportedArgKinds(t *testing.T) {
+	descs, _ := readBaseCorpusForTest(t)
+	assert.NoError(t, common.CheckArgKinds(descs, supportedArgKinds()))
+
+	d, err := common.ParseInsnDescriptionLine("700a0000 vadd.b                 VdVjVk")
+	assert.NoError(t, err)
+	assert.NoError(t, common.CheckArgKinds([]*common.InsnDescription{d}, supportedArgKinds()))
+
+	// the LBT scratch registers aren't supported yet
+	d, err = common.ParseInsnDescriptionLine("00000800 movgr2scr              TdJ             @lbt")
+	assert.NoError(t, err)
+	err = common.CheckArgKinds([]*common.InsnDescription{d}, supportedArgKinds())
+	assert.EqualError(t, err, "movgr2scr: arg td (scratch register) is not supported")
+}
+
+func TestVectorRegs(t *testing.T) {
+	var descs []*common.InsnDescription
+	for _, l := range []string{
+		"700a0000 vadd.b                 VdVjVk",
+		"740a0000 xvadd.b                XdXjXk",
+		"72eb8000 vinsgr2vr.b            VdJUk4          @role=elemidx",
+	} {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		descs = append(descs, d)
+	}
+	formats := common.GatherFormats(descs)
+	result := string(generate(descs, formats, common.GatherSlotCombinations(formats), ""))
+
+	assert.Contains(t, result, `func validateVdVjVk(insn *instruction) error {
+	if err := wantVReg(insn.as, insn.rd); err != nil {
+		return err
+	}
+`)
+	assert.Contains(t, result, "	if err := wantXReg(insn.as, insn.rk); err != nil {\n")
+	assert.Contains(t, result, `	case insnFormatVdJUk4:
+		vd := regV(insn.rd)
+		j := regInt(insn.rj)
+`)
+	assert.Contains(t, result, "		xk := regX(insn.rk)\n")
+}
+
+func TestUncommonSlotOffset(t *testing.T) {
+	var descs []*common.InsnDescription
+	for _, l := range []string{
+		"00100000 add.w                  DJK",
+		"00000000 foo                    DJUn5",
+		"00000000 bar                    FdFjFm",
+		"00000000 baz                    FdFjFn",
+	} {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		descs = append(descs, d)
+	}
+	formats := common.GatherFormats(descs)
+	result := string(generate(descs, formats, common.GatherSlotCombinations(formats), ""))
+
+	assert.Contains(t, result, "func encodeDJS18Slots(bits uint32, d uint32, j uint32, s18 uint32) uint32 {\n")
+	assert.Contains(t, result, "return bits | d | j<<5 | s18<<18\n")
+	assert.Contains(t, result, "return encodeDJS18Slots(enc.bits, ")
+
+	// registers at uncommon offsets are named after their slots
+	assert.Contains(t, result, "if err := wantFPReg(insn.as, insn.rm); err != nil {\n")
+	assert.Contains(t, result, "fm := regFP(insn.rm)\n")
+	assert.Contains(t, result, "return encodeDJMSlots(enc.bits, fd, fj, fm), nil\n")
+	assert.Contains(t, result, "if err := wantFPReg(insn.as, insn.rs18); err != nil {\n")
+	assert.Contains(t, result, "fn := regFP(insn.rs18)\n")
+	assert.Contains(t, result, "return encodeDJS18Slots(enc.bits, fd, fj, fn), nil\n")
+}
+
+func TestEmitInsnEncodingsExtComments(t *testing.T) {
+	var descs []*common.InsnDescription
+	for _, l := range []string{
+		"00100000 add.w                  DJK",
+		"00000800 movgr2scr              TdJ             @lbt",
+	} {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		descs = append(descs, d)
+	}
+
+	emit := func() string {
+		var ectx common.EmitterCtx
+		ectx.Emit("package loong\n\n")
+		emitInsnEncodings(&ectx, descs)
+		return string(ectx.Finalize())
+	}
+
+	result := emit()
+	assert.Contains(t, result, "AADDW & obj.AMask:      {bits: 0x00100000, fmt: insnFormatDJK},\n")
+	assert.Contains(t, result, "AMOVGR2SCR & obj.AMask: {bits: 0x00000800, fmt: insnFormatTdJ}, // LBT\n")
+
+	saved := *noComments
+	*noComments = true
+	defer func() { *noComments = saved }()
+	assert.NotContains(t, emit(), "//")
+}
+
+func TestEmitValidatorEvenRegs(t *testing.T) {
+	var descs []*common.InsnDescription
+	for _, l := range []string{
+		"00100000 add.w                  DJK",
+		"00110000 foo                    DJK             @even-reg=d,k",
+	} {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		descs = append(descs, d)
+	}
+
+	emit := func(descs []*common.InsnDescription) string {
+		var ectx common.EmitterCtx
+		ectx.Emit("package loong\n\n")
+		emitValidatorForFormat(&ectx, descs[0].Format, descs)
+		return string(ectx.Finalize())
+	}
+
+	result := emit(descs)
+	assert.Contains(t, result, `	switch insn.as {
+	case AFOO:
+		if insn.rd%2 != 0 {
+			return errUnalignedReg(insn.as, insn.rd)
+		}
+		if insn.rk%2 != 0 {
+			return errUnalignedReg(insn.as, insn.rk)
+		}
+	}
+	return nil
+`)
+	assert.NotContains(t, result, "AADDW")
+
+	// nothing for unconstrained formats
+	assert.NotContains(t, emit(descs[:1]), "switch")
+}
+
+func TestShiftedImms(t *testing.T) {
+	var descs []*common.InsnDescription
+	for _, l := range []string{
+		"10000000 addu16i.d              DJSk16",
+		"4c000000 jirl                   DJSk16          @orig_fmt=DJSk16ps2",
+		"58000000 beq                    DJSk16          @orig_fmt=JDSk16ps2",
+	} {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		descs = append(descs, d)
+	}
+
+	emit := func() string {
+		var ectx common.EmitterCtx
+		ectx.Emit("package loong\n\n")
+		emitValidatorForFormat(&ectx, descs[0].Format, descs)
+		emitBigEncoderFn(&ectx, []*common.InsnFormat{descs[0].Format}, map[string][]*common.InsnDescription{"DJSk16": descs})
+		return string(ectx.Finalize())
+	}
+
+	// unchanged by default
+	result := emit()
+	assert.NotContains(t, result, "switch insn.as")
+	assert.Contains(t, result, "sk16 := uint32(insn.imm1) & 0xffff\n")
+
+	saved := *shiftedImms
+	*shiftedImms = true
+	defer func() { *shiftedImms = saved }()
+
+	result = emit()
+	assert.Contains(t, result, `	switch insn.as {
+	case AJIRL, ABEQ:
+		if insn.imm1&0x3 != 0 {
+			return errUnalignedImm(insn.as, insn.imm1, 4)
+		}
+		if err := wantSignedImm(insn.as, insn.imm1, 18); err != nil {
+			return err
+		}
+	default:
+		if err := wantSignedImm(insn.as, insn.imm1, 16); err != nil {
+			return err
+		}
+	}
+`)
+	assert.Contains(t, result, `		sk16Imm := insn.imm1
+		switch insn.as {
+		case AJIRL, ABEQ:
+			sk16Imm >>= 2
+		}
+		sk16 := uint32(sk16Imm) & 0xffff
+`)
+
+	saved = *inlineBounds
+	*inlineBounds = true
+	defer func() { *inlineBounds = saved }()
+	assert.Contains(t, emit(), `	case AJIRL, ABEQ:
+		if insn.imm1&0x3 != 0 {
+			return errUnalignedImm(insn.as, insn.imm1, 4)
+		}
+		if insn.imm1 < -131072 || insn.imm1 > 131068 {
+			return errBadImm(insn.as, insn.imm1, -131072, 131068)
+		}
+`)
+}
+
+// inlineBoundRe matches the inline bounds checks emitted by emitArgCheck.
+var inlineBoundRe = regexp.MustCompile(`^\t+if insn\.(\w+) < (-?\d+) \|\| insn\.(\w+) > (-?\d+) \{$`)
+
+// inlineBoundsForTest returns the bounds checked by the validator of a
+// format, keyed by the insn field prefixed by the aname of the switch case
+// it is checked in, or by "*" outside the cases.
+func inlineBoundsForTest(t *testing.T, src string) map[string][2]int64 {
+	result := make(map[string][2]int64)
+	anames := []string{"*"}
+	for _, l := range strings.Split(src, "\n") {
+		switch {
+		case strings.HasPrefix(l, "\tcase "):
+			anames = strings.Split(strings.TrimSuffix(strings.TrimPrefix(l, "\tcase "), ":"), ", ")
+			continue
+		case l == "\tdefault:", l == "\t}":
+			anames = []string{"*"}
+			continue
+		}
+
+		m := inlineBoundRe.FindStringSubmatch(l)
+		if m == nil {
+			continue
+		}
+		assert.Equal(t, m[1], m[3], l)
+		min, err := strconv.ParseInt(m[2], 10, 64)
+		assert.NoError(t, err)
+		max, err := strconv.ParseInt(m[4], 10, 64)
+		assert.NoError(t, err)
+		for _, aname := range anames {
+			result[aname+"."+m[1]] = [2]int64{min, max}
+		}
+	}
+	return result
+}
+
+func TestInlineBoundsMatchInterpretiveRange(t *testing.T) {
+	descs, formats := readBaseCorpusForTest(t)
+	descsByFormat := make(map[string][]*common.InsnDescription)
+	for _, d := range descs {
+		repr := d.Format.CanonicalRepr()
+		descsByFormat[repr] = append(descsByFormat[repr], d)
+	}
+
+	saved := *inlineBounds
+	*inlineBounds = true
+	defer func() { *inlineBounds = saved }()
+	savedShifted := *shiftedImms
+	defer func() { *shiftedImms = savedShifted }()
+
+	for _, shifted := range []bool{false, true} {
+		*shiftedImms = shifted
+
+		var nScaled, nBiased int
+		for _, f := range formats {
+			fmtDescs := descsByFormat[f.CanonicalRepr()]
+			var ectx common.EmitterCtx
+			emitValidatorForFormat(&ectx, f, fmtDescs)
+			bounds := inlineBoundsForTest(t, string(ectx.Finalize()))
+
+			fieldNames := fieldNamesForArgs(f.Args)
+			for _, d := range fmtDescs {
+				manualArgs := make([]*common.Arg, len(f.Args))
+				for i, idx := range d.ManualSyntaxArgIndices() {
+					manualArgs[idx] = d.ManualSyntaxArgs()[i]
+				}
+
+				for i, a := range f.Args {
+					if !a.Kind.IsImm() {
+						continue
+					}
+
+					aname := common.GoAnameForInsn(d.Mnemonic)
+					b, ok := bounds[aname+"."+fieldNames[i]]
+					if !ok {
+						b, ok = bounds["*."+fieldNames[i]]
+					}
+					if !assert.True(t, ok, "%s: no bounds check of %s", d.Mnemonic, a.Name()) {
+						continue
+					}
+
+					// the value as written in assembly if the insn takes it
+					// shifted, otherwise as encoded, like ValidateAll
+					scale := int64(1)
+					if shifted {
+						scale = manualArgs[i].Scale()
+					}
+					if scale > 1 {
+						nScaled++
+						assert.Equal(t, [2]int64{manualArgs[i].AsmMinValue(), manualArgs[i].AsmMaxValue()}, b, "%s %s", d.Mnemonic, a.Name())
+					}
+					if manualArgs[i].Bias() != 0 {
+						nBiased++
+					}
+
+					// the bounds are exactly the values accepted by
+					// ValidateAll once scaled back
+					operands := make(map[string]int64)
+					for _, a := range f.Args {
+						operands[a.Name()] = 0
+					}
+					for _, v := range []int64{b[0], b[1]} {
+						operands[a.Name()] = v / scale
+						assert.Empty(t, d.ValidateAll(operands), "%s %s=%d", d.Mnemonic, a.Name(), v)
+					}
+					for _, v := range []int64{b[0] - scale, b[1] + scale} {
+						operands[a.Name()] = v / scale
+						errs := d.ValidateAll(operands)
+						if assert.Len(t, errs, 1, "%s %s=%d", d.Mnemonic, a.Name(), v) {
+							var opErr *common.OperandError
+							if assert.ErrorAs(t, errs[0], &opErr) {
+								assert.Equal(t, [2]int64{b[0] / scale, b[1] / scale}, [2]int64{opErr.Min, opErr.Max})
+							}
+						}
+					}
+				}
+			}
+		}
+
+		// the biased operands like sladd.w's are checked as encoded
+		assert.NotZero(t, nBiased)
+		if shifted {
+			assert.NotZero(t, nScaled)
+		} else {
+			assert.Zero(t, nScaled)
+		}
+	}
+}
+
+// compositeLitEntries returns the keys and values of the entries of the
+// composite literal assigned to the package-level var name, as printed.
+func compositeLitEntries(t *testing.T, fset *token.FileSet, f *ast.File, name string) map[string]string {
+	result := make(map[string]string)
+	for _, decl := range f.Decls {
+		gd, ok := decl.(*ast.GenDecl)
+		if !ok || gd.Tok != token.VAR {
+			continue
+		}
+		for _, spec := range gd.Specs {
+			vs := spec.(*ast.ValueSpec)
+			if vs.Names[0].Name != name {
+				continue
+			}
+			for _, elt := range vs.Values[0].(*ast.CompositeLit).Elts {
+				kv := elt.(*ast.KeyValueExpr)
+				var key, value strings.Builder
+				assert.NoError(t, printer.Fprint(&key, fset, kv.Key))
+				assert.NoError(t, printer.Fprint(&value, fset, kv.Value))
+				result[key.String()] = value.String()
+			}
+		}
+	}
+	return result
+}
+
+func TestGenerateNameMap(t *testing.T) {
+	for _, p := range []*bool{opcodeNames, nameMap} {
+		saved := *p
+		*p = true
+		defer func(p *bool) { *p = saved }(p)
+	}
+
+	descs, _ := readBaseCorpusForTest(t)
+	aliased := make(map[string]*common.InsnDescription)
+	for i, d := range descs {
+		if d.Mnemonic == "sext.h" {
+			descs[i] = d.Clone()
+			descs[i].Attribs["alias-name"] = "ext.w.h"
+			aliased["ext.w.h"] = descs[i]
+		}
+	}
+	assert.NoError(t, common.CheckAliasNames(descs))
+	formats := common.GatherFormats(descs)
+	src := generate(descs, formats, common.GatherSlotCombinations(formats), "")
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "insndata.go", src, 0)
+	assert.NoError(t, err)
+	asForName := compositeLitEntries(t, fset, f, "asForName")
+	opcodeMnemonics := compositeLitEntries(t, fset, f, "opcodeMnemonics")
+	assert.Len(t, asForName, len(descs)+len(aliased))
+
+	// every mnemonic maps to an opcode rendering as the same mnemonic
+	for _, d := range descs {
+		as, ok := asForName[strconv.Quote(d.Mnemonic)]
+		if assert.True(t, ok, d.Mnemonic) {
+			assert.Equal(t, strconv.Quote(d.Mnemonic), opcodeMnemonics[as+" & obj.AMask"], d.Mnemonic)
+		}
+	}
+
+	// while the alias names map to the opcodes of the insns they name
+	for name, d := range aliased {
+		assert.Equal(t, asForName[strconv.Quote(d.Mnemonic)], asForName[strconv.Quote(name)], name)
+	}
+}
+
+func TestEmitOpcodeNames(t *testing.T) {
+	descs, _ := readBaseCorpusForTest(t)
+
+	gen := func(path string) (*token.FileSet, *ast.File, string) {
+		saved := *importPath
+		*importPath = path
+		defer func() { *importPath = saved }()
+
+		var ectx common.EmitterCtx
+		ectx.Emit("package loong\n")
+		emitOpcodeNames(&ectx, descs)
+		src := string(ectx.Finalize())
+
+		fset := token.NewFileSet()
+		f, err := parser.ParseFile(fset, "opcodenames.go", src, 0)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		return fset, f, src
+	}
+
+	fset, f, src := gen("cmd/internal/obj")
+	opcodeMnemonics := compositeLitEntries(t, fset, f, "opcodeMnemonics")
+	assert.Len(t, opcodeMnemonics, len(descs))
+	assert.Equal(t, `"add.w"`, opcodeMnemonics["AADDW & obj.AMask"])
+	assert.Equal(t, `"fcmp.caf.s"`, opcodeMnemonics["AFCMPCAFS & obj.AMask"])
+	assert.Equal(t, `"bstrpick.d"`, opcodeMnemonics["ABSTRPICKD & obj.AMask"])
+
+	// indexed by the masked opcode like the encodings table, so the opcodes
+	// past ALAST are rejected rather than wrapping around
+	assert.Contains(t, src, "var opcodeMnemonics = [ALAST & obj.AMask]string{")
+	assert.Contains(t, src, "func opcodeMnemonic(a obj.As) string {")
+	assert.Contains(t, src, "if a < ALAST && a&^obj.AMask == ALAST&^obj.AMask {")
+	assert.Contains(t, src, "obj.RegisterOpcode(obj.ABaseLoong, opcodeMnemonics[:])")
+
+	// without the obj package, nothing is registered
+	_, _, src = gen("")
+	assert.Contains(t, src, "var opcodeMnemonics = [ALAST & AMask]string{")
+	assert.Contains(t, src, "func opcodeMnemonic(a As) string {")
+	assert.NotContains(t, src, "RegisterOpcode")
+}
+
+func readBaseCorpusForTest(tb testing.TB) ([]*common.InsnDescription, []*common.InsnFormat) {
+	paths, err := filepath.Glob("../../../la-*.txt")
+	if err != nil {
+		tb.Fatal(err)
+	}
+	descs, err := common.ReadInsnDescs(paths)
+	if err != nil {
+		tb.Fatal(err)
+	}
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+	return descs, common.GatherFormats(descs)
+}
+
+func TestCompactEncodings(t *testing.T) {
+	descs, formats := readBaseCorpusForTest(t)
+	scs := common.GatherSlotCombinations(formats)
+
+	fset := token.NewFileSet()
+	gen := func(compactEncodings bool) *ast.File {
+		saved := *compact
+		*compact = compactEncodings
+		defer func() { *compact = saved }()
+
+		f, err := parser.ParseFile(fset, "insndata.go", generate(descs, formats, scs, ""), 0)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		return f
+	}
+
+	direct := compositeLitEntries(t, fset, gen(false), "encodings")
+	f := gen(true)
+	packed := compositeLitEntries(t, fset, f, "compactEncodings")
+	bases := compositeLitEntries(t, fset, f, "formatBases")
+	assert.Len(t, packed, len(direct))
+	assert.Len(t, bases, len(formats))
+
+	// reconstruct the table from the generated source as the init function
+	// does
+	for key, entry := range direct {
+		c, err := strconv.ParseUint(packed[key], 0, 32)
+		if !assert.NoError(t, err, key) {
+			continue
+		}
+
+		fmtName := "insnFormat" + formats[c>>compactDeltaBits-1].CanonicalRepr()
+		var base uint32
+		var shift uint
+		_, err = fmt.Sscanf(bases[fmtName], "{0x%x, %d}", &base, &shift)
+		assert.NoError(t, err, fmtName)
+
+		bits := base + (uint32(c)&(1<<compactDeltaBits-1))<<shift
+		assert.Equal(t, fmt.Sprintf("{bits: 0x%08x, fmt: %s}", bits, fmtName), entry, key)
+	}
+
+	// deltas too large to fit
+	d, err := common.ParseInsnDescriptionLine("7f000000 foo                    DJ")
+	assert.NoError(t, err)
+	_, err = packEncoding(d, 1, formatBase{base: 0, shift: 0})
+	assert.Error(t, err)
+}
+
+// BenchmarkCompactEncodingsInit measures the reconstruction of the encodings
+// table from the compact one at init time, reporting the sizes of both
+// tables.
+func BenchmarkCompactEncodingsInit(b *testing.B) {
+	descs, formats := readBaseCorpusForTest(b)
+	bases := computeFormatBases(descs, formats)
+
+	idx := make(map[string]int, len(formats))
+	for i, f := range formats {
+		idx[f.CanonicalRepr()] = i
+	}
+	packed := make([]uint32, maxInsnsFor(defaultArchSpecific))
+	for i, d := range descs {
+		c, err := packEncoding(d, idx[d.Format.CanonicalRepr()]+1, bases[idx[d.Format.CanonicalRepr()]])
+		if err != nil {
+			b.Fatal(err)
+		}
+		packed[i] = c
+	}
+
+	unpacked := make([]uint32, len(packed))
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		for j, c := range packed {
+			if c == 0 {
+				continue
+			}
+			unpacked[j] = unpackEncoding(c, bases)
+		}
+	}
+
+	// the table entries are a uint32 and an int in the direct table, and a
+	// uint32 in the compact one, plus a base and a shift per format
+	b.ReportMetric(float64(len(packed)*16), "direct-bytes")
+	b.ReportMetric(float64(len(packed)*4+len(formats)*8), "compact-bytes")
+}
+
+func TestGenerateMnemonicCase(t *testing.T) {
+	paths, err := filepath.Glob("../../../la-*.txt")
+	assert.NoError(t, err)
+
+	gen := func(descs []*common.InsnDescription) []byte {
+		sort.Slice(descs, func(i int, j int) bool {
+			return descs[i].Word < descs[j].Word
+		})
+		formats := common.GatherFormats(descs)
+		return generate(descs, formats, common.GatherSlotCombinations(formats), "")
+	}
+
+	descs, err := common.ReadInsnDescs(paths)
+	assert.NoError(t, err)
+	expected := gen(descs)
+
+	// the same corpus, with addi.d written in upper case
+	dir := t.TempDir()
+	var upperPaths []string
+	for _, p := range paths {
+		content, err := os.ReadFile(p)
+		assert.NoError(t, err)
+		content = []byte(strings.Replace(string(content), " addi.d ", " ADDI.D ", 1))
+		upperPath := filepath.Join(dir, filepath.Base(p))
+		assert.NoError(t, os.WriteFile(upperPath, content, 0644))
+		upperPaths = append(upperPaths, upperPath)
+	}
+
+	descs, err = common.ReadInsnDescs(upperPaths)
+	assert.NoError(t, err)
+	assert.Len(t, common.MnemonicCaseWarnings(descs), 1)
+	assert.Equal(t, string(expected), string(gen(descs)))
+}
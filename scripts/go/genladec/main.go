@@ -0,0 +1,214 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/bits"
+	"os"
+	"sort"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+// indexBits must match the same constant in ladec.
+const indexBits = 10
+
+var (
+	pkgName     = flag.String("pkg", "ladec", "package name of the generated file")
+	outputPath  = flag.String("o", "", "write the output to this file instead of stdout; the file is replaced atomically")
+	incremental = flag.Bool("incremental", false, "with -o, skip regenerating if the output is stamped with the same inputs; stamps the output")
+	dryRun      = common.DryRunFlag(flag.CommandLine)
+)
+
+func main() {
+	flag.Parse()
+	inputs := flag.Args()
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+
+	fingerprint := common.InputsFingerprint("genladec", descs, flag.CommandLine, "o", "incremental")
+	plan, err := common.PlanOutput(*outputPath, *incremental, fingerprint, func(stamp string) []byte {
+		return generate(descs, stamp)
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	if *dryRun {
+		common.PrintOutputPlans(os.Stdout, []common.OutputPlan{plan}, len(descs), len(common.GatherFormats(descs)))
+		return
+	}
+
+	err = common.WriteOutputs([]common.OutputPlan{plan})
+	if err != nil {
+		panic(err)
+	}
+}
+
+func generate(descs []*common.InsnDescription, stamp string) []byte {
+	var ectx common.EmitterCtx
+
+	ectx.Emit("// Code generated by genladec from loongson-community/loongarch-opcodes; DO NOT EDIT.\n\n")
+	if stamp != "" {
+		ectx.EmitStamp(stamp)
+	}
+	ectx.Emit("package %s\n\n", *pkgName)
+
+	emitMnemonics(&ectx, descs)
+	offsets := emitDescriptors(&ectx, descs)
+	emitIndex(&ectx, descs, offsets)
+
+	return ectx.Finalize()
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+func emitMnemonics(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit("var mnemonics = [...]string{\n")
+	for _, d := range descs {
+		ectx.Emit("\t%q,\n", d.Mnemonic)
+	}
+	ectx.Emit("}\n\n")
+}
+
+func descriptorForInsn(idx int, d *common.InsnDescription) []uint8 {
+	var result []uint8
+	putUint32 := func(v uint32) {
+		result = append(result, uint8(v), uint8(v>>8), uint8(v>>16), uint8(v>>24))
+	}
+
+	putUint32(d.FixedMask())
+	putUint32(d.Word)
+	putUint32(d.ReservedMask())
+	result = append(result, uint8(idx), uint8(idx>>8))
+	result = append(result, uint8(len(d.Format.Args)))
+
+	// the postprocess ops are only present in the manual syntax
+	manualIndices := d.ManualSyntaxArgIndices()
+	posts := make([]common.PostprocessOp, len(d.Format.Args))
+	for i, a := range d.ManualSyntaxArgs() {
+		posts[manualIndices[i]] = a.Post
+	}
+
+	for i, a := range d.Format.Args {
+		if len(a.Slots) > 4 {
+			panic(fmt.Sprintf("%s: too many slots for a descriptor", d.Mnemonic))
+		}
+
+		// kind in the low nibble, then number of slots - 1 and the kind of
+		// the postprocess op in 2 bits each
+		post := posts[i]
+		result = append(result, uint8(a.Kind)|uint8(len(a.Slots)-1)<<4|uint8(post.Kind)<<6)
+		for _, s := range a.Slots {
+			result = append(result, uint8(s.Offset), uint8(s.Width))
+		}
+		if post.Kind != common.PostprocessOpKindNone {
+			result = append(result, uint8(post.Amount))
+		}
+	}
+
+	return result
+}
+
+// emitDescriptors emits the descriptor blob and returns the offset of every
+// insn's descriptor in it.
+func emitDescriptors(ectx *common.EmitterCtx, descs []*common.InsnDescription) []int {
+	offsets := make([]int, len(descs))
+
+	ectx.Emit("var descriptors = [...]uint8{\n")
+	offset := 0
+	for i, d := range descs {
+		offsets[i] = offset
+
+		desc := descriptorForInsn(i, d)
+		ectx.Emit("\t// %s %s\n\t", d.Mnemonic, d.Format.CanonicalRepr())
+		for j, b := range desc {
+			if j > 0 {
+				ectx.Emit(" ")
+			}
+			ectx.Emit("0x%02x,", b)
+		}
+		ectx.Emit("\n")
+
+		offset += len(desc)
+	}
+	ectx.Emit("}\n\n")
+
+	return offsets
+}
+
+// emitIndex emits the descriptor offsets of the insns grouped by the top
+// indexBits bits of their words. An insn with fewer fixed bits among these is
+// listed under every value of the bits it doesn't fix.
+func emitIndex(ectx *common.EmitterCtx, descs []*common.InsnDescription, offsets []int) {
+	const shift = 32 - indexBits
+
+	// more specific encodings (more fixed bits) come first in every bucket,
+	// so that special-cased sub-encodings of other instructions take
+	// precedence, the same as in common.Decoder
+	order := make([]int, len(descs))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i int, j int) bool {
+		ni := bits.OnesCount32(descs[order[i]].FixedMask())
+		nj := bits.OnesCount32(descs[order[j]].FixedMask())
+		if ni != nj {
+			return ni > nj
+		}
+		return descs[order[i]].Word < descs[order[j]].Word
+	})
+
+	buckets := make([][]int, 1<<indexBits)
+	for _, i := range order {
+		d := descs[i]
+		mask := d.FixedMask() >> shift
+		match := d.Word >> shift
+		for v := uint32(0); v < 1<<indexBits; v++ {
+			if v&mask == match {
+				buckets[v] = append(buckets[v], offsets[i])
+			}
+		}
+	}
+
+	ectx.Emit("// indexStarts[v] is the start of the descriptor offsets in indexOffsets\n")
+	ectx.Emit("// for the words with v as the top %d bits.\n", indexBits)
+	ectx.Emit("var indexStarts = [...]uint32{")
+	start := 0
+	for v := 0; v <= 1<<indexBits; v++ {
+		if v%16 == 0 {
+			ectx.Emit("\n\t")
+		} else {
+			ectx.Emit(" ")
+		}
+		ectx.Emit("%d,", start)
+		if v < 1<<indexBits {
+			start += len(buckets[v])
+		}
+	}
+	ectx.Emit("\n}\n\n")
+
+	ectx.Emit("var indexOffsets = [...]uint32{\n")
+	for v, b := range buckets {
+		if len(b) == 0 {
+			continue
+		}
+
+		ectx.Emit("\t// 0x%03x\n\t", v)
+		for j, off := range b {
+			if j > 0 {
+				ectx.Emit(" ")
+			}
+			ectx.Emit("%d,", off)
+		}
+		ectx.Emit("\n")
+	}
+	ectx.Emit("}\n")
+}
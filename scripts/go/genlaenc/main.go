@@ -0,0 +1,733 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+var (
+	pkgName     = flag.String("pkg", "laenc", "package name of the generated file")
+	outputPath  = flag.String("o", "", "write the output to this file instead of stdout; the file is replaced atomically")
+	incremental = flag.Bool("incremental", false, "with -o, skip regenerating if the output is stamped with the same inputs; stamps the output")
+	typedRegs   = flag.Bool("typed-regs", false, "also emit register types per register class, and exported per-format encoders taking them")
+	manifest    = flag.Bool("manifest", false, "emit a JSON manifest of the per-format functions, with the bit layouts they implement, instead of code")
+	dryRun      = common.DryRunFlag(flag.CommandLine)
+	shiftedImms = flag.Bool("shifted-imms", false, "make Encode take the immediates the manual shifts implicitly, like the branch offsets, as written in assembly instead of as encoded, checking their alignment and their range before the shift")
+	split       = flag.Int("split", 0, "with -o, emit the per-format validators and encoders into this many files next to the output, named after it with the suffixes _1, _2 etc., keeping the shared tables in the output itself")
+)
+
+func main() {
+	flag.Parse()
+	inputs := flag.Args()
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	formats := common.GatherFormats(descs)
+	err = common.CheckFormats(descs, formats)
+	if err != nil {
+		panic(err)
+	}
+
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+
+	fingerprint := common.InputsFingerprint("genlaenc", descs, flag.CommandLine, "o", "incremental")
+	var plans []common.OutputPlan
+	var stale []string
+	switch {
+	case *manifest:
+		plan, err := common.PlanOutput(*outputPath, false, fingerprint, func(string) []byte {
+			return generateManifest(descs, formats)
+		})
+		if err != nil {
+			panic(err)
+		}
+		plans = []common.OutputPlan{plan}
+
+	case *split <= 0:
+		plan, err := common.PlanOutput(*outputPath, *incremental, fingerprint, func(stamp string) []byte {
+			return generate(descs, formats, stamp)
+		})
+		if err != nil {
+			panic(err)
+		}
+		plans = []common.OutputPlan{plan}
+
+	default:
+		if *outputPath == "" {
+			panic("-split needs -o")
+		}
+
+		plans, err = common.PlanOutputs(common.SplitOutputPaths(*outputPath, *split), *incremental, fingerprint, func(stamp string) [][]byte {
+			return generateSplit(descs, formats, *split, stamp)
+		})
+		if err != nil {
+			panic(err)
+		}
+
+		// the parts of an earlier run with a larger -split would redefine
+		// the functions
+		stale, err = common.StaleSplitOutputPaths(*outputPath, *split)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	if *dryRun {
+		common.PrintOutputPlans(os.Stdout, plans, len(descs), len(formats))
+		for _, p := range stale {
+			fmt.Printf("  %s: stale, would be removed\n", p)
+		}
+		return
+	}
+
+	err = common.WriteOutputs(plans)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, p := range stale {
+		fmt.Fprintf(os.Stderr, "removing stale %s\n", p)
+		err = os.Remove(p)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+func emitHeader(ectx *common.EmitterCtx, stamp string) {
+	ectx.Emit("// Code generated by genlaenc from loongson-community/loongarch-opcodes; DO NOT EDIT.\n\n")
+	if stamp != "" {
+		ectx.EmitStamp(stamp)
+	}
+	ectx.Emit("package %s\n\n", *pkgName)
+}
+
+func generate(descs []*common.InsnDescription, formats []*common.InsnFormat, stamp string) []byte {
+	var ectx common.EmitterCtx
+
+	emitHeader(&ectx, stamp)
+
+	emitInsnFormatTypes(&ectx, formats)
+
+	for _, f := range formats {
+		emitValidatorForFormat(&ectx, f)
+		emitEncoderForFormat(&ectx, f)
+	}
+
+	emitSharedTables(&ectx, descs, formats)
+
+	if *typedRegs {
+		for _, f := range formats {
+			emitTypedEncoderForFormat(&ectx, f)
+		}
+	}
+
+	return ectx.Finalize()
+}
+
+// generateSplit is like generate, but returns the shared tables first,
+// followed by n parts holding the per-format functions, each for a
+// contiguous run of the formats.
+func generateSplit(descs []*common.InsnDescription, formats []*common.InsnFormat, n int, stamp string) [][]byte {
+	var ectx common.EmitterCtx
+	emitHeader(&ectx, stamp)
+	emitInsnFormatTypes(&ectx, formats)
+	emitSharedTables(&ectx, descs, formats)
+	result := [][]byte{ectx.Finalize()}
+
+	for i := 0; i < n; i++ {
+		var ectx common.EmitterCtx
+		emitHeader(&ectx, stamp)
+
+		part := formats[i*len(formats)/n : (i+1)*len(formats)/n]
+		for _, f := range part {
+			emitValidatorForFormat(&ectx, f)
+			emitEncoderForFormat(&ectx, f)
+		}
+		if *typedRegs {
+			for _, f := range part {
+				emitTypedEncoderForFormat(&ectx, f)
+			}
+		}
+
+		result = append(result, ectx.Finalize())
+	}
+
+	return result
+}
+
+// emitSharedTables emits everything but the per-format functions, which
+// refers to the latter by name.
+func emitSharedTables(ectx *common.EmitterCtx, descs []*common.InsnDescription, formats []*common.InsnFormat) {
+	emitBigEncoderFn(ectx, formats)
+	emitFormatFnTables(ectx, formats)
+	emitInsnTable(ectx, descs)
+	emitRelocOperandTable(ectx, descs)
+	emitRegValidators(ectx)
+	if *shiftedImms {
+		emitShiftedOperandTable(ectx, descs)
+	}
+
+	if *typedRegs {
+		emitRegTypes(ectx)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+func emitInsnFormatTypes(ectx *common.EmitterCtx, fmts []*common.InsnFormat) {
+	ectx.Emit("type insnFormat int\n\nconst (\n")
+	ectx.Emit("\tinsnFormatUnknown insnFormat = iota\n")
+
+	for _, f := range fmts {
+		ectx.Emit("\tinsnFormat%s\n", f.CanonicalRepr())
+	}
+
+	ectx.Emit(")\n\n")
+
+	ectx.Emit("var insnFormatArities = [...]int{\n")
+	for _, f := range fmts {
+		ectx.Emit("\tinsnFormat%s: %d,\n", f.CanonicalRepr(), f.ArgCount())
+	}
+	ectx.Emit("}\n\n")
+}
+
+func paramNamesForArgs(args []*common.Arg) []string {
+	result := make([]string, len(args))
+	for i, a := range args {
+		result[i] = a.Name()
+	}
+	return result
+}
+
+func emitParamList(ectx *common.EmitterCtx, names []string, withBits bool) {
+	ectx.Emit("(")
+	if withBits {
+		ectx.Emit("bits uint32")
+		if len(names) > 0 {
+			ectx.Emit(", ")
+		}
+	}
+	if len(names) > 0 {
+		ectx.Emit("%s int64", strings.Join(names, ", "))
+	}
+	ectx.Emit(")")
+}
+
+func validatorFnNameForFormat(f *common.InsnFormat) string {
+	return "validate" + f.CanonicalRepr()
+}
+
+func encoderFnNameForFormat(f *common.InsnFormat) string {
+	return "encode" + f.CanonicalRepr()
+}
+
+func emitValidatorSignature(ectx *common.EmitterCtx, f *common.InsnFormat) {
+	ectx.Emit("func %s", validatorFnNameForFormat(f))
+	emitParamList(ectx, paramNamesForArgs(f.Args), false)
+	ectx.Emit(" error")
+}
+
+func emitValidatorForFormat(ectx *common.EmitterCtx, f *common.InsnFormat) {
+	paramNames := paramNamesForArgs(f.Args)
+
+	emitValidatorSignature(ectx, f)
+	ectx.Emit(" {\n")
+
+	// things to emit:
+	//
+	// for every arg X:
+	//     if err := want<arg type>(X's index, "argX", argX[, width]); err != nil {
+	//         return err
+	//     }
+	for argIdx, a := range f.Args {
+		name := paramNames[argIdx]
+
+		ectx.Emit("\tif err := ")
+
+		switch a.Kind {
+		case common.ArgKindIntReg, common.ArgKindFPReg, common.ArgKindFCCReg,
+			common.ArgKindScratchReg, common.ArgKindVReg, common.ArgKindXReg:
+			ectx.Emit("%s(%d, %q, %s)", regValidatorFnNameForKind(a.Kind), argIdx, name, name)
+		case common.ArgKindSignedImm:
+			ectx.Emit("wantSignedImm(%d, %q, %s, %d)", argIdx, name, name, a.TotalWidth())
+		case common.ArgKindUnsignedImm:
+			ectx.Emit("wantUnsignedImm(%d, %q, %s, %d)", argIdx, name, name, a.TotalWidth())
+		default:
+			panic("unreachable")
+		}
+
+		ectx.Emit("; err != nil {\n\t\treturn err\n\t}\n")
+	}
+
+	ectx.Emit("\treturn nil\n}\n\n")
+}
+
+// slotExprsForArg returns the expressions of the slot values of the arg, in
+// the order of the arg's slots.
+func slotExprsForArg(a *common.Arg, name string) []string {
+	result := make([]string, len(a.Slots))
+
+	// take example of Sd5k16:
+	//
+	// Sd5k16 = (MSB) DDDDDKKKKKKKKKKKKKKKK (LSB)
+	//
+	// the value offsets of the slots are 16 for d5 and 0 for k16,
+	// thus d5 = (sd5k16 >> 16) & 0b11111
+	// and k16 = (sd5k16 >> 0) & 0b1111111111111111
+	//         = sd5k16 & 0b1111111111111111
+	valueOffsets := a.SlotValueOffsets()
+	for i, s := range a.Slots {
+		mask := (uint64(1) << s.Width) - 1
+
+		var sb strings.Builder
+		sb.WriteString("(uint32(")
+		sb.WriteString(name)
+		sb.WriteString(")")
+		if valueOffsets[i] > 0 {
+			fmt.Fprintf(&sb, ">>%d", valueOffsets[i])
+		}
+		fmt.Fprintf(&sb, "&0x%x)", mask)
+		if s.Offset > 0 {
+			fmt.Fprintf(&sb, "<<%d", s.Offset)
+		}
+
+		result[i] = sb.String()
+	}
+
+	return result
+}
+
+// isRegOnlyFormat returns whether all args of the format are registers, and
+// there's at least one of them.
+func isRegOnlyFormat(f *common.InsnFormat) bool {
+	kinds := f.ArgKinds()
+	for _, k := range kinds {
+		if _, ok := k.RegClassMax(); !ok {
+			return false
+		}
+	}
+	return len(kinds) > 0
+}
+
+func emitEncoderSignature(ectx *common.EmitterCtx, f *common.InsnFormat) {
+	ectx.Emit("func %s", encoderFnNameForFormat(f))
+	emitParamList(ectx, paramNamesForArgs(f.Args), true)
+	ectx.Emit(" (uint32, error)")
+}
+
+func emitEncoderForFormat(ectx *common.EmitterCtx, f *common.InsnFormat) {
+	paramNames := paramNamesForArgs(f.Args)
+
+	emitEncoderSignature(ectx, f)
+	ectx.Emit(" {\n")
+
+	if isRegOnlyFormat(f) {
+		emitRegOnlyEncoderBody(ectx, f, paramNames)
+		return
+	}
+
+	if len(f.Args) > 0 {
+		ectx.Emit("\tif err := %s(%s); err != nil {\n", validatorFnNameForFormat(f), strings.Join(paramNames, ", "))
+		ectx.Emit("\t\treturn 0, err\n\t}\n\n")
+	}
+
+	ectx.Emit("\treturn bits")
+	for argIdx, a := range f.Args {
+		for _, expr := range slotExprsForArg(a, paramNames[argIdx]) {
+			ectx.Emit(" | %s", expr)
+		}
+	}
+	ectx.Emit(", nil\n}\n\n")
+}
+
+// emitRegOnlyEncoderBody emits the body of the encoder of a format with only
+// register args, that are checked inline to keep the hottest insns, like
+// add.w, off the generic validation path. The validator is only called for
+// the error once some register is known to be out of range:
+//
+//	if uint64(d) < 32 && uint64(j) < 32 && uint64(k) < 32 {
+//		return bits | ..., nil
+//	}
+//	return 0, validateDJK(d, j, k)
+func emitRegOnlyEncoderBody(ectx *common.EmitterCtx, f *common.InsnFormat, paramNames []string) {
+	conds := make([]string, len(f.Args))
+	for i, a := range f.Args {
+		max, _ := a.Kind.RegClassMax()
+		// negative values wrap around to huge ones
+		conds[i] = fmt.Sprintf("uint64(%s) < %d", paramNames[i], max+1)
+	}
+
+	ectx.Emit("\tif %s {\n", strings.Join(conds, " && "))
+	ectx.Emit("\t\treturn bits")
+	for argIdx, a := range f.Args {
+		for _, expr := range slotExprsForArg(a, paramNames[argIdx]) {
+			ectx.Emit(" | %s", expr)
+		}
+	}
+	ectx.Emit(", nil\n\t}\n")
+	ectx.Emit("\treturn 0, %s(%s)\n}\n\n", validatorFnNameForFormat(f), strings.Join(paramNames, ", "))
+}
+
+func emitBigEncoderFn(ectx *common.EmitterCtx, fmts []*common.InsnFormat) {
+	ectx.Emit(`// Encode encodes the instruction with the given mnemonic and operands, in
+// the canonical operand order.
+func Encode(mnemonic string, operands ...int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+
+	if arity := insnFormatArities[insn.fmt]; len(operands) != arity {
+		return 0, &ArityError{Mnemonic: mnemonic, Want: arity, Got: len(operands)}
+	}
+`)
+
+	if *shiftedImms {
+		ectx.Emit(`
+	operands, err := shiftOperands(mnemonic, operands)
+	if err != nil {
+		return 0, err
+	}
+`)
+	}
+
+	ectx.Emit(`
+	result, err := encodeInsn(insn, operands)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+func encodeInsn(insn insn, operands []int64) (uint32, error) {
+	switch insn.fmt {
+`)
+
+	for _, f := range fmts {
+		ectx.Emit("\tcase insnFormat%s:\n", f.CanonicalRepr())
+		ectx.Emit("\t\treturn %s(insn.bits", encoderFnNameForFormat(f))
+		for i := range f.Args {
+			ectx.Emit(", operands[%d]", i)
+		}
+		ectx.Emit(")\n")
+	}
+
+	ectx.Emit("\tdefault:\n\t\tpanic(\"should never happen: unknown insn format\")\n")
+	ectx.Emit("\t}\n}\n\n")
+}
+
+// operandsFromSlice returns the arguments passing the operands slice to a
+// per-format function, e.g. "operands[0], operands[1]".
+func operandsFromSlice(f *common.InsnFormat) string {
+	exprs := make([]string, len(f.Args))
+	for i := range f.Args {
+		exprs[i] = fmt.Sprintf("operands[%d]", i)
+	}
+	return strings.Join(exprs, ", ")
+}
+
+func emitFormatFnTables(ectx *common.EmitterCtx, fmts []*common.InsnFormat) {
+	ectx.Emit("// formatValidators maps formats to their validators, taking the operands as\n")
+	ectx.Emit("// a slice, whose length must be the arity of the format.\n")
+	ectx.Emit("var formatValidators = map[insnFormat]func(operands []int64) error{\n")
+	for _, f := range fmts {
+		ectx.Emit(
+			"\tinsnFormat%s: func(operands []int64) error {\n\t\treturn %s(%s)\n\t},\n",
+			f.CanonicalRepr(),
+			validatorFnNameForFormat(f),
+			operandsFromSlice(f),
+		)
+	}
+	ectx.Emit("}\n\n")
+
+	ectx.Emit("// formatEncoders maps formats to their encoders, taking the operands as a\n")
+	ectx.Emit("// slice, whose length must be the arity of the format.\n")
+	ectx.Emit("var formatEncoders = map[insnFormat]func(bits uint32, operands []int64) (uint32, error){\n")
+	for _, f := range fmts {
+		args := "bits"
+		if len(f.Args) > 0 {
+			args += ", " + operandsFromSlice(f)
+		}
+		ectx.Emit(
+			"\tinsnFormat%s: func(bits uint32, operands []int64) (uint32, error) {\n\t\treturn %s(%s)\n\t},\n",
+			f.CanonicalRepr(),
+			encoderFnNameForFormat(f),
+			args,
+		)
+	}
+	ectx.Emit("}\n\n")
+}
+
+func emitInsnTable(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit("// operandRole is an operand with a role, like the element index of\n")
+	ectx.Emit("// vpickve2gr.b, described in the errors by the role instead of the kind.\n")
+	ectx.Emit("type operandRole struct {\n")
+	ectx.Emit("\tidx  int\n")
+	ectx.Emit("\tkind string\n")
+	ectx.Emit("}\n\n")
+	ectx.Emit("type insn struct {\n")
+	ectx.Emit("\tbits uint32\n")
+	ectx.Emit("\tfmt  insnFormat\n")
+	ectx.Emit("\t// role is nil if no operand has a role.\n")
+	ectx.Emit("\trole *operandRole\n")
+	ectx.Emit("}\n\n")
+	ectx.Emit("var insns = map[string]insn{\n")
+
+	for _, d := range descs {
+		ectx.Emit(
+			"\t%q: {bits: 0x%08x, fmt: insnFormat%s",
+			d.Mnemonic,
+			d.Word,
+			d.Format.CanonicalRepr(),
+		)
+		for i, r := range d.ArgRoles() {
+			if r != common.ArgRoleNone {
+				ectx.Emit(", role: &operandRole{idx: %d, kind: %q}", i, common.DescribeArg(d.Format.Args[i], r))
+			}
+		}
+		ectx.Emit("},\n")
+	}
+
+	ectx.Emit("}\n")
+}
+
+func emitRelocOperandTable(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit("\ntype relocOperand struct {\n")
+	ectx.Emit("\tidx   int\n")
+	ectx.Emit("\twidth uint\n")
+	ectx.Emit("}\n\n")
+	ectx.Emit("// relocOperands maps mnemonics to their relocatable operand.\n")
+	ectx.Emit("var relocOperands = map[string]relocOperand{\n")
+
+	for _, d := range descs {
+		if idx := d.RelocArgIndex(); idx >= 0 {
+			ectx.Emit(
+				"\t%q: {idx: %d, width: %d},\n",
+				d.Mnemonic,
+				idx,
+				d.Format.Args[idx].TotalWidth(),
+			)
+		}
+	}
+
+	ectx.Emit("}\n")
+}
+
+// emitShiftedOperandTable emits the table of the operands the manual shifts
+// implicitly, and shiftOperands turning them from the values written in
+// assembly into the encoded ones for Encode, with -shifted-imms.
+func emitShiftedOperandTable(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit("\ntype shiftedOperand struct {\n")
+	ectx.Emit("\tidx   int\n")
+	ectx.Emit("\tname  string\n")
+	ectx.Emit("\tkind  string\n")
+	ectx.Emit("\tshift uint\n")
+	ectx.Emit("\tmin   int64\n")
+	ectx.Emit("\tmax   int64\n")
+	ectx.Emit("}\n\n")
+	ectx.Emit("// shiftedOperands maps mnemonics to their operands taken shifted left by\n")
+	ectx.Emit("// Encode, as written in assembly, with their range before the shift.\n")
+	ectx.Emit("var shiftedOperands = map[string][]shiftedOperand{\n")
+
+	for _, d := range descs {
+		var entries []string
+		for i, shift := range d.ArgShifts() {
+			if shift == 0 {
+				continue
+			}
+
+			a := d.Format.Args[i]
+			kind := "unsigned immediate"
+			if a.Kind == common.ArgKindSignedImm {
+				kind = "signed immediate"
+			}
+			entries = append(entries, fmt.Sprintf(
+				"{idx: %d, name: %q, kind: %q, shift: %d, min: %d, max: %d}",
+				i,
+				a.Name(),
+				kind,
+				shift,
+				a.MinValue()<<shift,
+				a.MaxValue()<<shift,
+			))
+		}
+		if len(entries) > 0 {
+			ectx.Emit("\t%q: {%s},\n", d.Mnemonic, strings.Join(entries, ", "))
+		}
+	}
+
+	ectx.Emit("}\n")
+
+	ectx.Emit(`
+// shiftOperands returns the operands of the insn with the shifted ones
+// shifted right, as encoded, after checking them.
+func shiftOperands(mnemonic string, operands []int64) ([]int64, error) {
+	shifted, ok := shiftedOperands[mnemonic]
+	if !ok {
+		return operands, nil
+	}
+
+	result := make([]int64, len(operands))
+	copy(result, operands)
+	for _, o := range shifted {
+		v := operands[o.idx]
+		if err := wantInRange(o.idx, o.name, o.kind, v, o.min, o.max); err != nil {
+			return nil, err
+		}
+		if v&(1<<o.shift-1) != 0 {
+			return nil, &AlignmentError{Index: o.idx, Name: o.name, Value: v, Multiple: 1 << o.shift}
+		}
+		result[o.idx] = v >> o.shift
+	}
+	return result, nil
+}
+`)
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+var regKinds = []common.ArgKind{
+	common.ArgKindIntReg,
+	common.ArgKindFPReg,
+	common.ArgKindFCCReg,
+	common.ArgKindScratchReg,
+	common.ArgKindVReg,
+	common.ArgKindXReg,
+}
+
+func regTypeNameForKind(k common.ArgKind) string {
+	switch k {
+	case common.ArgKindIntReg:
+		return "GPReg"
+	case common.ArgKindFPReg:
+		return "FPReg"
+	case common.ArgKindFCCReg:
+		return "FCCReg"
+	case common.ArgKindScratchReg:
+		return "ScratchReg"
+	case common.ArgKindVReg:
+		return "VReg"
+	case common.ArgKindXReg:
+		return "XReg"
+	default:
+		panic("unreachable")
+	}
+}
+
+func regValidatorFnNameForKind(k common.ArgKind) string {
+	switch k {
+	case common.ArgKindIntReg:
+		return "wantIntReg"
+	case common.ArgKindFPReg:
+		return "wantFPReg"
+	case common.ArgKindFCCReg:
+		return "wantFCCReg"
+	case common.ArgKindScratchReg:
+		return "wantScratchReg"
+	case common.ArgKindVReg:
+		return "wantVReg"
+	case common.ArgKindXReg:
+		return "wantXReg"
+	default:
+		panic("unreachable")
+	}
+}
+
+// emitRegValidators emits the range checks of the register operands, the
+// bounds taken from ArgKind.RegClassMax.
+func emitRegValidators(ectx *common.EmitterCtx) {
+	for _, k := range regKinds {
+		desc := common.DescribeArg(&common.Arg{Kind: k}, common.ArgRoleNone)
+		max, _ := k.RegClassMax()
+
+		ectx.Emit("\nfunc %s(idx int, name string, v int64) error {\n", regValidatorFnNameForKind(k))
+		ectx.Emit("\treturn wantInRange(idx, name, %q, v, 0, %d)\n", desc, max)
+		ectx.Emit("}\n")
+	}
+}
+
+func emitRegTypes(ectx *common.EmitterCtx) {
+	for _, k := range regKinds {
+		typeName := regTypeNameForKind(k)
+		desc := common.DescribeArg(&common.Arg{Kind: k}, common.ArgRoleNone)
+		max, _ := k.RegClassMax()
+
+		ectx.Emit("\n// %s is %s %s, only constructible with a valid number.\n", typeName, indefiniteArticle(desc), desc)
+		ectx.Emit("type %s struct {\n\tn uint8\n}\n\n", typeName)
+
+		ectx.Emit("// New%s returns the %s numbered n.\n", typeName, desc)
+		ectx.Emit("func New%s(n uint) (%s, error) {\n", typeName, typeName)
+		ectx.Emit("\tif n > %d {\n", max)
+		ectx.Emit("\t\treturn %s{}, &RegisterError{Kind: %q, Num: n, Max: %d}\n", typeName, desc, max)
+		ectx.Emit("\t}\n")
+		ectx.Emit("\treturn %s{n: uint8(n)}, nil\n", typeName)
+		ectx.Emit("}\n\n")
+
+		ectx.Emit("// Num returns the number of the register.\n")
+		ectx.Emit("func (r %s) Num() uint {\n\treturn uint(r.n)\n}\n", typeName)
+	}
+}
+
+// indefiniteArticle returns "a" or "an" for the phrase, with acronyms read
+// letter by letter.
+func indefiniteArticle(phrase string) string {
+	if strings.ContainsRune("aeiouAEFHILMNORSX", rune(phrase[0])) {
+		return "an"
+	}
+	return "a"
+}
+
+func typedEncoderFnNameForFormat(f *common.InsnFormat) string {
+	return "Encode" + f.CanonicalRepr()
+}
+
+func emitTypedEncoderSignature(ectx *common.EmitterCtx, f *common.InsnFormat) {
+	paramNames := paramNamesForArgs(f.Args)
+
+	ectx.Emit("func %s(mnemonic string", typedEncoderFnNameForFormat(f))
+	for i, a := range f.Args {
+		typ := "int64"
+		if !a.Kind.IsImm() {
+			typ = regTypeNameForKind(a.Kind)
+		}
+		ectx.Emit(", %s %s", paramNames[i], typ)
+	}
+	ectx.Emit(") (uint32, error)")
+}
+
+func emitTypedEncoderForFormat(ectx *common.EmitterCtx, f *common.InsnFormat) {
+	paramNames := paramNamesForArgs(f.Args)
+	fmtName := f.CanonicalRepr()
+
+	ectx.Emit("\n// Encode%s encodes the instruction of format %s with the given mnemonic.\n", fmtName, fmtName)
+	emitTypedEncoderSignature(ectx, f)
+	ectx.Emit(" {\n")
+
+	ectx.Emit("\tinsn, ok := insns[mnemonic]\n")
+	ectx.Emit("\tif !ok {\n\t\treturn 0, &UnknownMnemonicError{Mnemonic: mnemonic}\n\t}\n")
+	ectx.Emit("\tif insn.fmt != insnFormat%s {\n", fmtName)
+	ectx.Emit("\t\treturn 0, &FormatMismatchError{Mnemonic: mnemonic, Format: %q}\n\t}\n\n", fmtName)
+
+	ectx.Emit("\tresult, err := %s(insn.bits", encoderFnNameForFormat(f))
+	for i, a := range f.Args {
+		if a.Kind.IsImm() {
+			ectx.Emit(", %s", paramNames[i])
+		} else {
+			ectx.Emit(", int64(%s.Num())", paramNames[i])
+		}
+	}
+	ectx.Emit(")\n")
+	ectx.Emit("\tif err != nil {\n\t\treturn 0, applyOperandRole(insn, err)\n\t}\n")
+	ectx.Emit("\treturn result, nil\n}\n")
+}
@@ -0,0 +1,273 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"go/types"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func readCorpusForTest() ([]*common.InsnDescription, []*common.InsnFormat) {
+	descs := common.Builtin()
+	formats := common.GatherFormats(descs)
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+	return descs, formats
+}
+
+// topLevelDecls returns the printed top-level declarations of the files,
+// keyed by the name of the declared func, or of the first name declared by a
+// var, const or type declaration.
+func topLevelDecls(t *testing.T, fset *token.FileSet, files []*ast.File) map[string]string {
+	result := make(map[string]string)
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			var key string
+			switch d := decl.(type) {
+			case *ast.FuncDecl:
+				key = "func " + d.Name.Name
+				if d.Recv != nil {
+					var sb strings.Builder
+					printer.Fprint(&sb, fset, d.Recv.List[0].Type)
+					key = "func (" + sb.String() + ") " + d.Name.Name
+				}
+			case *ast.GenDecl:
+				switch s := d.Specs[0].(type) {
+				case *ast.ValueSpec:
+					key = d.Tok.String() + " " + s.Names[0].Name
+				case *ast.TypeSpec:
+					key = "type " + s.Name.Name
+				case *ast.ImportSpec:
+					continue
+				}
+			}
+
+			var buf bytes.Buffer
+			assert.NoError(t, printer.Fprint(&buf, fset, decl))
+			_, dup := result[key]
+			assert.False(t, dup, key)
+			result[key] = buf.String()
+		}
+	}
+	return result
+}
+
+func parseOutputs(t *testing.T, fset *token.FileSet, outputs [][]byte) []*ast.File {
+	var result []*ast.File
+	for i, src := range outputs {
+		f, err := parser.ParseFile(fset, fmt.Sprintf("out%d.go", i), src, parser.ParseComments)
+		if !assert.NoError(t, err) {
+			t.FailNow()
+		}
+		result = append(result, f)
+	}
+	return result
+}
+
+func TestGenerateSplit(t *testing.T) {
+	saved := *typedRegs
+	*typedRegs = true
+	defer func() { *typedRegs = saved }()
+
+	descs, formats := readCorpusForTest()
+
+	fset := token.NewFileSet()
+	whole := parseOutputs(t, fset, [][]byte{generate(descs, formats, "")})
+	split := parseOutputs(t, fset, generateSplit(descs, formats, 3, ""))
+	assert.Len(t, split, 4)
+
+	// the split output declares exactly the same things as the whole one
+	assert.Equal(t, topLevelDecls(t, fset, whole), topLevelDecls(t, fset, split))
+
+	// the per-format functions are all in the parts
+	shared := topLevelDecls(t, fset, split[:1])
+	for _, f := range formats {
+		assert.NotContains(t, shared, "func "+validatorFnNameForFormat(f))
+		assert.NotContains(t, shared, "func "+encoderFnNameForFormat(f))
+	}
+	for _, f := range split[1:] {
+		assert.NotEmpty(t, f.Decls)
+	}
+
+	// and the parts compile together with the hand-written part of laenc
+	handWritten, err := filepath.Glob("../laenc/*.go")
+	assert.NoError(t, err)
+	files := split
+	for _, path := range handWritten {
+		if strings.HasSuffix(path, "_test.go") || filepath.Base(path) == "insns.go" {
+			continue
+		}
+		f, err := parser.ParseFile(fset, path, nil, 0)
+		assert.NoError(t, err)
+		files = append(files, f)
+	}
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "source", nil)}
+	_, err = conf.Check("laenc", fset, files, nil)
+	assert.NoError(t, err)
+}
+
+func TestSlotExprsForArg(t *testing.T) {
+	f, err := common.ParseInsnFormat("JSd5k16")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"(uint32(v)>>16&0x1f)", "(uint32(v)&0xffff)<<10"}, slotExprsForArg(f.Args[1], "v"))
+
+	// the low bits in the slot at the higher offset
+	f, err = common.ParseInsnFormat("JSk16d5")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"(uint32(v)>>5&0xffff)<<10", "(uint32(v)&0x1f)"}, slotExprsForArg(f.Args[1], "v"))
+}
+
+func TestGenerateManifest(t *testing.T) {
+	saved := *typedRegs
+	*typedRegs = true
+	defer func() { *typedRegs = saved }()
+
+	descs, formats := readCorpusForTest()
+	out := generateManifest(descs, formats)
+	assert.Equal(t, out, generateManifest(descs, formats))
+
+	var manifest []formatManifest
+	assert.NoError(t, json.Unmarshal(out, &manifest))
+	assert.Len(t, manifest, len(formats))
+
+	// every listed function is in the checked-in laenc with the listed
+	// signature
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "../laenc/insns.go", nil, 0)
+	assert.NoError(t, err)
+	signatures := make(map[string]string)
+	for _, decl := range f.Decls {
+		if fd, ok := decl.(*ast.FuncDecl); ok && fd.Recv == nil {
+			header := *fd
+			header.Body = nil
+			header.Doc = nil
+			var buf bytes.Buffer
+			assert.NoError(t, printer.Fprint(&buf, fset, &header))
+			signatures[fd.Name.Name] = buf.String()
+		}
+	}
+
+	var djsk12 *formatManifest
+	for i, fm := range manifest {
+		assert.Len(t, fm.Functions, 3)
+		for _, fn := range fm.Functions {
+			assert.Equal(t, signatures[fn.Name], fn.Signature, fn.Name)
+		}
+		assert.NotEmpty(t, fm.Insns)
+		if fm.Format == "DJSk12" {
+			djsk12 = &manifest[i]
+		}
+	}
+
+	if assert.NotNil(t, djsk12) {
+		assert.Equal(t, "0b----------_ssssssssssss_jjjjj_ddddd", djsk12.Layout)
+		assert.Equal(t, operandManifest{
+			Name:   "sk12",
+			Kind:   "simm",
+			Signed: true,
+			Slots:  []slotManifest{{MSB: 21, LSB: 10, ValueLSB: 0}},
+		}, djsk12.Operands[2])
+		assert.Contains(t, djsk12.Insns, insnManifest{Mnemonic: "addi.w", Match: "0x02800000", Mask: "0xffc00000"})
+	}
+
+	// the value bits of split immediates are laid out MSB first
+	for _, fm := range manifest {
+		if fm.Format == "Sd5k16" {
+			assert.Equal(t, []slotManifest{{MSB: 4, LSB: 0, ValueLSB: 16}, {MSB: 25, LSB: 10, ValueLSB: 0}}, fm.Operands[0].Slots)
+		}
+	}
+}
+
+const shiftedImmsTestSrc = `package laenc
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestShiftedImms(t *testing.T) {
+	// b -16 and jirl $r1, $r2, 8
+	for _, tc := range []struct {
+		mnemonic string
+		operands []int64
+		expected uint32
+	}{
+		{"b", []int64{-16}, 0x53fff3ff},
+		{"jirl", []int64{1, 2, 8}, 0x4c000841},
+		// not shifted
+		{"addu16i.d", []int64{1, 2, 8}, 0x10002041},
+	} {
+		word, err := Encode(tc.mnemonic, tc.operands...)
+		if err != nil || word != tc.expected {
+			t.Errorf("%s %v: got 0x%08x, %v, want 0x%08x", tc.mnemonic, tc.operands, word, err, tc.expected)
+		}
+	}
+
+	var ae *AlignmentError
+	if _, err := Encode("b", 6); !errors.As(err, &ae) || ae.Multiple != 4 {
+		t.Errorf("b 6: got %v", err)
+	}
+
+	// the range is that before the shift
+	var oe *OperandError
+	if _, err := Encode("jirl", 1, 2, 1<<17); !errors.As(err, &oe) || oe.Max != 1<<17-4 {
+		t.Errorf("jirl 1, 2, 1<<17: got %v", err)
+	}
+	if _, err := Encode("jirl", 1, 2, 1<<17-4); err != nil {
+		t.Errorf("jirl 1, 2, 1<<17-4: got %v", err)
+	}
+}
+`
+
+func TestGenerateShiftedImms(t *testing.T) {
+	goCmd, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("no go command found")
+	}
+
+	for _, p := range []*bool{typedRegs, shiftedImms} {
+		saved := *p
+		*p = true
+		defer func(p *bool) { *p = saved }(p)
+	}
+
+	descs, formats := readCorpusForTest()
+
+	// build laenc with the generated insns.go in a module of its own
+	dir := t.TempDir()
+	paths, err := filepath.Glob("../laenc/*.go")
+	assert.NoError(t, err)
+	for _, p := range paths {
+		if strings.HasSuffix(p, "_test.go") || filepath.Base(p) == "insns.go" {
+			continue
+		}
+		content, err := os.ReadFile(p)
+		assert.NoError(t, err)
+		assert.NoError(t, os.WriteFile(filepath.Join(dir, filepath.Base(p)), content, 0644))
+	}
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "insns.go"), generate(descs, formats, ""), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "shift_test.go"), []byte(shiftedImmsTestSrc), 0644))
+	assert.NoError(t, os.WriteFile(filepath.Join(dir, "go.mod"), []byte("module laenc\n\ngo 1.19\n"), 0644))
+
+	cmd := exec.Command(goCmd, "test", "-run", "TestShiftedImms", ".")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	assert.NoError(t, err, string(out))
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+// formatManifest describes the functions generated for a format, and the bit
+// layout they implement, for auditing the generated code against the manual
+// without reading it.
+type formatManifest struct {
+	Format string `json:"format"`
+	// Layout is the bit pattern notation of the format, as in
+	// common.FormatInfo.
+	Layout    string             `json:"layout"`
+	Operands  []operandManifest  `json:"operands"`
+	Functions []functionManifest `json:"functions"`
+	Insns     []insnManifest     `json:"insns"`
+}
+
+type operandManifest struct {
+	Name   string         `json:"name"`
+	Kind   string         `json:"kind"`
+	Signed bool           `json:"signed"`
+	Slots  []slotManifest `json:"slots"`
+}
+
+// slotManifest tells that the insn bits MSB..LSB hold the operand value bits
+// starting from ValueLSB.
+type slotManifest struct {
+	MSB      uint `json:"msb"`
+	LSB      uint `json:"lsb"`
+	ValueLSB uint `json:"value_lsb"`
+}
+
+type functionManifest struct {
+	Name      string `json:"name"`
+	Signature string `json:"signature"`
+}
+
+// insnManifest tells that a word w is the insn iff w&Mask == Match.
+type insnManifest struct {
+	Mnemonic string `json:"mnemonic"`
+	Match    string `json:"match"`
+	Mask     string `json:"mask"`
+}
+
+// generateManifest returns the JSON manifest of the functions generate emits
+// per format, in the order of formats.
+func generateManifest(descs []*common.InsnDescription, formats []*common.InsnFormat) []byte {
+	sorted := make([]*common.InsnDescription, len(descs))
+	copy(sorted, descs)
+	sort.Slice(sorted, func(i int, j int) bool {
+		return sorted[i].Word < sorted[j].Word
+	})
+
+	insnsByFormat := make(map[string][]insnManifest)
+	for _, d := range sorted {
+		repr := d.Format.CanonicalRepr()
+		insnsByFormat[repr] = append(insnsByFormat[repr], insnManifest{
+			Mnemonic: d.Mnemonic,
+			Match:    fmt.Sprintf("0x%08x", d.Word),
+			Mask:     fmt.Sprintf("0x%08x", d.FixedMask()),
+		})
+	}
+
+	layouts := make(map[string]string)
+	for _, fi := range common.FormatCatalog(descs) {
+		layouts[fi.Format] = fi.Layout
+	}
+
+	result := make([]formatManifest, len(formats))
+	for i, f := range formats {
+		repr := f.CanonicalRepr()
+
+		paramNames := paramNamesForArgs(f.Args)
+		operands := make([]operandManifest, len(f.Args))
+		for j, a := range f.Args {
+			valueOffsets := a.SlotValueOffsets()
+			slots := make([]slotManifest, len(a.Slots))
+			for k, s := range a.Slots {
+				slots[k] = slotManifest{
+					MSB:      s.Offset + s.Width - 1,
+					LSB:      s.Offset,
+					ValueLSB: valueOffsets[k],
+				}
+			}
+
+			operands[j] = operandManifest{
+				Name:   paramNames[j],
+				Kind:   a.Kind.SpecName(),
+				Signed: a.Kind == common.ArgKindSignedImm,
+				Slots:  slots,
+			}
+		}
+
+		functions := []functionManifest{
+			{validatorFnNameForFormat(f), renderSignature(emitValidatorSignature, f)},
+			{encoderFnNameForFormat(f), renderSignature(emitEncoderSignature, f)},
+		}
+		if *typedRegs {
+			functions = append(functions, functionManifest{typedEncoderFnNameForFormat(f), renderSignature(emitTypedEncoderSignature, f)})
+		}
+
+		result[i] = formatManifest{
+			Format:    repr,
+			Layout:    layouts[repr],
+			Operands:  operands,
+			Functions: functions,
+			Insns:     insnsByFormat[repr],
+		}
+	}
+
+	b, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+	return append(b, '\n')
+}
+
+// renderSignature returns the function header emitted by emitSignature, as
+// it reads in the generated code once gofmt'ed.
+func renderSignature(emitSignature func(*common.EmitterCtx, *common.InsnFormat), f *common.InsnFormat) string {
+	ectx := common.EmitterCtx{
+		DontGofmt: true,
+	}
+	emitSignature(&ectx, f)
+	return string(ectx.Finalize())
+}
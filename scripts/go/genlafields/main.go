@@ -0,0 +1,227 @@
+// Command genlafields emits accessors reading and writing the operand fields
+// of already encoded insn words, for the laenc package: e.g. GetRk and SetRk
+// for the register field at bits 14..10, or GetSd5k16 for the offset of beqz,
+// concatenated from two slots and sign-extended. The fields are those of all
+// args of the described insns, the register fields being named after their
+// slot regardless of the register class.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+var pkgName = flag.String("pkg", "laenc", "package name of the generated file")
+
+func main() {
+	flag.Parse()
+
+	descs, err := common.ReadInsnDescs(flag.Args())
+	if err != nil {
+		panic(err)
+	}
+
+	os.Stdout.Write(generate(gatherFields(descs)))
+}
+
+// field is an operand field of insn words, made of one or more slots.
+type field struct {
+	// name is e.g. "Rd" or "Sd5k16"
+	name   string
+	signed bool
+	slots  []*common.Slot
+}
+
+func (f *field) width() uint {
+	var result uint
+	for _, s := range f.slots {
+		result += s.Width
+	}
+	return result
+}
+
+// valueOffsets returns the positions of the slots' LSBs in the field value,
+// like common.Arg.SlotValueOffsets.
+func (f *field) valueOffsets() []uint {
+	return (&common.Arg{Slots: f.slots}).SlotValueOffsets()
+}
+
+func (f *field) mask() uint32 {
+	var result uint32
+	for _, s := range f.slots {
+		result |= s.Bitmask()
+	}
+	return result
+}
+
+// valueType is the Go type of the values of the field.
+func (f *field) valueType() string {
+	if f.signed {
+		return "int32"
+	}
+	return "uint32"
+}
+
+// fieldNameForArg returns the name of the field of the arg.
+func fieldNameForArg(a *common.Arg) string {
+	var sb strings.Builder
+	switch a.Kind {
+	case common.ArgKindIntReg, common.ArgKindFPReg, common.ArgKindVReg, common.ArgKindXReg:
+		sb.WriteByte('R')
+	case common.ArgKindFCCReg:
+		sb.WriteByte('C')
+	case common.ArgKindScratchReg:
+		sb.WriteByte('T')
+	case common.ArgKindSignedImm:
+		sb.WriteByte('S')
+	case common.ArgKindUnsignedImm:
+		sb.WriteByte('U')
+	default:
+		panic("unreachable")
+	}
+
+	if !a.Kind.IsImm() {
+		sb.WriteString(a.Slots[0].CanonicalRepr()[:1])
+		return sb.String()
+	}
+	for _, s := range a.Slots {
+		sb.WriteString(s.CanonicalRepr())
+	}
+	return sb.String()
+}
+
+// gatherFields returns the distinct fields of all args of descs, sorted by
+// name.
+func gatherFields(descs []*common.InsnDescription) []*field {
+	fields := make(map[string]*field)
+	for _, d := range descs {
+		for _, a := range d.Format.Args {
+			name := fieldNameForArg(a)
+			if _, ok := fields[name]; ok {
+				continue
+			}
+			fields[name] = &field{
+				name:   name,
+				signed: a.Kind == common.ArgKindSignedImm,
+				slots:  a.Slots,
+			}
+		}
+	}
+
+	result := make([]*field, 0, len(fields))
+	for _, f := range fields {
+		result = append(result, f)
+	}
+	sort.Slice(result, func(i int, j int) bool {
+		return result[i].name < result[j].name
+	})
+	return result
+}
+
+func generate(fields []*field) []byte {
+	var ectx common.EmitterCtx
+
+	ectx.Emit("// Code generated by genlafields from loongson-community/loongarch-opcodes; DO NOT EDIT.\n\n")
+	ectx.Emit("package %s\n\n", *pkgName)
+
+	for _, f := range fields {
+		emitGetter(&ectx, f)
+		emitSetter(&ectx, f)
+	}
+	emitFieldTable(&ectx, fields)
+
+	return ectx.Finalize()
+}
+
+// bitsDesc describes the bits of the field, e.g. "bits 4..0" or "bits 4..0
+// and 25..10".
+func bitsDesc(f *field) string {
+	ranges := make([]string, len(f.slots))
+	for i, s := range f.slots {
+		ranges[i] = fmt.Sprintf("%d..%d", s.MSB(), s.Offset)
+	}
+	if len(ranges) == 1 {
+		return "bits " + ranges[0]
+	}
+	return "bits " + strings.Join(ranges[:len(ranges)-1], ", ") + " and " + ranges[len(ranges)-1]
+}
+
+func emitGetter(ectx *common.EmitterCtx, f *field) {
+	var parts []string
+	valueOffsets := f.valueOffsets()
+	for i, s := range f.slots {
+		part := "word"
+		if s.Offset > 0 {
+			part = fmt.Sprintf("word>>%d", s.Offset)
+		}
+		part = fmt.Sprintf("%s&0x%x", part, (uint64(1)<<s.Width)-1)
+		if valueOffsets[i] > 0 {
+			part = fmt.Sprintf("(%s)<<%d", part, valueOffsets[i])
+		}
+		parts = append(parts, part)
+	}
+	expr := strings.Join(parts, " | ")
+
+	ectx.Emit("// Get%s returns the %s field of the insn word, at %s", f.name, strings.ToLower(f.name), bitsDesc(f))
+	if f.signed {
+		ectx.Emit(",\n// sign-extended")
+	}
+	ectx.Emit(".\n")
+	ectx.Emit("func Get%s(word uint32) %s {\n", f.name, f.valueType())
+	if f.signed {
+		shamt := 32 - f.width()
+		if len(parts) > 1 {
+			expr = "(" + expr + ")"
+		}
+		ectx.Emit("\treturn int32(%s<<%d) >> %d\n", expr, shamt, shamt)
+	} else {
+		ectx.Emit("\treturn %s\n", expr)
+	}
+	ectx.Emit("}\n\n")
+}
+
+func emitSetter(ectx *common.EmitterCtx, f *field) {
+	v := "v"
+	if f.signed {
+		v = "uint32(v)"
+	}
+
+	var parts []string
+	valueOffsets := f.valueOffsets()
+	for i, s := range f.slots {
+		part := v
+		if valueOffsets[i] > 0 {
+			part = fmt.Sprintf("%s>>%d", part, valueOffsets[i])
+		}
+		part = fmt.Sprintf("%s&0x%x", part, (uint64(1)<<s.Width)-1)
+		if s.Offset > 0 {
+			part = fmt.Sprintf("(%s)<<%d", part, s.Offset)
+		}
+		parts = append(parts, part)
+	}
+
+	ectx.Emit("// Set%s returns the insn word with the %s field set to v, truncated to\n", f.name, strings.ToLower(f.name))
+	ectx.Emit("// its %d bits.\n", f.width())
+	ectx.Emit("func Set%s(word uint32, v %s) uint32 {\n", f.name, f.valueType())
+	ectx.Emit("\treturn word&^0x%08x | %s\n", f.mask(), strings.Join(parts, " | "))
+	ectx.Emit("}\n\n")
+}
+
+func emitFieldTable(ectx *common.EmitterCtx, fields []*field) {
+	ectx.Emit("// fields maps the names of the fields in lower case to their accessors.\n")
+	ectx.Emit("var fields = map[string]fieldAccessor{\n")
+	for _, f := range fields {
+		ectx.Emit("\t%q: {\n", strings.ToLower(f.name))
+		ectx.Emit("\t\twidth: %d,\n", f.width())
+		ectx.Emit("\t\tsigned: %t,\n", f.signed)
+		ectx.Emit("\t\tget: func(word uint32) int64 { return int64(Get%s(word)) },\n", f.name)
+		ectx.Emit("\t\tset: func(word uint32, v int64) uint32 { return Set%s(word, %s(v)) },\n", f.name, f.valueType())
+		ectx.Emit("\t},\n")
+	}
+	ectx.Emit("}\n")
+}
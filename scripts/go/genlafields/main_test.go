@@ -0,0 +1,26 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestFieldNameForArg(t *testing.T) {
+	f, err := common.ParseInsnFormat("FdJSd5k16")
+	assert.NoError(t, err)
+	assert.Equal(t, "Rd", fieldNameForArg(f.Args[0]))
+	assert.Equal(t, "Rj", fieldNameForArg(f.Args[1]))
+	assert.Equal(t, "Sd5k16", fieldNameForArg(f.Args[2]))
+}
+
+// TestGenerateUpToDate checks that the accessors in laenc are regenerated
+// after changes to the fields used by the insns.
+func TestGenerateUpToDate(t *testing.T) {
+	expected, err := os.ReadFile("../laenc/fields.go")
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(generate(gatherFields(common.Builtin()))), "run go generate ./laenc")
+}
@@ -0,0 +1,273 @@
+// Command genlamacros emits the expander of the assembler macros described
+// by the "macro" lines of the description files, for the laenc package. Every
+// description of a macro becomes a function encoding its expansion with
+// laenc.Encode, or laenc.EncodeWithReloc for the insns referring to a symbol.
+// The macros materializing the address of a symbol, like la.local, also
+// become AddrIdiom constants for EncodeAddrIdiom.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+var pkgName = flag.String("pkg", "laenc", "package name of the generated file")
+
+// relocTypeNames maps the ELF relocation names to the laenc.RelocType
+// constants.
+var relocTypeNames = map[string]string{
+	"R_LARCH_ABS_HI20":    "RelocAbsHi20",
+	"R_LARCH_ABS_LO12":    "RelocAbsLo12",
+	"R_LARCH_ABS64_LO20":  "RelocAbs64Lo20",
+	"R_LARCH_ABS64_HI12":  "RelocAbs64Hi12",
+	"R_LARCH_PCALA_HI20":  "RelocPCALAHi20",
+	"R_LARCH_PCALA_LO12":  "RelocPCALALo12",
+	"R_LARCH_GOT_PC_HI20": "RelocGOTPCHi20",
+	"R_LARCH_GOT_PC_LO12": "RelocGOTPCLo12",
+}
+
+func main() {
+	flag.Parse()
+	inputs := flag.Args()
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	macros, err := common.ReadMacroDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, m := range macros {
+		err = m.Validate(descs)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	os.Stdout.Write(generate(macros))
+}
+
+func generate(macros []*common.MacroDescription) []byte {
+	var ectx common.EmitterCtx
+
+	ectx.Emit("// Code generated by genlamacros from loongson-community/loongarch-opcodes; DO NOT EDIT.\n\n")
+	ectx.Emit("package %s\n\n", *pkgName)
+
+	var names []string
+	overloads := make(map[string][]*common.MacroDescription)
+	for _, m := range macros {
+		if _, ok := overloads[m.Name]; !ok {
+			names = append(names, m.Name)
+		}
+		overloads[m.Name] = append(overloads[m.Name], m)
+	}
+
+	emitMacroTable(&ectx, names, overloads)
+	emitAddrIdioms(&ectx, names, overloads)
+	for _, name := range names {
+		for i, m := range overloads[name] {
+			emitExpanderFn(&ectx, m, expanderFnName(name, i))
+		}
+	}
+
+	return ectx.Finalize()
+}
+
+// expanderFnName returns the name of the function expanding the i-th
+// description of the macro, e.g. "expandLiW0" for the first one of li.w.
+func expanderFnName(name string, i int) string {
+	return fmt.Sprintf("expand%s%d", camelCase(name), i)
+}
+
+// camelCase turns a name separated by dots or underscores into upper camel
+// case, e.g. "la.local" into "LaLocal".
+func camelCase(name string) string {
+	var sb strings.Builder
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool { return r == '.' || r == '_' }) {
+		sb.WriteString(strings.ToUpper(part[:1]))
+		sb.WriteString(part[1:])
+	}
+	return sb.String()
+}
+
+// modifierFnName returns the name of the laenc function evaluating the
+// modifier, e.g. "absHi20" for abs_hi20.
+func modifierFnName(modifier string) string {
+	name := camelCase(modifier)
+	return strings.ToLower(name[:1]) + name[1:]
+}
+
+func emitMacroTable(ectx *common.EmitterCtx, names []string, overloads map[string][]*common.MacroDescription) {
+	ectx.Emit("// macros maps the macro names to their descriptions, in the order of\n")
+	ectx.Emit("// preference.\n")
+	ectx.Emit("var macros = map[string][]macroOverload{\n")
+	for _, name := range names {
+		ectx.Emit("\t%q: {\n", name)
+		for i, m := range overloads[name] {
+			ectx.Emit("\t\t{\n\t\t\toperands: []macroOperand{")
+			for j, o := range m.Operands {
+				if j > 0 {
+					ectx.Emit(", ")
+				}
+				switch o.Kind {
+				case common.MacroOperandKindReg:
+					ectx.Emit("{name: %q, kind: macroOperandReg}", o.Name)
+				case common.MacroOperandKindImm:
+					ectx.Emit("{name: %q, kind: macroOperandImm, signed: %t, width: %d}", o.Name, o.Signed, o.Width)
+				case common.MacroOperandKindSym:
+					ectx.Emit("{name: %q, kind: macroOperandSym}", o.Name)
+				default:
+					panic("unreachable")
+				}
+			}
+			ectx.Emit("},\n")
+			ectx.Emit("\t\t\texpand: %s,\n\t\t},\n", expanderFnName(name, i))
+		}
+		ectx.Emit("\t},\n")
+	}
+	ectx.Emit("}\n\n")
+}
+
+// addrIdiomConstName returns the name of the AddrIdiom constant of the macro,
+// e.g. "IdiomLaLocal" for la.local.
+func addrIdiomConstName(name string) string {
+	return "Idiom" + camelCase(name)
+}
+
+// emitAddrIdioms emits the AddrIdiom constants of the macros materializing
+// the address of a symbol, and EncodeAddrIdiom expanding them. Overloaded
+// macros are left out, as an idiom has a single expansion.
+func emitAddrIdioms(ectx *common.EmitterCtx, names []string, overloads map[string][]*common.MacroDescription) {
+	var idioms []*common.MacroDescription
+	for _, name := range names {
+		if len(overloads[name]) == 1 && overloads[name][0].IsAddrIdiom() {
+			idioms = append(idioms, overloads[name][0])
+		}
+	}
+
+	ectx.Emit(`// AddrIdiom is an idiom materializing the address of a symbol in a register,
+// as expanded by the assemblers from the la.* macros.
+type AddrIdiom int
+
+`)
+
+	ectx.Emit("const (\n")
+	for i, m := range idioms {
+		ectx.Emit("\t// %s is %s, expanding to:\n\t//\n", addrIdiomConstName(m.Name), m.Name)
+		for _, insn := range m.Expansion {
+			idx := m.RelocOperandIndex(insn)
+			if idx < 0 {
+				ectx.Emit("\t//\t%s\n", insn.Mnemonic)
+				continue
+			}
+			mod, _ := common.LookupMacroModifier(insn.Operands[idx].Modifier)
+			ectx.Emit("\t//\t%-10s %s\n", insn.Mnemonic, mod.Reloc)
+		}
+		if i == 0 {
+			ectx.Emit("\t%s AddrIdiom = iota\n", addrIdiomConstName(m.Name))
+		} else {
+			ectx.Emit("\t%s\n", addrIdiomConstName(m.Name))
+		}
+	}
+	ectx.Emit(")\n\n")
+
+	ectx.Emit("var addrIdiomMacros = [...]string{\n")
+	for _, m := range idioms {
+		ectx.Emit("\t%s: %q,\n", addrIdiomConstName(m.Name), m.Name)
+	}
+	ectx.Emit("}\n\n")
+
+	ectx.Emit(`// EncodeAddrIdiom encodes the insn sequence of the idiom loading the address
+// of sym plus addend into the integer register rd, with the relocatable
+// operands zeroed, as EncodeWithReloc does. The relocation record relocs[i]
+// applies to words[i], that is at byte offset 4*i of the sequence; all of
+// them carry the addend, like the records emitted by GNU as.
+//
+// This is ExpandMacro for the macro of the idiom.
+func EncodeAddrIdiom(idiom AddrIdiom, rd int64, sym string, addend int64) (words []uint32, relocs []RelocRecord, err error) {
+	if idiom < 0 || int(idiom) >= len(addrIdiomMacros) {
+		panic("unknown address idiom")
+	}
+
+	return ExpandMacro(addrIdiomMacros[idiom], sym, rd, addend)
+}
+
+`)
+}
+
+// operandExpr returns the Go expression of the insn operand.
+func operandExpr(m *common.MacroDescription, o *common.MacroInsnOperand) string {
+	if o.Ref == "" {
+		return fmt.Sprint(o.Value)
+	}
+
+	var idx int
+	for i, mo := range m.Operands {
+		if mo.Name == o.Ref {
+			idx = i
+		}
+	}
+	expr := fmt.Sprintf("operands[%d]", idx)
+
+	// modifiers on symbols are applied by the linker, the operand being the
+	// addend
+	if o.Modifier != "" && m.Operand(o.Ref).Kind == common.MacroOperandKindImm {
+		return fmt.Sprintf("%s(%s)", modifierFnName(o.Modifier), expr)
+	}
+	return expr
+}
+
+func emitExpanderFn(ectx *common.EmitterCtx, m *common.MacroDescription, fnName string) {
+	symUsed := false
+	for _, insn := range m.Expansion {
+		if m.RelocOperandIndex(insn) >= 0 {
+			symUsed = true
+		}
+	}
+
+	symParam := "_"
+	if symUsed {
+		symParam = "sym"
+	}
+	operandsParam := "_"
+	if len(m.Operands) > 0 {
+		operandsParam = "operands"
+	}
+
+	ectx.Emit("func %s(%s string, %s []int64) ([]uint32, []RelocRecord, error) {\n", fnName, symParam, operandsParam)
+	ectx.Emit("\twords := make([]uint32, %d)\n", len(m.Expansion))
+	ectx.Emit("\trelocs := make([]RelocRecord, %d)\n", len(m.Expansion))
+	ectx.Emit("\tvar err error\n\n")
+
+	for i, insn := range m.Expansion {
+		exprs := make([]string, len(insn.Operands))
+		for j, o := range insn.Operands {
+			exprs[j] = operandExpr(m, o)
+		}
+
+		if idx := m.RelocOperandIndex(insn); idx >= 0 {
+			mod, _ := common.LookupMacroModifier(insn.Operands[idx].Modifier)
+			relocType, ok := relocTypeNames[mod.Reloc]
+			if !ok {
+				panic(fmt.Sprintf("unsupported relocation %s", mod.Reloc))
+			}
+			ectx.Emit(
+				"\twords[%d], relocs[%d], err = EncodeWithReloc(%q, []int64{%s}, sym, %s)\n",
+				i, i, insn.Mnemonic, strings.Join(exprs, ", "), relocType,
+			)
+		} else {
+			args := append([]string{fmt.Sprintf("%q", insn.Mnemonic)}, exprs...)
+			ectx.Emit("\twords[%d], err = Encode(%s)\n", i, strings.Join(args, ", "))
+		}
+		ectx.Emit("\tif err != nil {\n\t\treturn nil, nil, err\n\t}\n")
+	}
+
+	ectx.Emit("\n\treturn words, relocs, nil\n}\n\n")
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestCamelCase(t *testing.T) {
+	assert.Equal(t, "LaLocal", camelCase("la.local"))
+	assert.Equal(t, "LiW", camelCase("li.w"))
+	assert.Equal(t, "IdiomLaLocal", addrIdiomConstName("la.local"))
+	assert.Equal(t, "absHi20", modifierFnName("abs_hi20"))
+	assert.Equal(t, "abs64Lo20", modifierFnName("abs64_lo20"))
+}
+
+// TestGenerateUpToDate checks that the expander in laenc is regenerated after
+// changes to the macros.
+func TestGenerateUpToDate(t *testing.T) {
+	paths, err := filepath.Glob("../../../*.txt")
+	assert.NoError(t, err)
+
+	macros, err := common.ReadMacroDescs(paths)
+	assert.NoError(t, err)
+
+	expected, err := os.ReadFile("../laenc/macros.go")
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(generate(macros)), "run go generate ./laenc")
+}
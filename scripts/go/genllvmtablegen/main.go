@@ -0,0 +1,277 @@
+// Command genllvmtablegen emits LLVM TableGen definitions of the insns, one
+// def per insn with the fixed opcode bits and the operand fields assigned to
+// the Inst bits:
+//
+//	def ADDI_D : LAInst<(outs GPR:$d), (ins GPR:$j, simm12:$sk12),
+//	    "addi.d", "$d, $j, $sk12"> {
+//	  bits<5> d;
+//	  bits<5> j;
+//	  bits<12> sk12;
+//
+//	  let Inst{31-22} = 0b0000001011;
+//	  let Inst{21-10} = sk12;
+//	  let Inst{9-5} = j;
+//	  let Inst{4-0} = d;
+//	}
+//
+// The operands are named after the args, and args spanning several slots are
+// assigned one Inst range per slot. The written registers are the outs, and
+// everything else the ins. The register classes (GPR, FPR, CFR, SCR, LSX128
+// and LASX256) are left to the including target to define, while the
+// immediate operands and the LAInst base class are emitted here.
+//
+// Like genqemutcgdefs, only the insns selected by -select are emitted, the
+// @llvm ones by default, so that insns can be brought over incrementally.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+var insnSelector = common.AttribSelectorFlag(flag.CommandLine, "llvm")
+
+func main() {
+	flag.Parse()
+
+	// like genqemutcgdefs, take all instruction description files and
+	// filter by attrib
+	inputs, err := filepath.Glob("../../*.txt")
+	if err != nil {
+		panic(err)
+	}
+
+	descs, err := common.ReadInsnDescsFiltered(inputs, insnSelector.Matches)
+	if err != nil {
+		panic(err)
+	}
+
+	err = common.CheckArgKinds(descs, supportedArgKinds())
+	if err != nil {
+		panic(err)
+	}
+
+	os.Stdout.Write(generate(descs, common.MustGetGitCommitHash()))
+}
+
+func supportedArgKinds() []common.ArgKind {
+	result := []common.ArgKind{
+		common.ArgKindSignedImm,
+		common.ArgKindUnsignedImm,
+	}
+	for k := range regClasses {
+		result = append(result, k)
+	}
+	return result
+}
+
+func generate(descs []*common.InsnDescription, commitHash string) []byte {
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+
+	ectx := common.EmitterCtx{
+		DontGofmt: true,
+	}
+
+	ectx.Emit("//===- LoongArch instruction definitions ----------------------*- tablegen -*-===//\n")
+	ectx.Emit("//\n")
+	ectx.Emit("// This file is auto-generated by genllvmtablegen from\n")
+	ectx.Emit("// https://github.com/loongson-community/loongarch-opcodes,\n")
+	ectx.Emit("// from commit %s.\n", commitHash)
+	ectx.Emit("// DO NOT EDIT.\n")
+	ectx.Emit("//\n")
+	ectx.Emit("//===----------------------------------------------------------------------===//\n")
+
+	emitBaseClass(&ectx)
+	emitImmOperands(&ectx, descs)
+	for _, d := range descs {
+		emitInsnDef(&ectx, d)
+	}
+
+	return ectx.Finalize()
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+var regClasses = map[common.ArgKind]string{
+	common.ArgKindIntReg:     "GPR",
+	common.ArgKindFPReg:      "FPR",
+	common.ArgKindFCCReg:     "CFR",
+	common.ArgKindScratchReg: "SCR",
+	common.ArgKindVReg:       "LSX128",
+	common.ArgKindXReg:       "LASX256",
+}
+
+// operandType returns the register class or the immediate operand of the
+// arg, e.g. "GPR" or "simm12".
+func operandType(a *common.Arg) string {
+	if rc, ok := regClasses[a.Kind]; ok {
+		return rc
+	}
+
+	switch a.Kind {
+	case common.ArgKindSignedImm:
+		return fmt.Sprintf("simm%d", a.TotalWidth())
+	case common.ArgKindUnsignedImm:
+		return fmt.Sprintf("uimm%d", a.TotalWidth())
+	default:
+		panic("should never happen")
+	}
+}
+
+func emitBaseClass(ectx *common.EmitterCtx) {
+	ectx.Emit("\nclass LAInst<dag outs, dag ins, string opcstr, string opnstr>\n")
+	ectx.Emit("    : Instruction {\n")
+	ectx.Emit("  field bits<32> Inst;\n")
+	ectx.Emit("  field bits<32> SoftFail = 0;\n")
+	ectx.Emit("  let Namespace = \"LoongArch\";\n")
+	ectx.Emit("  let Size = 4;\n")
+	ectx.Emit("  let OutOperandList = outs;\n")
+	ectx.Emit("  let InOperandList = ins;\n")
+	ectx.Emit("  let AsmString = opcstr # \"\\t\" # opnstr;\n")
+	ectx.Emit("}\n")
+}
+
+// emitImmOperands emits the immediate operands used by the insns, sorted by
+// name.
+func emitImmOperands(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	predicates := make(map[string]string)
+	for _, d := range descs {
+		for _, a := range d.Format.Args {
+			switch a.Kind {
+			case common.ArgKindSignedImm:
+				predicates[operandType(a)] = fmt.Sprintf("isInt<%d>(Imm)", a.TotalWidth())
+			case common.ArgKindUnsignedImm:
+				predicates[operandType(a)] = fmt.Sprintf("isUInt<%d>(Imm)", a.TotalWidth())
+			}
+		}
+	}
+	if len(predicates) == 0 {
+		return
+	}
+
+	names := make([]string, 0, len(predicates))
+	for name := range predicates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	ectx.Emit("\n")
+	for _, name := range names {
+		ectx.Emit("def %s : Operand<i64>, ImmLeaf<i64, [{return %s;}]>;\n", name, predicates[name])
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+func defNameForInsn(mnemonic string) string {
+	return strings.ToUpper(strings.ReplaceAll(mnemonic, ".", "_"))
+}
+
+// instAssignment is the assignment of a value to a range of the Inst bits.
+type instAssignment struct {
+	r     common.BitRange
+	value string
+}
+
+// instRange returns the TableGen notation of the bit range, e.g. "31-15", or
+// "3" for a single bit.
+func instRange(r common.BitRange) string {
+	if r.MSB == r.LSB {
+		return fmt.Sprintf("%d", r.LSB)
+	}
+	return fmt.Sprintf("%d-%d", r.MSB, r.LSB)
+}
+
+// instAssignmentsForInsn returns the assignments to the Inst bits of the
+// insn, from the MSB to the LSB.
+func instAssignmentsForInsn(d *common.InsnDescription) []instAssignment {
+	var result []instAssignment
+	for _, r := range d.FixedBitRanges() {
+		width := r.MSB - r.LSB + 1
+		value := (d.Word >> r.LSB) & (1<<width - 1)
+		result = append(result, instAssignment{
+			r:     r,
+			value: fmt.Sprintf("0b%0*b", width, value),
+		})
+	}
+
+	for _, a := range d.Format.Args {
+		if len(a.Slots) == 1 {
+			result = append(result, instAssignment{r: a.BitRanges()[0], value: a.Name()})
+			continue
+		}
+
+		// one assignment per slot, taking the bits of the operand at the
+		// value offset of the slot
+		valueOffsets := a.SlotValueOffsets()
+		for i, r := range a.BitRanges() {
+			valueRange := common.BitRange{
+				MSB: valueOffsets[i] + a.Slots[i].Width - 1,
+				LSB: valueOffsets[i],
+			}
+			result = append(result, instAssignment{
+				r:     r,
+				value: fmt.Sprintf("%s{%s}", a.Name(), instRange(valueRange)),
+			})
+		}
+	}
+
+	sort.Slice(result, func(i int, j int) bool {
+		return result[i].r.MSB > result[j].r.MSB
+	})
+	return result
+}
+
+// operandList returns the dag of the operands, e.g. "(ins GPR:$j, GPR:$k)".
+func operandList(op string, operands []string) string {
+	if len(operands) == 0 {
+		return "(" + op + ")"
+	}
+	return "(" + op + " " + strings.Join(operands, ", ") + ")"
+}
+
+func emitInsnDef(ectx *common.EmitterCtx, d *common.InsnDescription) {
+	var outs, ins []string
+	for i, a := range d.Format.Args {
+		operand := fmt.Sprintf("%s:$%s", operandType(a), a.Name())
+		if d.IsOutput(i) {
+			outs = append(outs, operand)
+		} else {
+			ins = append(ins, operand)
+		}
+	}
+
+	var syntaxOperands []string
+	for _, i := range d.SyntaxArgIndices() {
+		syntaxOperands = append(syntaxOperands, "$"+d.Format.Args[i].Name())
+	}
+
+	ectx.Emit(
+		"\ndef %s : LAInst<%s, %s,\n",
+		defNameForInsn(d.Mnemonic),
+		operandList("outs", outs),
+		operandList("ins", ins),
+	)
+	ectx.Emit("    %q, %q> {\n", d.Mnemonic, strings.Join(syntaxOperands, ", "))
+
+	for _, a := range d.Format.Args {
+		ectx.Emit("  bits<%d> %s;\n", a.TotalWidth(), a.Name())
+	}
+	if len(d.Format.Args) > 0 {
+		ectx.Emit("\n")
+	}
+
+	for _, x := range instAssignmentsForInsn(d) {
+		ectx.Emit("  let Inst{%s} = %s;\n", instRange(x.r), x.value)
+	}
+
+	ectx.Emit("}\n")
+}
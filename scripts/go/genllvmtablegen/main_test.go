@@ -0,0 +1,114 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestGenerate(t *testing.T) {
+	var descs []*common.InsnDescription
+	for _, l := range []string{
+		"40000000 beqz                   JSd5k16         @writes=",
+		"00100000 add.w                  DJK",
+		"0c100000 fcmp.caf.s             CdFjFk",
+		"06483800 ertn                   EMPTY",
+	} {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		descs = append(descs, d)
+	}
+
+	result := string(generate(descs, "0000000000000000000000000000000000000000"))
+	assert.Contains(t, result, "def simm21 : Operand<i64>, ImmLeaf<i64, [{return isInt<21>(Imm);}]>;\n")
+	assert.Contains(t, result, `
+def ADD_W : LAInst<(outs GPR:$d), (ins GPR:$j, GPR:$k),
+    "add.w", "$d, $j, $k"> {
+  bits<5> d;
+  bits<5> j;
+  bits<5> k;
+
+  let Inst{31-15} = 0b00000000000100000;
+  let Inst{14-10} = k;
+  let Inst{9-5} = j;
+  let Inst{4-0} = d;
+}
+`)
+	assert.Contains(t, result, `
+def BEQZ : LAInst<(outs), (ins GPR:$j, simm21:$sd5k16),
+    "beqz", "$j, $sd5k16"> {
+  bits<5> j;
+  bits<21> sd5k16;
+
+  let Inst{31-26} = 0b010000;
+  let Inst{25-10} = sd5k16{15-0};
+  let Inst{9-5} = j;
+  let Inst{4-0} = sd5k16{20-16};
+}
+`)
+	assert.Contains(t, result, "def FCMP_CAF_S : LAInst<(outs CFR:$cd), (ins FPR:$fj, FPR:$fk),\n")
+	assert.Contains(t, result, "  let Inst{4-3} = 0b00;\n")
+	assert.Contains(t, result, `
+def ERTN : LAInst<(outs), (ins),
+    "ertn", ""> {
+  let Inst{31-0} = 0b00000110010010000011100000000000;
+}
+`)
+
+	// sorted by word
+	assert.Less(t, strings.Index(result, "def ADD_W "), strings.Index(result, "def FCMP_CAF_S "))
+	assert.Less(t, strings.Index(result, "def FCMP_CAF_S "), strings.Index(result, "def BEQZ "))
+}
+
+var instLetRE = regexp.MustCompile(`^  let Inst\{(\d+)(?:-(\d+))?\} = (0b[01]+)?`)
+
+// TestGenerateOverCorpus checks that the Inst assignments of every def cover
+// all 32 bits exactly once, and that the fixed bits are those of the word.
+func TestGenerateOverCorpus(t *testing.T) {
+	descs := common.Builtin()
+	assert.NoError(t, common.CheckArgKinds(descs, supportedArgKinds()))
+
+	result := string(generate(descs, "0000000000000000000000000000000000000000"))
+	defs := strings.Split(result, "\ndef ")[1:]
+	// the first ones are the immediate operands
+	for len(defs) > 0 && !strings.Contains(defs[0], "LAInst") {
+		defs = defs[1:]
+	}
+	if !assert.Len(t, defs, len(descs)) {
+		return
+	}
+
+	for i, def := range defs {
+		d := descs[i]
+		var covered, fixedMask, fixedMatch uint32
+		for _, l := range strings.Split(def, "\n") {
+			m := instLetRE.FindStringSubmatch(l)
+			if m == nil {
+				continue
+			}
+			msb, _ := strconv.Atoi(m[1])
+			lsb := msb
+			if m[2] != "" {
+				lsb, _ = strconv.Atoi(m[2])
+			}
+			mask := uint32((uint64(1)<<(msb+1) - 1) &^ (uint64(1)<<lsb - 1))
+			assert.Zero(t, covered&mask, d.Mnemonic)
+			covered |= mask
+			if m[3] != "" {
+				v, err := strconv.ParseUint(m[3][2:], 2, 32)
+				assert.NoError(t, err)
+				fixedMask |= mask
+				fixedMatch |= uint32(v) << lsb
+			}
+		}
+
+		assert.Equal(t, uint32(0xffffffff), covered, d.Mnemonic)
+		assert.Equal(t, d.FixedMask(), fixedMask, d.Mnemonic)
+		assert.Equal(t, d.Word, fixedMatch, d.Mnemonic)
+	}
+}
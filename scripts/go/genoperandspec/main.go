@@ -0,0 +1,76 @@
+// Command genoperandspec emits a JSON description of the legal operands of
+// every insn, for consumption by randomized assembler testers and the like.
+//
+// The output is an array of insns, sorted by their opcode words:
+//
+//	{
+//	  "mnemonic": "sladd.w",
+//	  "word": 262144, // the fixed bits of the insn word
+//	  "mask": 4294836224, // which bits of the insn word are fixed, excluding reserved slots
+//	  "format": "DJKUa2", // canonical repr of the format
+//	  "operands": [...], // in the order of the canonical format
+//	  "asm_order": [0, 1, 2, 3], // indices into operands in manual syntax order
+//	  "outputs": [0], // indices into operands of the registers written
+//	  "implicit_reads": [1], // integer registers read but not in operands
+//	  "implicit_writes": [1] // integer registers written but not in operands
+//	}
+//
+// The outputs, implicit_reads and implicit_writes fields are omitted if
+// empty. An insn may write any number of its register operands.
+//
+// Each operand is described as:
+//
+//	{
+//	  "name": "ua2", // lowercased canonical repr of the arg
+//	  "kind": "uimm", // one of gpr, fpr, fcc, scr, vr, xr, simm and uimm
+//	  "role": "elemidx", // only present if the operand has a role
+//	  "signed": false,
+//	  "width": 2, // total number of bits in the insn word
+//	  "min": 0, // range of the encoded value, inclusive
+//	  "max": 3,
+//	  "scale": 1, // asm value = encoded value * scale + bias
+//	  "bias": 1,
+//	  "asm_min": 1, // range of the value as written in assembly, inclusive
+//	  "asm_max": 4,
+//	  "slots": [{"offset": 15, "width": 2}] // MSB to LSB
+//	}
+//
+// Every integer in [min, max] is legal, so uniformly sampling an operand tuple
+// amounts to independently sampling each operand from its range. Register
+// numbers are the encoded values, with scale 1 and bias 0. The scale and bias
+// come from the postprocess ops of the manual syntax; for example the branch
+// offsets are written in bytes in assembly but encoded in units of 4 bytes.
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"sort"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func main() {
+	inputs := os.Args[1:]
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	sort.SliceStable(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+
+	spec := make([]common.InsnSpec, len(descs))
+	for i, d := range descs {
+		spec[i] = common.MakeInsnSpec(d)
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	err = enc.Encode(spec)
+	if err != nil {
+		panic(err)
+	}
+}
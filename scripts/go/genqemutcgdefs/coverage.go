@@ -0,0 +1,119 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+// coverageGroup is the QEMU coverage of a group of insns, e.g. those of an
+// extension or of a format.
+type coverageGroup struct {
+	name    string
+	emitted int
+	total   int
+	// missing holds the mnemonics of the insns not emitted by QEMU, in
+	// opcode order.
+	missing []string
+}
+
+// extensionNameForPath returns the name of the extension described by the
+// insn description file at path, e.g. "lasx" for "../../lasx.txt".
+func extensionNameForPath(path string) string {
+	return strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+}
+
+// gatherCoverage groups the insns by the key returned by keyFn, and counts
+// the insns emitted by QEMU in every group, i.e. those kept by isUsedByQEMU.
+// The groups are sorted by name.
+func gatherCoverage(descs []*common.InsnDescription, keyFn func(d *common.InsnDescription) string) []*coverageGroup {
+	sorted := make([]*common.InsnDescription, len(descs))
+	copy(sorted, descs)
+	sort.SliceStable(sorted, func(i int, j int) bool {
+		return sorted[i].Word < sorted[j].Word
+	})
+
+	groups := make(map[string]*coverageGroup)
+	for _, d := range sorted {
+		key := keyFn(d)
+		g, ok := groups[key]
+		if !ok {
+			g = &coverageGroup{name: key}
+			groups[key] = g
+		}
+
+		g.total++
+		if isUsedByQEMU(d) {
+			g.emitted++
+		} else {
+			g.missing = append(g.missing, d.Mnemonic)
+		}
+	}
+
+	result := make([]*coverageGroup, 0, len(groups))
+	for _, g := range groups {
+		result = append(result, g)
+	}
+	sort.Slice(result, func(i int, j int) bool {
+		return result[i].name < result[j].name
+	})
+
+	return result
+}
+
+// generateCoverageReport returns a report of which insns are emitted by QEMU
+// and which aren't, grouped by extension and by format. descsByExt holds the
+// insns of every extension, keyed by the extension name.
+func generateCoverageReport(descsByExt map[string][]*common.InsnDescription) []byte {
+	var all []*common.InsnDescription
+	extOf := make(map[*common.InsnDescription]string)
+	for ext, descs := range descsByExt {
+		for _, d := range descs {
+			extOf[d] = ext
+		}
+		all = append(all, descs...)
+	}
+
+	var buf bytes.Buffer
+
+	byExt := gatherCoverage(all, func(d *common.InsnDescription) string {
+		return extOf[d]
+	})
+	buf.WriteString("By extension:\n")
+	for _, g := range byExt {
+		writeCoverageLine(&buf, g)
+		if len(g.missing) > 0 {
+			fmt.Fprintf(&buf, "    missing: %s\n", strings.Join(g.missing, " "))
+		}
+	}
+
+	byFormat := gatherCoverage(all, func(d *common.InsnDescription) string {
+		return d.Format.CanonicalRepr()
+	})
+	buf.WriteString("\nBy format:\n")
+	for _, g := range byFormat {
+		writeCoverageLine(&buf, g)
+	}
+
+	total := &coverageGroup{name: "total"}
+	for _, g := range byExt {
+		total.emitted += g.emitted
+		total.total += g.total
+	}
+	buf.WriteString("\n")
+	writeCoverageLine(&buf, total)
+
+	return buf.Bytes()
+}
+
+func writeCoverageLine(buf *bytes.Buffer, g *coverageGroup) {
+	var percentage float64
+	if g.total > 0 {
+		percentage = float64(g.emitted) * 100 / float64(g.total)
+	}
+	fmt.Fprintf(buf, "  %-20s %4d of %4d insns emitted (%5.1f%%)\n", g.name, g.emitted, g.total, percentage)
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+// generateEncodeTest returns the C code of a standalone test program for the
+// encoders generated by generate, that includes the generated file as
+// incFileName, calls the TCG emitter of every insn with sample operands, and
+// checks the results against the words computed by the interpretive encoder.
+func generateEncodeTest(descs []*common.InsnDescription, commitHash string, incFileName string) []byte {
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+
+	ectx := common.EmitterCtx{
+		DontGofmt: true,
+	}
+
+	ectx.Emit("/* SPDX-License-Identifier: MIT */\n")
+	ectx.Emit("/*\n")
+	ectx.Emit(" * Tests for the LoongArch instruction encoders for TCG use.\n")
+	ectx.Emit(" *\n")
+	ectx.Emit(" * This file is auto-generated by genqemutcgdefs from\n")
+	ectx.Emit(" * https://github.com/loongson-community/loongarch-opcodes,\n")
+	ectx.Emit(" * from commit %s.\n", commitHash)
+	ectx.Emit(" * DO NOT EDIT.\n")
+	ectx.Emit(" */\n\n")
+
+	ectx.Emit(`#include <assert.h>
+#include <stdint.h>
+#include <stdio.h>
+
+/* Minimal stand-ins for the QEMU definitions used by the encoders.  */
+
+typedef int TCGReg;
+typedef uint32_t tcg_insn_unit;
+
+typedef struct TCGContext {
+    uint32_t last_insn;
+    tcg_insn_unit *code_ptr;
+} TCGContext;
+
+#define tcg_debug_assert(X) assert(X)
+
+static inline uint32_t extract32(uint32_t value, int start, int length)
+{
+    return (value >> start) & (~0U >> (32 - length));
+}
+
+static inline int32_t sextract32(uint32_t value, int start, int length)
+{
+    return ((int32_t)(value << (32 - length - start))) >> (32 - length);
+}
+
+static void tcg_out32(TCGContext *s, uint32_t v)
+{
+    *s->code_ptr++ = v;
+    s->last_insn = v;
+}
+
+`)
+	ectx.Emit("#include \"%s\"\n\n", incFileName)
+
+	ectx.Emit(`static int failures;
+static int total;
+
+static void check(const char *desc, uint32_t actual, uint32_t expected)
+{
+    total++;
+    if (actual != expected) {
+        fprintf(stderr, "%%s: got 0x%%08x, want 0x%%08x\n", desc, actual, expected);
+        failures++;
+    }
+}
+
+int main(void)
+{
+    static tcg_insn_unit code_buf[2];
+    TCGContext s;
+`)
+	if *relocPtrs {
+		ectx.Emit("    tcg_insn_unit *saved;\n")
+	}
+
+	for _, d := range descs {
+		emitEncodeTestCasesForInsn(&ectx, d)
+	}
+
+	ectx.Emit(`
+    printf("%%d/%%d tests passed\n", total - failures, total);
+    return failures != 0;
+}
+`)
+
+	return ectx.Finalize()
+}
+
+// emitEncodeTestCasesForInsn emits as many test cases as the largest number of
+// sample values among the args, taking the sample values of the args in
+// lockstep and wrapping around.
+func emitEncodeTestCasesForInsn(ectx *common.EmitterCtx, d *common.InsnDescription) {
+	samples := make([][]int64, len(d.Format.Args))
+	n := 1
+	for i, a := range d.Format.Args {
+		samples[i] = a.SampleValues()
+		if len(samples[i]) > n {
+			n = len(samples[i])
+		}
+	}
+
+	// the emitters take the shifted imms as written in assembly
+	shifts := make([]uint, len(d.Format.Args))
+	if *shiftedImms {
+		shifts = d.ArgShifts()
+	}
+
+	fnName := "tcg_out_" + strings.ToLower(insnMnemonicToEnumVariantName(d.Mnemonic))
+	ectx.Emit("\n")
+	for k := 0; k < n; k++ {
+		args := make([]int64, len(d.Format.Args))
+		argStrs := make([]string, len(d.Format.Args))
+		for i := range d.Format.Args {
+			args[i] = samples[i][k%len(samples[i])]
+			argStrs[i] = fmt.Sprintf("%d", args[i]<<shifts[i])
+		}
+
+		// every emitter call starts over at the start of the buffer
+		ectx.Emit("    s.code_ptr = code_buf;\n")
+		ectx.Emit("    %s(&s", fnName)
+		for _, a := range argStrs {
+			ectx.Emit(", %s", a)
+		}
+		ectx.Emit(");\n")
+
+		desc := d.Mnemonic
+		if len(argStrs) > 0 {
+			desc += " " + strings.Join(argStrs, ", ")
+		}
+		ectx.Emit("    check(%q, s.last_insn, 0x%08x);\n", desc, d.Encode(args))
+
+		if *relocPtrs && d.RelocArgIndex() >= 0 {
+			// the returned pointer must be the one from before emission
+			ectx.Emit("    s.code_ptr = code_buf;\n")
+			ectx.Emit("    saved = s.code_ptr;\n")
+			ectx.Emit("    check(%q, %s_get_ptr(&s", desc+" (get_ptr)", fnName)
+			for _, a := range argStrs {
+				ectx.Emit(", %s", a)
+			}
+			ectx.Emit(") == saved, 1);\n")
+			ectx.Emit("    check(%q, s.code_ptr == saved + 1, 1);\n", desc+" (get_ptr advances)")
+			ectx.Emit("    check(%q, s.last_insn, 0x%08x);\n", desc+" (get_ptr)", d.Encode(args))
+		}
+
+		if *wasmExports {
+			ectx.Emit("    check(%q, %s(%s), 0x%08x);\n", desc+" (plain)", plainEncoderFnNameForInsn(d), strings.Join(argStrs, ", "), d.Encode(args))
+		}
+	}
+}
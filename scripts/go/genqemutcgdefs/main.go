@@ -3,6 +3,7 @@ package main
 import (
 	"bytes"
 	_ "embed"
+	"flag"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -20,7 +21,18 @@ const attribUnused = "__attribute__((unused))"
 //go:embed qemu.clang-format
 var qemuStyleFileBytes []byte
 
+var relocPtrs = flag.Bool("reloc-ptrs", false, "also emit tcg_out_opc_*_get_ptr companions to the emitters of the @reloc insns, returning where the insn is emitted for patching it later")
+var coverage = flag.Bool("coverage", false, "print a report of which insns are emitted by QEMU and which aren't, grouped by extension and by format, instead of generating code")
+var wasmExports = flag.Bool("wasm-exports", false, "also emit a plain encoder per insn, returning the insn word, exported from the module by the name of the function when compiling to WebAssembly")
+var unmasked = flag.Bool("unmasked", false, "pass the operands of the encoders to the slots without masking them to the slot widths where in-range values don't need it, leaving out-of-range values to the tcg_debug_assert of debug builds instead of wrapping them")
+var shiftedImms = flag.Bool("shifted-imms", false, "take the immediates the manual shifts implicitly, like the branch offsets, as written in assembly instead of as encoded, asserting their alignment and shifting them in the emitters of the insns")
+var encodeTest = flag.String("test", "", "emit a test program for the encoders instead, that includes the generated file by this name, e.g. tcg-insn-defs.c.inc")
+
+var insnSelector = common.AttribSelectorFlag(flag.CommandLine, "qemu")
+
 func main() {
+	flag.Parse()
+
 	// unconditionally take all instruction description files,
 	// filtering is done by individually attaching @qemu attribute for
 	// insns we want to use
@@ -29,208 +41,149 @@ func main() {
 		panic(err)
 	}
 
-	descs, err := common.ReadInsnDescs(inputs)
+	if *coverage {
+		descsByExt := make(map[string][]*common.InsnDescription)
+		for _, path := range inputs {
+			descs, err := common.ReadInsnDescriptionFile(path)
+			if err != nil {
+				panic(err)
+			}
+			descsByExt[extensionNameForPath(path)] = descs
+		}
+
+		os.Stdout.Write(generateCoverageReport(descsByExt))
+		return
+	}
+
+	descs, err := common.ReadInsnDescsFiltered(inputs, isUsedByQEMU)
 	if err != nil {
 		panic(err)
 	}
 
-	descs = filterUnusedInsns(descs)
+	err = common.CheckArgKinds(descs, supportedArgKinds())
+	if err != nil {
+		panic(err)
+	}
 
-	formats := gatherFormats(descs)
-	scs := gatherDistinctSlotCombinations(formats)
+	var result []byte
+	if *encodeTest != "" {
+		result = generateEncodeTest(descs, common.MustGetGitCommitHash(), *encodeTest)
+	} else {
+		result = generate(descs, common.MustGetGitCommitHash())
+	}
+
+	formattedResult, err := clangFormat(result)
+	if err != nil {
+		panic(err)
+	}
+
+	os.Stdout.Write(formattedResult)
+}
+
+// generate returns the generated C code for the given insns, before
+// formatting with clang-format.
+func generate(descs []*common.InsnDescription, commitHash string) []byte {
+	formats := common.GatherFormats(descs)
+	scs := common.GatherSlotCombinations(formats)
 
 	sort.Slice(descs, func(i int, j int) bool {
 		return descs[i].Word < descs[j].Word
 	})
 
-	sort.Slice(formats, func(i int, j int) bool {
-		return formats[i].CanonicalRepr() < formats[j].CanonicalRepr()
-	})
-
 	ectx := common.EmitterCtx{
 		DontGofmt: true,
 	}
 
 	ectx.Emit("/* SPDX-License-Identifier: MIT */\n")
 	ectx.Emit("/*\n")
-	ectx.Emit(" * LoongArch instruction formats, opcodes, and encoders for TCG use.\n")
+	ectx.Emit(" * LoongArch instruction formats, opcodes, encoders and decoders for TCG use.\n")
 	ectx.Emit(" *\n")
 	ectx.Emit(" * This file is auto-generated by genqemutcgdefs from\n")
 	ectx.Emit(" * https://github.com/loongson-community/loongarch-opcodes,\n")
-	ectx.Emit(" * from commit %s.\n", common.MustGetGitCommitHash())
+	ectx.Emit(" * from commit %s.\n", commitHash)
 	ectx.Emit(" * DO NOT EDIT.\n")
 	ectx.Emit(" */\n")
 
 	emitOpcEnum(&ectx, descs)
 
+	emitOverflowComment(&ectx)
 	emitSlotEncoders(&ectx, scs)
+	emitSlotDecoders(&ectx, scs)
 
 	for _, f := range formats {
 		emitFmtEncoderFn(&ectx, f)
+		emitFmtDecoderFn(&ectx, f)
 	}
 
 	for _, d := range descs {
 		emitTCGEmitterForInsn(&ectx, d)
+		if *relocPtrs && d.RelocArgIndex() >= 0 {
+			emitTCGGetPtrEmitterForInsn(&ectx, d)
+		}
+	}
+
+	if *wasmExports {
+		emitWasmExportMacro(&ectx)
+		for _, d := range descs {
+			emitPlainEncoderForInsn(&ectx, d)
+		}
 	}
 
 	ectx.Emit("\n/* End of generated code.  */\n")
 
-	result := ectx.Finalize()
+	return ectx.Finalize()
+}
 
-	// format the generated code with clang-format, using the qemu style
-	//
+// clangFormat formats the generated code with clang-format, using the qemu
+// style.
+func clangFormat(src []byte) ([]byte, error) {
 	// due to clang-format madness (can't customize .clang-format path nor filename),
 	// we have to use a temporary directory for not polluting our repo with
 	// inadequately named file(s)
 	//
 	// see https://bugs.llvm.org/show_bug.cgi?id=20753
-	var formattedResult []byte
-	{
-		tempdir, err := ioutil.TempDir("", "genqemutcgdefs.*")
-		if err != nil {
-			panic(err)
-		}
-		defer os.RemoveAll(tempdir)
-
-		// write the style file there
-		styleFilePath := filepath.Join(tempdir, ".clang-format")
-		err = ioutil.WriteFile(styleFilePath, qemuStyleFileBytes, 0644)
-		if err != nil {
-			panic(err)
-		}
-
-		err = os.Chdir(tempdir)
-		if err != nil {
-			panic(err)
-		}
-
-		clangFormat := exec.Command(
-			"clang-format",
-			"--style=file",
-		)
-		clangFormat.Stdin = bytes.NewBuffer(result)
-		formattedResult, err = clangFormat.Output()
-		if err != nil {
-			exitError, ok := err.(*exec.ExitError)
-			if !ok {
-				panic(err)
-			}
-			fmt.Fprintf(os.Stderr, "fatal: clang-format failed\nstderr:\n%s", string(exitError.Stderr))
-			panic(err)
-		}
-	}
-
-	os.Stdout.Write(formattedResult)
-}
-
-////////////////////////////////////////////////////////////////////////////
-
-func filterUnusedInsns(descs []*common.InsnDescription) []*common.InsnDescription {
-	var result []*common.InsnDescription
-	for _, d := range descs {
-		if _, ok := d.Attribs["qemu"]; !ok {
-			// QEMU TCG doesn't emit this instruction for now, so ignore this
-			// to reduce code size.
-			continue
-		}
-
-		result = append(result, d)
-	}
-
-	return result
-}
-
-func gatherFormats(descs []*common.InsnDescription) []*common.InsnFormat {
-	formatsSet := make(map[string]*common.InsnFormat)
-	for _, d := range descs {
-		canonicalFormatName := d.Format.CanonicalRepr()
-		if _, ok := formatsSet[canonicalFormatName]; !ok {
-			formatsSet[canonicalFormatName] = d.Format
-		}
+	tempdir, err := ioutil.TempDir("", "genqemutcgdefs.*")
+	if err != nil {
+		return nil, err
 	}
+	defer os.RemoveAll(tempdir)
 
-	result := make([]*common.InsnFormat, 0, len(formatsSet))
-	for _, f := range formatsSet {
-		result = append(result, f)
+	// write the style file there
+	styleFilePath := filepath.Join(tempdir, ".clang-format")
+	err = ioutil.WriteFile(styleFilePath, qemuStyleFileBytes, 0644)
+	if err != nil {
+		return nil, err
 	}
 
-	return result
-}
-
-const (
-	slotD = 0
-	slotJ = 5
-	slotK = 10
-	slotA = 15
-	slotM = 16
-)
-
-func gatherDistinctSlotCombinations(fmts []*common.InsnFormat) []string {
-	slotCombinationsSet := make(map[string]struct{})
-	for _, f := range fmts {
-		// skip EMPTY
-		if len(f.Args) == 0 {
-			continue
+	cmd := exec.Command(
+		"clang-format",
+		"--style=file",
+	)
+	cmd.Dir = tempdir
+	cmd.Stdin = bytes.NewBuffer(src)
+	result, err := cmd.Output()
+	if err != nil {
+		if exitError, ok := err.(*exec.ExitError); ok {
+			return nil, fmt.Errorf("clang-format failed: %w\nstderr:\n%s", err, string(exitError.Stderr))
 		}
-		slotCombinationsSet[slotCombinationForFmt(f)] = struct{}{}
+		return nil, err
 	}
 
-	result := make([]string, 0, len(slotCombinationsSet))
-	for sc := range slotCombinationsSet {
-		result = append(result, sc)
-	}
-	sort.Strings(result)
-
-	return result
+	return result, nil
 }
 
-// slot combination looks like "DJKM"
-func slotCombinationForFmt(f *common.InsnFormat) string {
-
-	var slots []int
-	for _, a := range f.Args {
-		for _, s := range a.Slots {
-			slots = append(slots, int(s.Offset))
-		}
-	}
-	sort.Ints(slots)
-
-	var sb strings.Builder
-	for _, s := range slots {
-		switch s {
-		case slotD:
-			sb.WriteRune('D')
-		case slotJ:
-			sb.WriteRune('J')
-		case slotK:
-			sb.WriteRune('K')
-		case slotA:
-			sb.WriteRune('A')
-		case slotM:
-			sb.WriteRune('M')
-		default:
-			panic("should never happen")
-		}
-	}
+////////////////////////////////////////////////////////////////////////////
 
-	return sb.String()
+func isUsedByQEMU(d *common.InsnDescription) bool {
+	// QEMU TCG doesn't emit the other instructions for now, so ignore them
+	// to reduce code size. They are the @qemu ones unless -select says
+	// otherwise.
+	return insnSelector.Matches(d)
 }
 
-func slotOffsetFromRune(s rune) int {
-	switch s {
-	case 'D', 'd':
-		return slotD
-	case 'J', 'j':
-		return slotJ
-	case 'K', 'k':
-		return slotK
-	case 'A', 'a':
-		return slotA
-	case 'M', 'm':
-		return slotM
-	default:
-		panic("should never happen")
-	}
+// slotVarName returns the name of the C variable holding the slot at offset.
+func slotVarName(offset uint) string {
+	return strings.ToLower(common.SlotOffsetName(offset))
 }
 
 ////////////////////////////////////////////////////////////////////////////
@@ -266,6 +219,39 @@ func insnFieldNameForRegArg(a *common.Arg) string {
 	return strings.ToLower(a.CanonicalRepr())
 }
 
+// supportedArgKinds returns the kinds of args with a TCG type in the
+// generated code.
+func supportedArgKinds() []common.ArgKind {
+	return []common.ArgKind{
+		common.ArgKindIntReg,
+		common.ArgKindFPReg,
+		common.ArgKindFCCReg,
+		common.ArgKindVReg,
+		common.ArgKindXReg,
+		common.ArgKindSignedImm,
+		common.ArgKindUnsignedImm,
+	}
+}
+
+// tcgTypeForArgKind returns the C type used for args of the kind in the
+// generated code, or false if the kind isn't supported yet.
+func tcgTypeForArgKind(k common.ArgKind) (string, bool) {
+	switch k {
+	case common.ArgKindIntReg,
+		common.ArgKindFPReg,
+		common.ArgKindFCCReg,
+		common.ArgKindVReg,
+		common.ArgKindXReg:
+		return "TCGReg", true
+	case common.ArgKindSignedImm:
+		return "int32_t", true
+	case common.ArgKindUnsignedImm:
+		return "uint32_t", true
+	default:
+		return "", false
+	}
+}
+
 type fieldDesc struct {
 	name string
 	typ  string
@@ -276,14 +262,9 @@ func fieldDescsForArgs(args []*common.Arg) []fieldDesc {
 	for i, a := range args {
 		fieldName := insnFieldNameForRegArg(a)
 
-		var typ string
-		switch a.Kind {
-		case common.ArgKindIntReg, common.ArgKindFPReg, common.ArgKindFCCReg:
-			typ = "TCGReg"
-		case common.ArgKindSignedImm:
-			typ = "int32_t"
-		case common.ArgKindUnsignedImm:
-			typ = "uint32_t"
+		typ, ok := tcgTypeForArgKind(a.Kind)
+		if !ok {
+			panic("should never happen: unchecked arg kind without TCG type")
 		}
 
 		result[i] = fieldDesc{name: fieldName, typ: typ}
@@ -292,37 +273,34 @@ func fieldDescsForArgs(args []*common.Arg) []fieldDesc {
 	return result
 }
 
-func emitSlotEncoders(ectx *common.EmitterCtx, scs []string) {
+func emitSlotEncoders(ectx *common.EmitterCtx, scs []common.SlotCombination) {
 	for _, sc := range scs {
 		emitSlotEncoderFn(ectx, sc)
 	}
 }
 
-func slotEncoderFnNameForSc(sc string) string {
+func slotEncoderFnNameForSc(sc common.SlotCombination) string {
 	plural := ""
 	if len(sc) > 1 {
 		plural = "s"
 	}
 
-	return fmt.Sprintf("encode_%s_slot%s", strings.ToLower(sc), plural)
+	return fmt.Sprintf("encode_%s_slot%s", strings.ToLower(sc.String()), plural)
 }
 
-func emitSlotEncoderFn(ectx *common.EmitterCtx, sc string) {
+func emitSlotEncoderFn(ectx *common.EmitterCtx, sc common.SlotCombination) {
 	funcName := slotEncoderFnNameForSc(sc)
-	scLower := strings.ToLower(sc)
 
 	ectx.Emit("\nstatic int32_t %s\n%s(LoongArchInsn opc", attribUnused, funcName)
-	for _, s := range scLower {
-		ectx.Emit(", uint32_t %c", s)
+	for _, offset := range sc {
+		ectx.Emit(", uint32_t %s", slotVarName(offset))
 	}
 	ectx.Emit(")\n{\n")
 
 	ectx.Emit("    return opc")
 
-	for _, s := range scLower {
-		offset := slotOffsetFromRune(s)
-
-		ectx.Emit(" | %c", s)
+	for _, offset := range sc {
+		ectx.Emit(" | %s", slotVarName(offset))
 		if offset > 0 {
 			ectx.Emit(" << %d", offset)
 		}
@@ -335,6 +313,38 @@ func fmtEncoderFnNameForInsnFormat(f *common.InsnFormat) string {
 	return fmt.Sprintf("encode_%s_insn", strings.ToLower(f.CanonicalRepr()))
 }
 
+// emitOverflowComment documents what the encoders make of out-of-range
+// operands; see slotNeedsMask.
+func emitOverflowComment(ectx *common.EmitterCtx) {
+	ectx.Emit("\n/*\n")
+	ectx.Emit(" * The operands of the encoders are only checked with tcg_debug_assert.\n")
+	if *unmasked {
+		ectx.Emit(" * Only the bits in-range operands need are masked, so the bits of an\n")
+		ectx.Emit(" * out-of-range register or unsigned immediate past its field spill into\n")
+		ectx.Emit(" * the next fields and the opcode in non-debug builds.\n")
+	} else {
+		ectx.Emit(" * Every operand is masked to its field, so an out-of-range operand wraps\n")
+		ectx.Emit(" * to its low bits in non-debug builds, e.g. register 33 encodes as 1 and\n")
+		ectx.Emit(" * the 12-bit signed immediate 2048 as -2048.\n")
+	}
+	ectx.Emit(" */\n")
+}
+
+// slotNeedsMask returns whether the value of the slot of a, holding the value
+// bits from valueOffset up, is masked to the slot width before being put in
+// place. By default every slot is, so an out-of-range value, which only the
+// tcg_debug_assert of debug builds catches, wraps to its low bits like with
+// common.Arg.Encode. With -unmasked, only the slots of in-range values that
+// would spill otherwise are: the sign bits of signed imms, and the bits of
+// the upper slots of split imms; out-of-range values then spill into the
+// neighbouring fields and the opcode.
+func slotNeedsMask(a *common.Arg, s *common.Slot, valueOffset uint) bool {
+	if !*unmasked || a.Kind == common.ArgKindSignedImm {
+		return true
+	}
+	return valueOffset+s.Width < a.TotalWidth()
+}
+
 func emitFmtEncoderFn(ectx *common.EmitterCtx, f *common.InsnFormat) {
 	// EMPTY doesn't need encoder after all
 	if len(f.Args) == 0 {
@@ -356,9 +366,11 @@ func emitFmtEncoderFn(ectx *common.EmitterCtx, f *common.InsnFormat) {
 		switch a.Kind {
 		case common.ArgKindIntReg,
 			common.ArgKindFPReg,
-			common.ArgKindFCCReg:
+			common.ArgKindFCCReg,
+			common.ArgKindVReg,
+			common.ArgKindXReg:
 			// 0 <= x <= max
-			max := (1 << a.TotalWidth()) - 1
+			max, _ := a.Kind.RegClassMax()
 			ectx.Emit("%s >= 0 && %s <= 0x%x", varName, varName, max)
 
 		case common.ArgKindSignedImm:
@@ -384,65 +396,43 @@ func emitFmtEncoderFn(ectx *common.EmitterCtx, f *common.InsnFormat) {
 	for argIdx, a := range f.Args {
 		argVarName := argFieldDescs[argIdx].name
 
-		if len(a.Slots) == 1 {
-			if a.Kind == common.ArgKindSignedImm {
-				// signed imms need masking to convert to unsigned slot value
-				mask := (1 << a.TotalWidth()) - 1
-				slotExprs[a.Slots[0].Offset] = fmt.Sprintf("%s & 0x%x", argVarName, mask)
+		// take example of Sd5k16:
+		//
+		// Sd5k16 = (MSB) DDDDDKKKKKKKKKKKKKKKK (LSB)
+		//
+		// the value offsets of the slots are 16 for d5 and 0 for k16,
+		// thus d5 = (sd5k16 >> 16) & 0b11111
+		// and k16 = (sd5k16 >> 0) & 0b1111111111111111
+		//         = sd5k16 & 0b1111111111111111
+		valueOffsets := a.SlotValueOffsets()
+		for i, s := range a.Slots {
+			var sb strings.Builder
+
+			if valueOffsets[i] > 0 {
+				sb.WriteRune('(')
+				sb.WriteString(argVarName)
+				sb.WriteString(" >> ")
+				sb.WriteString(strconv.Itoa(int(valueOffsets[i])))
+				sb.WriteRune(')')
 			} else {
-				// and pass through everything else
-				slotExprs[a.Slots[0].Offset] = argVarName
+				sb.WriteString(argVarName)
 			}
-		} else {
-			// remainingBits is shift amount to extract the current slot from arg
-			//
-			// take example of Sd5k16:
-			//
-			// Sd5k16 = (MSB) DDDDDKKKKKKKKKKKKKKKK (LSB)
-			//
-			// initially remainingBits = 5+16
-			//
-			// consume from left to right:
-			//
-			// slot d5: remainingBits = 16
-			// thus d5 = (sd5k16 >> 16) & 0b11111
-			// emit (d5 expr above)
-			//
-			// slot k16: remainingBits = 0
-			// thus k16 = (sd5k16 >> 0) & 0b1111111111111111
-			//          = sd5k16 & 0b1111111111111111
-			// emit (k16 expr above)
-			remainingBits := int(a.TotalWidth())
-			for _, s := range a.Slots {
-				remainingBits -= int(s.Width)
-				mask := int((1 << s.Width) - 1)
-
-				var sb strings.Builder
-
-				if remainingBits > 0 {
-					sb.WriteRune('(')
-					sb.WriteString(argVarName)
-					sb.WriteString(" >> ")
-					sb.WriteString(strconv.Itoa(remainingBits))
-					sb.WriteRune(')')
-				} else {
-					sb.WriteString(argVarName)
-				}
 
+			if slotNeedsMask(a, s, valueOffsets[i]) {
+				mask := int((1 << s.Width) - 1)
 				sb.WriteString(" & 0x")
 				sb.WriteString(strconv.FormatUint(uint64(mask), 16))
-
-				slotExprs[s.Offset] = sb.String()
 			}
+
+			slotExprs[s.Offset] = sb.String()
 		}
 	}
 
-	sc := slotCombinationForFmt(f)
+	sc := common.SlotCombinationForFormat(f)
 	encFnName := slotEncoderFnNameForSc(sc)
 	ectx.Emit("    return %s(opc", encFnName)
 
-	for _, s := range sc {
-		offset := uint(slotOffsetFromRune(s))
+	for _, offset := range sc {
 		slotExpr, ok := slotExprs[offset]
 		if !ok {
 			panic("should never happen")
@@ -453,6 +443,112 @@ func emitFmtEncoderFn(ectx *common.EmitterCtx, f *common.InsnFormat) {
 	ectx.Emit(");\n}\n")
 }
 
+func emitSlotDecoders(ectx *common.EmitterCtx, scs []common.SlotCombination) {
+	for _, sc := range scs {
+		emitSlotDecoderFn(ectx, sc)
+	}
+}
+
+func slotDecoderFnNameForSc(sc common.SlotCombination) string {
+	plural := ""
+	if len(sc) > 1 {
+		plural = "s"
+	}
+
+	return fmt.Sprintf("decode_%s_slot%s", strings.ToLower(sc.String()), plural)
+}
+
+// emitSlotDecoderFn emits the reverse of the slot encoder: every slot is
+// extracted up to the next slot of the combination (or the MSB for the last
+// one), because the slot combination alone doesn't tell the slot widths; it's
+// the format decoder's job to extract the actual bits from the slot values.
+func emitSlotDecoderFn(ectx *common.EmitterCtx, sc common.SlotCombination) {
+	funcName := slotDecoderFnNameForSc(sc)
+
+	ectx.Emit("\nstatic void %s\n%s(uint32_t insn", attribUnused, funcName)
+	for _, offset := range sc {
+		ectx.Emit(", uint32_t *%s", slotVarName(offset))
+	}
+	ectx.Emit(")\n{\n")
+
+	for i, offset := range sc {
+		width := 32 - offset
+		if i+1 < len(sc) {
+			width = sc[i+1] - offset
+		}
+
+		ectx.Emit("    *%s = extract32(insn, %d, %d);\n", slotVarName(offset), offset, width)
+	}
+
+	ectx.Emit("}\n")
+}
+
+func fmtDecoderFnNameForInsnFormat(f *common.InsnFormat) string {
+	return fmt.Sprintf("decode_%s_insn", strings.ToLower(f.CanonicalRepr()))
+}
+
+func emitFmtDecoderFn(ectx *common.EmitterCtx, f *common.InsnFormat) {
+	// EMPTY doesn't need decoder after all
+	if len(f.Args) == 0 {
+		return
+	}
+
+	argFieldDescs := fieldDescsForArgs(f.Args)
+
+	ectx.Emit("\nstatic void %s\n%s(uint32_t insn", attribUnused, fmtDecoderFnNameForInsnFormat(f))
+	for i := range f.Args {
+		ectx.Emit(", %s *%s", argFieldDescs[i].typ, argFieldDescs[i].name)
+	}
+	ectx.Emit(")\n{\n")
+
+	sc := common.SlotCombinationForFormat(f)
+
+	ectx.Emit("    uint32_t ")
+	for i, offset := range sc {
+		if i > 0 {
+			ectx.Emit(", ")
+		}
+		ectx.Emit("slot_%s", slotVarName(offset))
+	}
+	ectx.Emit(";\n\n")
+
+	ectx.Emit("    %s(insn", slotDecoderFnNameForSc(sc))
+	for _, offset := range sc {
+		ectx.Emit(", &slot_%s", slotVarName(offset))
+	}
+	ectx.Emit(");\n")
+
+	for argIdx, a := range f.Args {
+		// put the slots back at their value offsets, the reverse of the slot
+		// expressions of the format encoder
+		var sb strings.Builder
+		valueOffsets := a.SlotValueOffsets()
+		for i, s := range a.Slots {
+			if i > 0 {
+				sb.WriteString(" | ")
+			}
+
+			fmt.Fprintf(&sb, "extract32(slot_%s, 0, %d)", slotVarName(s.Offset), s.Width)
+			if valueOffsets[i] > 0 {
+				fmt.Fprintf(&sb, " << %d", valueOffsets[i])
+			}
+		}
+
+		expr := sb.String()
+		if a.Kind == common.ArgKindSignedImm {
+			if len(a.Slots) == 1 {
+				expr = fmt.Sprintf("sextract32(slot_%s, 0, %d)", slotVarName(a.Slots[0].Offset), a.TotalWidth())
+			} else {
+				expr = fmt.Sprintf("sextract32(%s, 0, %d)", expr, a.TotalWidth())
+			}
+		}
+
+		ectx.Emit("    *%s = %s;\n", argFieldDescs[argIdx].name, expr)
+	}
+
+	ectx.Emit("}\n")
+}
+
 // transform InsnDescription to syntax example, e.g. "addi.d d, j, sk12"
 func insnSyntaxDescForInsn(d *common.InsnDescription) string {
 	if len(d.Format.Args) == 0 {
@@ -463,7 +559,7 @@ func insnSyntaxDescForInsn(d *common.InsnDescription) string {
 	var sb strings.Builder
 
 	sb.WriteString(d.Mnemonic)
-	for i, a := range d.Format.Args {
+	for i, a := range d.SyntaxArgs() {
 		if i == 0 {
 			sb.WriteRune(' ')
 		} else {
@@ -500,12 +596,129 @@ func emitTCGEmitterForInsn(ectx *common.EmitterCtx, d *common.InsnDescription) {
 
 	// body and tail
 	fmtEncoderFnName := fmtEncoderFnNameForInsnFormat(d.Format)
+	argExprs := emitShiftedImmAsserts(ectx, d, argFieldDescs)
 
 	ectx.Emit("    tcg_out32(s, %s(%s", fmtEncoderFnName, opc)
+	for _, e := range argExprs {
+		ectx.Emit(", %s", e)
+	}
+	ectx.Emit("));\n")
+
+	ectx.Emit("}\n")
+}
+
+// emitShiftedImmAsserts returns the expressions passing the operands of the
+// emitter of d to the encoder of its format. With -shifted-imms, the imms the
+// manual shifts implicitly are taken as written in assembly: it emits the
+// tcg_debug_assert of their alignment and of their range before the shift,
+// and they are shifted right to get the encoded values, e.g. for b:
+//
+//	tcg_debug_assert((sd10k16 & 0x3) == 0);
+//	tcg_debug_assert(sd10k16 >= -0x8000000 && sd10k16 <= 0x7fffffc);
+//
+// passing sd10k16 >> 2.
+func emitShiftedImmAsserts(ectx *common.EmitterCtx, d *common.InsnDescription, argFieldDescs []fieldDesc) []string {
+	result := make([]string, len(argFieldDescs))
+	for i, fd := range argFieldDescs {
+		result[i] = fd.name
+	}
+	if !*shiftedImms {
+		return result
+	}
+
+	for i, shift := range d.ArgShifts() {
+		if shift == 0 {
+			continue
+		}
+
+		a := d.Format.Args[i]
+		name := argFieldDescs[i].name
+		ectx.Emit("    tcg_debug_assert((%s & 0x%x) == 0);\n", name, 1<<shift-1)
+		if a.Kind == common.ArgKindSignedImm {
+			ectx.Emit("    tcg_debug_assert(%s >= -0x%x && %s <= 0x%x);\n", name, -(a.MinValue() << shift), name, a.MaxValue()<<shift)
+		} else {
+			ectx.Emit("    tcg_debug_assert(%s <= 0x%x);\n", name, a.MaxValue()<<shift)
+		}
+		result[i] = fmt.Sprintf("%s >> %d", name, shift)
+	}
+	return result
+}
+
+// emitTCGGetPtrEmitterForInsn emits the companion of the TCG emitter of a
+// relocatable insn, that returns the emission site for the backend to record
+// and patch the reloc operand later. The encoding is the same.
+func emitTCGGetPtrEmitterForInsn(ectx *common.EmitterCtx, d *common.InsnDescription) {
+	opcLower := strings.ToLower(insnMnemonicToEnumVariantName(d.Mnemonic))
+	argFieldDescs := fieldDescsForArgs(d.Format.Args)
+
+	ectx.Emit("\n/*\n")
+	ectx.Emit(" * Emits the `%s` instruction, returning where it is\n", insnSyntaxDescForInsn(d))
+	ectx.Emit(" * emitted, for patching the %s operand later.\n", d.Attribs["reloc"])
+	ectx.Emit(" */\n")
+
+	ectx.Emit("static tcg_insn_unit *%s\ntcg_out_%s_get_ptr(TCGContext *s", attribUnused, opcLower)
+	for _, fd := range argFieldDescs {
+		ectx.Emit(", %s %s", fd.typ, fd.name)
+	}
+	ectx.Emit(")\n{\n")
+
+	ectx.Emit("    tcg_insn_unit *ptr = s->code_ptr;\n")
+	ectx.Emit("    tcg_out_%s(s", opcLower)
 	for _, fd := range argFieldDescs {
 		ectx.Emit(", %s", fd.name)
 	}
-	ectx.Emit("));\n")
+	ectx.Emit(");\n")
+	ectx.Emit("    return ptr;\n")
+	ectx.Emit("}\n")
+}
+
+func emitWasmExportMacro(ectx *common.EmitterCtx) {
+	ectx.Emit("\n#ifdef __wasm__\n")
+	ectx.Emit("#define LA_WASM_EXPORT(name) __attribute__((export_name(name)))\n")
+	ectx.Emit("#else\n")
+	ectx.Emit("#define LA_WASM_EXPORT(name)\n")
+	ectx.Emit("#endif\n")
+}
+
+func plainEncoderFnNameForInsn(d *common.InsnDescription) string {
+	return "encode_" + strings.ToLower(insnMnemonicToUpperCase(d.Mnemonic))
+}
+
+// emitPlainEncoderForInsn emits an encoder returning the insn word instead of
+// emitting it into a TCGContext, so it can be called from outside QEMU, e.g.
+// by the JS glue of a WebAssembly module. It is not static, for the linker to
+// keep it and export it.
+func emitPlainEncoderForInsn(ectx *common.EmitterCtx, d *common.InsnDescription) {
+	opc := insnMnemonicToEnumVariantName(d.Mnemonic)
+	fnName := plainEncoderFnNameForInsn(d)
+	argFieldDescs := fieldDescsForArgs(d.Format.Args)
 
+	ectx.Emit("\n/* Encodes the `%s` instruction.  */\n", insnSyntaxDescForInsn(d))
+
+	ectx.Emit("LA_WASM_EXPORT(\"%s\") uint32_t\n%s(", fnName, fnName)
+	for i, fd := range argFieldDescs {
+		if i > 0 {
+			ectx.Emit(", ")
+		}
+		ectx.Emit("%s %s", fd.typ, fd.name)
+	}
+	if len(argFieldDescs) == 0 {
+		ectx.Emit("void")
+	}
+	ectx.Emit(")\n{\n")
+
+	if len(d.Format.Args) == 0 {
+		// special-case EMPTY
+		ectx.Emit("    return %s;\n", opc)
+		ectx.Emit("}\n")
+		return
+	}
+
+	argExprs := emitShiftedImmAsserts(ectx, d, argFieldDescs)
+	ectx.Emit("    return %s(%s", fmtEncoderFnNameForInsnFormat(d.Format), opc)
+	for _, e := range argExprs {
+		ectx.Emit(", %s", e)
+	}
+	ectx.Emit(");\n")
 	ectx.Emit("}\n")
 }
@@ -0,0 +1,389 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+var update = flag.Bool("update", false, "update the golden files")
+
+// TestGenerateGolden checks the generated code before clang-format, so the
+// result does not depend on the clang-format version installed.
+func TestGenerateGolden(t *testing.T) {
+	descs, err := common.ReadInsnDescsFiltered([]string{"testdata/insns.txt"}, isUsedByQEMU)
+	assert.NoError(t, err)
+
+	result := generate(descs, "0000000000000000000000000000000000000000")
+
+	const goldenPath = "testdata/tcg-insn-defs.c.inc.golden"
+	if *update {
+		err := ioutil.WriteFile(goldenPath, result, 0644)
+		assert.NoError(t, err)
+	}
+
+	expected, err := ioutil.ReadFile(goldenPath)
+	assert.NoError(t, err)
+	assert.Equal(t, string(expected), string(result))
+
+	// the opcodes must carry exactly the fixed bits of the insns
+	for _, d := range descs {
+		assert.Zero(t, d.Word&^d.FixedMask(), d.Mnemonic)
+		opc := fmt.Sprintf("%s = 0x%08x,", insnMnemonicToEnumVariantName(d.Mnemonic), d.Word)
+		assert.Contains(t, string(result), opc)
+	}
+}
+
+func TestGenerateVectorRegs(t *testing.T) {
+	descs, err := common.ReadInsnDescsFiltered([]string{"testdata/insns.txt"}, isUsedByQEMU)
+	assert.NoError(t, err)
+
+	result := string(generate(descs, "0000000000000000000000000000000000000000"))
+	assert.Contains(t, result, "tcg_out_opc_vadd_b(TCGContext *s, TCGReg vd, TCGReg vj, TCGReg vk)")
+	assert.Contains(t, result, "tcg_out_opc_xvadd_b(TCGContext *s, TCGReg xd, TCGReg xj, TCGReg xk)")
+	assert.Contains(t, result, "tcg_out_opc_vinsgr2vr_b(TCGContext *s, TCGReg vd, TCGReg j, uint32_t uk4)")
+	assert.Contains(t, result, "    tcg_debug_assert(vd >= 0 && vd <= 0x1f);\n")
+	assert.Contains(t, result, "    tcg_debug_assert(xk >= 0 && xk <= 0x1f);\n")
+}
+
+func TestGenerateUncommonSlotOffset(t *testing.T) {
+	d, err := common.ParseInsnDescriptionLine("00000000 foo                    DJUn5           @qemu")
+	assert.NoError(t, err)
+
+	result := string(generate([]*common.InsnDescription{d}, "0000000000000000000000000000000000000000"))
+	assert.Contains(t, result, "encode_djs18_slots(LoongArchInsn opc, uint32_t d, uint32_t j, uint32_t s18)")
+	assert.Contains(t, result, "    return opc | d | j << 5 | s18 << 18;\n")
+	assert.Contains(t, result, "    *j = extract32(insn, 5, 13);\n    *s18 = extract32(insn, 18, 14);\n")
+	assert.Contains(t, result, "    uint32_t slot_d, slot_j, slot_s18;\n")
+}
+
+func TestInsnSyntaxDescForInsn(t *testing.T) {
+	descs, err := common.ReadInsnDescs([]string{"testdata/insns.txt"})
+	assert.NoError(t, err)
+
+	expected := map[string]string{
+		"add.w": "add.w d, j, k",
+		// rd is a source, but is written first all the same
+		"st.d":       "st.d d, j, sk12",
+		"bstrpick.d": "bstrpick.d d, j, um6, uk6",
+		"b":          "b sd10k16",
+	}
+	for _, d := range descs {
+		if e, ok := expected[d.Mnemonic]; ok {
+			assert.Equal(t, e, insnSyntaxDescForInsn(d))
+			delete(expected, d.Mnemonic)
+		}
+	}
+	assert.Empty(t, expected)
+}
+
+func TestGenerateEncodeTest(t *testing.T) {
+	testGenerateEncodeTest(t)
+}
+
+func testGenerateEncodeTest(t *testing.T) {
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler found")
+	}
+
+	descs, err := common.ReadInsnDescsFiltered([]string{"testdata/insns.txt"}, isUsedByQEMU)
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	const commitHash = "0000000000000000000000000000000000000000"
+	inc := generate(descs, commitHash)
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "tcg-insn-defs.c.inc"), inc, 0644))
+	test := generateEncodeTest(descs, commitHash, "tcg-insn-defs.c.inc")
+	assert.NoError(t, ioutil.WriteFile(filepath.Join(dir, "test_encode.c"), test, 0644))
+
+	exe := filepath.Join(dir, "test_encode")
+	out, err := exec.Command(cc, "-Wall", "-Werror", "-o", exe, filepath.Join(dir, "test_encode.c")).CombinedOutput()
+	if !assert.NoError(t, err, string(out)) {
+		return
+	}
+
+	out, err = exec.Command(exe).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	assert.Contains(t, string(out), "tests passed")
+}
+
+func TestGenerateRelocPtrs(t *testing.T) {
+	*relocPtrs = true
+	defer func() { *relocPtrs = false }()
+
+	descs, err := common.ReadInsnDescsFiltered([]string{"testdata/insns.txt"}, isUsedByQEMU)
+	assert.NoError(t, err)
+
+	result := string(generate(descs, "0000000000000000000000000000000000000000"))
+	assert.Contains(t, result, "tcg_out_opc_b_get_ptr(TCGContext *s, int32_t sd10k16)")
+	assert.Contains(t, result, "tcg_out_opc_ld_d_get_ptr(TCGContext *s, TCGReg d, TCGReg j, int32_t sk12)")
+	assert.Contains(t, result, " * emitted, for patching the sk16 operand later.")
+	assert.NotContains(t, result, "tcg_out_opc_add_w_get_ptr")
+
+	testGenerateEncodeTest(t)
+}
+
+func TestGenerateShiftedImms(t *testing.T) {
+	*shiftedImms = true
+	defer func() { *shiftedImms = false }()
+
+	descs, err := common.ReadInsnDescsFiltered([]string{"testdata/insns.txt"}, isUsedByQEMU)
+	assert.NoError(t, err)
+
+	result := string(generate(descs, "0000000000000000000000000000000000000000"))
+	assert.Contains(t, result, `    tcg_debug_assert((sd10k16 & 0x3) == 0);
+    tcg_debug_assert(sd10k16 >= -0x8000000 && sd10k16 <= 0x7fffffc);
+    tcg_out32(s, encode_sd10k16_insn(OPC_B, sd10k16 >> 2));
+`)
+	assert.Contains(t, result, "tcg_out32(s, encode_djsk16_insn(OPC_JIRL, d, j, sk16 >> 2));\n")
+	// the format encoders are unchanged
+	assert.Contains(t, result, "tcg_debug_assert(sd10k16 >= -0x2000000 && sd10k16 <= 0x1ffffff);\n")
+	assert.Contains(t, result, "tcg_out32(s, encode_djsk12_insn(OPC_LD_D, d, j, sk12));\n")
+
+	testGenerateEncodeTest(t)
+}
+
+func TestGenerateWasmExports(t *testing.T) {
+	*wasmExports = true
+	defer func() { *wasmExports = false }()
+
+	descs, err := common.ReadInsnDescsFiltered([]string{"testdata/insns.txt"}, isUsedByQEMU)
+	assert.NoError(t, err)
+
+	result := string(generate(descs, "0000000000000000000000000000000000000000"))
+	assert.Contains(t, result, "#define LA_WASM_EXPORT(name) __attribute__((export_name(name)))\n")
+	assert.Contains(t, result, "LA_WASM_EXPORT(\"encode_add_w\") uint32_t\nencode_add_w(TCGReg d, TCGReg j, TCGReg k)\n")
+	assert.Contains(t, result, "    return encode_djk_insn(OPC_ADD_W, d, j, k);\n")
+	assert.Contains(t, result, "encode_bstrpick_d(TCGReg d, TCGReg j, uint32_t uk6, uint32_t um6)\n")
+
+	testGenerateEncodeTest(t)
+}
+
+// wasmPrelude stands in for the QEMU definitions used by the encoders, without
+// the libc headers missing when compiling for a bare wasm32 target.
+const wasmPrelude = `typedef int int32_t;
+typedef unsigned int uint32_t;
+typedef int TCGReg;
+typedef uint32_t tcg_insn_unit;
+typedef struct TCGContext {
+    tcg_insn_unit *code_ptr;
+} TCGContext;
+
+#define tcg_debug_assert(X) ((void)0)
+
+static inline uint32_t extract32(uint32_t value, int start, int length)
+{
+    return (value >> start) & (~0U >> (32 - length));
+}
+
+static inline int32_t sextract32(uint32_t value, int start, int length)
+{
+    return ((int32_t)(value << (32 - length - start))) >> (32 - length);
+}
+
+static void tcg_out32(TCGContext *s, uint32_t v)
+{
+    *s->code_ptr++ = v;
+}
+
+`
+
+func TestCompileWasmExports(t *testing.T) {
+	clang, err := exec.LookPath("clang")
+	if err != nil {
+		t.Skip("no clang found")
+	}
+	targets, err := exec.Command(clang, "--print-targets").Output()
+	if err != nil || !strings.Contains(string(targets), "wasm32") {
+		t.Skip("clang does not support the wasm32 target")
+	}
+
+	*wasmExports = true
+	defer func() { *wasmExports = false }()
+
+	descs, err := common.ReadInsnDescsFiltered([]string{"testdata/insns.txt"}, isUsedByQEMU)
+	assert.NoError(t, err)
+
+	dir := t.TempDir()
+	src := wasmPrelude + string(generate(descs, "0000000000000000000000000000000000000000"))
+	srcPath := filepath.Join(dir, "tcg-insn-defs.c")
+	assert.NoError(t, ioutil.WriteFile(srcPath, []byte(src), 0644))
+
+	obj := filepath.Join(dir, "tcg-insn-defs.o")
+	out, err := exec.Command(clang, "--target=wasm32", "-ffreestanding", "-O2", "-Wall", "-Werror", "-c", "-o", obj, srcPath).CombinedOutput()
+	if !assert.NoError(t, err, string(out)) {
+		return
+	}
+
+	// the export names are recorded in the object for the linker
+	b, err := ioutil.ReadFile(obj)
+	assert.NoError(t, err)
+	for _, d := range descs {
+		assert.True(t, bytes.Contains(b, []byte(plainEncoderFnNameForInsn(d))), d.Mnemonic)
+	}
+}
+
+// runOutOfRangeEncodes compiles the generated encoders with debug assertions
+// off, and returns the words they produce for the out-of-range operands in
+// calls, like "encode_djk_insn(OPC_ADD_W, 33, 2, 3)", by the insn mnemonic.
+func runOutOfRangeEncodes(t *testing.T, descs []*common.InsnDescription, calls map[string]string) map[string]uint32 {
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler found")
+	}
+
+	var src strings.Builder
+	src.WriteString(wasmPrelude)
+	src.Write(generate(descs, "0000000000000000000000000000000000000000"))
+	src.WriteString("\nint printf(const char *format, ...);\n\nint main(void)\n{\n")
+	var mnemonics []string
+	for m := range calls {
+		mnemonics = append(mnemonics, m)
+	}
+	sort.Strings(mnemonics)
+	for _, m := range mnemonics {
+		fmt.Fprintf(&src, "    printf(\"%%s %%08x\\n\", %q, (uint32_t)%s);\n", m, calls[m])
+	}
+	src.WriteString("    return 0;\n}\n")
+
+	dir := t.TempDir()
+	srcPath := filepath.Join(dir, "overflow.c")
+	assert.NoError(t, ioutil.WriteFile(srcPath, []byte(src.String()), 0644))
+	exe := filepath.Join(dir, "overflow")
+	out, err := exec.Command(cc, "-Wall", "-Werror", "-o", exe, srcPath).CombinedOutput()
+	if !assert.NoError(t, err, string(out)) {
+		t.FailNow()
+	}
+	out, err = exec.Command(exe).CombinedOutput()
+	assert.NoError(t, err, string(out))
+
+	result := make(map[string]uint32)
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		var m string
+		var w uint32
+		_, err := fmt.Sscanf(line, "%s %x", &m, &w)
+		assert.NoError(t, err)
+		result[m] = w
+	}
+	return result
+}
+
+func TestOutOfRangeOperands(t *testing.T) {
+	descs, err := common.ReadInsnDescsFiltered([]string{"testdata/insns.txt"}, isUsedByQEMU)
+	assert.NoError(t, err)
+	byMnemonic := make(map[string]*common.InsnDescription)
+	for _, d := range descs {
+		byMnemonic[d.Mnemonic] = d
+	}
+
+	calls := map[string]string{
+		"add.w":      "encode_djk_insn(OPC_ADD_W, 33, 2, 3)",
+		"addi.d":     "encode_djsk12_insn(OPC_ADDI_D, 1, 2, 2048)",
+		"bstrpick.d": "encode_djuk6um6_insn(OPC_BSTRPICK_D, 1, 2, 64, 2)",
+		"b":          "encode_sd10k16_insn(OPC_B, (1 << 25) + 1)",
+	}
+	args := map[string][]int64{
+		"add.w":      {33, 2, 3},
+		"addi.d":     {1, 2, 2048},
+		"bstrpick.d": {1, 2, 64, 2},
+		"b":          {1<<25 + 1},
+	}
+
+	// out-of-range operands wrap to their low bits, like with the
+	// interpretive encoder
+	words := runOutOfRangeEncodes(t, descs, calls)
+	for m, a := range args {
+		assert.Equal(t, byMnemonic[m].Encode(a), words[m], m)
+	}
+	assert.Equal(t, uint32(0x00100c41), words["add.w"])
+	assert.Equal(t, uint32(0x02e00041), words["addi.d"])
+
+	*unmasked = true
+	defer func() { *unmasked = false }()
+
+	// registers and unsigned imms spill into the next fields instead, while
+	// signed imms still wrap
+	words = runOutOfRangeEncodes(t, descs, calls)
+	assert.Equal(t, uint32(0x00100000|33|2<<5|3<<10), words["add.w"])
+	assert.Equal(t, uint32(0x00c00000|1|2<<5|64<<10|2<<16), words["bstrpick.d"])
+	assert.Equal(t, byMnemonic["addi.d"].Encode(args["addi.d"]), words["addi.d"])
+	assert.Equal(t, byMnemonic["b"].Encode(args["b"]), words["b"])
+
+	// and in-range operands are unaffected
+	testGenerateEncodeTest(t)
+}
+
+func TestSupportedArgKinds(t *testing.T) {
+	descs, err := common.ReadInsnDescsFiltered([]string{"testdata/insns.txt"}, isUsedByQEMU)
+	assert.NoError(t, err)
+	assert.NoError(t, common.CheckArgKinds(descs, supportedArgKinds()))
+
+	paths, err := filepath.Glob("../../../*.txt")
+	assert.NoError(t, err)
+	descs, err = common.ReadInsnDescsFiltered(paths, isUsedByQEMU)
+	assert.NoError(t, err)
+	assert.NoError(t, common.CheckArgKinds(descs, supportedArgKinds()))
+
+	// as if an LBT insn were tagged @qemu
+	d, err := common.ParseInsnDescriptionLine("00000800 movgr2scr              TdJ             @qemu")
+	assert.NoError(t, err)
+	err = common.CheckArgKinds(append(descs, d), supportedArgKinds())
+	assert.EqualError(t, err, "movgr2scr: arg td (scratch register) is not supported")
+
+	// every supported kind has a TCG type
+	for _, k := range supportedArgKinds() {
+		_, ok := tcgTypeForArgKind(k)
+		assert.True(t, ok)
+	}
+}
+
+func TestGenerateCoverageReport(t *testing.T) {
+	parse := func(lines ...string) []*common.InsnDescription {
+		result := make([]*common.InsnDescription, len(lines))
+		for i, l := range lines {
+			d, err := common.ParseInsnDescriptionLine(l)
+			assert.NoError(t, err)
+			result[i] = d
+		}
+		return result
+	}
+
+	descsByExt := map[string][]*common.InsnDescription{
+		"la-base-32": parse(
+			"00110000 sub.w                  DJK",
+			"00100000 add.w                  DJK             @qemu",
+			"02800000 addi.w                 DJSk12          @qemu",
+		),
+		"lsx": parse(
+			"700a0000 vadd.b                 VdVjVk",
+			"700a8000 vadd.h                 VdVjVk",
+		),
+	}
+
+	expected := `By extension:
+  la-base-32              2 of    3 insns emitted ( 66.7%)
+    missing: sub.w
+  lsx                     0 of    2 insns emitted (  0.0%)
+    missing: vadd.b vadd.h
+
+By format:
+  DJK                     1 of    2 insns emitted ( 50.0%)
+  DJSk12                  1 of    1 insns emitted (100.0%)
+  VdVjVk                  0 of    2 insns emitted (  0.0%)
+
+  total                   2 of    5 insns emitted ( 40.0%)
+`
+	assert.Equal(t, expected, string(generateCoverageReport(descsByExt)))
+	assert.Equal(t, "lasx", extensionNameForPath("../../lasx.txt"))
+}
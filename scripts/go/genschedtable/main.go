@@ -0,0 +1,115 @@
+package main
+
+import (
+	"os"
+	"sort"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func main() {
+	inputs := os.Args[1:]
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	os.Stdout.Write(generate(descs, common.MustGetGitCommitHash()))
+}
+
+func generate(descs []*common.InsnDescription, commitHash string) []byte {
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+
+	ectx := common.EmitterCtx{
+		DontGofmt: true,
+	}
+
+	ectx.Emit("/* SPDX-License-Identifier: MIT */\n")
+	ectx.Emit("/*\n")
+	ectx.Emit(" * LoongArch instruction latencies for scheduling.\n")
+	ectx.Emit(" *\n")
+	ectx.Emit(" * This file is auto-generated by genschedtable from\n")
+	ectx.Emit(" * https://github.com/loongson-community/loongarch-opcodes,\n")
+	ectx.Emit(" * from commit %s.\n", commitHash)
+	ectx.Emit(" * DO NOT EDIT.\n")
+	ectx.Emit(" */\n\n")
+	ectx.Emit("#include <stddef.h>\n")
+	ectx.Emit("#include <stdint.h>\n")
+
+	emitSchedInfoStruct(&ectx)
+	emitSchedTable(&ectx, descs)
+	emitLookupFn(&ectx)
+
+	return ectx.Finalize()
+}
+
+func emitSchedInfoStruct(ectx *common.EmitterCtx) {
+	ectx.Emit(`
+/*
+ * The operands are numbered in the order of the instruction format, e.g.
+ * rd, rj, sk12 for DJSk12.
+ */
+struct la_sched_info {
+    const char *mnemonic;
+    /* the instruction is insn if (insn & mask) == match */
+    uint32_t match;
+    uint32_t mask;
+    /* cycles from issue until the result can be used */
+    uint8_t latency;
+    /* the operand holding the late-available result, or -1 if none */
+    int8_t result;
+    /* bitmasks of the register operands read and written */
+    uint8_t reads;
+    uint8_t writes;
+};
+`)
+}
+
+// argsBitmask returns the bitmask of the arg indices.
+func argsBitmask(indices []int) uint8 {
+	var result uint8
+	for _, i := range indices {
+		result |= 1 << i
+	}
+	return result
+}
+
+func emitSchedTable(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit("\n#define LA_SCHED_COUNT %d\n\n", len(descs))
+	ectx.Emit("static const struct la_sched_info la_sched_table[LA_SCHED_COUNT] = {\n")
+	for _, d := range descs {
+		lat := d.Latency()
+		ectx.Emit(
+			"    { \"%s\", 0x%08x, 0x%08x, %d, %d, 0x%02x, 0x%02x },\n",
+			d.Mnemonic,
+			d.Word,
+			d.FixedMask(),
+			lat.Cycles,
+			lat.ResultArg,
+			argsBitmask(d.InputArgs()),
+			argsBitmask(d.OutputArgs()),
+		)
+	}
+	ectx.Emit("};\n")
+}
+
+func emitLookupFn(ectx *common.EmitterCtx) {
+	ectx.Emit(`
+/* Returns the entry of the instruction encoded by insn, or NULL if none.  */
+static const struct la_sched_info *__attribute__((unused))
+la_sched_lookup(uint32_t insn)
+{
+    size_t i;
+
+    for (i = 0; i < LA_SCHED_COUNT; i++) {
+        if ((insn & la_sched_table[i].mask) == la_sched_table[i].match) {
+            return &la_sched_table[i];
+        }
+    }
+    return NULL;
+}
+`)
+}
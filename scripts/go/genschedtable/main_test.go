@@ -0,0 +1,67 @@
+package main
+
+import (
+	"io/ioutil"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func parseForTest(t *testing.T, lines ...string) []*common.InsnDescription {
+	result := make([]*common.InsnDescription, len(lines))
+	for i, l := range lines {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		result[i] = d
+	}
+	return result
+}
+
+func TestGenerate(t *testing.T) {
+	descs := parseForTest(
+		t,
+		"28800000 ld.w                   DJSk12          @lat=4",
+		"00100000 add.w                  DJK",
+		"29800000 st.w                   DJSk12          @writes= @reads=d,j",
+		"00110000 foo                    DJK             @writes=d,k @lat=3,k",
+	)
+	result := string(generate(descs, "0000000000000000000000000000000000000000"))
+
+	assert.Contains(t, result, "#define LA_SCHED_COUNT 4\n")
+	// the latency defaults to 1 with the rd result
+	assert.Contains(t, result, "    { \"add.w\", 0x00100000, 0xffff8000, 1, 0, 0x06, 0x01 },\n")
+	assert.Contains(t, result, "    { \"ld.w\", 0x28800000, 0xffc00000, 4, 0, 0x02, 0x01 },\n")
+	assert.Contains(t, result, "    { \"st.w\", 0x29800000, 0xffc00000, 1, -1, 0x03, 0x00 },\n")
+	assert.Contains(t, result, "    { \"foo\", 0x00110000, 0xffff8000, 3, 2, 0x02, 0x05 },\n")
+
+	cc, err := exec.LookPath("cc")
+	if err != nil {
+		t.Skip("no C compiler found")
+	}
+
+	dir := t.TempDir()
+	src := result + `
+#include <stdio.h>
+
+int main(void)
+{
+    const struct la_sched_info *x = la_sched_lookup(0x28bff0a4); /* ld.w $r4, $r5, -4 */
+    printf("%s %d %d\n", x->mnemonic, x->latency, x->result);
+    return la_sched_lookup(0xffffffff) != NULL;
+}
+`
+	srcPath := filepath.Join(dir, "sched.c")
+	assert.NoError(t, ioutil.WriteFile(srcPath, []byte(src), 0644))
+	exe := filepath.Join(dir, "sched")
+	out, err := exec.Command(cc, "-Wall", "-Werror", "-o", exe, srcPath).CombinedOutput()
+	if !assert.NoError(t, err, string(out)) {
+		return
+	}
+	out, err = exec.Command(exe).CombinedOutput()
+	assert.NoError(t, err, string(out))
+	assert.Equal(t, "ld.w 4 0\n", string(out))
+}
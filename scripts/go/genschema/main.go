@@ -0,0 +1,144 @@
+// Command genschema emits the JSON Schema of the output of genoperandspec.
+//
+// The schema is derived from the common.InsnSpec type by reflection, so it
+// stays in sync with the Go types: every field is a property, and fields
+// without omitempty are required. Constraints that can't be derived from the
+// types, like the enum of operand kinds, are listed in fieldConstraints.
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+const schemaDialect = "https://json-schema.org/draft/2020-12/schema"
+
+type schema map[string]interface{}
+
+func main() {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	err := enc.Encode(generate())
+	if err != nil {
+		panic(err)
+	}
+}
+
+func generate() schema {
+	s := schemaForType(reflect.TypeOf(common.InsnSpec{}))
+
+	result := schema{
+		"$schema": schemaDialect,
+		"title":   "LoongArch insn operand specs",
+		"type":    "array",
+		"items":   s,
+	}
+
+	checkAllConstraintsUsed()
+	return result
+}
+
+func roleNames() []string {
+	var result []string
+	for _, r := range common.KnownArgRoles() {
+		result = append(result, string(r))
+	}
+	return result
+}
+
+// fieldConstraints holds the extra keywords of the fields, keyed by
+// "TypeName.FieldName".
+var fieldConstraints = map[string]schema{
+	"InsnSpec.Mnemonic":       {"pattern": "^[a-z][0-9a-z_.]*$"},
+	"InsnSpec.Format":         {"pattern": "^(EMPTY|[0-9DJKACFVXSTUdjkamn]+)$"},
+	"InsnSpec.ImplicitReads":  {"items": schema{"maximum": 31}, "uniqueItems": true},
+	"InsnSpec.ImplicitWrites": {"items": schema{"maximum": 31}, "uniqueItems": true},
+	"InsnSpec.AsmOrder":       {"items": schema{"minimum": 0}, "uniqueItems": true},
+	"InsnSpec.Outputs":        {"items": schema{"minimum": 0}, "uniqueItems": true},
+	"OperandSpec.Kind":        {"enum": common.ArgKindSpecNames()},
+	"OperandSpec.Role":        {"enum": roleNames()},
+	"OperandSpec.Width":       {"minimum": 1, "maximum": 32},
+	"OperandSpec.Slots":       {"minItems": 1},
+	"SlotSpec.Offset":         {"maximum": 31},
+	"SlotSpec.Width":          {"minimum": 1, "maximum": 32},
+}
+
+var usedConstraints = map[string]bool{}
+
+func checkAllConstraintsUsed() {
+	for k := range fieldConstraints {
+		if !usedConstraints[k] {
+			panic(fmt.Sprintf("constraint for nonexistent field %s", k))
+		}
+	}
+}
+
+func schemaForType(t reflect.Type) schema {
+	switch t.Kind() {
+	case reflect.Bool:
+		return schema{"type": "boolean"}
+	case reflect.String:
+		return schema{"type": "string"}
+	case reflect.Int, reflect.Int64:
+		return schema{"type": "integer"}
+	case reflect.Uint, reflect.Uint32:
+		s := schema{"type": "integer", "minimum": 0}
+		if t.Kind() == reflect.Uint32 {
+			s["maximum"] = uint64(1)<<32 - 1
+		}
+		return s
+	case reflect.Slice:
+		return schema{"type": "array", "items": schemaForType(t.Elem())}
+	case reflect.Struct:
+		return schemaForStruct(t)
+	}
+
+	panic(fmt.Sprintf("unsupported type %s", t))
+}
+
+func schemaForStruct(t reflect.Type) schema {
+	props := schema{}
+	required := []string{}
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+
+		s := schemaForType(f.Type)
+		key := t.Name() + "." + f.Name
+		if extra, ok := fieldConstraints[key]; ok {
+			mergeSchema(s, extra)
+			usedConstraints[key] = true
+		}
+
+		props[name] = s
+		if opts != "omitempty" {
+			required = append(required, name)
+		}
+	}
+
+	return schema{
+		"type":                 "object",
+		"properties":           props,
+		"required":             required,
+		"additionalProperties": false,
+	}
+}
+
+// mergeSchema adds the keywords of extra to s, merging into nested schemas
+// like "items" instead of replacing them.
+func mergeSchema(s schema, extra schema) {
+	for k, v := range extra {
+		if sub, ok := v.(schema); ok {
+			if orig, ok := s[k].(schema); ok {
+				mergeSchema(orig, sub)
+				continue
+			}
+		}
+		s[k] = v
+	}
+}
@@ -0,0 +1,157 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// validateForTest checks v against the schema s, supporting only the
+// keywords generate emits, and returns the violations found.
+func validateForTest(s map[string]interface{}, v interface{}, path string) []string {
+	var result []string
+	fail := func(format string, a ...interface{}) {
+		result = append(result, path+": "+fmt.Sprintf(format, a...))
+	}
+
+	switch s["type"] {
+	case "array":
+		items, ok := v.([]interface{})
+		if !ok {
+			fail("want an array, got %v", v)
+			return result
+		}
+		if n, ok := s["minItems"].(float64); ok && float64(len(items)) < n {
+			fail("want at least %v items, got %d", n, len(items))
+		}
+		seen := make(map[string]bool)
+		for i, x := range items {
+			if s["uniqueItems"] == true {
+				key := fmt.Sprint(x)
+				if seen[key] {
+					fail("duplicate item %v", x)
+				}
+				seen[key] = true
+			}
+			result = append(result, validateForTest(s["items"].(map[string]interface{}), x, fmt.Sprintf("%s[%d]", path, i))...)
+		}
+	case "object":
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			fail("want an object, got %v", v)
+			return result
+		}
+		props := s["properties"].(map[string]interface{})
+		for _, name := range s["required"].([]interface{}) {
+			if _, ok := obj[name.(string)]; !ok {
+				fail("missing required property %q", name)
+			}
+		}
+		for name, x := range obj {
+			ps, ok := props[name]
+			if !ok {
+				if s["additionalProperties"] == false {
+					fail("unknown property %q", name)
+				}
+				continue
+			}
+			result = append(result, validateForTest(ps.(map[string]interface{}), x, path+"."+name)...)
+		}
+	case "integer":
+		n, ok := v.(float64)
+		if !ok || n != float64(int64(n)) {
+			fail("want an integer, got %v", v)
+			return result
+		}
+		if min, ok := s["minimum"].(float64); ok && n < min {
+			fail("%v below the minimum %v", n, min)
+		}
+		if max, ok := s["maximum"].(float64); ok && n > max {
+			fail("%v above the maximum %v", n, max)
+		}
+	case "string":
+		str, ok := v.(string)
+		if !ok {
+			fail("want a string, got %v", v)
+			return result
+		}
+		if pattern, ok := s["pattern"].(string); ok && !regexp.MustCompile(pattern).MatchString(str) {
+			fail("%q does not match %s", str, pattern)
+		}
+	case "boolean":
+		if _, ok := v.(bool); !ok {
+			fail("want a boolean, got %v", v)
+		}
+	default:
+		fail("unsupported schema type %v", s["type"])
+	}
+
+	if enum, ok := s["enum"].([]interface{}); ok {
+		found := false
+		for _, x := range enum {
+			if reflect.DeepEqual(x, v) {
+				found = true
+			}
+		}
+		if !found {
+			fail("%v not in %v", v, enum)
+		}
+	}
+
+	return result
+}
+
+// TestOperandSpecMatchesSchema validates the output of genoperandspec over
+// the corpus against the schema.
+func TestOperandSpecMatchesSchema(t *testing.T) {
+	goCmd, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go not found")
+	}
+
+	paths, err := filepath.Glob("../../../*.txt")
+	assert.NoError(t, err)
+	out, err := exec.Command(goCmd, append([]string{"run", "../genoperandspec"}, paths...)...).Output()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	var specs []interface{}
+	assert.NoError(t, json.Unmarshal(out, &specs))
+	assert.NotEmpty(t, specs)
+
+	// round trip the schema through JSON to validate against what consumers
+	// see
+	b, err := json.Marshal(generate())
+	assert.NoError(t, err)
+	var s map[string]interface{}
+	assert.NoError(t, json.Unmarshal(b, &s))
+
+	for _, v := range validateForTest(s, specs, "$") {
+		t.Error(v)
+	}
+
+	// while mangled specs are caught
+	var mangled map[string]interface{}
+	for _, x := range specs {
+		mangled = x.(map[string]interface{})
+		if len(mangled["operands"].([]interface{})) > 0 {
+			break
+		}
+	}
+	delete(mangled, "mask")
+	mangled["operands"].([]interface{})[0].(map[string]interface{})["kind"] = "gpr64"
+	mangled["extra"] = true
+	errs := validateForTest(s, []interface{}{mangled}, "$")
+	assert.ElementsMatch(t, []string{
+		`$[0]: missing required property "mask"`,
+		`$[0]: unknown property "extra"`,
+		`$[0].operands[0].kind: gpr64 not in [gpr fpr fcc scr vr xr simm uimm]`,
+	}, errs)
+}
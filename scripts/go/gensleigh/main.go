@@ -0,0 +1,297 @@
+// Command gensleigh emits a skeleton of a Ghidra SLEIGH processor spec for
+// LoongArch: the register spaces, the fields of the 32-bit instruction token,
+// and one constructor per insn matching its fixed opcode bits and binding the
+// fields to the operands. The semantics are stubbed out with unimpl.
+//
+// Register and immediate fields are named after the slots they occupy, e.g.
+// "rd" for an integer register at offset 0 and "sk12" for a signed 12-bit
+// immediate at offset 10, and the fixed opcode bits after their bit ranges,
+// e.g. "op31_15". Immediates spanning several slots are assembled from the
+// fields of the slots by a subtable named after the arg, e.g. "sd10k16".
+package main
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func main() {
+	inputs := os.Args[1:]
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+
+	os.Stdout.Write(generate(descs, common.MustGetGitCommitHash()))
+}
+
+func generate(descs []*common.InsnDescription, commitHash string) []byte {
+	fields := gatherFields(descs)
+
+	ectx := common.EmitterCtx{
+		DontGofmt: true,
+	}
+
+	ectx.Emit("# LoongArch SLEIGH processor spec skeleton.\n")
+	ectx.Emit("#\n")
+	ectx.Emit("# This file is auto-generated by gensleigh from\n")
+	ectx.Emit("# https://github.com/loongson-community/loongarch-opcodes,\n")
+	ectx.Emit("# from commit %s.\n", commitHash)
+	ectx.Emit("# DO NOT EDIT.\n\n")
+
+	emitSpaces(&ectx)
+	emitToken(&ectx, fields)
+	emitAttachVariables(&ectx, fields)
+	emitMultiSlotTables(&ectx, descs)
+	for _, d := range descs {
+		emitConstructor(&ectx, d)
+	}
+
+	return ectx.Finalize()
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+// regFile is a register file of the spec, holding the registers of a
+// register arg kind.
+type regFile struct {
+	kind   common.ArgKind
+	offset int
+	size   int
+}
+
+var regFiles = []regFile{
+	{kind: common.ArgKindIntReg, offset: 0x0000, size: 8},
+	{kind: common.ArgKindFPReg, offset: 0x0100, size: 8},
+	{kind: common.ArgKindFCCReg, offset: 0x0200, size: 1},
+	{kind: common.ArgKindScratchReg, offset: 0x0300, size: 8},
+	{kind: common.ArgKindVReg, offset: 0x0400, size: 16},
+	{kind: common.ArgKindXReg, offset: 0x0800, size: 32},
+}
+
+func regNames(k common.ArgKind) []string {
+	max, ok := k.RegClassMax()
+	if !ok {
+		panic("unreachable")
+	}
+
+	result := make([]string, max+1)
+	for i := range result {
+		result[i] = strings.TrimPrefix(common.RegName(k, int64(i)), "$")
+	}
+	return result
+}
+
+func emitSpaces(ectx *common.EmitterCtx) {
+	ectx.Emit("define endian=little;\n")
+	ectx.Emit("define alignment=4;\n\n")
+	ectx.Emit("define space ram type=ram_space size=8 default;\n")
+	ectx.Emit("define space register type=register_space size=4;\n\n")
+
+	for _, rf := range regFiles {
+		ectx.Emit(
+			"define register offset=0x%04x size=%d [ %s ];\n",
+			rf.offset,
+			rf.size,
+			strings.Join(regNames(rf.kind), " "),
+		)
+	}
+	ectx.Emit("define register offset=0x1000 size=8 [ pc ];\n")
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+// field is a field of the instruction token.
+type field struct {
+	name   string
+	r      common.BitRange
+	signed bool
+	// regKind is the kind of the registers attached to the field, or
+	// ArgKindUnknown if the field is not a register.
+	regKind common.ArgKind
+}
+
+var regFieldPrefixes = map[common.ArgKind]string{
+	common.ArgKindIntReg:     "r",
+	common.ArgKindFPReg:      "f",
+	common.ArgKindFCCReg:     "c",
+	common.ArgKindScratchReg: "t",
+	common.ArgKindVReg:       "v",
+	common.ArgKindXReg:       "x",
+}
+
+// fieldsForArg returns the fields of the slots of the arg, in slot order. For
+// a signed immediate, only the most significant slot is sign-extended.
+func fieldsForArg(a *common.Arg) []field {
+	ranges := a.BitRanges()
+	result := make([]field, len(a.Slots))
+	for i, s := range a.Slots {
+		f := field{r: ranges[i]}
+
+		if prefix, ok := regFieldPrefixes[a.Kind]; ok {
+			// the slot letter only, as the width is implied by the kind
+			f.name = prefix + s.CanonicalRepr()[:1]
+			f.regKind = a.Kind
+		} else {
+			f.signed = a.Kind == common.ArgKindSignedImm && i == 0
+			if f.signed {
+				f.name = "s" + s.CanonicalRepr()
+			} else {
+				f.name = "u" + s.CanonicalRepr()
+			}
+		}
+
+		result[i] = f
+	}
+	return result
+}
+
+func fixedFieldName(r common.BitRange) string {
+	return fmt.Sprintf("op%d_%d", r.MSB, r.LSB)
+}
+
+// gatherFields returns all fields used by the insns, sorted by name.
+func gatherFields(descs []*common.InsnDescription) []field {
+	fields := make(map[string]field)
+	add := func(f field) {
+		if existing, ok := fields[f.name]; ok && existing != f {
+			panic(fmt.Sprintf("conflicting definitions of field %s", f.name))
+		}
+		fields[f.name] = f
+	}
+
+	for _, d := range descs {
+		for _, r := range d.FixedBitRanges() {
+			add(field{name: fixedFieldName(r), r: r})
+		}
+		for _, a := range d.Format.Args {
+			for _, f := range fieldsForArg(a) {
+				add(f)
+			}
+		}
+	}
+
+	result := make([]field, 0, len(fields))
+	for _, f := range fields {
+		result = append(result, f)
+	}
+	sort.Slice(result, func(i int, j int) bool {
+		return result[i].name < result[j].name
+	})
+	return result
+}
+
+func emitToken(ectx *common.EmitterCtx, fields []field) {
+	ectx.Emit("\ndefine token instr (32)\n")
+	for _, f := range fields {
+		ectx.Emit("\t%s = (%d, %d)", f.name, f.r.LSB, f.r.MSB)
+		if f.signed {
+			ectx.Emit(" signed")
+		}
+		ectx.Emit("\n")
+	}
+	ectx.Emit(";\n")
+}
+
+func emitAttachVariables(ectx *common.EmitterCtx, fields []field) {
+	for _, rf := range regFiles {
+		var names []string
+		for _, f := range fields {
+			if f.regKind == rf.kind {
+				names = append(names, f.name)
+			}
+		}
+		if len(names) == 0 {
+			continue
+		}
+
+		ectx.Emit(
+			"\nattach variables [ %s ] [ %s ];\n",
+			strings.Join(names, " "),
+			strings.Join(regNames(rf.kind), " "),
+		)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+// operandName returns the name by which the arg is referred to in the
+// constructors: the field itself for args of a single slot, and the subtable
+// assembling the fields otherwise.
+func operandName(a *common.Arg) string {
+	if len(a.Slots) == 1 {
+		return fieldsForArg(a)[0].name
+	}
+	return strings.ToLower(a.CanonicalRepr())
+}
+
+// emitMultiSlotTables emits a subtable for every distinct immediate arg
+// spanning several slots, concatenating the slots from the MSB direction to
+// the LSB direction like common.Arg.Extract.
+func emitMultiSlotTables(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	seen := make(map[string]bool)
+	for _, d := range descs {
+		for _, a := range d.Format.Args {
+			if len(a.Slots) == 1 {
+				continue
+			}
+
+			name := operandName(a)
+			if seen[name] {
+				continue
+			}
+			seen[name] = true
+
+			fields := fieldsForArg(a)
+			names := make([]string, len(fields))
+			var terms []string
+			valueOffsets := a.SlotValueOffsets()
+			for i, f := range fields {
+				names[i] = f.name
+				if valueOffsets[i] > 0 {
+					terms = append(terms, fmt.Sprintf("(%s << %d)", f.name, valueOffsets[i]))
+				} else {
+					terms = append(terms, f.name)
+				}
+			}
+
+			ectx.Emit(
+				"\n%s: val is %s [ val = %s; ] { export *[const]:8 val; }\n",
+				name,
+				strings.Join(names, " & "),
+				strings.Join(terms, " | "),
+			)
+		}
+	}
+}
+
+func emitConstructor(ectx *common.EmitterCtx, d *common.InsnDescription) {
+	var operands []string
+	for _, i := range d.SyntaxArgIndices() {
+		operands = append(operands, operandName(d.Format.Args[i]))
+	}
+
+	var constraints []string
+	for _, r := range d.FixedBitRanges() {
+		value := (d.Word >> r.LSB) & (1<<(r.MSB-r.LSB+1) - 1)
+		constraints = append(constraints, fmt.Sprintf("%s=0x%x", fixedFieldName(r), value))
+	}
+	for _, a := range d.Format.Args {
+		constraints = append(constraints, operandName(a))
+	}
+
+	ectx.Emit("\n:%s", d.Mnemonic)
+	if len(operands) > 0 {
+		ectx.Emit(" %s", strings.Join(operands, ", "))
+	}
+	ectx.Emit(" is %s unimpl\n", strings.Join(constraints, " & "))
+}
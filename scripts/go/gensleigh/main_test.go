@@ -0,0 +1,104 @@
+package main
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestGenerate(t *testing.T) {
+	var descs []*common.InsnDescription
+	for _, l := range []string{
+		"00100000 add.w                  DJK",
+		"02c00000 addi.d                 DJSk12",
+		"40000000 beqz                   JSd5k16",
+		"0c100000 fcmp.caf.s             CdFjFk",
+	} {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		descs = append(descs, d)
+	}
+
+	result := string(generate(descs, "0000000000000000000000000000000000000000"))
+	for _, s := range []string{
+		"\trd = (0, 4)\n",
+		"\tsk12 = (10, 21) signed\n",
+		"\tsd5 = (0, 4) signed\n",
+		"\tuk16 = (10, 25)\n",
+		"\top31_15 = (15, 31)\n",
+		"attach variables [ rd rj rk ] [ r0 r1 ",
+		"attach variables [ cd ] [ fcc0 fcc1 fcc2 fcc3 fcc4 fcc5 fcc6 fcc7 ];\n",
+		"sd5k16: val is sd5 & uk16 [ val = (sd5 << 16) | uk16; ] { export *[const]:8 val; }\n",
+		":add.w rd, rj, rk is op31_15=0x20 & rd & rj & rk unimpl\n",
+		":addi.d rd, rj, sk12 is op31_22=0xb & rd & rj & sk12 unimpl\n",
+		":beqz rj, sd5k16 is op31_26=0x10 & rj & sd5k16 unimpl\n",
+		":fcmp.caf.s cd, fj, fk is op31_15=0x1820 & op4_3=0x0 & cd & fj & fk unimpl\n",
+	} {
+		assert.Contains(t, result, s)
+	}
+}
+
+var (
+	fieldDefRE   = regexp.MustCompile(`^\t(\w+) = \((\d+), (\d+)\)`)
+	constraintRE = regexp.MustCompile(`^(\w+)=0x([0-9a-f]+)$`)
+)
+
+// TestGenerateOverCorpus checks that the fixed-bit constraints of every
+// constructor select exactly the encodings of the insn, and that the operand
+// fields cover exactly the bits of the args.
+func TestGenerateOverCorpus(t *testing.T) {
+	descs := common.Builtin()
+
+	result := string(generate(descs, "0000000000000000000000000000000000000000"))
+
+	fieldMasks := make(map[string]uint32)
+	fieldLSBs := make(map[string]uint)
+	constructors := make(map[string]string)
+	for _, l := range strings.Split(result, "\n") {
+		if m := fieldDefRE.FindStringSubmatch(l); m != nil {
+			lsb, _ := strconv.Atoi(m[2])
+			msb, _ := strconv.Atoi(m[3])
+			s := common.Slot{Offset: uint(lsb), Width: uint(msb - lsb + 1)}
+			fieldMasks[m[1]] = s.Bitmask()
+			fieldLSBs[m[1]] = uint(lsb)
+		}
+		if strings.HasPrefix(l, ":") {
+			mnemonic := strings.Fields(l[1:])[0]
+			constructors[mnemonic] = l
+		}
+	}
+	assert.Len(t, constructors, len(descs))
+
+	for _, d := range descs {
+		l, ok := constructors[d.Mnemonic]
+		if !assert.True(t, ok, d.Mnemonic) {
+			continue
+		}
+		pattern := strings.TrimSuffix(l[strings.Index(l, " is ")+4:], " unimpl")
+
+		var mask, match, argsMask uint32
+		for _, c := range strings.Split(pattern, " & ") {
+			if m := constraintRE.FindStringSubmatch(c); m != nil {
+				v, err := strconv.ParseUint(m[2], 16, 32)
+				assert.NoError(t, err)
+				mask |= fieldMasks[m[1]]
+				match |= uint32(v) << fieldLSBs[m[1]]
+			}
+		}
+		for _, a := range d.Format.Args {
+			for _, f := range fieldsForArg(a) {
+				assert.Contains(t, pattern, operandName(a), d.Mnemonic)
+				argsMask |= fieldMasks[f.name]
+			}
+		}
+
+		assert.Equal(t, d.FixedMask(), mask, d.Mnemonic)
+		assert.Equal(t, d.Word, match, d.Mnemonic)
+		assert.Equal(t, d.Format.ArgsBitmask(), argsMask, d.Mnemonic)
+	}
+}
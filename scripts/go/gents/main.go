@@ -0,0 +1,301 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+var (
+	emitVectors = flag.Bool("vectors", false, "emit the test vectors module instead of the encoder module")
+	modulePath  = flag.String("module", "./loongarch", "import path of the encoder module, for the test vectors module")
+)
+
+func main() {
+	flag.Parse()
+	inputs := flag.Args()
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	sort.Slice(descs, func(i int, j int) bool {
+		return descs[i].Word < descs[j].Word
+	})
+
+	// the function names are derived from mnemonics in a lossy way, so make
+	// sure they don't collide
+	seenFnNames := make(map[string]string)
+	for _, d := range descs {
+		fnName := encoderFnNameForInsn(d)
+		if other, ok := seenFnNames[fnName]; ok {
+			panic(fmt.Sprintf("%s and %s both map to %s", other, d.Mnemonic, fnName))
+		}
+		seenFnNames[fnName] = d.Mnemonic
+	}
+
+	ectx := common.EmitterCtx{
+		DontGofmt: true,
+	}
+
+	ectx.Emit("// Code generated by gents from loongson-community/loongarch-opcodes; DO NOT EDIT.\n")
+
+	if *emitVectors {
+		emitVectorsModule(&ectx, descs)
+	} else {
+		emitEncoderModule(&ectx, descs)
+	}
+
+	result := ectx.Finalize()
+	os.Stdout.Write(result)
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+// e.g. "amadd_db.w" -> "encodeAmaddDbW"
+func encoderFnNameForInsn(d *common.InsnDescription) string {
+	var sb strings.Builder
+	sb.WriteString("encode")
+	for _, part := range strings.FieldsFunc(d.Mnemonic, func(r rune) bool {
+		return r == '.' || r == '_'
+	}) {
+		sb.WriteString(strings.ToUpper(part[:1]))
+		sb.WriteString(part[1:])
+	}
+	return sb.String()
+}
+
+// paramNameForArg returns the parameter name in the style of the LoongArch
+// manual for registers (e.g. "rd" or "fj"), and the canonical name for
+// immediates (e.g. "sk12"), which is unique among the args of a format.
+func paramNameForArg(a *common.Arg) string {
+	var prefix string
+	switch a.Kind {
+	case common.ArgKindIntReg:
+		prefix = "r"
+	case common.ArgKindFPReg:
+		prefix = "f"
+	case common.ArgKindFCCReg:
+		prefix = "c"
+	case common.ArgKindScratchReg:
+		prefix = "t"
+	case common.ArgKindVReg:
+		prefix = "v"
+	case common.ArgKindXReg:
+		prefix = "x"
+	case common.ArgKindSignedImm, common.ArgKindUnsignedImm:
+		return a.Name()
+	default:
+		panic("unreachable")
+	}
+
+	return prefix + a.Slots[0].Letter()
+}
+
+func paramNamesForInsn(d *common.InsnDescription) []string {
+	result := make([]string, len(d.Format.Args))
+	for i, a := range d.Format.Args {
+		result[i] = paramNameForArg(a)
+	}
+	return result
+}
+
+// slotExprsForArg returns the TypeScript expressions of the slot values of
+// the arg, already shifted into place.
+func slotExprsForArg(a *common.Arg, name string) []string {
+	result := make([]string, len(a.Slots))
+
+	// see common.Arg.SlotValueOffsets for the slot order; the shifts are
+	// arithmetic so negative values work, but masking makes the sign
+	// irrelevant anyway
+	valueOffsets := a.SlotValueOffsets()
+	for i, s := range a.Slots {
+		mask := (uint64(1) << s.Width) - 1
+
+		expr := name
+		if valueOffsets[i] > 0 {
+			expr = fmt.Sprintf("(%s >> %d)", name, valueOffsets[i])
+		}
+		expr = fmt.Sprintf("(%s & 0x%x)", expr, mask)
+		if s.Offset > 0 {
+			expr = fmt.Sprintf("(%s << %d)", expr, s.Offset)
+		}
+
+		result[i] = expr
+	}
+
+	return result
+}
+
+func emitEncoderModule(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit(`
+function checkRange(
+  mnemonic: string,
+  name: string,
+  kind: string,
+  v: number,
+  min: number,
+  max: number,
+): void {
+  if (!Number.isInteger(v) || v < min || v > max) {
+    throw new RangeError(
+      ` + "`${mnemonic}: operand ${name}: ${kind} ${v} out of range [${min}, ${max}]`" + `,
+    );
+  }
+}
+`)
+
+	for _, d := range descs {
+		emitEncoderFn(ectx, d)
+	}
+
+	ectx.Emit(`
+export interface InsnInfo {
+  readonly mnemonic: string;
+  /** The fixed bits of the instruction word. */
+  readonly word: number;
+  /** The canonical representation of the instruction format. */
+  readonly format: string;
+  /** The operand names, in the order of the encoder's parameters. */
+  readonly operands: readonly string[];
+  readonly encode: (...operands: number[]) => number;
+}
+
+export const insns: readonly InsnInfo[] = [
+`)
+
+	for _, d := range descs {
+		quoted := make([]string, len(d.Format.Args))
+		for i, name := range paramNamesForInsn(d) {
+			quoted[i] = fmt.Sprintf("%q", name)
+		}
+
+		ectx.Emit(
+			"  { mnemonic: %q, word: 0x%08x, format: %q, operands: [%s], encode: %s },\n",
+			d.Mnemonic,
+			d.Word,
+			d.Format.CanonicalRepr(),
+			strings.Join(quoted, ", "),
+			encoderFnNameForInsn(d),
+		)
+	}
+
+	ectx.Emit("];\n")
+}
+
+func emitEncoderFn(ectx *common.EmitterCtx, d *common.InsnDescription) {
+	names := paramNamesForInsn(d)
+	roles := d.ArgRoles()
+
+	params := make([]string, len(names))
+	for i, name := range names {
+		params[i] = name + ": number"
+	}
+
+	syntax := d.Mnemonic
+	if len(names) > 0 {
+		syntax += " " + strings.Join(names, ", ")
+	}
+
+	ectx.Emit("\n/** Encodes `%s`. */\n", syntax)
+	ectx.Emit(
+		"export function %s(%s): number {\n",
+		encoderFnNameForInsn(d),
+		strings.Join(params, ", "),
+	)
+
+	var exprs []string
+	exprs = append(exprs, fmt.Sprintf("0x%08x", d.Word))
+	for i, a := range d.Format.Args {
+		ectx.Emit(
+			"  checkRange(%q, %q, %q, %s, %d, %d);\n",
+			d.Mnemonic,
+			names[i],
+			common.DescribeArg(a, roles[i]),
+			names[i],
+			a.MinValue(),
+			a.MaxValue(),
+		)
+		exprs = append(exprs, slotExprsForArg(a, names[i])...)
+	}
+
+	// the bitwise ops work on signed 32-bit integers, convert back to
+	// unsigned in the end
+	ectx.Emit("  return (%s) >>> 0;\n", strings.Join(exprs, " | "))
+	ectx.Emit("}\n")
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+func emitVectorsModule(ectx *common.EmitterCtx, descs []*common.InsnDescription) {
+	ectx.Emit("\nimport { insns } from %q;\n", *modulePath)
+	ectx.Emit(`
+/** Test vectors, as [mnemonic, operands, expected instruction word]. */
+export const vectors: readonly (readonly [string, readonly number[], number])[] = [
+`)
+
+	rng := rand.New(rand.NewSource(1))
+	for _, d := range descs {
+		for _, args := range vectorArgsForInsn(rng, d) {
+			strs := make([]string, len(args))
+			for i, v := range args {
+				strs[i] = fmt.Sprintf("%d", v)
+			}
+
+			ectx.Emit("  [%q, [%s], 0x%08x],\n", d.Mnemonic, strings.Join(strs, ", "), d.Encode(args))
+		}
+	}
+
+	ectx.Emit(`];
+
+/**
+ * Checks the encoders against the test vectors, returning descriptions of
+ * the mismatches, if any.
+ */
+export function checkVectors(): string[] {
+  const byMnemonic = new Map(insns.map((x) => [x.mnemonic, x]));
+  const result: string[] = [];
+  for (const [mnemonic, operands, expected] of vectors) {
+    const insn = byMnemonic.get(mnemonic);
+    if (insn === undefined) {
+      result.push(` + "`${mnemonic}: missing`" + `);
+      continue;
+    }
+
+    const actual = insn.encode(...operands);
+    if (actual !== expected) {
+      result.push(
+        ` + "`${mnemonic} ${operands.join(\", \")}: got 0x${actual.toString(16)}, want 0x${expected.toString(16)}`" + `,
+      );
+    }
+  }
+  return result;
+}
+`)
+}
+
+// vectorArgsForInsn returns the operand tuples to test: all minimum values,
+// all maximum values, and a random one.
+func vectorArgsForInsn(rng *rand.Rand, d *common.InsnDescription) [][]int64 {
+	if len(d.Format.Args) == 0 {
+		return [][]int64{nil}
+	}
+
+	n := len(d.Format.Args)
+	mins := make([]int64, n)
+	maxs := make([]int64, n)
+	randoms := make([]int64, n)
+	for i, a := range d.Format.Args {
+		mins[i] = a.MinValue()
+		maxs[i] = a.MaxValue()
+		randoms[i] = mins[i] + rng.Int63n(maxs[i]-mins[i]+1)
+	}
+
+	return [][]int64{mins, maxs, randoms}
+}
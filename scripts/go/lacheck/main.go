@@ -0,0 +1,125 @@
+// Command lacheck encodes or decodes a single insn, for checking encodings by
+// hand while editing the descriptions:
+//
+//	$ lacheck addi.d r4,r5,-16
+//	0x02ffc0a4
+//	$ echo 'addi.d $r4, $r5, 0x10' | lacheck
+//	0x02c040a4
+//	$ lacheck -d 0x02ffc0a4
+//	addi.d $r4, $r5, -16
+//
+// The insn to encode is taken from the arguments, or read from stdin if there
+// are none. Operands are comma-separated in the canonical order, with the
+// immediates as encoded, in decimal or hex, which is also how decoded insns
+// are printed; the "$" prefix of the registers is optional.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func main() {
+	insnsGlob := flag.String("insns", "", "glob pattern of the instruction description files; if empty, use the descriptions built into the common package")
+	decode := flag.Bool("d", false, "decode the insn words given as arguments, instead of encoding an insn")
+	count := flag.Bool("n", false, "print the number of insns known, instead of encoding an insn")
+	flag.Parse()
+
+	descs, err := loadDescs(*insnsGlob)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "fatal: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *count {
+		fmt.Printf("%d insns\n", len(descs))
+		return
+	}
+
+	if *decode {
+		dec := common.NewDecoder(descs)
+		for _, arg := range flag.Args() {
+			s, err := decodeWord(dec, arg)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "fatal: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Println(s)
+		}
+		return
+	}
+
+	os.Exit(run(descs, flag.Args(), os.Stdin, os.Stdout, os.Stderr))
+}
+
+// loadDescs reads the description files matching the glob pattern, or
+// returns the built-in descriptions if it is empty.
+func loadDescs(insnsGlob string) ([]*common.InsnDescription, error) {
+	if insnsGlob == "" {
+		return common.Builtin(), nil
+	}
+
+	inputs, err := filepath.Glob(insnsGlob)
+	if err != nil {
+		return nil, fmt.Errorf("-insns: %w", err)
+	}
+	if len(inputs) == 0 {
+		return nil, fmt.Errorf("-insns: no files match %q", insnsGlob)
+	}
+	return common.ReadInsnDescs(inputs)
+}
+
+// run encodes the insn given by args, or read from stdin if args is empty,
+// and returns the exit status.
+func run(descs []*common.InsnDescription, args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int {
+	line := strings.Join(args, " ")
+	if len(args) == 0 {
+		b, err := io.ReadAll(stdin)
+		if err != nil {
+			fmt.Fprintf(stderr, "fatal: %v\n", err)
+			return 1
+		}
+		line = strings.TrimSpace(string(b))
+	}
+
+	switch {
+	case line == "":
+		fmt.Fprintf(stderr, "fatal: no insn given\n")
+		return 1
+	case strings.Contains(line, "\n"):
+		fmt.Fprintf(stderr, "fatal: more than one insn given\n")
+		return 1
+	}
+
+	word, err := common.NewAssembler(descs).AssembleLine(line)
+	if err != nil {
+		fmt.Fprintf(stderr, "fatal: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(stdout, "0x%08x\n", word)
+	return 0
+}
+
+func decodeWord(dec common.InsnDecoder, s string) (string, error) {
+	word, err := strconv.ParseUint(s, 0, 32)
+	if err != nil {
+		return "", fmt.Errorf("invalid insn word %q", s)
+	}
+
+	x, ok := dec.Decode(uint32(word))
+	if !ok {
+		return "", fmt.Errorf("0x%08x: unknown insn", word)
+	}
+
+	if x.Illegal {
+		return fmt.Sprintf("%s: reserved bits set", x.Desc.Mnemonic), nil
+	}
+	return x.String(), nil
+}
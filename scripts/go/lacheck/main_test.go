@@ -0,0 +1,54 @@
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestRun(t *testing.T) {
+	descs := common.Builtin()
+
+	testcases := []struct {
+		args   []string
+		stdin  string
+		status int
+		stdout string
+		stderr string
+	}{
+		{args: []string{"addi.d", "r4,", "r5,", "-16"}, stdout: "0x02ffc0a4\n"},
+		{stdin: "addi.d $r4, $r5, 0x10\n", stdout: "0x02c040a4\n"},
+		{stdin: "fadd.s f0, f1, f2", stdout: "0x01008820\n"},
+		{args: []string{"addi.d r4, r5, 4096"}, status: 1, stderr: "fatal: addi.d: operand sk12: signed immediate 4096 out of range [-2048, 2047]\n"},
+		{args: []string{"addi.d f4, r5, -16"}, status: 1, stderr: "fatal: addi.d: operand d: want a register like $r0, got \"f4\"\n"},
+		{args: []string{"foo r1"}, status: 1, stderr: "fatal: unknown mnemonic \"foo\"\n"},
+		{stdin: "\n", status: 1, stderr: "fatal: no insn given\n"},
+		{stdin: "nop\nnop\n", status: 1, stderr: "fatal: more than one insn given\n"},
+	}
+	for _, tc := range testcases {
+		var stdout, stderr strings.Builder
+		status := run(descs, tc.args, strings.NewReader(tc.stdin), &stdout, &stderr)
+		assert.Equal(t, tc.status, status, "%v %q", tc.args, tc.stdin)
+		assert.Equal(t, tc.stdout, stdout.String(), "%v %q", tc.args, tc.stdin)
+		assert.Equal(t, tc.stderr, stderr.String(), "%v %q", tc.args, tc.stdin)
+	}
+}
+
+func TestLoadDescs(t *testing.T) {
+	descs, err := loadDescs("")
+	assert.NoError(t, err)
+	assert.Equal(t, common.Builtin(), descs)
+
+	descs, err = loadDescs("../../../la-base-32.txt")
+	assert.NoError(t, err)
+	assert.NotEmpty(t, descs)
+
+	_, err = loadDescs("[")
+	assert.EqualError(t, err, "-insns: syntax error in pattern")
+
+	_, err = loadDescs("nonexistent/*.txt")
+	assert.EqualError(t, err, "-insns: no files match \"nonexistent/*.txt\"")
+}
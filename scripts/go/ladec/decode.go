@@ -0,0 +1,187 @@
+package ladec
+
+import (
+	"fmt"
+	"strings"
+)
+
+// indexBits is the number of high-order bits of the insn word the descriptor
+// index dispatches on. It must match the same constant in genladec.
+const indexBits = 10
+
+// OperandKind is the kind of an operand, with the same numbering as
+// common.ArgKind.
+type OperandKind uint8
+
+const (
+	OperandKindUnknown     OperandKind = 0
+	OperandKindIntReg      OperandKind = 1
+	OperandKindFPReg       OperandKind = 2
+	OperandKindFCCReg      OperandKind = 3
+	OperandKindScratchReg  OperandKind = 4
+	OperandKindVReg        OperandKind = 5
+	OperandKindXReg        OperandKind = 6
+	OperandKindSignedImm   OperandKind = 7
+	OperandKindUnsignedImm OperandKind = 8
+)
+
+type Operand struct {
+	Kind OperandKind
+	// Value is the value as encoded, sign-extended for signed imms.
+	Value int64
+	// Shift and Bias turn the encoded value of an imm into the value written
+	// in assembly, see Imm.
+	Shift uint
+	Bias  int64
+}
+
+func (o Operand) IsReg() bool {
+	return o.Kind >= OperandKindIntReg && o.Kind <= OperandKindXReg
+}
+
+func (o Operand) IsImm() bool {
+	return o.Kind == OperandKindSignedImm || o.Kind == OperandKindUnsignedImm
+}
+
+// Reg returns the register number of a register operand.
+func (o Operand) Reg() (uint, bool) {
+	if !o.IsReg() {
+		return 0, false
+	}
+	return uint(o.Value), true
+}
+
+// Imm returns the value of an imm operand as written in assembly, i.e.
+// sign-extended if signed, and scaled and biased as in the manual, e.g. in
+// bytes instead of words for branch offsets.
+func (o Operand) Imm() (int64, bool) {
+	if !o.IsImm() {
+		return 0, false
+	}
+	return o.Value<<o.Shift + o.Bias, true
+}
+
+func (o Operand) String() string {
+	switch o.Kind {
+	case OperandKindIntReg:
+		return fmt.Sprintf("$r%d", o.Value)
+	case OperandKindFPReg:
+		return fmt.Sprintf("$f%d", o.Value)
+	case OperandKindFCCReg:
+		return fmt.Sprintf("$fcc%d", o.Value)
+	case OperandKindScratchReg:
+		return fmt.Sprintf("$scr%d", o.Value)
+	case OperandKindVReg:
+		return fmt.Sprintf("$vr%d", o.Value)
+	case OperandKindXReg:
+		return fmt.Sprintf("$xr%d", o.Value)
+	default:
+		return fmt.Sprintf("%d", o.Value)
+	}
+}
+
+type Insn struct {
+	Word     uint32
+	Mnemonic string
+	// Operands are in the canonical order of the insn format.
+	Operands []Operand
+	// Illegal is set if the word matches the opcode of the insn, but has
+	// reserved bits set.
+	Illegal bool
+}
+
+func (x *Insn) String() string {
+	if x.Illegal {
+		return "<illegal: reserved bits set>"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(x.Mnemonic)
+	for i, o := range x.Operands {
+		if i == 0 {
+			sb.WriteRune(' ')
+		} else {
+			sb.WriteString(", ")
+		}
+
+		sb.WriteString(o.String())
+	}
+
+	return sb.String()
+}
+
+func readUint32(b []uint8) uint32 {
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24
+}
+
+// decodeGeneric decodes word according to the descriptor at the start of
+// desc, returning false if the word doesn't match it.
+func decodeGeneric(word uint32, desc []uint8) (Insn, bool) {
+	mask := readUint32(desc[0:])
+	match := readUint32(desc[4:])
+	if word&mask != match {
+		return Insn{}, false
+	}
+
+	reserved := readUint32(desc[8:])
+	mnemonicIdx := uint16(desc[12]) | uint16(desc[13])<<8
+	nargs := int(desc[14])
+	p := 15
+
+	var operands []Operand
+	if nargs > 0 {
+		operands = make([]Operand, nargs)
+	}
+	for i := range operands {
+		kind := OperandKind(desc[p] & 0xf)
+		nslots := int(desc[p]>>4&0x3) + 1
+		postKind := desc[p] >> 6
+		p++
+
+		var v uint64
+		var totalWidth uint
+		for j := 0; j < nslots; j++ {
+			offset, width := uint(desc[p]), uint(desc[p+1])
+			p += 2
+
+			v = v<<width | uint64(word>>offset&(1<<width-1))
+			totalWidth += width
+		}
+
+		operands[i].Kind = kind
+		if kind == OperandKindSignedImm {
+			operands[i].Value = int64(v<<(64-totalWidth)) >> (64 - totalWidth)
+		} else {
+			operands[i].Value = int64(v)
+		}
+
+		// see common.PostprocessOpKind
+		switch postKind {
+		case 1:
+			operands[i].Bias = int64(desc[p])
+			p++
+		case 2:
+			operands[i].Shift = uint(desc[p])
+			p++
+		}
+	}
+
+	return Insn{
+		Word:     word,
+		Mnemonic: mnemonics[mnemonicIdx],
+		Operands: operands,
+		Illegal:  word&reserved != 0,
+	}, true
+}
+
+// Decode decodes the insn word, returning false if it doesn't encode any
+// known instruction.
+func Decode(word uint32) (Insn, bool) {
+	bucket := word >> (32 - indexBits)
+	for _, off := range indexOffsets[indexStarts[bucket]:indexStarts[bucket+1]] {
+		if x, ok := decodeGeneric(word, descriptors[off:]); ok {
+			return x, true
+		}
+	}
+	return Insn{}, false
+}
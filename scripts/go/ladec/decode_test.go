@@ -0,0 +1,200 @@
+package ladec
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestDecode(t *testing.T) {
+	testcases := []struct {
+		word     uint32
+		expected string
+	}{
+		{word: 0x00101483, expected: "add.w $r3, $r4, $r5"},
+		{word: 0x02ffc0a4, expected: "addi.d $r4, $r5, -16"},
+		{word: 0x43fffc9f, expected: "beqz $r4, -1"},
+		{word: 0x53fffdff, expected: "b 33554431"},
+		{word: 0x50000200, expected: "b -33554432"},
+		{word: 0x06483800, expected: "eret"},
+		{word: 0x00011480, expected: "asrtle $r4, $r5"},
+	}
+
+	for _, tc := range testcases {
+		x, ok := Decode(tc.word)
+		assert.True(t, ok, "%08x", tc.word)
+		assert.Equal(t, tc.expected, x.String(), "%08x", tc.word)
+	}
+
+	_, ok := Decode(0xffffffff)
+	assert.False(t, ok)
+}
+
+func TestDecodeGeneric(t *testing.T) {
+	desc := []uint8{
+		// mask, match and reserved: JK with rd reserved
+		0x00, 0x80, 0xff, 0xff,
+		0x00, 0x00, 0x01, 0x00,
+		0x1f, 0x00, 0x00, 0x00,
+		0x00, 0x00, 0x02,
+		0x01, 0x05, 0x05,
+		0x01, 0x0a, 0x05,
+	}
+
+	_, ok := decodeGeneric(0x00001480, desc)
+	assert.False(t, ok)
+
+	x, ok := decodeGeneric(0x0001149f, desc)
+	assert.True(t, ok)
+	assert.True(t, x.Illegal)
+	assert.Equal(t, []Operand{
+		{Kind: OperandKindIntReg, Value: 4},
+		{Kind: OperandKindIntReg, Value: 5},
+	}, x.Operands)
+}
+
+func TestOperandAccessors(t *testing.T) {
+	testcases := []struct {
+		word uint32
+		imm  int64
+	}{
+		// addi.w $r4, $r5, si12 at the sign boundary
+		{word: 0x02bffca4, imm: -1},
+		{word: 0x029ffca4, imm: 2047},
+		{word: 0x02a000a4, imm: -2048},
+		// ori $r4, $r5, 0xfff is unsigned
+		{word: 0x03bffca4, imm: 4095},
+		// beq $r5, $r4, offs16 in bytes
+		{word: 0x5bfffca4, imm: -4},
+		{word: 0x5a0000a4, imm: -0x20000},
+		// sladd.w (alsl.w) $r4, $r5, $r6, sa2 biased by 1
+		{word: 0x000598a4, imm: 4},
+	}
+
+	for _, tc := range testcases {
+		x, ok := Decode(tc.word)
+		if !assert.True(t, ok, "%08x", tc.word) {
+			continue
+		}
+
+		imm := x.Operands[len(x.Operands)-1]
+		v, ok := imm.Imm()
+		assert.True(t, ok, "%08x", tc.word)
+		assert.Equal(t, tc.imm, v, "%08x %s", tc.word, x.Mnemonic)
+		_, ok = imm.Reg()
+		assert.False(t, ok)
+
+		n, ok := x.Operands[0].Reg()
+		assert.True(t, ok)
+		assert.Equal(t, uint(4), n)
+		_, ok = x.Operands[0].Imm()
+		assert.False(t, ok)
+	}
+}
+
+func checkAgainstDecoder(t *testing.T, dec common.InsnDecoder, word uint32) {
+	expected, expectedOK := dec.Decode(word)
+	actual, ok := Decode(word)
+	if !assert.Equal(t, expectedOK, ok, "%08x", word) || !ok {
+		return
+	}
+
+	assert.Equal(t, expected.Desc.Mnemonic, actual.Mnemonic, "%08x", word)
+	assert.Equal(t, expected.Illegal, actual.Illegal, "%08x", word)
+	assert.Equal(t, expected.String(), actual.String(), "%08x", word)
+
+	manualIndices := expected.Desc.ManualSyntaxArgIndices()
+	for j, ma := range expected.Desc.ManualSyntaxArgs() {
+		i := manualIndices[j]
+		a := expected.Desc.Format.Args[i]
+		o := actual.Operands[i]
+		assert.Equal(t, OperandKind(a.Kind), o.Kind, "%08x", word)
+		assert.Equal(t, expected.Args[i], o.Value, "%08x", word)
+
+		if a.Kind.IsImm() {
+			v, ok := o.Imm()
+			assert.True(t, ok)
+			assert.Equal(t, expected.Args[i]*ma.Scale()+ma.Bias(), v, "%08x", word)
+		} else {
+			n, ok := o.Reg()
+			assert.True(t, ok)
+			assert.Equal(t, uint(expected.Args[i]), n, "%08x", word)
+		}
+	}
+}
+
+func TestDecodeMatchesInterpretiveDecoder(t *testing.T) {
+	descs := common.Builtin()
+	assert.Equal(t, len(descs), len(mnemonics))
+
+	dec := common.NewDecoder(descs)
+	rng := rand.New(rand.NewSource(1))
+	for _, d := range descs {
+		checkAgainstDecoder(t, dec, d.Word)
+
+		args := make([]int64, len(d.Format.Args))
+		for i, a := range d.Format.Args {
+			args[i] = a.MinValue() + rng.Int63n(a.MaxValue()-a.MinValue()+1)
+		}
+		w := d.Encode(args)
+		checkAgainstDecoder(t, dec, w)
+		checkAgainstDecoder(t, dec, w^(1<<rng.Intn(32)))
+		checkAgainstDecoder(t, dec, w|d.ReservedMask())
+	}
+
+	for i := 0; i < 20000; i++ {
+		checkAgainstDecoder(t, dec, rng.Uint32())
+	}
+}
+
+func BenchmarkDecode(b *testing.B) {
+	descs := common.Builtin()
+
+	rng := rand.New(rand.NewSource(42))
+	words := make([]uint32, 1<<16)
+	for i := range words {
+		d := descs[rng.Intn(len(descs))]
+		args := make([]int64, len(d.Format.Args))
+		for j, a := range d.Format.Args {
+			args[j] = a.MinValue() + rng.Int63n(a.MaxValue()-a.MinValue()+1)
+		}
+		words[i] = d.Encode(args)
+	}
+
+	b.Run("Descriptor", func(b *testing.B) {
+		b.SetBytes(int64(len(words) * 4))
+		for i := 0; i < b.N; i++ {
+			for _, w := range words {
+				if _, ok := Decode(w); !ok {
+					b.Fatalf("failed to decode %08x", w)
+				}
+			}
+		}
+	})
+
+	decoders := []struct {
+		name string
+		dec  common.InsnDecoder
+	}{
+		{name: "Indexed", dec: common.NewIndexedDecoder(descs)},
+		{name: "Tree", dec: common.BuildDecodeTree(descs)},
+	}
+
+	for _, x := range decoders {
+		b.Run(x.name, func(b *testing.B) {
+			b.SetBytes(int64(len(words) * 4))
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				for _, w := range words {
+					if _, ok := x.dec.Decode(w); !ok {
+						b.Fatalf("failed to decode %08x", w)
+					}
+				}
+			}
+		})
+	}
+}
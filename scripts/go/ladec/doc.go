@@ -0,0 +1,27 @@
+// Package ladec is a standalone LoongArch instruction decoder, generated from
+// the instruction descriptions in this repository.
+//
+// Instead of a switch statement per instruction format, every instruction is
+// described by a few bytes in a compact descriptor blob, which a single
+// generic routine walks to match and extract the operands. This keeps the
+// generated code small at the cost of some decoding speed; see BenchmarkDecode
+// for a comparison with the decode tree in package common.
+//
+// Every descriptor is laid out as follows, with multi-byte fields in
+// little-endian order:
+//
+//	mask     uint32 // fixed bits of the insn word
+//	match    uint32 // values of the fixed bits
+//	reserved uint32 // reserved bits that must be zero
+//	mnemonic uint16 // index into the mnemonic table
+//	nargs    uint8
+//	args     [nargs]arg
+//
+// where every arg is one byte holding the OperandKind in the low 4 bits, the
+// number of slots minus 1 in bits 5:4, and the kind of the postprocess op of
+// the manual syntax in bits 7:6 (0 for none, 1 for add, 2 for shift left),
+// followed by an (offset, width) byte pair for each slot, from MSB to LSB,
+// then by the amount of the postprocess op if any.
+package ladec
+
+//go:generate sh -c "go run ../genladec ../../../*.txt > insns.go"
@@ -0,0 +1,32 @@
+package ladec_test
+
+import (
+	"fmt"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/ladec"
+)
+
+func ExampleDecode() {
+	// addi.d $r4, $r5, -16
+	x, ok := ladec.Decode(0x02ffc0a4)
+	if !ok {
+		panic("unknown insn")
+	}
+
+	fmt.Println(x.Mnemonic)
+	for _, o := range x.Operands {
+		if r, ok := o.Reg(); ok {
+			fmt.Println("reg", r)
+		}
+		if v, ok := o.Imm(); ok {
+			fmt.Println("imm", v)
+		}
+	}
+	fmt.Println(x.String())
+	// Output:
+	// addi.d
+	// reg 4
+	// reg 5
+	// imm -16
+	// addi.d $r4, $r5, -16
+}
@@ -0,0 +1,6866 @@
+// Code generated by genladec from loongson-community/loongarch-opcodes; DO NOT EDIT.
+
+package ladec
+
+var mnemonics = [...]string{
+	"movgr2scr",
+	"movscr2gr",
+	"clo.w",
+	"clz.w",
+	"cto.w",
+	"ctz.w",
+	"clo.d",
+	"clz.d",
+	"cto.d",
+	"ctz.d",
+	"revb.2h",
+	"revb.4h",
+	"revb.2w",
+	"revb.d",
+	"revh.2w",
+	"revh.d",
+	"revbit.4b",
+	"revbit.8b",
+	"revbit.w",
+	"revbit.d",
+	"sext.h",
+	"sext.b",
+	"rdtimel.w",
+	"rdtimeh.w",
+	"rdtime.d",
+	"cpucfg",
+	"x86mttop",
+	"x86mftop",
+	"x86setloope",
+	"x86setloopne",
+	"x86inc.b",
+	"x86inc.h",
+	"x86inc.w",
+	"x86inc.d",
+	"x86dec.b",
+	"x86dec.h",
+	"x86dec.w",
+	"x86dec.d",
+	"x86settm",
+	"x86inctop",
+	"x86clrtm",
+	"x86dectop",
+	"asrtle",
+	"asrtgt",
+	"sladd.w",
+	"sladd.wu",
+	"catpick.w",
+	"catpick.d",
+	"add.w",
+	"add.d",
+	"sub.w",
+	"sub.d",
+	"slt",
+	"sltu",
+	"maskeqz",
+	"masknez",
+	"nor",
+	"and",
+	"or",
+	"xor",
+	"orn",
+	"andn",
+	"sll.w",
+	"srl.w",
+	"sra.w",
+	"sll.d",
+	"srl.d",
+	"sra.d",
+	"rotr.b",
+	"rotr.h",
+	"rotr.w",
+	"rotr.d",
+	"mul.w",
+	"mulh.w",
+	"mulh.wu",
+	"mul.d",
+	"mulh.d",
+	"mulh.du",
+	"mulw.d.w",
+	"mulw.d.wu",
+	"div.w",
+	"mod.w",
+	"div.wu",
+	"mod.wu",
+	"div.d",
+	"mod.d",
+	"div.du",
+	"mod.du",
+	"crc.w.b.w",
+	"crc.w.h.w",
+	"crc.w.w.w",
+	"crc.w.d.w",
+	"crcc.w.b.w",
+	"crcc.w.h.w",
+	"crcc.w.w.w",
+	"crcc.w.d.w",
+	"addu12i.w",
+	"addu12i.d",
+	"break",
+	"dbgcall",
+	"syscall",
+	"hypcall",
+	"sladd.d",
+	"adc.b",
+	"adc.h",
+	"adc.w",
+	"adc.d",
+	"sbc.b",
+	"sbc.h",
+	"sbc.w",
+	"sbc.d",
+	"rcr.b",
+	"rcr.h",
+	"rcr.w",
+	"rcr.d",
+	"armmove",
+	"x86setj",
+	"armsetj",
+	"armadd.w",
+	"armsub.w",
+	"armadc.w",
+	"armsbc.w",
+	"armand.w",
+	"armor.w",
+	"armxor.w",
+	"armsll.w",
+	"armsrl.w",
+	"armsra.w",
+	"armrotr.w",
+	"armslli.w",
+	"armsrli.w",
+	"armsrai.w",
+	"armrotri.w",
+	"x86mul.b",
+	"x86mul.h",
+	"x86mul.w",
+	"x86mul.d",
+	"x86mul.bu",
+	"x86mul.hu",
+	"x86mul.wu",
+	"x86mul.du",
+	"x86add.wu",
+	"x86add.du",
+	"x86sub.wu",
+	"x86sub.du",
+	"x86add.b",
+	"x86add.h",
+	"x86add.w",
+	"x86add.d",
+	"x86sub.b",
+	"x86sub.h",
+	"x86sub.w",
+	"x86sub.d",
+	"x86adc.b",
+	"x86adc.h",
+	"x86adc.w",
+	"x86adc.d",
+	"x86sbc.b",
+	"x86sbc.h",
+	"x86sbc.w",
+	"x86sbc.d",
+	"x86sll.b",
+	"x86sll.h",
+	"x86sll.w",
+	"x86sll.d",
+	"x86srl.b",
+	"x86srl.h",
+	"x86srl.w",
+	"x86srl.d",
+	"x86sra.b",
+	"x86sra.h",
+	"x86sra.w",
+	"x86sra.d",
+	"x86rotr.b",
+	"x86rotr.h",
+	"x86rotr.d",
+	"x86rotr.w",
+	"x86rotl.b",
+	"x86rotl.h",
+	"x86rotl.w",
+	"x86rotl.d",
+	"x86rcr.b",
+	"x86rcr.h",
+	"x86rcr.w",
+	"x86rcr.d",
+	"x86rcl.b",
+	"x86rcl.h",
+	"x86rcl.w",
+	"x86rcl.d",
+	"x86and.b",
+	"x86and.h",
+	"x86and.w",
+	"x86and.d",
+	"x86or.b",
+	"x86or.h",
+	"x86or.w",
+	"x86or.d",
+	"x86xor.b",
+	"x86xor.h",
+	"x86xor.w",
+	"x86xor.d",
+	"armnot.w",
+	"armmov.w",
+	"armmov.d",
+	"armrrx.w",
+	"slli.w",
+	"slli.d",
+	"srli.w",
+	"srli.d",
+	"srai.w",
+	"srai.d",
+	"rotri.b",
+	"rotri.h",
+	"rotri.w",
+	"rotri.d",
+	"rcri.b",
+	"rcri.h",
+	"rcri.w",
+	"rcri.d",
+	"x86slli.b",
+	"x86srli.b",
+	"x86srai.b",
+	"x86rotri.b",
+	"x86rcri.b",
+	"x86rotli.b",
+	"x86rcli.b",
+	"x86slli.h",
+	"x86srli.h",
+	"x86srai.h",
+	"x86rotri.h",
+	"x86rcri.h",
+	"x86rotli.h",
+	"x86rcli.h",
+	"x86slli.w",
+	"x86srli.w",
+	"x86srai.w",
+	"x86rotri.w",
+	"x86rcri.w",
+	"x86rotli.w",
+	"x86rcli.w",
+	"x86slli.d",
+	"x86srli.d",
+	"x86srai.d",
+	"x86rotri.d",
+	"x86rcri.d",
+	"x86rotli.d",
+	"x86rcli.d",
+	"x86settag",
+	"x86mfflag",
+	"x86mtflag",
+	"armmfflag",
+	"armmtflag",
+	"bstrins.w",
+	"bstrpick.w",
+	"bstrins.d",
+	"bstrpick.d",
+	"fadd.s",
+	"fadd.d",
+	"fsub.s",
+	"fsub.d",
+	"fmul.s",
+	"fmul.d",
+	"fdiv.s",
+	"fdiv.d",
+	"fmax.s",
+	"fmax.d",
+	"fmin.s",
+	"fmin.d",
+	"fmaxa.s",
+	"fmaxa.d",
+	"fmina.s",
+	"fmina.d",
+	"fscaleb.s",
+	"fscaleb.d",
+	"fcopysign.s",
+	"fcopysign.d",
+	"fabs.s",
+	"fabs.d",
+	"fneg.s",
+	"fneg.d",
+	"flogb.s",
+	"flogb.d",
+	"fclass.s",
+	"fclass.d",
+	"fsqrt.s",
+	"fsqrt.d",
+	"frecip.s",
+	"frecip.d",
+	"frsqrt.s",
+	"frsqrt.d",
+	"fmov.s",
+	"fmov.d",
+	"movgr2fr.w",
+	"movgr2fr.d",
+	"movgr2frh.w",
+	"movfr2gr.s",
+	"movfr2gr.d",
+	"movfrh2gr.s",
+	"fcsrwr",
+	"fcsrrd",
+	"movfr2fcc",
+	"movfcc2fr",
+	"movgr2fcc",
+	"movfcc2gr",
+	"fcvt.ld.d",
+	"fcvt.ud.d",
+	"fcvt.d.ld",
+	"fcvt.s.d",
+	"fcvt.d.s",
+	"ftintrm.w.s",
+	"ftintrm.w.d",
+	"ftintrm.l.s",
+	"ftintrm.l.d",
+	"ftintrp.w.s",
+	"ftintrp.w.d",
+	"ftintrp.l.s",
+	"ftintrp.l.d",
+	"ftintrz.w.s",
+	"ftintrz.w.d",
+	"ftintrz.l.s",
+	"ftintrz.l.d",
+	"ftintrne.w.s",
+	"ftintrne.w.d",
+	"ftintrne.l.s",
+	"ftintrne.l.d",
+	"ftint.w.s",
+	"ftint.w.d",
+	"ftint.l.s",
+	"ftint.l.d",
+	"ffint.s.w",
+	"ffint.s.l",
+	"ffint.d.w",
+	"ffint.d.l",
+	"frint.s",
+	"frint.d",
+	"slti",
+	"sltui",
+	"addi.w",
+	"addi.d",
+	"cu52i.d",
+	"andi",
+	"ori",
+	"xori",
+	"csrxchg",
+	"gcsrxchg",
+	"cacop",
+	"lddir",
+	"ldpte",
+	"iocsrrd.b",
+	"iocsrrd.h",
+	"iocsrrd.w",
+	"iocsrrd.d",
+	"iocsrwr.b",
+	"iocsrwr.h",
+	"iocsrwr.w",
+	"iocsrwr.d",
+	"tlbclr",
+	"gtlbclr",
+	"tlbflush",
+	"gtlbflush",
+	"tlbsrch",
+	"gtlbsrch",
+	"tlbrd",
+	"gtlbrd",
+	"tlbwr",
+	"gtlbwr",
+	"tlbfill",
+	"gtlbfill",
+	"eret",
+	"idle",
+	"tlbinv",
+	"fmadd.s",
+	"fmadd.d",
+	"fmsub.s",
+	"fmsub.d",
+	"fnmadd.s",
+	"fnmadd.d",
+	"fnmsub.s",
+	"fnmsub.d",
+	"vfmadd.s",
+	"vfmadd.d",
+	"vfmsub.s",
+	"vfmsub.d",
+	"vfnmadd.s",
+	"vfnmadd.d",
+	"vfnmsub.s",
+	"vfnmsub.d",
+	"xvfmadd.s",
+	"xvfmadd.d",
+	"xvfmsub.s",
+	"xvfmsub.d",
+	"xvfnmadd.s",
+	"xvfnmadd.d",
+	"xvfnmsub.s",
+	"xvfnmsub.d",
+	"fcmp.caf.s",
+	"fcmp.saf.s",
+	"fcmp.clt.s",
+	"fcmp.slt.s",
+	"fcmp.ceq.s",
+	"fcmp.seq.s",
+	"fcmp.cle.s",
+	"fcmp.sle.s",
+	"fcmp.cun.s",
+	"fcmp.sun.s",
+	"fcmp.cult.s",
+	"fcmp.sult.s",
+	"fcmp.cueq.s",
+	"fcmp.sueq.s",
+	"fcmp.cule.s",
+	"fcmp.sule.s",
+	"fcmp.cne.s",
+	"fcmp.sne.s",
+	"fcmp.cor.s",
+	"fcmp.sor.s",
+	"fcmp.cune.s",
+	"fcmp.sune.s",
+	"fcmp.caf.d",
+	"fcmp.saf.d",
+	"fcmp.clt.d",
+	"fcmp.slt.d",
+	"fcmp.ceq.d",
+	"fcmp.seq.d",
+	"fcmp.cle.d",
+	"fcmp.sle.d",
+	"fcmp.cun.d",
+	"fcmp.sun.d",
+	"fcmp.cult.d",
+	"fcmp.sult.d",
+	"fcmp.cueq.d",
+	"fcmp.sueq.d",
+	"fcmp.cule.d",
+	"fcmp.sule.d",
+	"fcmp.cne.d",
+	"fcmp.sne.d",
+	"fcmp.cor.d",
+	"fcmp.sor.d",
+	"fcmp.cune.d",
+	"fcmp.sune.d",
+	"vfcmp.caf.s",
+	"vfcmp.saf.s",
+	"vfcmp.clt.s",
+	"vfcmp.slt.s",
+	"vfcmp.ceq.s",
+	"vfcmp.seq.s",
+	"vfcmp.cle.s",
+	"vfcmp.sle.s",
+	"vfcmp.cun.s",
+	"vfcmp.sun.s",
+	"vfcmp.cult.s",
+	"vfcmp.sult.s",
+	"vfcmp.cueq.s",
+	"vfcmp.sueq.s",
+	"vfcmp.cule.s",
+	"vfcmp.sule.s",
+	"vfcmp.cne.s",
+	"vfcmp.sne.s",
+	"vfcmp.cor.s",
+	"vfcmp.sor.s",
+	"vfcmp.cune.s",
+	"vfcmp.sune.s",
+	"vfcmp.caf.d",
+	"vfcmp.saf.d",
+	"vfcmp.clt.d",
+	"vfcmp.slt.d",
+	"vfcmp.ceq.d",
+	"vfcmp.seq.d",
+	"vfcmp.cle.d",
+	"vfcmp.sle.d",
+	"vfcmp.cun.d",
+	"vfcmp.sun.d",
+	"vfcmp.cult.d",
+	"vfcmp.sult.d",
+	"vfcmp.cueq.d",
+	"vfcmp.sueq.d",
+	"vfcmp.cule.d",
+	"vfcmp.sule.d",
+	"vfcmp.cne.d",
+	"vfcmp.sne.d",
+	"vfcmp.cor.d",
+	"vfcmp.sor.d",
+	"vfcmp.cune.d",
+	"vfcmp.sune.d",
+	"xvfcmp.caf.s",
+	"xvfcmp.saf.s",
+	"xvfcmp.clt.s",
+	"xvfcmp.slt.s",
+	"xvfcmp.ceq.s",
+	"xvfcmp.seq.s",
+	"xvfcmp.cle.s",
+	"xvfcmp.sle.s",
+	"xvfcmp.cun.s",
+	"xvfcmp.sun.s",
+	"xvfcmp.cult.s",
+	"xvfcmp.sult.s",
+	"xvfcmp.cueq.s",
+	"xvfcmp.sueq.s",
+	"xvfcmp.cule.s",
+	"xvfcmp.sule.s",
+	"xvfcmp.cne.s",
+	"xvfcmp.sne.s",
+	"xvfcmp.cor.s",
+	"xvfcmp.sor.s",
+	"xvfcmp.cune.s",
+	"xvfcmp.sune.s",
+	"xvfcmp.caf.d",
+	"xvfcmp.saf.d",
+	"xvfcmp.clt.d",
+	"xvfcmp.slt.d",
+	"xvfcmp.ceq.d",
+	"xvfcmp.seq.d",
+	"xvfcmp.cle.d",
+	"xvfcmp.sle.d",
+	"xvfcmp.cun.d",
+	"xvfcmp.sun.d",
+	"xvfcmp.cult.d",
+	"xvfcmp.sult.d",
+	"xvfcmp.cueq.d",
+	"xvfcmp.sueq.d",
+	"xvfcmp.cule.d",
+	"xvfcmp.sule.d",
+	"xvfcmp.cne.d",
+	"xvfcmp.sne.d",
+	"xvfcmp.cor.d",
+	"xvfcmp.sor.d",
+	"xvfcmp.cune.d",
+	"xvfcmp.sune.d",
+	"fsel",
+	"vbitsel.v",
+	"xvbitsel.v",
+	"vshuf.b",
+	"xvshuf.b",
+	"addu16i.d",
+	"lu12i.w",
+	"cu32i.d",
+	"pcaddu2i",
+	"pcalau12i",
+	"pcaddu12i",
+	"pcaddu18i",
+	"ll.w",
+	"sc.w",
+	"ll.d",
+	"sc.d",
+	"ldox4.w",
+	"stox4.w",
+	"ldox4.d",
+	"stox4.d",
+	"ld.b",
+	"ld.h",
+	"ld.w",
+	"ld.d",
+	"st.b",
+	"st.h",
+	"st.w",
+	"st.d",
+	"ld.bu",
+	"ld.hu",
+	"ld.wu",
+	"preld",
+	"fld.s",
+	"fst.s",
+	"fld.d",
+	"fst.d",
+	"vld",
+	"vst",
+	"xvld",
+	"xvst",
+	"ldl.w",
+	"ldr.w",
+	"ldl.d",
+	"ldr.d",
+	"stl.w",
+	"str.w",
+	"stl.d",
+	"str.d",
+	"vldrepl.d",
+	"vldrepl.w",
+	"vldrepl.h",
+	"vldrepl.b",
+	"vstelm.d",
+	"vstelm.w",
+	"vstelm.h",
+	"vstelm.b",
+	"xvldrepl.d",
+	"xvldrepl.w",
+	"xvldrepl.h",
+	"xvldrepl.b",
+	"xvstelm.d",
+	"xvstelm.w",
+	"xvstelm.h",
+	"xvstelm.b",
+	"ldx.b",
+	"ldx.h",
+	"ldx.w",
+	"ldx.d",
+	"stx.b",
+	"stx.h",
+	"stx.w",
+	"stx.d",
+	"ldx.bu",
+	"ldx.hu",
+	"ldx.wu",
+	"preldx",
+	"fldx.s",
+	"fldx.d",
+	"fstx.s",
+	"fstx.d",
+	"vldx",
+	"vstx",
+	"xvldx",
+	"xvstx",
+	"amswap.w",
+	"amswap.d",
+	"amadd.w",
+	"amadd.d",
+	"amand.w",
+	"amand.d",
+	"amor.w",
+	"amor.d",
+	"amxor.w",
+	"amxor.d",
+	"ammax.w",
+	"ammax.d",
+	"ammin.w",
+	"ammin.d",
+	"ammax.wu",
+	"ammax.du",
+	"ammin.wu",
+	"ammin.du",
+	"amswap_db.w",
+	"amswap_db.d",
+	"amadd_db.w",
+	"amadd_db.d",
+	"amand_db.w",
+	"amand_db.d",
+	"amor_db.w",
+	"amor_db.d",
+	"amxor_db.w",
+	"amxor_db.d",
+	"ammax_db.w",
+	"ammax_db.d",
+	"ammin_db.w",
+	"ammin_db.d",
+	"ammax_db.wu",
+	"ammax_db.du",
+	"ammin_db.wu",
+	"ammin_db.du",
+	"dbar",
+	"ibar",
+	"fldgt.s",
+	"fldgt.d",
+	"fldle.s",
+	"fldle.d",
+	"fstgt.s",
+	"fstgt.d",
+	"fstle.s",
+	"fstle.d",
+	"ldgt.b",
+	"ldgt.h",
+	"ldgt.w",
+	"ldgt.d",
+	"ldle.b",
+	"ldle.h",
+	"ldle.w",
+	"ldle.d",
+	"stgt.b",
+	"stgt.h",
+	"stgt.w",
+	"stgt.d",
+	"stle.b",
+	"stle.h",
+	"stle.w",
+	"stle.d",
+	"beqz",
+	"bnez",
+	"bceqz",
+	"bcnez",
+	"jiscr0",
+	"jiscr1",
+	"jirl",
+	"b",
+	"bl",
+	"beq",
+	"bne",
+	"bgt",
+	"ble",
+	"bgtu",
+	"bleu",
+	"vseq.b",
+	"vseq.h",
+	"vseq.w",
+	"vseq.d",
+	"vsle.b",
+	"vsle.h",
+	"vsle.w",
+	"vsle.d",
+	"vsle.bu",
+	"vsle.hu",
+	"vsle.wu",
+	"vsle.du",
+	"vslt.b",
+	"vslt.h",
+	"vslt.w",
+	"vslt.d",
+	"vslt.bu",
+	"vslt.hu",
+	"vslt.wu",
+	"vslt.du",
+	"vadd.b",
+	"vadd.h",
+	"vadd.w",
+	"vadd.d",
+	"vsub.b",
+	"vsub.h",
+	"vsub.w",
+	"vsub.d",
+	"vaddwev.h.b",
+	"vaddwev.w.h",
+	"vaddwev.d.w",
+	"vaddwev.q.d",
+	"vsubwev.h.b",
+	"vsubwev.w.h",
+	"vsubwev.d.w",
+	"vsubwev.q.d",
+	"vaddwod.h.b",
+	"vaddwod.w.h",
+	"vaddwod.d.w",
+	"vaddwod.q.d",
+	"vsubwod.h.b",
+	"vsubwod.w.h",
+	"vsubwod.d.w",
+	"vsubwod.q.d",
+	"vaddwev.h.bu",
+	"vaddwev.w.hu",
+	"vaddwev.d.wu",
+	"vaddwev.q.du",
+	"vsubwev.h.bu",
+	"vsubwev.w.hu",
+	"vsubwev.d.wu",
+	"vsubwev.q.du",
+	"vaddwod.h.bu",
+	"vaddwod.w.hu",
+	"vaddwod.d.wu",
+	"vaddwod.q.du",
+	"vsubwod.h.bu",
+	"vsubwod.w.hu",
+	"vsubwod.d.wu",
+	"vsubwod.q.du",
+	"vaddwev.h.bu.b",
+	"vaddwev.w.hu.h",
+	"vaddwev.d.wu.w",
+	"vaddwev.q.du.d",
+	"vaddwod.h.bu.b",
+	"vaddwod.w.hu.h",
+	"vaddwod.d.wu.w",
+	"vaddwod.q.du.d",
+	"vsadd.b",
+	"vsadd.h",
+	"vsadd.w",
+	"vsadd.d",
+	"vssub.b",
+	"vssub.h",
+	"vssub.w",
+	"vssub.d",
+	"vsadd.bu",
+	"vsadd.hu",
+	"vsadd.wu",
+	"vsadd.du",
+	"vssub.bu",
+	"vssub.hu",
+	"vssub.wu",
+	"vssub.du",
+	"vhaddw.h.b",
+	"vhaddw.w.h",
+	"vhaddw.d.w",
+	"vhaddw.q.d",
+	"vhsubw.h.b",
+	"vhsubw.w.h",
+	"vhsubw.d.w",
+	"vhsubw.q.d",
+	"vhaddw.hu.bu",
+	"vhaddw.wu.hu",
+	"vhaddw.du.wu",
+	"vhaddw.qu.du",
+	"vhsubw.hu.bu",
+	"vhsubw.wu.hu",
+	"vhsubw.du.wu",
+	"vhsubw.qu.du",
+	"vadda.b",
+	"vadda.h",
+	"vadda.w",
+	"vadda.d",
+	"vabsd.b",
+	"vabsd.h",
+	"vabsd.w",
+	"vabsd.d",
+	"vabsd.bu",
+	"vabsd.hu",
+	"vabsd.wu",
+	"vabsd.du",
+	"vavg.b",
+	"vavg.h",
+	"vavg.w",
+	"vavg.d",
+	"vavg.bu",
+	"vavg.hu",
+	"vavg.wu",
+	"vavg.du",
+	"vavgr.b",
+	"vavgr.h",
+	"vavgr.w",
+	"vavgr.d",
+	"vavgr.bu",
+	"vavgr.hu",
+	"vavgr.wu",
+	"vavgr.du",
+	"vmax.b",
+	"vmax.h",
+	"vmax.w",
+	"vmax.d",
+	"vmin.b",
+	"vmin.h",
+	"vmin.w",
+	"vmin.d",
+	"vmax.bu",
+	"vmax.hu",
+	"vmax.wu",
+	"vmax.du",
+	"vmin.bu",
+	"vmin.hu",
+	"vmin.wu",
+	"vmin.du",
+	"vmul.b",
+	"vmul.h",
+	"vmul.w",
+	"vmul.d",
+	"vmuh.b",
+	"vmuh.h",
+	"vmuh.w",
+	"vmuh.d",
+	"vmuh.bu",
+	"vmuh.hu",
+	"vmuh.wu",
+	"vmuh.du",
+	"vmulwev.h.b",
+	"vmulwev.w.h",
+	"vmulwev.d.w",
+	"vmulwev.q.d",
+	"vmulwod.h.b",
+	"vmulwod.w.h",
+	"vmulwod.d.w",
+	"vmulwod.q.d",
+	"vmulwev.h.bu",
+	"vmulwev.w.hu",
+	"vmulwev.d.wu",
+	"vmulwev.q.du",
+	"vmulwod.h.bu",
+	"vmulwod.w.hu",
+	"vmulwod.d.wu",
+	"vmulwod.q.du",
+	"vmulwev.h.bu.b",
+	"vmulwev.w.hu.h",
+	"vmulwev.d.wu.w",
+	"vmulwev.q.du.d",
+	"vmulwod.h.bu.b",
+	"vmulwod.w.hu.h",
+	"vmulwod.d.wu.w",
+	"vmulwod.q.du.d",
+	"vmadd.b",
+	"vmadd.h",
+	"vmadd.w",
+	"vmadd.d",
+	"vmsub.b",
+	"vmsub.h",
+	"vmsub.w",
+	"vmsub.d",
+	"vmaddwev.h.b",
+	"vmaddwev.w.h",
+	"vmaddwev.d.w",
+	"vmaddwev.q.d",
+	"vmaddwod.h.b",
+	"vmaddwod.w.h",
+	"vmaddwod.d.w",
+	"vmaddwod.q.d",
+	"vmaddwev.h.bu",
+	"vmaddwev.w.hu",
+	"vmaddwev.d.wu",
+	"vmaddwev.q.du",
+	"vmaddwod.h.bu",
+	"vmaddwod.w.hu",
+	"vmaddwod.d.wu",
+	"vmaddwod.q.du",
+	"vmaddwev.h.bu.b",
+	"vmaddwev.w.hu.h",
+	"vmaddwev.d.wu.w",
+	"vmaddwev.q.du.d",
+	"vmaddwod.h.bu.b",
+	"vmaddwod.w.hu.h",
+	"vmaddwod.d.wu.w",
+	"vmaddwod.q.du.d",
+	"vdiv.b",
+	"vdiv.h",
+	"vdiv.w",
+	"vdiv.d",
+	"vmod.b",
+	"vmod.h",
+	"vmod.w",
+	"vmod.d",
+	"vdiv.bu",
+	"vdiv.hu",
+	"vdiv.wu",
+	"vdiv.du",
+	"vmod.bu",
+	"vmod.hu",
+	"vmod.wu",
+	"vmod.du",
+	"vsll.b",
+	"vsll.h",
+	"vsll.w",
+	"vsll.d",
+	"vsrl.b",
+	"vsrl.h",
+	"vsrl.w",
+	"vsrl.d",
+	"vsra.b",
+	"vsra.h",
+	"vsra.w",
+	"vsra.d",
+	"vrotr.b",
+	"vrotr.h",
+	"vrotr.w",
+	"vrotr.d",
+	"vsrlr.b",
+	"vsrlr.h",
+	"vsrlr.w",
+	"vsrlr.d",
+	"vsrar.b",
+	"vsrar.h",
+	"vsrar.w",
+	"vsrar.d",
+	"vsrln.b.h",
+	"vsrln.h.w",
+	"vsrln.w.d",
+	"vsran.b.h",
+	"vsran.h.w",
+	"vsran.w.d",
+	"vsrlrn.b.h",
+	"vsrlrn.h.w",
+	"vsrlrn.w.d",
+	"vsrarn.b.h",
+	"vsrarn.h.w",
+	"vsrarn.w.d",
+	"vssrln.b.h",
+	"vssrln.h.w",
+	"vssrln.w.d",
+	"vssran.b.h",
+	"vssran.h.w",
+	"vssran.w.d",
+	"vssrlrn.b.h",
+	"vssrlrn.h.w",
+	"vssrlrn.w.d",
+	"vssrarn.b.h",
+	"vssrarn.h.w",
+	"vssrarn.w.d",
+	"vssrln.bu.h",
+	"vssrln.hu.w",
+	"vssrln.wu.d",
+	"vssran.bu.h",
+	"vssran.hu.w",
+	"vssran.wu.d",
+	"vssrlrn.bu.h",
+	"vssrlrn.hu.w",
+	"vssrlrn.wu.d",
+	"vssrarn.bu.h",
+	"vssrarn.hu.w",
+	"vssrarn.wu.d",
+	"vbitclr.b",
+	"vbitclr.h",
+	"vbitclr.w",
+	"vbitclr.d",
+	"vbitset.b",
+	"vbitset.h",
+	"vbitset.w",
+	"vbitset.d",
+	"vbitrev.b",
+	"vbitrev.h",
+	"vbitrev.w",
+	"vbitrev.d",
+	"vpackev.b",
+	"vpackev.h",
+	"vpackev.w",
+	"vpackev.d",
+	"vpackod.b",
+	"vpackod.h",
+	"vpackod.w",
+	"vpackod.d",
+	"vilvl.b",
+	"vilvl.h",
+	"vilvl.w",
+	"vilvl.d",
+	"vilvh.b",
+	"vilvh.h",
+	"vilvh.w",
+	"vilvh.d",
+	"vpickev.b",
+	"vpickev.h",
+	"vpickev.w",
+	"vpickev.d",
+	"vpickod.b",
+	"vpickod.h",
+	"vpickod.w",
+	"vpickod.d",
+	"vreplve.b",
+	"vreplve.h",
+	"vreplve.w",
+	"vreplve.d",
+	"vand.v",
+	"vor.v",
+	"vxor.v",
+	"vnor.v",
+	"vandn.v",
+	"vorn.v",
+	"vfrstp.b",
+	"vfrstp.h",
+	"vadd.q",
+	"vsub.q",
+	"vsigncov.b",
+	"vsigncov.h",
+	"vsigncov.w",
+	"vsigncov.d",
+	"vfadd.s",
+	"vfadd.d",
+	"vfsub.s",
+	"vfsub.d",
+	"vfmul.s",
+	"vfmul.d",
+	"vfdiv.s",
+	"vfdiv.d",
+	"vfmax.s",
+	"vfmax.d",
+	"vfmin.s",
+	"vfmin.d",
+	"vfmaxa.s",
+	"vfmaxa.d",
+	"vfmina.s",
+	"vfmina.d",
+	"vfcvt.h.s",
+	"vfcvt.s.d",
+	"vffint.s.l",
+	"vftint.w.d",
+	"vftintrm.w.d",
+	"vftintrp.w.d",
+	"vftintrz.w.d",
+	"vftintrne.w.d",
+	"vshuf.h",
+	"vshuf.w",
+	"vshuf.d",
+	"vseqi.b",
+	"vseqi.h",
+	"vseqi.w",
+	"vseqi.d",
+	"vslei.b",
+	"vslei.h",
+	"vslei.w",
+	"vslei.d",
+	"vslei.bu",
+	"vslei.hu",
+	"vslei.wu",
+	"vslei.du",
+	"vslti.b",
+	"vslti.h",
+	"vslti.w",
+	"vslti.d",
+	"vslti.bu",
+	"vslti.hu",
+	"vslti.wu",
+	"vslti.du",
+	"vaddi.bu",
+	"vaddi.hu",
+	"vaddi.wu",
+	"vaddi.du",
+	"vsubi.bu",
+	"vsubi.hu",
+	"vsubi.wu",
+	"vsubi.du",
+	"vbsll.v",
+	"vbsrl.v",
+	"vmaxi.b",
+	"vmaxi.h",
+	"vmaxi.w",
+	"vmaxi.d",
+	"vmini.b",
+	"vmini.h",
+	"vmini.w",
+	"vmini.d",
+	"vmaxi.bu",
+	"vmaxi.hu",
+	"vmaxi.wu",
+	"vmaxi.du",
+	"vmini.bu",
+	"vmini.hu",
+	"vmini.wu",
+	"vmini.du",
+	"vfrstpi.b",
+	"vfrstpi.h",
+	"vclo.b",
+	"vclo.h",
+	"vclo.w",
+	"vclo.d",
+	"vclz.b",
+	"vclz.h",
+	"vclz.w",
+	"vclz.d",
+	"vpcnt.b",
+	"vpcnt.h",
+	"vpcnt.w",
+	"vpcnt.d",
+	"vneg.b",
+	"vneg.h",
+	"vneg.w",
+	"vneg.d",
+	"vmskltz.b",
+	"vmskltz.h",
+	"vmskltz.w",
+	"vmskltz.d",
+	"vmskgez.b",
+	"vmsknz.b",
+	"vseteqz.v",
+	"vsetnez.v",
+	"vsetanyeqz.b",
+	"vsetanyeqz.h",
+	"vsetanyeqz.w",
+	"vsetanyeqz.d",
+	"vsetallnez.b",
+	"vsetallnez.h",
+	"vsetallnez.w",
+	"vsetallnez.d",
+	"vflogb.s",
+	"vflogb.d",
+	"vfclass.s",
+	"vfclass.d",
+	"vfsqrt.s",
+	"vfsqrt.d",
+	"vfrecip.s",
+	"vfrecip.d",
+	"vfrsqrt.s",
+	"vfrsqrt.d",
+	"vfrint.s",
+	"vfrint.d",
+	"vfrintrm.s",
+	"vfrintrm.d",
+	"vfrintrp.s",
+	"vfrintrp.d",
+	"vfrintrz.s",
+	"vfrintrz.d",
+	"vfrintrne.s",
+	"vfrintrne.d",
+	"vfcvtl.s.h",
+	"vfcvth.s.h",
+	"vfcvtl.d.s",
+	"vfcvth.d.s",
+	"vffint.s.w",
+	"vffint.s.wu",
+	"vffint.d.l",
+	"vffint.d.lu",
+	"vffintl.d.w",
+	"vffinth.d.w",
+	"vftint.w.s",
+	"vftint.l.d",
+	"vftintrm.w.s",
+	"vftintrm.l.d",
+	"vftintrp.w.s",
+	"vftintrp.l.d",
+	"vftintrz.w.s",
+	"vftintrz.l.d",
+	"vftintrne.w.s",
+	"vftintrne.l.d",
+	"vftint.wu.s",
+	"vftint.lu.d",
+	"vftintrz.wu.s",
+	"vftintrz.lu.d",
+	"vftintl.l.s",
+	"vftinth.l.s",
+	"vftintrml.l.s",
+	"vftintrmh.l.s",
+	"vftintrpl.l.s",
+	"vftintrph.l.s",
+	"vftintrzl.l.s",
+	"vftintrzh.l.s",
+	"vftintrnel.l.s",
+	"vftintrneh.l.s",
+	"vexth.h.b",
+	"vexth.w.h",
+	"vexth.d.w",
+	"vexth.q.d",
+	"vexth.hu.bu",
+	"vexth.wu.hu",
+	"vexth.du.wu",
+	"vexth.qu.du",
+	"vreplgr2vr.b",
+	"vreplgr2vr.h",
+	"vreplgr2vr.w",
+	"vreplgr2vr.d",
+	"vrotri.b",
+	"vrotri.h",
+	"vrotri.w",
+	"vrotri.d",
+	"vsrlri.b",
+	"vsrlri.h",
+	"vsrlri.w",
+	"vsrlri.d",
+	"vsrari.b",
+	"vsrari.h",
+	"vsrari.w",
+	"vsrari.d",
+	"vinsgr2vr.b",
+	"vinsgr2vr.h",
+	"vinsgr2vr.w",
+	"vinsgr2vr.d",
+	"vpickve2gr.b",
+	"vpickve2gr.h",
+	"vpickve2gr.w",
+	"vpickve2gr.d",
+	"vpickve2gr.bu",
+	"vpickve2gr.hu",
+	"vpickve2gr.wu",
+	"vpickve2gr.du",
+	"vreplvei.b",
+	"vreplvei.h",
+	"vreplvei.w",
+	"vreplvei.d",
+	"vsllwil.h.b",
+	"vsllwil.w.h",
+	"vsllwil.d.w",
+	"vextl.q.d",
+	"vsllwil.hu.bu",
+	"vsllwil.wu.hu",
+	"vsllwil.du.wu",
+	"vextl.qu.du",
+	"vbitclri.b",
+	"vbitclri.h",
+	"vbitclri.w",
+	"vbitclri.d",
+	"vbitseti.b",
+	"vbitseti.h",
+	"vbitseti.w",
+	"vbitseti.d",
+	"vbitrevi.b",
+	"vbitrevi.h",
+	"vbitrevi.w",
+	"vbitrevi.d",
+	"vsat.b",
+	"vsat.h",
+	"vsat.w",
+	"vsat.d",
+	"vsat.bu",
+	"vsat.hu",
+	"vsat.wu",
+	"vsat.du",
+	"vslli.b",
+	"vslli.h",
+	"vslli.w",
+	"vslli.d",
+	"vsrli.b",
+	"vsrli.h",
+	"vsrli.w",
+	"vsrli.d",
+	"vsrai.b",
+	"vsrai.h",
+	"vsrai.w",
+	"vsrai.d",
+	"vsrlni.b.h",
+	"vsrlni.h.w",
+	"vsrlni.w.d",
+	"vsrlni.d.q",
+	"vsrlrni.b.h",
+	"vsrlrni.h.w",
+	"vsrlrni.w.d",
+	"vsrlrni.d.q",
+	"vssrlni.b.h",
+	"vssrlni.h.w",
+	"vssrlni.w.d",
+	"vssrlni.d.q",
+	"vssrlni.bu.h",
+	"vssrlni.hu.w",
+	"vssrlni.wu.d",
+	"vssrlni.du.q",
+	"vssrlrni.b.h",
+	"vssrlrni.h.w",
+	"vssrlrni.w.d",
+	"vssrlrni.d.q",
+	"vssrlrni.bu.h",
+	"vssrlrni.hu.w",
+	"vssrlrni.wu.d",
+	"vssrlrni.du.q",
+	"vsrani.b.h",
+	"vsrani.h.w",
+	"vsrani.w.d",
+	"vsrani.d.q",
+	"vsrarni.b.h",
+	"vsrarni.h.w",
+	"vsrarni.w.d",
+	"vsrarni.d.q",
+	"vssrani.b.h",
+	"vssrani.h.w",
+	"vssrani.w.d",
+	"vssrani.d.q",
+	"vssrani.bu.h",
+	"vssrani.hu.w",
+	"vssrani.wu.d",
+	"vssrani.du.q",
+	"vssrarni.b.h",
+	"vssrarni.h.w",
+	"vssrarni.w.d",
+	"vssrarni.d.q",
+	"vssrarni.bu.h",
+	"vssrarni.hu.w",
+	"vssrarni.wu.d",
+	"vssrarni.du.q",
+	"vextrins.d",
+	"vextrins.w",
+	"vextrins.h",
+	"vextrins.b",
+	"vshuf4i.b",
+	"vshuf4i.h",
+	"vshuf4i.w",
+	"vshuf4i.d",
+	"vbitseli.b",
+	"vandi.b",
+	"vori.b",
+	"vxori.b",
+	"vnori.b",
+	"vldi",
+	"vpermi.w",
+	"xvseq.b",
+	"xvseq.h",
+	"xvseq.w",
+	"xvseq.d",
+	"xvsle.b",
+	"xvsle.h",
+	"xvsle.w",
+	"xvsle.d",
+	"xvsle.bu",
+	"xvsle.hu",
+	"xvsle.wu",
+	"xvsle.du",
+	"xvslt.b",
+	"xvslt.h",
+	"xvslt.w",
+	"xvslt.d",
+	"xvslt.bu",
+	"xvslt.hu",
+	"xvslt.wu",
+	"xvslt.du",
+	"xvadd.b",
+	"xvadd.h",
+	"xvadd.w",
+	"xvadd.d",
+	"xvsub.b",
+	"xvsub.h",
+	"xvsub.w",
+	"xvsub.d",
+	"xvaddwev.h.b",
+	"xvaddwev.w.h",
+	"xvaddwev.d.w",
+	"xvaddwev.q.d",
+	"xvsubwev.h.b",
+	"xvsubwev.w.h",
+	"xvsubwev.d.w",
+	"xvsubwev.q.d",
+	"xvaddwod.h.b",
+	"xvaddwod.w.h",
+	"xvaddwod.d.w",
+	"xvaddwod.q.d",
+	"xvsubwod.h.b",
+	"xvsubwod.w.h",
+	"xvsubwod.d.w",
+	"xvsubwod.q.d",
+	"xvaddwev.h.bu",
+	"xvaddwev.w.hu",
+	"xvaddwev.d.wu",
+	"xvaddwev.q.du",
+	"xvsubwev.h.bu",
+	"xvsubwev.w.hu",
+	"xvsubwev.d.wu",
+	"xvsubwev.q.du",
+	"xvaddwod.h.bu",
+	"xvaddwod.w.hu",
+	"xvaddwod.d.wu",
+	"xvaddwod.q.du",
+	"xvsubwod.h.bu",
+	"xvsubwod.w.hu",
+	"xvsubwod.d.wu",
+	"xvsubwod.q.du",
+	"xvaddwev.h.bu.b",
+	"xvaddwev.w.hu.h",
+	"xvaddwev.d.wu.w",
+	"xvaddwev.q.du.d",
+	"xvaddwod.h.bu.b",
+	"xvaddwod.w.hu.h",
+	"xvaddwod.d.wu.w",
+	"xvaddwod.q.du.d",
+	"xvsadd.b",
+	"xvsadd.h",
+	"xvsadd.w",
+	"xvsadd.d",
+	"xvssub.b",
+	"xvssub.h",
+	"xvssub.w",
+	"xvssub.d",
+	"xvsadd.bu",
+	"xvsadd.hu",
+	"xvsadd.wu",
+	"xvsadd.du",
+	"xvssub.bu",
+	"xvssub.hu",
+	"xvssub.wu",
+	"xvssub.du",
+	"xvhaddw.h.b",
+	"xvhaddw.w.h",
+	"xvhaddw.d.w",
+	"xvhaddw.q.d",
+	"xvhsubw.h.b",
+	"xvhsubw.w.h",
+	"xvhsubw.d.w",
+	"xvhsubw.q.d",
+	"xvhaddw.hu.bu",
+	"xvhaddw.wu.hu",
+	"xvhaddw.du.wu",
+	"xvhaddw.qu.du",
+	"xvhsubw.hu.bu",
+	"xvhsubw.wu.hu",
+	"xvhsubw.du.wu",
+	"xvhsubw.qu.du",
+	"xvadda.b",
+	"xvadda.h",
+	"xvadda.w",
+	"xvadda.d",
+	"xvabsd.b",
+	"xvabsd.h",
+	"xvabsd.w",
+	"xvabsd.d",
+	"xvabsd.bu",
+	"xvabsd.hu",
+	"xvabsd.wu",
+	"xvabsd.du",
+	"xvavg.b",
+	"xvavg.h",
+	"xvavg.w",
+	"xvavg.d",
+	"xvavg.bu",
+	"xvavg.hu",
+	"xvavg.wu",
+	"xvavg.du",
+	"xvavgr.b",
+	"xvavgr.h",
+	"xvavgr.w",
+	"xvavgr.d",
+	"xvavgr.bu",
+	"xvavgr.hu",
+	"xvavgr.wu",
+	"xvavgr.du",
+	"xvmax.b",
+	"xvmax.h",
+	"xvmax.w",
+	"xvmax.d",
+	"xvmin.b",
+	"xvmin.h",
+	"xvmin.w",
+	"xvmin.d",
+	"xvmax.bu",
+	"xvmax.hu",
+	"xvmax.wu",
+	"xvmax.du",
+	"xvmin.bu",
+	"xvmin.hu",
+	"xvmin.wu",
+	"xvmin.du",
+	"xvmul.b",
+	"xvmul.h",
+	"xvmul.w",
+	"xvmul.d",
+	"xvmuh.b",
+	"xvmuh.h",
+	"xvmuh.w",
+	"xvmuh.d",
+	"xvmuh.bu",
+	"xvmuh.hu",
+	"xvmuh.wu",
+	"xvmuh.du",
+	"xvmulwev.h.b",
+	"xvmulwev.w.h",
+	"xvmulwev.d.w",
+	"xvmulwev.q.d",
+	"xvmulwod.h.b",
+	"xvmulwod.w.h",
+	"xvmulwod.d.w",
+	"xvmulwod.q.d",
+	"xvmulwev.h.bu",
+	"xvmulwev.w.hu",
+	"xvmulwev.d.wu",
+	"xvmulwev.q.du",
+	"xvmulwod.h.bu",
+	"xvmulwod.w.hu",
+	"xvmulwod.d.wu",
+	"xvmulwod.q.du",
+	"xvmulwev.h.bu.b",
+	"xvmulwev.w.hu.h",
+	"xvmulwev.d.wu.w",
+	"xvmulwev.q.du.d",
+	"xvmulwod.h.bu.b",
+	"xvmulwod.w.hu.h",
+	"xvmulwod.d.wu.w",
+	"xvmulwod.q.du.d",
+	"xvmadd.b",
+	"xvmadd.h",
+	"xvmadd.w",
+	"xvmadd.d",
+	"xvmsub.b",
+	"xvmsub.h",
+	"xvmsub.w",
+	"xvmsub.d",
+	"xvmaddwev.h.b",
+	"xvmaddwev.w.h",
+	"xvmaddwev.d.w",
+	"xvmaddwev.q.d",
+	"xvmaddwod.h.b",
+	"xvmaddwod.w.h",
+	"xvmaddwod.d.w",
+	"xvmaddwod.q.d",
+	"xvmaddwev.h.bu",
+	"xvmaddwev.w.hu",
+	"xvmaddwev.d.wu",
+	"xvmaddwev.q.du",
+	"xvmaddwod.h.bu",
+	"xvmaddwod.w.hu",
+	"xvmaddwod.d.wu",
+	"xvmaddwod.q.du",
+	"xvmaddwev.h.bu.b",
+	"xvmaddwev.w.hu.h",
+	"xvmaddwev.d.wu.w",
+	"xvmaddwev.q.du.d",
+	"xvmaddwod.h.bu.b",
+	"xvmaddwod.w.hu.h",
+	"xvmaddwod.d.wu.w",
+	"xvmaddwod.q.du.d",
+	"xvdiv.b",
+	"xvdiv.h",
+	"xvdiv.w",
+	"xvdiv.d",
+	"xvmod.b",
+	"xvmod.h",
+	"xvmod.w",
+	"xvmod.d",
+	"xvdiv.bu",
+	"xvdiv.hu",
+	"xvdiv.wu",
+	"xvdiv.du",
+	"xvmod.bu",
+	"xvmod.hu",
+	"xvmod.wu",
+	"xvmod.du",
+	"xvsll.b",
+	"xvsll.h",
+	"xvsll.w",
+	"xvsll.d",
+	"xvsrl.b",
+	"xvsrl.h",
+	"xvsrl.w",
+	"xvsrl.d",
+	"xvsra.b",
+	"xvsra.h",
+	"xvsra.w",
+	"xvsra.d",
+	"xvrotr.b",
+	"xvrotr.h",
+	"xvrotr.w",
+	"xvrotr.d",
+	"xvsrlr.b",
+	"xvsrlr.h",
+	"xvsrlr.w",
+	"xvsrlr.d",
+	"xvsrar.b",
+	"xvsrar.h",
+	"xvsrar.w",
+	"xvsrar.d",
+	"xvsrln.b.h",
+	"xvsrln.h.w",
+	"xvsrln.w.d",
+	"xvsran.b.h",
+	"xvsran.h.w",
+	"xvsran.w.d",
+	"xvsrlrn.b.h",
+	"xvsrlrn.h.w",
+	"xvsrlrn.w.d",
+	"xvsrarn.b.h",
+	"xvsrarn.h.w",
+	"xvsrarn.w.d",
+	"xvssrln.b.h",
+	"xvssrln.h.w",
+	"xvssrln.w.d",
+	"xvssran.b.h",
+	"xvssran.h.w",
+	"xvssran.w.d",
+	"xvssrlrn.b.h",
+	"xvssrlrn.h.w",
+	"xvssrlrn.w.d",
+	"xvssrarn.b.h",
+	"xvssrarn.h.w",
+	"xvssrarn.w.d",
+	"xvssrln.bu.h",
+	"xvssrln.hu.w",
+	"xvssrln.wu.d",
+	"xvssran.bu.h",
+	"xvssran.hu.w",
+	"xvssran.wu.d",
+	"xvssrlrn.bu.h",
+	"xvssrlrn.hu.w",
+	"xvssrlrn.wu.d",
+	"xvssrarn.bu.h",
+	"xvssrarn.hu.w",
+	"xvssrarn.wu.d",
+	"xvbitclr.b",
+	"xvbitclr.h",
+	"xvbitclr.w",
+	"xvbitclr.d",
+	"xvbitset.b",
+	"xvbitset.h",
+	"xvbitset.w",
+	"xvbitset.d",
+	"xvbitrev.b",
+	"xvbitrev.h",
+	"xvbitrev.w",
+	"xvbitrev.d",
+	"xvpackev.b",
+	"xvpackev.h",
+	"xvpackev.w",
+	"xvpackev.d",
+	"xvpackod.b",
+	"xvpackod.h",
+	"xvpackod.w",
+	"xvpackod.d",
+	"xvilvl.b",
+	"xvilvl.h",
+	"xvilvl.w",
+	"xvilvl.d",
+	"xvilvh.b",
+	"xvilvh.h",
+	"xvilvh.w",
+	"xvilvh.d",
+	"xvpickev.b",
+	"xvpickev.h",
+	"xvpickev.w",
+	"xvpickev.d",
+	"xvpickod.b",
+	"xvpickod.h",
+	"xvpickod.w",
+	"xvpickod.d",
+	"xvreplve.b",
+	"xvreplve.h",
+	"xvreplve.w",
+	"xvreplve.d",
+	"xvand.v",
+	"xvor.v",
+	"xvxor.v",
+	"xvnor.v",
+	"xvandn.v",
+	"xvorn.v",
+	"xvfrstp.b",
+	"xvfrstp.h",
+	"xvadd.q",
+	"xvsub.q",
+	"xvsigncov.b",
+	"xvsigncov.h",
+	"xvsigncov.w",
+	"xvsigncov.d",
+	"xvfadd.s",
+	"xvfadd.d",
+	"xvfsub.s",
+	"xvfsub.d",
+	"xvfmul.s",
+	"xvfmul.d",
+	"xvfdiv.s",
+	"xvfdiv.d",
+	"xvfmax.s",
+	"xvfmax.d",
+	"xvfmin.s",
+	"xvfmin.d",
+	"xvfmaxa.s",
+	"xvfmaxa.d",
+	"xvfmina.s",
+	"xvfmina.d",
+	"xvfcvt.h.s",
+	"xvfcvt.s.d",
+	"xvffint.s.l",
+	"xvftint.w.d",
+	"xvftintrm.w.d",
+	"xvftintrp.w.d",
+	"xvftintrz.w.d",
+	"xvftintrne.w.d",
+	"xvshuf.h",
+	"xvshuf.w",
+	"xvshuf.d",
+	"xvperm.w",
+	"xvseqi.b",
+	"xvseqi.h",
+	"xvseqi.w",
+	"xvseqi.d",
+	"xvslei.b",
+	"xvslei.h",
+	"xvslei.w",
+	"xvslei.d",
+	"xvslei.bu",
+	"xvslei.hu",
+	"xvslei.wu",
+	"xvslei.du",
+	"xvslti.b",
+	"xvslti.h",
+	"xvslti.w",
+	"xvslti.d",
+	"xvslti.bu",
+	"xvslti.hu",
+	"xvslti.wu",
+	"xvslti.du",
+	"xvaddi.bu",
+	"xvaddi.hu",
+	"xvaddi.wu",
+	"xvaddi.du",
+	"xvsubi.bu",
+	"xvsubi.hu",
+	"xvsubi.wu",
+	"xvsubi.du",
+	"xvbsll.v",
+	"xvbsrl.v",
+	"xvmaxi.b",
+	"xvmaxi.h",
+	"xvmaxi.w",
+	"xvmaxi.d",
+	"xvmini.b",
+	"xvmini.h",
+	"xvmini.w",
+	"xvmini.d",
+	"xvmaxi.bu",
+	"xvmaxi.hu",
+	"xvmaxi.wu",
+	"xvmaxi.du",
+	"xvmini.bu",
+	"xvmini.hu",
+	"xvmini.wu",
+	"xvmini.du",
+	"xvfrstpi.b",
+	"xvfrstpi.h",
+	"xvclo.b",
+	"xvclo.h",
+	"xvclo.w",
+	"xvclo.d",
+	"xvclz.b",
+	"xvclz.h",
+	"xvclz.w",
+	"xvclz.d",
+	"xvpcnt.b",
+	"xvpcnt.h",
+	"xvpcnt.w",
+	"xvpcnt.d",
+	"xvneg.b",
+	"xvneg.h",
+	"xvneg.w",
+	"xvneg.d",
+	"xvmskltz.b",
+	"xvmskltz.h",
+	"xvmskltz.w",
+	"xvmskltz.d",
+	"xvmskgez.b",
+	"xvmsknz.b",
+	"xvseteqz.v",
+	"xvsetnez.v",
+	"xvsetanyeqz.b",
+	"xvsetanyeqz.h",
+	"xvsetanyeqz.w",
+	"xvsetanyeqz.d",
+	"xvsetallnez.b",
+	"xvsetallnez.h",
+	"xvsetallnez.w",
+	"xvsetallnez.d",
+	"xvflogb.s",
+	"xvflogb.d",
+	"xvfclass.s",
+	"xvfclass.d",
+	"xvfsqrt.s",
+	"xvfsqrt.d",
+	"xvfrecip.s",
+	"xvfrecip.d",
+	"xvfrsqrt.s",
+	"xvfrsqrt.d",
+	"xvfrint.s",
+	"xvfrint.d",
+	"xvfrintrm.s",
+	"xvfrintrm.d",
+	"xvfrintrp.s",
+	"xvfrintrp.d",
+	"xvfrintrz.s",
+	"xvfrintrz.d",
+	"xvfrintrne.s",
+	"xvfrintrne.d",
+	"xvfcvtl.s.h",
+	"xvfcvth.s.h",
+	"xvfcvtl.d.s",
+	"xvfcvth.d.s",
+	"xvffint.s.w",
+	"xvffint.s.wu",
+	"xvffint.d.l",
+	"xvffint.d.lu",
+	"xvffintl.d.w",
+	"xvffinth.d.w",
+	"xvftint.w.s",
+	"xvftint.l.d",
+	"xvftintrm.w.s",
+	"xvftintrm.l.d",
+	"xvftintrp.w.s",
+	"xvftintrp.l.d",
+	"xvftintrz.w.s",
+	"xvftintrz.l.d",
+	"xvftintrne.w.s",
+	"xvftintrne.l.d",
+	"xvftint.wu.s",
+	"xvftint.lu.d",
+	"xvftintrz.wu.s",
+	"xvftintrz.lu.d",
+	"xvftintl.l.s",
+	"xvftinth.l.s",
+	"xvftintrml.l.s",
+	"xvftintrmh.l.s",
+	"xvftintrpl.l.s",
+	"xvftintrph.l.s",
+	"xvftintrzl.l.s",
+	"xvftintrzh.l.s",
+	"xvftintrnel.l.s",
+	"xvftintrneh.l.s",
+	"xvexth.h.b",
+	"xvexth.w.h",
+	"xvexth.d.w",
+	"xvexth.q.d",
+	"xvexth.hu.bu",
+	"xvexth.wu.hu",
+	"xvexth.du.wu",
+	"xvexth.qu.du",
+	"xvreplgr2vr.b",
+	"xvreplgr2vr.h",
+	"xvreplgr2vr.w",
+	"xvreplgr2vr.d",
+	"vext2xv.h.b",
+	"vext2xv.w.b",
+	"vext2xv.d.b",
+	"vext2xv.w.h",
+	"vext2xv.d.h",
+	"vext2xv.d.w",
+	"vext2xv.hu.bu",
+	"vext2xv.wu.bu",
+	"vext2xv.du.bu",
+	"vext2xv.wu.hu",
+	"vext2xv.du.hu",
+	"vext2xv.du.wu",
+	"xvrotri.b",
+	"xvrotri.h",
+	"xvrotri.w",
+	"xvrotri.d",
+	"xvsrlri.b",
+	"xvsrlri.h",
+	"xvsrlri.w",
+	"xvsrlri.d",
+	"xvsrari.b",
+	"xvsrari.h",
+	"xvsrari.w",
+	"xvsrari.d",
+	"xvinsgr2vr.w",
+	"xvinsgr2vr.d",
+	"xvpickve2gr.w",
+	"xvpickve2gr.d",
+	"xvpickve2gr.wu",
+	"xvpickve2gr.du",
+	"xvrepl128vei.b",
+	"xvrepl128vei.h",
+	"xvrepl128vei.w",
+	"xvrepl128vei.d",
+	"xvinsve0.w",
+	"xvinsve0.d",
+	"xvpickve.w",
+	"xvpickve.d",
+	"xvreplve0.b",
+	"xvreplve0.h",
+	"xvreplve0.w",
+	"xvreplve0.d",
+	"xvreplve0.q",
+	"xvsllwil.h.b",
+	"xvsllwil.w.h",
+	"xvsllwil.d.w",
+	"xvextl.q.d",
+	"xvsllwil.hu.bu",
+	"xvsllwil.wu.hu",
+	"xvsllwil.du.wu",
+	"xvextl.qu.du",
+	"xvbitclri.b",
+	"xvbitclri.h",
+	"xvbitclri.w",
+	"xvbitclri.d",
+	"xvbitseti.b",
+	"xvbitseti.h",
+	"xvbitseti.w",
+	"xvbitseti.d",
+	"xvbitrevi.b",
+	"xvbitrevi.h",
+	"xvbitrevi.w",
+	"xvbitrevi.d",
+	"xvsat.b",
+	"xvsat.h",
+	"xvsat.w",
+	"xvsat.d",
+	"xvsat.bu",
+	"xvsat.hu",
+	"xvsat.wu",
+	"xvsat.du",
+	"xvslli.b",
+	"xvslli.h",
+	"xvslli.w",
+	"xvslli.d",
+	"xvsrli.b",
+	"xvsrli.h",
+	"xvsrli.w",
+	"xvsrli.d",
+	"xvsrai.b",
+	"xvsrai.h",
+	"xvsrai.w",
+	"xvsrai.d",
+	"xvsrlni.b.h",
+	"xvsrlni.h.w",
+	"xvsrlni.w.d",
+	"xvsrlni.d.q",
+	"xvsrlrni.b.h",
+	"xvsrlrni.h.w",
+	"xvsrlrni.w.d",
+	"xvsrlrni.d.q",
+	"xvssrlni.b.h",
+	"xvssrlni.h.w",
+	"xvssrlni.w.d",
+	"xvssrlni.d.q",
+	"xvssrlni.bu.h",
+	"xvssrlni.hu.w",
+	"xvssrlni.wu.d",
+	"xvssrlni.du.q",
+	"xvssrlrni.b.h",
+	"xvssrlrni.h.w",
+	"xvssrlrni.w.d",
+	"xvssrlrni.d.q",
+	"xvssrlrni.bu.h",
+	"xvssrlrni.hu.w",
+	"xvssrlrni.wu.d",
+	"xvssrlrni.du.q",
+	"xvsrani.b.h",
+	"xvsrani.h.w",
+	"xvsrani.w.d",
+	"xvsrani.d.q",
+	"xvsrarni.b.h",
+	"xvsrarni.h.w",
+	"xvsrarni.w.d",
+	"xvsrarni.d.q",
+	"xvssrani.b.h",
+	"xvssrani.h.w",
+	"xvssrani.w.d",
+	"xvssrani.d.q",
+	"xvssrani.bu.h",
+	"xvssrani.hu.w",
+	"xvssrani.wu.d",
+	"xvssrani.du.q",
+	"xvssrarni.b.h",
+	"xvssrarni.h.w",
+	"xvssrarni.w.d",
+	"xvssrarni.d.q",
+	"xvssrarni.bu.h",
+	"xvssrarni.hu.w",
+	"xvssrarni.wu.d",
+	"xvssrarni.du.q",
+	"xvextrins.d",
+	"xvextrins.w",
+	"xvextrins.h",
+	"xvextrins.b",
+	"xvshuf4i.b",
+	"xvshuf4i.h",
+	"xvshuf4i.w",
+	"xvshuf4i.d",
+	"xvbitseli.b",
+	"xvandi.b",
+	"xvori.b",
+	"xvxori.b",
+	"xvnori.b",
+	"xvldi",
+	"xvpermi.w",
+	"xvpermi.d",
+	"xvpermi.q",
+}
+
+var descriptors = [...]uint8{
+	// movgr2scr TdJ
+	0x1c, 0xfc, 0xff, 0xff, 0x00, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x04, 0x00, 0x02, 0x01, 0x05, 0x05,
+	// movscr2gr DTj
+	0x80, 0xff, 0xff, 0xff, 0x00, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x00, 0x02, 0x01, 0x00, 0x05, 0x04, 0x05, 0x02,
+	// clo.w DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// clz.w DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// cto.w DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x18, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// ctz.w DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x1c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// clo.d DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x06, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// clz.d DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x24, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x07, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// cto.d DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x28, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x08, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// ctz.d DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x2c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x09, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// revb.2h DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x30, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// revb.4h DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x34, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0b, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// revb.2w DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x38, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0c, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// revb.d DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x3c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0d, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// revh.2w DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x40, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0e, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// revh.d DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x44, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// revbit.4b DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x48, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x10, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// revbit.8b DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x4c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x11, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// revbit.w DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x50, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x12, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// revbit.d DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x13, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// sext.h DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x58, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x14, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// sext.b DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x5c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x15, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// rdtimel.w DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x60, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x16, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// rdtimeh.w DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x64, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x17, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// rdtime.d DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x68, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x18, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// cpucfg DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x6c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x19, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// x86mttop Uj3
+	0x1f, 0xff, 0xff, 0xff, 0x00, 0x70, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1a, 0x00, 0x01, 0x08, 0x05, 0x03,
+	// x86mftop D
+	0xe0, 0xff, 0xff, 0xff, 0x00, 0x74, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1b, 0x00, 0x01, 0x01, 0x00, 0x05,
+	// x86setloope DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x78, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// x86setloopne DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x7c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1d, 0x00, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// x86inc.b J
+	0x1f, 0xfc, 0xff, 0xff, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1e, 0x00, 0x01, 0x01, 0x05, 0x05,
+	// x86inc.h J
+	0x1f, 0xfc, 0xff, 0xff, 0x01, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x1f, 0x00, 0x01, 0x01, 0x05, 0x05,
+	// x86inc.w J
+	0x1f, 0xfc, 0xff, 0xff, 0x02, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x20, 0x00, 0x01, 0x01, 0x05, 0x05,
+	// x86inc.d J
+	0x1f, 0xfc, 0xff, 0xff, 0x03, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x21, 0x00, 0x01, 0x01, 0x05, 0x05,
+	// x86dec.b J
+	0x1f, 0xfc, 0xff, 0xff, 0x04, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x22, 0x00, 0x01, 0x01, 0x05, 0x05,
+	// x86dec.h J
+	0x1f, 0xfc, 0xff, 0xff, 0x05, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x23, 0x00, 0x01, 0x01, 0x05, 0x05,
+	// x86dec.w J
+	0x1f, 0xfc, 0xff, 0xff, 0x06, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x24, 0x00, 0x01, 0x01, 0x05, 0x05,
+	// x86dec.d J
+	0x1f, 0xfc, 0xff, 0xff, 0x07, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x25, 0x00, 0x01, 0x01, 0x05, 0x05,
+	// x86settm EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x08, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x26, 0x00, 0x00,
+	// x86inctop EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x09, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x27, 0x00, 0x00,
+	// x86clrtm EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x28, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x28, 0x00, 0x00,
+	// x86dectop EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x29, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x29, 0x00, 0x00,
+	// asrtle JK
+	0x1f, 0x80, 0xff, 0xff, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x2a, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// asrtgt JK
+	0x1f, 0x80, 0xff, 0xff, 0x00, 0x80, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x2b, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// sladd.w DJKUa2
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x00, 0x04, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x48, 0x0f, 0x02, 0x01,
+	// sladd.wu DJKUa2
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x06, 0x00, 0x00, 0x00, 0x00, 0x00, 0x2d, 0x00, 0x04, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x48, 0x0f, 0x02, 0x01,
+	// catpick.w DJKUa2
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x2e, 0x00, 0x04, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x08, 0x0f, 0x02,
+	// catpick.d DJKUa3
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x2f, 0x00, 0x04, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x08, 0x0f, 0x03,
+	// add.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x30, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// add.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x10, 0x00, 0x00, 0x00, 0x00, 0x00, 0x31, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// sub.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x32, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// sub.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x11, 0x00, 0x00, 0x00, 0x00, 0x00, 0x33, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// slt DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x12, 0x00, 0x00, 0x00, 0x00, 0x00, 0x34, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// sltu DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x12, 0x00, 0x00, 0x00, 0x00, 0x00, 0x35, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// maskeqz DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x13, 0x00, 0x00, 0x00, 0x00, 0x00, 0x36, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// masknez DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x13, 0x00, 0x00, 0x00, 0x00, 0x00, 0x37, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// nor DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x38, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// and DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x14, 0x00, 0x00, 0x00, 0x00, 0x00, 0x39, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// or DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x15, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3a, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// xor DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x15, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3b, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// orn DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x16, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3c, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// andn DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x16, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3d, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// sll.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x17, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// srl.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x17, 0x00, 0x00, 0x00, 0x00, 0x00, 0x3f, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// sra.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x18, 0x00, 0x00, 0x00, 0x00, 0x00, 0x40, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// sll.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x18, 0x00, 0x00, 0x00, 0x00, 0x00, 0x41, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// srl.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x42, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// sra.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x43, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// rotr.b DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x44, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// rotr.h DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x45, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// rotr.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1b, 0x00, 0x00, 0x00, 0x00, 0x00, 0x46, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// rotr.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1b, 0x00, 0x00, 0x00, 0x00, 0x00, 0x47, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// mul.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x48, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// mulh.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x49, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// mulh.wu DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1d, 0x00, 0x00, 0x00, 0x00, 0x00, 0x4a, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// mul.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1d, 0x00, 0x00, 0x00, 0x00, 0x00, 0x4b, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// mulh.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x4c, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// mulh.du DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x4d, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// mulw.d.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x4e, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// mulw.d.wu DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x4f, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// div.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x50, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// mod.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x20, 0x00, 0x00, 0x00, 0x00, 0x00, 0x51, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// div.wu DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x52, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// mod.wu DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x21, 0x00, 0x00, 0x00, 0x00, 0x00, 0x53, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// div.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x22, 0x00, 0x00, 0x00, 0x00, 0x00, 0x54, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// mod.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x22, 0x00, 0x00, 0x00, 0x00, 0x00, 0x55, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// div.du DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x23, 0x00, 0x00, 0x00, 0x00, 0x00, 0x56, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// mod.du DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x23, 0x00, 0x00, 0x00, 0x00, 0x00, 0x57, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// crc.w.b.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x24, 0x00, 0x00, 0x00, 0x00, 0x00, 0x58, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// crc.w.h.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x24, 0x00, 0x00, 0x00, 0x00, 0x00, 0x59, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// crc.w.w.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x25, 0x00, 0x00, 0x00, 0x00, 0x00, 0x5a, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// crc.w.d.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x25, 0x00, 0x00, 0x00, 0x00, 0x00, 0x5b, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// crcc.w.b.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x26, 0x00, 0x00, 0x00, 0x00, 0x00, 0x5c, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// crcc.w.h.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x26, 0x00, 0x00, 0x00, 0x00, 0x00, 0x5d, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// crcc.w.w.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x27, 0x00, 0x00, 0x00, 0x00, 0x00, 0x5e, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// crcc.w.d.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x27, 0x00, 0x00, 0x00, 0x00, 0x00, 0x5f, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// addu12i.w DJSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x60, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// addu12i.d DJSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x29, 0x00, 0x00, 0x00, 0x00, 0x00, 0x61, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// break Ud15
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x62, 0x00, 0x01, 0x08, 0x00, 0x0f,
+	// dbgcall Ud15
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x63, 0x00, 0x01, 0x08, 0x00, 0x0f,
+	// syscall Ud15
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2b, 0x00, 0x00, 0x00, 0x00, 0x00, 0x64, 0x00, 0x01, 0x08, 0x00, 0x0f,
+	// hypcall Ud15
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2b, 0x00, 0x00, 0x00, 0x00, 0x00, 0x65, 0x00, 0x01, 0x08, 0x00, 0x0f,
+	// sladd.d DJKUa2
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x2c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x66, 0x00, 0x04, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x48, 0x0f, 0x02, 0x01,
+	// adc.b DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x30, 0x00, 0x00, 0x00, 0x00, 0x00, 0x67, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// adc.h DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x30, 0x00, 0x00, 0x00, 0x00, 0x00, 0x68, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// adc.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x31, 0x00, 0x00, 0x00, 0x00, 0x00, 0x69, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// adc.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x31, 0x00, 0x00, 0x00, 0x00, 0x00, 0x6a, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// sbc.b DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x32, 0x00, 0x00, 0x00, 0x00, 0x00, 0x6b, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// sbc.h DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x32, 0x00, 0x00, 0x00, 0x00, 0x00, 0x6c, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// sbc.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x33, 0x00, 0x00, 0x00, 0x00, 0x00, 0x6d, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// sbc.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x33, 0x00, 0x00, 0x00, 0x00, 0x00, 0x6e, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// rcr.b DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x34, 0x00, 0x00, 0x00, 0x00, 0x00, 0x6f, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// rcr.h DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x34, 0x00, 0x00, 0x00, 0x00, 0x00, 0x70, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// rcr.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x35, 0x00, 0x00, 0x00, 0x00, 0x00, 0x71, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// rcr.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x35, 0x00, 0x00, 0x00, 0x00, 0x00, 0x72, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// armmove DJUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x36, 0x00, 0x00, 0x00, 0x00, 0x00, 0x73, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// x86setj DUk4
+	0xe0, 0xc3, 0xff, 0xff, 0x00, 0x80, 0x36, 0x00, 0x00, 0x00, 0x00, 0x00, 0x74, 0x00, 0x02, 0x01, 0x00, 0x05, 0x08, 0x0a, 0x04,
+	// armsetj DUk4
+	0xe0, 0xc3, 0xff, 0xff, 0x00, 0xc0, 0x36, 0x00, 0x00, 0x00, 0x00, 0x00, 0x75, 0x00, 0x02, 0x01, 0x00, 0x05, 0x08, 0x0a, 0x04,
+	// armadd.w JKUd4
+	0x10, 0x80, 0xff, 0xff, 0x10, 0x00, 0x37, 0x00, 0x00, 0x00, 0x00, 0x00, 0x76, 0x00, 0x03, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x08, 0x00, 0x04,
+	// armsub.w JKUd4
+	0x10, 0x80, 0xff, 0xff, 0x10, 0x80, 0x37, 0x00, 0x00, 0x00, 0x00, 0x00, 0x77, 0x00, 0x03, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x08, 0x00, 0x04,
+	// armadc.w JKUd4
+	0x10, 0x80, 0xff, 0xff, 0x10, 0x00, 0x38, 0x00, 0x00, 0x00, 0x00, 0x00, 0x78, 0x00, 0x03, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x08, 0x00, 0x04,
+	// armsbc.w JKUd4
+	0x10, 0x80, 0xff, 0xff, 0x10, 0x80, 0x38, 0x00, 0x00, 0x00, 0x00, 0x00, 0x79, 0x00, 0x03, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x08, 0x00, 0x04,
+	// armand.w JKUd4
+	0x10, 0x80, 0xff, 0xff, 0x10, 0x00, 0x39, 0x00, 0x00, 0x00, 0x00, 0x00, 0x7a, 0x00, 0x03, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x08, 0x00, 0x04,
+	// armor.w JKUd4
+	0x10, 0x80, 0xff, 0xff, 0x10, 0x80, 0x39, 0x00, 0x00, 0x00, 0x00, 0x00, 0x7b, 0x00, 0x03, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x08, 0x00, 0x04,
+	// armxor.w JKUd4
+	0x10, 0x80, 0xff, 0xff, 0x10, 0x00, 0x3a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x7c, 0x00, 0x03, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x08, 0x00, 0x04,
+	// armsll.w JKUd4
+	0x10, 0x80, 0xff, 0xff, 0x10, 0x80, 0x3a, 0x00, 0x00, 0x00, 0x00, 0x00, 0x7d, 0x00, 0x03, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x08, 0x00, 0x04,
+	// armsrl.w JKUd4
+	0x10, 0x80, 0xff, 0xff, 0x10, 0x00, 0x3b, 0x00, 0x00, 0x00, 0x00, 0x00, 0x7e, 0x00, 0x03, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x08, 0x00, 0x04,
+	// armsra.w JKUd4
+	0x10, 0x80, 0xff, 0xff, 0x10, 0x80, 0x3b, 0x00, 0x00, 0x00, 0x00, 0x00, 0x7f, 0x00, 0x03, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x08, 0x00, 0x04,
+	// armrotr.w JKUd4
+	0x10, 0x80, 0xff, 0xff, 0x10, 0x00, 0x3c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x80, 0x00, 0x03, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x08, 0x00, 0x04,
+	// armslli.w JUd4Uk5
+	0x10, 0x80, 0xff, 0xff, 0x10, 0x80, 0x3c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x81, 0x00, 0x03, 0x01, 0x05, 0x05, 0x08, 0x00, 0x04, 0x08, 0x0a, 0x05,
+	// armsrli.w JUd4Uk5
+	0x10, 0x80, 0xff, 0xff, 0x10, 0x00, 0x3d, 0x00, 0x00, 0x00, 0x00, 0x00, 0x82, 0x00, 0x03, 0x01, 0x05, 0x05, 0x08, 0x00, 0x04, 0x08, 0x0a, 0x05,
+	// armsrai.w JUd4Uk5
+	0x10, 0x80, 0xff, 0xff, 0x10, 0x80, 0x3d, 0x00, 0x00, 0x00, 0x00, 0x00, 0x83, 0x00, 0x03, 0x01, 0x05, 0x05, 0x08, 0x00, 0x04, 0x08, 0x0a, 0x05,
+	// armrotri.w JUd4Uk5
+	0x10, 0x80, 0xff, 0xff, 0x10, 0x00, 0x3e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x84, 0x00, 0x03, 0x01, 0x05, 0x05, 0x08, 0x00, 0x04, 0x08, 0x0a, 0x05,
+	// x86mul.b JK
+	0x1f, 0x80, 0xff, 0xff, 0x00, 0x80, 0x3e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x85, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86mul.h JK
+	0x1f, 0x80, 0xff, 0xff, 0x01, 0x80, 0x3e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x86, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86mul.w JK
+	0x1f, 0x80, 0xff, 0xff, 0x02, 0x80, 0x3e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x87, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86mul.d JK
+	0x1f, 0x80, 0xff, 0xff, 0x03, 0x80, 0x3e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x88, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86mul.bu JK
+	0x1f, 0x80, 0xff, 0xff, 0x04, 0x80, 0x3e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x89, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86mul.hu JK
+	0x1f, 0x80, 0xff, 0xff, 0x05, 0x80, 0x3e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x8a, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86mul.wu JK
+	0x1f, 0x80, 0xff, 0xff, 0x06, 0x80, 0x3e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x8b, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86mul.du JK
+	0x1f, 0x80, 0xff, 0xff, 0x07, 0x80, 0x3e, 0x00, 0x00, 0x00, 0x00, 0x00, 0x8c, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86add.wu JK
+	0x1f, 0x80, 0xff, 0xff, 0x00, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x8d, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86add.du JK
+	0x1f, 0x80, 0xff, 0xff, 0x01, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x8e, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sub.wu JK
+	0x1f, 0x80, 0xff, 0xff, 0x02, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x8f, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sub.du JK
+	0x1f, 0x80, 0xff, 0xff, 0x03, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x90, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86add.b JK
+	0x1f, 0x80, 0xff, 0xff, 0x04, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x91, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86add.h JK
+	0x1f, 0x80, 0xff, 0xff, 0x05, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x92, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86add.w JK
+	0x1f, 0x80, 0xff, 0xff, 0x06, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x93, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86add.d JK
+	0x1f, 0x80, 0xff, 0xff, 0x07, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x94, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sub.b JK
+	0x1f, 0x80, 0xff, 0xff, 0x08, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x95, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sub.h JK
+	0x1f, 0x80, 0xff, 0xff, 0x09, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x96, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sub.w JK
+	0x1f, 0x80, 0xff, 0xff, 0x0a, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x97, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sub.d JK
+	0x1f, 0x80, 0xff, 0xff, 0x0b, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x98, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86adc.b JK
+	0x1f, 0x80, 0xff, 0xff, 0x0c, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x99, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86adc.h JK
+	0x1f, 0x80, 0xff, 0xff, 0x0d, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x9a, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86adc.w JK
+	0x1f, 0x80, 0xff, 0xff, 0x0e, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x9b, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86adc.d JK
+	0x1f, 0x80, 0xff, 0xff, 0x0f, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x9c, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sbc.b JK
+	0x1f, 0x80, 0xff, 0xff, 0x10, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x9d, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sbc.h JK
+	0x1f, 0x80, 0xff, 0xff, 0x11, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x9e, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sbc.w JK
+	0x1f, 0x80, 0xff, 0xff, 0x12, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0x9f, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sbc.d JK
+	0x1f, 0x80, 0xff, 0xff, 0x13, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa0, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sll.b JK
+	0x1f, 0x80, 0xff, 0xff, 0x14, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa1, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sll.h JK
+	0x1f, 0x80, 0xff, 0xff, 0x15, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa2, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sll.w JK
+	0x1f, 0x80, 0xff, 0xff, 0x16, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa3, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sll.d JK
+	0x1f, 0x80, 0xff, 0xff, 0x17, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86srl.b JK
+	0x1f, 0x80, 0xff, 0xff, 0x18, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa5, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86srl.h JK
+	0x1f, 0x80, 0xff, 0xff, 0x19, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa6, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86srl.w JK
+	0x1f, 0x80, 0xff, 0xff, 0x1a, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa7, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86srl.d JK
+	0x1f, 0x80, 0xff, 0xff, 0x1b, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa8, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sra.b JK
+	0x1f, 0x80, 0xff, 0xff, 0x1c, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xa9, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sra.h JK
+	0x1f, 0x80, 0xff, 0xff, 0x1d, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xaa, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sra.w JK
+	0x1f, 0x80, 0xff, 0xff, 0x1e, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xab, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86sra.d JK
+	0x1f, 0x80, 0xff, 0xff, 0x1f, 0x00, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xac, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rotr.b JK
+	0x1f, 0x80, 0xff, 0xff, 0x00, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xad, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rotr.h JK
+	0x1f, 0x80, 0xff, 0xff, 0x01, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xae, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rotr.d JK
+	0x1f, 0x80, 0xff, 0xff, 0x02, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xaf, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rotr.w JK
+	0x1f, 0x80, 0xff, 0xff, 0x03, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xb0, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rotl.b JK
+	0x1f, 0x80, 0xff, 0xff, 0x04, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xb1, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rotl.h JK
+	0x1f, 0x80, 0xff, 0xff, 0x05, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xb2, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rotl.w JK
+	0x1f, 0x80, 0xff, 0xff, 0x06, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xb3, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rotl.d JK
+	0x1f, 0x80, 0xff, 0xff, 0x07, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xb4, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rcr.b JK
+	0x1f, 0x80, 0xff, 0xff, 0x08, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xb5, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rcr.h JK
+	0x1f, 0x80, 0xff, 0xff, 0x09, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xb6, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rcr.w JK
+	0x1f, 0x80, 0xff, 0xff, 0x0a, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xb7, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rcr.d JK
+	0x1f, 0x80, 0xff, 0xff, 0x0b, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xb8, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rcl.b JK
+	0x1f, 0x80, 0xff, 0xff, 0x0c, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xb9, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rcl.h JK
+	0x1f, 0x80, 0xff, 0xff, 0x0d, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xba, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rcl.w JK
+	0x1f, 0x80, 0xff, 0xff, 0x0e, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xbb, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86rcl.d JK
+	0x1f, 0x80, 0xff, 0xff, 0x0f, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xbc, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86and.b JK
+	0x1f, 0x80, 0xff, 0xff, 0x10, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xbd, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86and.h JK
+	0x1f, 0x80, 0xff, 0xff, 0x11, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xbe, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86and.w JK
+	0x1f, 0x80, 0xff, 0xff, 0x12, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xbf, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86and.d JK
+	0x1f, 0x80, 0xff, 0xff, 0x13, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86or.b JK
+	0x1f, 0x80, 0xff, 0xff, 0x14, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc1, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86or.h JK
+	0x1f, 0x80, 0xff, 0xff, 0x15, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc2, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86or.w JK
+	0x1f, 0x80, 0xff, 0xff, 0x16, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc3, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86or.d JK
+	0x1f, 0x80, 0xff, 0xff, 0x17, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc4, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86xor.b JK
+	0x1f, 0x80, 0xff, 0xff, 0x18, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc5, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86xor.h JK
+	0x1f, 0x80, 0xff, 0xff, 0x19, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc6, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86xor.w JK
+	0x1f, 0x80, 0xff, 0xff, 0x1a, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc7, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// x86xor.d JK
+	0x1f, 0x80, 0xff, 0xff, 0x1b, 0x80, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc8, 0x00, 0x02, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// armnot.w JUk4
+	0x1f, 0xc0, 0xff, 0xff, 0x1c, 0xc0, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xc9, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// armmov.w JUk4
+	0x1f, 0xc0, 0xff, 0xff, 0x1d, 0xc0, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xca, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// armmov.d JUk4
+	0x1f, 0xc0, 0xff, 0xff, 0x1e, 0xc0, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xcb, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// armrrx.w JUk4
+	0x1f, 0xc0, 0xff, 0xff, 0x1f, 0xc0, 0x3f, 0x00, 0x00, 0x00, 0x00, 0x00, 0xcc, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// slli.w DJUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x40, 0x00, 0x00, 0x00, 0x00, 0x00, 0xcd, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// slli.d DJUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x41, 0x00, 0x00, 0x00, 0x00, 0x00, 0xce, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// srli.w DJUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x44, 0x00, 0x00, 0x00, 0x00, 0x00, 0xcf, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// srli.d DJUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x45, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd0, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// srai.w DJUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x48, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd1, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// srai.d DJUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x49, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd2, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// rotri.b DJUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x4c, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd3, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// rotri.h DJUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x4c, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd4, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// rotri.w DJUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x4c, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd5, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// rotri.d DJUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x4d, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd6, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// rcri.b DJUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x50, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd7, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// rcri.h DJUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x50, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd8, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// rcri.w DJUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x50, 0x00, 0x00, 0x00, 0x00, 0x00, 0xd9, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// rcri.d DJUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x51, 0x00, 0x00, 0x00, 0x00, 0x00, 0xda, 0x00, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// x86slli.b JUk3
+	0x1f, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xdb, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// x86srli.b JUk3
+	0x1f, 0xe0, 0xff, 0xff, 0x04, 0x20, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xdc, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// x86srai.b JUk3
+	0x1f, 0xe0, 0xff, 0xff, 0x08, 0x20, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xdd, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// x86rotri.b JUk3
+	0x1f, 0xe0, 0xff, 0xff, 0x0c, 0x20, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xde, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// x86rcri.b JUk3
+	0x1f, 0xe0, 0xff, 0xff, 0x10, 0x20, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xdf, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// x86rotli.b JUk3
+	0x1f, 0xe0, 0xff, 0xff, 0x14, 0x20, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe0, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// x86rcli.b JUk3
+	0x1f, 0xe0, 0xff, 0xff, 0x18, 0x20, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe1, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// x86slli.h JUk4
+	0x1f, 0xc0, 0xff, 0xff, 0x01, 0x40, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe2, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// x86srli.h JUk4
+	0x1f, 0xc0, 0xff, 0xff, 0x05, 0x40, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe3, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// x86srai.h JUk4
+	0x1f, 0xc0, 0xff, 0xff, 0x09, 0x40, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe4, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// x86rotri.h JUk4
+	0x1f, 0xc0, 0xff, 0xff, 0x0d, 0x40, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe5, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// x86rcri.h JUk4
+	0x1f, 0xc0, 0xff, 0xff, 0x11, 0x40, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe6, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// x86rotli.h JUk4
+	0x1f, 0xc0, 0xff, 0xff, 0x15, 0x40, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe7, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// x86rcli.h JUk4
+	0x1f, 0xc0, 0xff, 0xff, 0x19, 0x40, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe8, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// x86slli.w JUk5
+	0x1f, 0x80, 0xff, 0xff, 0x02, 0x80, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xe9, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// x86srli.w JUk5
+	0x1f, 0x80, 0xff, 0xff, 0x06, 0x80, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xea, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// x86srai.w JUk5
+	0x1f, 0x80, 0xff, 0xff, 0x0a, 0x80, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xeb, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// x86rotri.w JUk5
+	0x1f, 0x80, 0xff, 0xff, 0x0e, 0x80, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xec, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// x86rcri.w JUk5
+	0x1f, 0x80, 0xff, 0xff, 0x12, 0x80, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xed, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// x86rotli.w JUk5
+	0x1f, 0x80, 0xff, 0xff, 0x16, 0x80, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xee, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// x86rcli.w JUk5
+	0x1f, 0x80, 0xff, 0xff, 0x1a, 0x80, 0x54, 0x00, 0x00, 0x00, 0x00, 0x00, 0xef, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// x86slli.d JUk6
+	0x1f, 0x00, 0xff, 0xff, 0x03, 0x00, 0x55, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// x86srli.d JUk6
+	0x1f, 0x00, 0xff, 0xff, 0x07, 0x00, 0x55, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf1, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// x86srai.d JUk6
+	0x1f, 0x00, 0xff, 0xff, 0x0b, 0x00, 0x55, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf2, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// x86rotri.d JUk6
+	0x1f, 0x00, 0xff, 0xff, 0x0f, 0x00, 0x55, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf3, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// x86rcri.d JUk6
+	0x1f, 0x00, 0xff, 0xff, 0x13, 0x00, 0x55, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf4, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// x86rotli.d JUk6
+	0x1f, 0x00, 0xff, 0xff, 0x17, 0x00, 0x55, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf5, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// x86rcli.d JUk6
+	0x1f, 0x00, 0xff, 0xff, 0x1b, 0x00, 0x55, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf6, 0x00, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// x86settag DUj5Uk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x58, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf7, 0x00, 0x03, 0x01, 0x00, 0x05, 0x08, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// x86mfflag DUk8
+	0xe0, 0x03, 0xfc, 0xff, 0x00, 0x00, 0x5c, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x00, 0x02, 0x01, 0x00, 0x05, 0x08, 0x0a, 0x08,
+	// x86mtflag DUk8
+	0xe0, 0x03, 0xfc, 0xff, 0x20, 0x00, 0x5c, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf9, 0x00, 0x02, 0x01, 0x00, 0x05, 0x08, 0x0a, 0x08,
+	// armmfflag DUk8
+	0xe0, 0x03, 0xfc, 0xff, 0x40, 0x00, 0x5c, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfa, 0x00, 0x02, 0x01, 0x00, 0x05, 0x08, 0x0a, 0x08,
+	// armmtflag DUk8
+	0xe0, 0x03, 0xfc, 0xff, 0x60, 0x00, 0x5c, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfb, 0x00, 0x02, 0x01, 0x00, 0x05, 0x08, 0x0a, 0x08,
+	// bstrins.w DJUk5Um5
+	0x00, 0x80, 0xe0, 0xff, 0x00, 0x00, 0x60, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfc, 0x00, 0x04, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x05, 0x08, 0x10, 0x05,
+	// bstrpick.w DJUk5Um5
+	0x00, 0x80, 0xe0, 0xff, 0x00, 0x80, 0x60, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfd, 0x00, 0x04, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x05, 0x08, 0x10, 0x05,
+	// bstrins.d DJUk6Um6
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x00, 0x04, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x06, 0x08, 0x10, 0x06,
+	// bstrpick.d DJUk6Um6
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0xc0, 0x00, 0x00, 0x00, 0x00, 0x00, 0xff, 0x00, 0x04, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x06, 0x08, 0x10, 0x06,
+	// fadd.s FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x00, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fadd.d FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x01, 0x01, 0x00, 0x00, 0x00, 0x00, 0x01, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fsub.s FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x02, 0x01, 0x00, 0x00, 0x00, 0x00, 0x02, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fsub.d FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x03, 0x01, 0x00, 0x00, 0x00, 0x00, 0x03, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fmul.s FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x04, 0x01, 0x00, 0x00, 0x00, 0x00, 0x04, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fmul.d FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x05, 0x01, 0x00, 0x00, 0x00, 0x00, 0x05, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fdiv.s FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x06, 0x01, 0x00, 0x00, 0x00, 0x00, 0x06, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fdiv.d FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x07, 0x01, 0x00, 0x00, 0x00, 0x00, 0x07, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fmax.s FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x08, 0x01, 0x00, 0x00, 0x00, 0x00, 0x08, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fmax.d FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x09, 0x01, 0x00, 0x00, 0x00, 0x00, 0x09, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fmin.s FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fmin.d FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0b, 0x01, 0x00, 0x00, 0x00, 0x00, 0x0b, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fmaxa.s FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0c, 0x01, 0x00, 0x00, 0x00, 0x00, 0x0c, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fmaxa.d FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0d, 0x01, 0x00, 0x00, 0x00, 0x00, 0x0d, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fmina.s FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0e, 0x01, 0x00, 0x00, 0x00, 0x00, 0x0e, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fmina.d FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0f, 0x01, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fscaleb.s FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x10, 0x01, 0x00, 0x00, 0x00, 0x00, 0x10, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fscaleb.d FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x11, 0x01, 0x00, 0x00, 0x00, 0x00, 0x11, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcopysign.s FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x12, 0x01, 0x00, 0x00, 0x00, 0x00, 0x12, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcopysign.d FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x13, 0x01, 0x00, 0x00, 0x00, 0x00, 0x13, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fabs.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x04, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x14, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// fabs.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x08, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x15, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// fneg.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x14, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x16, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// fneg.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x18, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x17, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// flogb.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x24, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x18, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// flogb.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x28, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x19, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// fclass.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x34, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x1a, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// fclass.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x38, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x1b, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// fsqrt.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x44, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// fsqrt.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x48, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x1d, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// frecip.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x54, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x1e, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// frecip.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x58, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x1f, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// frsqrt.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x64, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x20, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// frsqrt.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x68, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x21, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// fmov.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x94, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x22, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// fmov.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x98, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x23, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// movgr2fr.w FdJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xa4, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x24, 0x01, 0x02, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// movgr2fr.d FdJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xa8, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x25, 0x01, 0x02, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// movgr2frh.w FdJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xac, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x26, 0x01, 0x02, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// movfr2gr.s DFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xb4, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x27, 0x01, 0x02, 0x01, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// movfr2gr.d DFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xb8, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x28, 0x01, 0x02, 0x01, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// movfrh2gr.s DFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xbc, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x29, 0x01, 0x02, 0x01, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// fcsrwr JUd5
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xc0, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x2a, 0x01, 0x02, 0x01, 0x05, 0x05, 0x08, 0x00, 0x05,
+	// fcsrrd DUj5
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xc8, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x2b, 0x01, 0x02, 0x01, 0x00, 0x05, 0x08, 0x05, 0x05,
+	// movfr2fcc CdFj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xd0, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x01, 0x02, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05,
+	// movfcc2fr FdCj
+	0x00, 0xff, 0xff, 0xff, 0x00, 0xd4, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x2d, 0x01, 0x02, 0x02, 0x00, 0x05, 0x03, 0x05, 0x03,
+	// movgr2fcc CdJ
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xd8, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x2e, 0x01, 0x02, 0x03, 0x00, 0x03, 0x01, 0x05, 0x05,
+	// movfcc2gr DCj
+	0x00, 0xff, 0xff, 0xff, 0x00, 0xdc, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x2f, 0x01, 0x02, 0x01, 0x00, 0x05, 0x03, 0x05, 0x03,
+	// fcvt.ld.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe0, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x30, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// fcvt.ud.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe4, 0x14, 0x01, 0x00, 0x00, 0x00, 0x00, 0x31, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// fcvt.d.ld FdFjFk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x15, 0x01, 0x00, 0x00, 0x00, 0x00, 0x32, 0x01, 0x03, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcvt.s.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x18, 0x19, 0x01, 0x00, 0x00, 0x00, 0x00, 0x33, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// fcvt.d.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x24, 0x19, 0x01, 0x00, 0x00, 0x00, 0x00, 0x34, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrm.w.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x04, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x35, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrm.w.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x08, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x36, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrm.l.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x24, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x37, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrm.l.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x28, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x38, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrp.w.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x44, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x39, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrp.w.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x48, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x3a, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrp.l.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x64, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x3b, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrp.l.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x68, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x3c, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrz.w.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x84, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x3d, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrz.w.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x88, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrz.l.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xa4, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x3f, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrz.l.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xa8, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x40, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrne.w.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xc4, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x41, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrne.w.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xc8, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x42, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrne.l.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe4, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x43, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftintrne.l.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe8, 0x1a, 0x01, 0x00, 0x00, 0x00, 0x00, 0x44, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftint.w.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x04, 0x1b, 0x01, 0x00, 0x00, 0x00, 0x00, 0x45, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftint.w.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x08, 0x1b, 0x01, 0x00, 0x00, 0x00, 0x00, 0x46, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftint.l.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x24, 0x1b, 0x01, 0x00, 0x00, 0x00, 0x00, 0x47, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ftint.l.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x28, 0x1b, 0x01, 0x00, 0x00, 0x00, 0x00, 0x48, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ffint.s.w FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x10, 0x1d, 0x01, 0x00, 0x00, 0x00, 0x00, 0x49, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ffint.s.l FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x18, 0x1d, 0x01, 0x00, 0x00, 0x00, 0x00, 0x4a, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ffint.d.w FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x20, 0x1d, 0x01, 0x00, 0x00, 0x00, 0x00, 0x4b, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// ffint.d.l FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x28, 0x1d, 0x01, 0x00, 0x00, 0x00, 0x00, 0x4c, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// frint.s FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x44, 0x1e, 0x01, 0x00, 0x00, 0x00, 0x00, 0x4d, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// frint.d FdFj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x48, 0x1e, 0x01, 0x00, 0x00, 0x00, 0x00, 0x4e, 0x01, 0x02, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05,
+	// slti DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x00, 0x02, 0x00, 0x00, 0x00, 0x00, 0x4f, 0x01, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// sltui DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x40, 0x02, 0x00, 0x00, 0x00, 0x00, 0x50, 0x01, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// addi.w DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x80, 0x02, 0x00, 0x00, 0x00, 0x00, 0x51, 0x01, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// addi.d DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0xc0, 0x02, 0x00, 0x00, 0x00, 0x00, 0x52, 0x01, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// cu52i.d DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x00, 0x03, 0x00, 0x00, 0x00, 0x00, 0x53, 0x01, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// andi DJUk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x40, 0x03, 0x00, 0x00, 0x00, 0x00, 0x54, 0x01, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x0c,
+	// ori DJUk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x80, 0x03, 0x00, 0x00, 0x00, 0x00, 0x55, 0x01, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x0c,
+	// xori DJUk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0xc0, 0x03, 0x00, 0x00, 0x00, 0x00, 0x56, 0x01, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x0c,
+	// csrxchg DJUk14
+	0x00, 0x00, 0x00, 0xff, 0x00, 0x00, 0x00, 0x04, 0x00, 0x00, 0x00, 0x00, 0x57, 0x01, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x0e,
+	// gcsrxchg DJUk14
+	0x00, 0x00, 0x00, 0xff, 0x00, 0x00, 0x00, 0x05, 0x00, 0x00, 0x00, 0x00, 0x58, 0x01, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x0e,
+	// cacop JUd5Sk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x00, 0x06, 0x00, 0x00, 0x00, 0x00, 0x59, 0x01, 0x03, 0x01, 0x05, 0x05, 0x08, 0x00, 0x05, 0x07, 0x0a, 0x0c,
+	// lddir DJUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x40, 0x06, 0x00, 0x00, 0x00, 0x00, 0x5a, 0x01, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// ldpte JUk8
+	0x1f, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x44, 0x06, 0x00, 0x00, 0x00, 0x00, 0x5b, 0x01, 0x02, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// iocsrrd.b DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x00, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x5c, 0x01, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// iocsrrd.h DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x04, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x5d, 0x01, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// iocsrrd.w DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x08, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x5e, 0x01, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// iocsrrd.d DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x0c, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x5f, 0x01, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// iocsrwr.b DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x10, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x60, 0x01, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// iocsrwr.h DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x14, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x61, 0x01, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// iocsrwr.w DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x18, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x62, 0x01, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// iocsrwr.d DJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x1c, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x63, 0x01, 0x02, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// tlbclr EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x00, 0x20, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x64, 0x01, 0x00,
+	// gtlbclr EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x01, 0x20, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x65, 0x01, 0x00,
+	// tlbflush EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x00, 0x24, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x66, 0x01, 0x00,
+	// gtlbflush EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x01, 0x24, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x67, 0x01, 0x00,
+	// tlbsrch EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x00, 0x28, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x68, 0x01, 0x00,
+	// gtlbsrch EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x01, 0x28, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x69, 0x01, 0x00,
+	// tlbrd EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x00, 0x2c, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x6a, 0x01, 0x00,
+	// gtlbrd EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x01, 0x2c, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x6b, 0x01, 0x00,
+	// tlbwr EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x00, 0x30, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x6c, 0x01, 0x00,
+	// gtlbwr EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x01, 0x30, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x6d, 0x01, 0x00,
+	// tlbfill EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x00, 0x34, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x6e, 0x01, 0x00,
+	// gtlbfill EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x01, 0x34, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x6f, 0x01, 0x00,
+	// eret EMPTY
+	0xff, 0xff, 0xff, 0xff, 0x00, 0x38, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x70, 0x01, 0x00,
+	// idle Ud15
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x48, 0x06, 0x00, 0x00, 0x00, 0x00, 0x71, 0x01, 0x01, 0x08, 0x00, 0x0f,
+	// tlbinv JKUd5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x49, 0x06, 0x00, 0x00, 0x00, 0x00, 0x72, 0x01, 0x03, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x08, 0x00, 0x05,
+	// fmadd.s FdFjFkFa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x10, 0x08, 0x00, 0x00, 0x00, 0x00, 0x73, 0x01, 0x04, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05, 0x02, 0x0f, 0x05,
+	// fmadd.d FdFjFkFa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x20, 0x08, 0x00, 0x00, 0x00, 0x00, 0x74, 0x01, 0x04, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05, 0x02, 0x0f, 0x05,
+	// fmsub.s FdFjFkFa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x50, 0x08, 0x00, 0x00, 0x00, 0x00, 0x75, 0x01, 0x04, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05, 0x02, 0x0f, 0x05,
+	// fmsub.d FdFjFkFa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x60, 0x08, 0x00, 0x00, 0x00, 0x00, 0x76, 0x01, 0x04, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05, 0x02, 0x0f, 0x05,
+	// fnmadd.s FdFjFkFa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x90, 0x08, 0x00, 0x00, 0x00, 0x00, 0x77, 0x01, 0x04, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05, 0x02, 0x0f, 0x05,
+	// fnmadd.d FdFjFkFa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0xa0, 0x08, 0x00, 0x00, 0x00, 0x00, 0x78, 0x01, 0x04, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05, 0x02, 0x0f, 0x05,
+	// fnmsub.s FdFjFkFa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0xd0, 0x08, 0x00, 0x00, 0x00, 0x00, 0x79, 0x01, 0x04, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05, 0x02, 0x0f, 0x05,
+	// fnmsub.d FdFjFkFa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0xe0, 0x08, 0x00, 0x00, 0x00, 0x00, 0x7a, 0x01, 0x04, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05, 0x02, 0x0f, 0x05,
+	// vfmadd.s VdVjVkVa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x10, 0x09, 0x00, 0x00, 0x00, 0x00, 0x7b, 0x01, 0x04, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05, 0x05, 0x0f, 0x05,
+	// vfmadd.d VdVjVkVa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x20, 0x09, 0x00, 0x00, 0x00, 0x00, 0x7c, 0x01, 0x04, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05, 0x05, 0x0f, 0x05,
+	// vfmsub.s VdVjVkVa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x50, 0x09, 0x00, 0x00, 0x00, 0x00, 0x7d, 0x01, 0x04, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05, 0x05, 0x0f, 0x05,
+	// vfmsub.d VdVjVkVa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x60, 0x09, 0x00, 0x00, 0x00, 0x00, 0x7e, 0x01, 0x04, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05, 0x05, 0x0f, 0x05,
+	// vfnmadd.s VdVjVkVa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x90, 0x09, 0x00, 0x00, 0x00, 0x00, 0x7f, 0x01, 0x04, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05, 0x05, 0x0f, 0x05,
+	// vfnmadd.d VdVjVkVa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0xa0, 0x09, 0x00, 0x00, 0x00, 0x00, 0x80, 0x01, 0x04, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05, 0x05, 0x0f, 0x05,
+	// vfnmsub.s VdVjVkVa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0xd0, 0x09, 0x00, 0x00, 0x00, 0x00, 0x81, 0x01, 0x04, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05, 0x05, 0x0f, 0x05,
+	// vfnmsub.d VdVjVkVa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0xe0, 0x09, 0x00, 0x00, 0x00, 0x00, 0x82, 0x01, 0x04, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05, 0x05, 0x0f, 0x05,
+	// xvfmadd.s XdXjXkXa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x10, 0x0a, 0x00, 0x00, 0x00, 0x00, 0x83, 0x01, 0x04, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05, 0x06, 0x0f, 0x05,
+	// xvfmadd.d XdXjXkXa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x20, 0x0a, 0x00, 0x00, 0x00, 0x00, 0x84, 0x01, 0x04, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05, 0x06, 0x0f, 0x05,
+	// xvfmsub.s XdXjXkXa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x50, 0x0a, 0x00, 0x00, 0x00, 0x00, 0x85, 0x01, 0x04, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05, 0x06, 0x0f, 0x05,
+	// xvfmsub.d XdXjXkXa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x60, 0x0a, 0x00, 0x00, 0x00, 0x00, 0x86, 0x01, 0x04, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05, 0x06, 0x0f, 0x05,
+	// xvfnmadd.s XdXjXkXa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x90, 0x0a, 0x00, 0x00, 0x00, 0x00, 0x87, 0x01, 0x04, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05, 0x06, 0x0f, 0x05,
+	// xvfnmadd.d XdXjXkXa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0xa0, 0x0a, 0x00, 0x00, 0x00, 0x00, 0x88, 0x01, 0x04, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05, 0x06, 0x0f, 0x05,
+	// xvfnmsub.s XdXjXkXa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0xd0, 0x0a, 0x00, 0x00, 0x00, 0x00, 0x89, 0x01, 0x04, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05, 0x06, 0x0f, 0x05,
+	// xvfnmsub.d XdXjXkXa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0xe0, 0x0a, 0x00, 0x00, 0x00, 0x00, 0x8a, 0x01, 0x04, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05, 0x06, 0x0f, 0x05,
+	// fcmp.caf.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x10, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x8b, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.saf.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x10, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x8c, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.clt.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x11, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x8d, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.slt.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x11, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x8e, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.ceq.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x12, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x8f, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.seq.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x12, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x90, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cle.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x13, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x91, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sle.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x13, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x92, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cun.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x14, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x93, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sun.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x14, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x94, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cult.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x15, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x95, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sult.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x15, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x96, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cueq.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x16, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x97, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sueq.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x16, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x98, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cule.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x17, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x99, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sule.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x17, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x9a, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cne.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x18, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x9b, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sne.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x18, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x9c, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cor.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1a, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x9d, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sor.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1a, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x9e, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cune.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1c, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x9f, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sune.s CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1c, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xa0, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.caf.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x20, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xa1, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.saf.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x20, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xa2, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.clt.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x21, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xa3, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.slt.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x21, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.ceq.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x22, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xa5, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.seq.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x22, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xa6, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cle.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x23, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xa7, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sle.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x23, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xa8, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cun.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x24, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xa9, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sun.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x24, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xaa, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cult.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x25, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xab, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sult.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x25, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xac, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cueq.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x26, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xad, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sueq.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x26, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xae, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cule.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x27, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xaf, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sule.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x27, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xb0, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cne.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x28, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xb1, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sne.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x28, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xb2, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cor.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2a, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xb3, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sor.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2a, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xb4, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.cune.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2c, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xb5, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// fcmp.sune.d CdFjFk
+	0x18, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2c, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xb6, 0x01, 0x03, 0x03, 0x00, 0x03, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05,
+	// vfcmp.caf.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x50, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xb7, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.saf.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x50, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xb8, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.clt.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x51, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xb9, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.slt.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x51, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xba, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.ceq.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x52, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xbb, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.seq.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x52, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xbc, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cle.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x53, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xbd, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sle.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x53, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xbe, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cun.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x54, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xbf, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sun.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x54, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cult.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x55, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xc1, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sult.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x55, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xc2, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cueq.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x56, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xc3, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sueq.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x56, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xc4, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cule.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x57, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xc5, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sule.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x57, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xc6, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cne.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x58, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xc7, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sne.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x58, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xc8, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cor.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x5a, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xc9, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sor.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x5a, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xca, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cune.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x5c, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xcb, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sune.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x5c, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xcc, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.caf.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x60, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xcd, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.saf.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x60, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xce, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.clt.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x61, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xcf, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.slt.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x61, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xd0, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.ceq.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x62, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xd1, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.seq.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x62, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xd2, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cle.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x63, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xd3, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sle.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x63, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xd4, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cun.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x64, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xd5, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sun.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x64, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xd6, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cult.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x65, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xd7, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sult.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x65, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xd8, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cueq.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x66, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xd9, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sueq.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x66, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xda, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cule.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x67, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xdb, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sule.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x67, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xdc, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cne.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x68, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xdd, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sne.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x68, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xde, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cor.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x6a, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xdf, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sor.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x6a, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xe0, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.cune.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x6c, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xe1, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcmp.sune.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x6c, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xe2, 0x01, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// xvfcmp.caf.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x90, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xe3, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.saf.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x90, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xe4, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.clt.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x91, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xe5, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.slt.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x91, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xe6, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.ceq.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x92, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xe7, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.seq.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x92, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xe8, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cle.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x93, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xe9, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sle.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x93, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xea, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cun.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x94, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xeb, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sun.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x94, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xec, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cult.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x95, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xed, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sult.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x95, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xee, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cueq.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x96, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xef, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sueq.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x96, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cule.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x97, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xf1, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sule.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x97, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xf2, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cne.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x98, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xf3, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sne.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x98, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xf4, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cor.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x9a, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xf5, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sor.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x9a, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xf6, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cune.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x9c, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xf7, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sune.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x9c, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.caf.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa0, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xf9, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.saf.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa0, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xfa, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.clt.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa1, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xfb, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.slt.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa1, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xfc, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.ceq.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa2, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xfd, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.seq.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa2, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cle.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa3, 0x0c, 0x00, 0x00, 0x00, 0x00, 0xff, 0x01, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sle.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa3, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cun.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa4, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sun.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa4, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x02, 0x02, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cult.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa5, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x03, 0x02, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sult.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa5, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x04, 0x02, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cueq.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa6, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x05, 0x02, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sueq.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa6, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x06, 0x02, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cule.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa7, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x07, 0x02, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sule.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa7, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x08, 0x02, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cne.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa8, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x09, 0x02, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sne.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa8, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x02, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cor.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xaa, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x0b, 0x02, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sor.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xaa, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x0c, 0x02, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.cune.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xac, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x0d, 0x02, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcmp.sune.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xac, 0x0c, 0x00, 0x00, 0x00, 0x00, 0x0e, 0x02, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// fsel FdFjFkCa
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x00, 0x0d, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x02, 0x04, 0x02, 0x00, 0x05, 0x02, 0x05, 0x05, 0x02, 0x0a, 0x05, 0x03, 0x0f, 0x03,
+	// vbitsel.v VdVjVkVa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x10, 0x0d, 0x00, 0x00, 0x00, 0x00, 0x10, 0x02, 0x04, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05, 0x05, 0x0f, 0x05,
+	// xvbitsel.v XdXjXkXa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x20, 0x0d, 0x00, 0x00, 0x00, 0x00, 0x11, 0x02, 0x04, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05, 0x06, 0x0f, 0x05,
+	// vshuf.b VdVjVkVa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x50, 0x0d, 0x00, 0x00, 0x00, 0x00, 0x12, 0x02, 0x04, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05, 0x05, 0x0f, 0x05,
+	// xvshuf.b XdXjXkXa
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x60, 0x0d, 0x00, 0x00, 0x00, 0x00, 0x13, 0x02, 0x04, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05, 0x06, 0x0f, 0x05,
+	// addu16i.d DJSk16
+	0x00, 0x00, 0x00, 0xfc, 0x00, 0x00, 0x00, 0x10, 0x00, 0x00, 0x00, 0x00, 0x14, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x10,
+	// lu12i.w DSj20
+	0x00, 0x00, 0x00, 0xfe, 0x00, 0x00, 0x00, 0x14, 0x00, 0x00, 0x00, 0x00, 0x15, 0x02, 0x02, 0x01, 0x00, 0x05, 0x07, 0x05, 0x14,
+	// cu32i.d DSj20
+	0x00, 0x00, 0x00, 0xfe, 0x00, 0x00, 0x00, 0x16, 0x00, 0x00, 0x00, 0x00, 0x16, 0x02, 0x02, 0x01, 0x00, 0x05, 0x07, 0x05, 0x14,
+	// pcaddu2i DSj20
+	0x00, 0x00, 0x00, 0xfe, 0x00, 0x00, 0x00, 0x18, 0x00, 0x00, 0x00, 0x00, 0x17, 0x02, 0x02, 0x01, 0x00, 0x05, 0x07, 0x05, 0x14,
+	// pcalau12i DSj20
+	0x00, 0x00, 0x00, 0xfe, 0x00, 0x00, 0x00, 0x1a, 0x00, 0x00, 0x00, 0x00, 0x18, 0x02, 0x02, 0x01, 0x00, 0x05, 0x07, 0x05, 0x14,
+	// pcaddu12i DSj20
+	0x00, 0x00, 0x00, 0xfe, 0x00, 0x00, 0x00, 0x1c, 0x00, 0x00, 0x00, 0x00, 0x19, 0x02, 0x02, 0x01, 0x00, 0x05, 0x07, 0x05, 0x14,
+	// pcaddu18i DSj20
+	0x00, 0x00, 0x00, 0xfe, 0x00, 0x00, 0x00, 0x1e, 0x00, 0x00, 0x00, 0x00, 0x1a, 0x02, 0x02, 0x01, 0x00, 0x05, 0x07, 0x05, 0x14,
+	// ll.w DJSk14
+	0x00, 0x00, 0x00, 0xff, 0x00, 0x00, 0x00, 0x20, 0x00, 0x00, 0x00, 0x00, 0x1b, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x0e, 0x02,
+	// sc.w DJSk14
+	0x00, 0x00, 0x00, 0xff, 0x00, 0x00, 0x00, 0x21, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x0e, 0x02,
+	// ll.d DJSk14
+	0x00, 0x00, 0x00, 0xff, 0x00, 0x00, 0x00, 0x22, 0x00, 0x00, 0x00, 0x00, 0x1d, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x0e, 0x02,
+	// sc.d DJSk14
+	0x00, 0x00, 0x00, 0xff, 0x00, 0x00, 0x00, 0x23, 0x00, 0x00, 0x00, 0x00, 0x1e, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x0e, 0x02,
+	// ldox4.w DJSk14
+	0x00, 0x00, 0x00, 0xff, 0x00, 0x00, 0x00, 0x24, 0x00, 0x00, 0x00, 0x00, 0x1f, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x0e, 0x02,
+	// stox4.w DJSk14
+	0x00, 0x00, 0x00, 0xff, 0x00, 0x00, 0x00, 0x25, 0x00, 0x00, 0x00, 0x00, 0x20, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x0e, 0x02,
+	// ldox4.d DJSk14
+	0x00, 0x00, 0x00, 0xff, 0x00, 0x00, 0x00, 0x26, 0x00, 0x00, 0x00, 0x00, 0x21, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x0e, 0x02,
+	// stox4.d DJSk14
+	0x00, 0x00, 0x00, 0xff, 0x00, 0x00, 0x00, 0x27, 0x00, 0x00, 0x00, 0x00, 0x22, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x0e, 0x02,
+	// ld.b DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x00, 0x28, 0x00, 0x00, 0x00, 0x00, 0x23, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// ld.h DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x40, 0x28, 0x00, 0x00, 0x00, 0x00, 0x24, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// ld.w DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x80, 0x28, 0x00, 0x00, 0x00, 0x00, 0x25, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// ld.d DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0xc0, 0x28, 0x00, 0x00, 0x00, 0x00, 0x26, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// st.b DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x00, 0x29, 0x00, 0x00, 0x00, 0x00, 0x27, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// st.h DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x40, 0x29, 0x00, 0x00, 0x00, 0x00, 0x28, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// st.w DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x80, 0x29, 0x00, 0x00, 0x00, 0x00, 0x29, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// st.d DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0xc0, 0x29, 0x00, 0x00, 0x00, 0x00, 0x2a, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// ld.bu DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x00, 0x2a, 0x00, 0x00, 0x00, 0x00, 0x2b, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// ld.hu DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x40, 0x2a, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// ld.wu DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x80, 0x2a, 0x00, 0x00, 0x00, 0x00, 0x2d, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// preld JUd5Sk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0xc0, 0x2a, 0x00, 0x00, 0x00, 0x00, 0x2e, 0x02, 0x03, 0x01, 0x05, 0x05, 0x08, 0x00, 0x05, 0x07, 0x0a, 0x0c,
+	// fld.s FdJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x00, 0x2b, 0x00, 0x00, 0x00, 0x00, 0x2f, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// fst.s FdJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x40, 0x2b, 0x00, 0x00, 0x00, 0x00, 0x30, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// fld.d FdJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x80, 0x2b, 0x00, 0x00, 0x00, 0x00, 0x31, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// fst.d FdJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0xc0, 0x2b, 0x00, 0x00, 0x00, 0x00, 0x32, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// vld VdJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x00, 0x2c, 0x00, 0x00, 0x00, 0x00, 0x33, 0x02, 0x03, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// vst VdJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x40, 0x2c, 0x00, 0x00, 0x00, 0x00, 0x34, 0x02, 0x03, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// xvld XdJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x80, 0x2c, 0x00, 0x00, 0x00, 0x00, 0x35, 0x02, 0x03, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// xvst XdJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0xc0, 0x2c, 0x00, 0x00, 0x00, 0x00, 0x36, 0x02, 0x03, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// ldl.w DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x00, 0x2e, 0x00, 0x00, 0x00, 0x00, 0x37, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// ldr.w DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x40, 0x2e, 0x00, 0x00, 0x00, 0x00, 0x38, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// ldl.d DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x80, 0x2e, 0x00, 0x00, 0x00, 0x00, 0x39, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// ldr.d DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0xc0, 0x2e, 0x00, 0x00, 0x00, 0x00, 0x3a, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// stl.w DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x00, 0x2f, 0x00, 0x00, 0x00, 0x00, 0x3b, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// str.w DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x40, 0x2f, 0x00, 0x00, 0x00, 0x00, 0x3c, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// stl.d DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x80, 0x2f, 0x00, 0x00, 0x00, 0x00, 0x3d, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// str.d DJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0xc0, 0x2f, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// vldrepl.d VdJSk9
+	0x00, 0x00, 0xf8, 0xff, 0x00, 0x00, 0x10, 0x30, 0x00, 0x00, 0x00, 0x00, 0x3f, 0x02, 0x03, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x09, 0x03,
+	// vldrepl.w VdJSk10
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x20, 0x30, 0x00, 0x00, 0x00, 0x00, 0x40, 0x02, 0x03, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x0a, 0x02,
+	// vldrepl.h VdJSk11
+	0x00, 0x00, 0xe0, 0xff, 0x00, 0x00, 0x40, 0x30, 0x00, 0x00, 0x00, 0x00, 0x41, 0x02, 0x03, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x0b, 0x01,
+	// vldrepl.b VdJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x80, 0x30, 0x00, 0x00, 0x00, 0x00, 0x42, 0x02, 0x03, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// vstelm.d VdJSk8Un1
+	0x00, 0x00, 0xf8, 0xff, 0x00, 0x00, 0x10, 0x31, 0x00, 0x00, 0x00, 0x00, 0x43, 0x02, 0x04, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x08, 0x03, 0x08, 0x12, 0x01,
+	// vstelm.w VdJSk8Un2
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x20, 0x31, 0x00, 0x00, 0x00, 0x00, 0x44, 0x02, 0x04, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x08, 0x02, 0x08, 0x12, 0x02,
+	// vstelm.h VdJSk8Un3
+	0x00, 0x00, 0xe0, 0xff, 0x00, 0x00, 0x40, 0x31, 0x00, 0x00, 0x00, 0x00, 0x45, 0x02, 0x04, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x08, 0x01, 0x08, 0x12, 0x03,
+	// vstelm.b VdJSk8Un4
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x80, 0x31, 0x00, 0x00, 0x00, 0x00, 0x46, 0x02, 0x04, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x08, 0x08, 0x12, 0x04,
+	// xvldrepl.d XdJSk9
+	0x00, 0x00, 0xf8, 0xff, 0x00, 0x00, 0x10, 0x32, 0x00, 0x00, 0x00, 0x00, 0x47, 0x02, 0x03, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x09, 0x03,
+	// xvldrepl.w XdJSk10
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x20, 0x32, 0x00, 0x00, 0x00, 0x00, 0x48, 0x02, 0x03, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x0a, 0x02,
+	// xvldrepl.h XdJSk11
+	0x00, 0x00, 0xe0, 0xff, 0x00, 0x00, 0x40, 0x32, 0x00, 0x00, 0x00, 0x00, 0x49, 0x02, 0x03, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x0b, 0x01,
+	// xvldrepl.b XdJSk12
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x80, 0x32, 0x00, 0x00, 0x00, 0x00, 0x4a, 0x02, 0x03, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x0c,
+	// xvstelm.d XdJSk8Un2
+	0x00, 0x00, 0xf0, 0xff, 0x00, 0x00, 0x10, 0x33, 0x00, 0x00, 0x00, 0x00, 0x4b, 0x02, 0x04, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x08, 0x03, 0x08, 0x12, 0x02,
+	// xvstelm.w XdJSk8Un3
+	0x00, 0x00, 0xe0, 0xff, 0x00, 0x00, 0x20, 0x33, 0x00, 0x00, 0x00, 0x00, 0x4c, 0x02, 0x04, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x08, 0x02, 0x08, 0x12, 0x03,
+	// xvstelm.h XdJSk8Un4
+	0x00, 0x00, 0xc0, 0xff, 0x00, 0x00, 0x40, 0x33, 0x00, 0x00, 0x00, 0x00, 0x4d, 0x02, 0x04, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x08, 0x01, 0x08, 0x12, 0x04,
+	// xvstelm.b XdJSk8Un5
+	0x00, 0x00, 0x80, 0xff, 0x00, 0x00, 0x80, 0x33, 0x00, 0x00, 0x00, 0x00, 0x4e, 0x02, 0x04, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05, 0x07, 0x0a, 0x08, 0x08, 0x12, 0x05,
+	// ldx.b DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x00, 0x38, 0x00, 0x00, 0x00, 0x00, 0x4f, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ldx.h DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x04, 0x38, 0x00, 0x00, 0x00, 0x00, 0x50, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ldx.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x08, 0x38, 0x00, 0x00, 0x00, 0x00, 0x51, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ldx.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0c, 0x38, 0x00, 0x00, 0x00, 0x00, 0x52, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// stx.b DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x10, 0x38, 0x00, 0x00, 0x00, 0x00, 0x53, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// stx.h DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x14, 0x38, 0x00, 0x00, 0x00, 0x00, 0x54, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// stx.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x18, 0x38, 0x00, 0x00, 0x00, 0x00, 0x55, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// stx.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1c, 0x38, 0x00, 0x00, 0x00, 0x00, 0x56, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ldx.bu DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x20, 0x38, 0x00, 0x00, 0x00, 0x00, 0x57, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ldx.hu DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x24, 0x38, 0x00, 0x00, 0x00, 0x00, 0x58, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ldx.wu DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x28, 0x38, 0x00, 0x00, 0x00, 0x00, 0x59, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// preldx JKUd5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2c, 0x38, 0x00, 0x00, 0x00, 0x00, 0x5a, 0x02, 0x03, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05, 0x08, 0x00, 0x05,
+	// fldx.s FdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x30, 0x38, 0x00, 0x00, 0x00, 0x00, 0x5b, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// fldx.d FdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x34, 0x38, 0x00, 0x00, 0x00, 0x00, 0x5c, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// fstx.s FdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x38, 0x38, 0x00, 0x00, 0x00, 0x00, 0x5d, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// fstx.d FdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x3c, 0x38, 0x00, 0x00, 0x00, 0x00, 0x5e, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// vldx VdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x40, 0x38, 0x00, 0x00, 0x00, 0x00, 0x5f, 0x02, 0x03, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// vstx VdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x44, 0x38, 0x00, 0x00, 0x00, 0x00, 0x60, 0x02, 0x03, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// xvldx XdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x48, 0x38, 0x00, 0x00, 0x00, 0x00, 0x61, 0x02, 0x03, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// xvstx XdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x4c, 0x38, 0x00, 0x00, 0x00, 0x00, 0x62, 0x02, 0x03, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amswap.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x60, 0x38, 0x00, 0x00, 0x00, 0x00, 0x63, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amswap.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x60, 0x38, 0x00, 0x00, 0x00, 0x00, 0x64, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amadd.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x61, 0x38, 0x00, 0x00, 0x00, 0x00, 0x65, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amadd.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x61, 0x38, 0x00, 0x00, 0x00, 0x00, 0x66, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amand.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x62, 0x38, 0x00, 0x00, 0x00, 0x00, 0x67, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amand.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x62, 0x38, 0x00, 0x00, 0x00, 0x00, 0x68, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amor.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x63, 0x38, 0x00, 0x00, 0x00, 0x00, 0x69, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amor.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x63, 0x38, 0x00, 0x00, 0x00, 0x00, 0x6a, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amxor.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x64, 0x38, 0x00, 0x00, 0x00, 0x00, 0x6b, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amxor.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x64, 0x38, 0x00, 0x00, 0x00, 0x00, 0x6c, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammax.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x65, 0x38, 0x00, 0x00, 0x00, 0x00, 0x6d, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammax.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x65, 0x38, 0x00, 0x00, 0x00, 0x00, 0x6e, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammin.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x66, 0x38, 0x00, 0x00, 0x00, 0x00, 0x6f, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammin.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x66, 0x38, 0x00, 0x00, 0x00, 0x00, 0x70, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammax.wu DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x67, 0x38, 0x00, 0x00, 0x00, 0x00, 0x71, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammax.du DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x67, 0x38, 0x00, 0x00, 0x00, 0x00, 0x72, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammin.wu DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x68, 0x38, 0x00, 0x00, 0x00, 0x00, 0x73, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammin.du DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x68, 0x38, 0x00, 0x00, 0x00, 0x00, 0x74, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amswap_db.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x69, 0x38, 0x00, 0x00, 0x00, 0x00, 0x75, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amswap_db.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x69, 0x38, 0x00, 0x00, 0x00, 0x00, 0x76, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amadd_db.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x6a, 0x38, 0x00, 0x00, 0x00, 0x00, 0x77, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amadd_db.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x6a, 0x38, 0x00, 0x00, 0x00, 0x00, 0x78, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amand_db.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x6b, 0x38, 0x00, 0x00, 0x00, 0x00, 0x79, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amand_db.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x6b, 0x38, 0x00, 0x00, 0x00, 0x00, 0x7a, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amor_db.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x6c, 0x38, 0x00, 0x00, 0x00, 0x00, 0x7b, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amor_db.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x6c, 0x38, 0x00, 0x00, 0x00, 0x00, 0x7c, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amxor_db.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x6d, 0x38, 0x00, 0x00, 0x00, 0x00, 0x7d, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// amxor_db.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x6d, 0x38, 0x00, 0x00, 0x00, 0x00, 0x7e, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammax_db.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x6e, 0x38, 0x00, 0x00, 0x00, 0x00, 0x7f, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammax_db.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x6e, 0x38, 0x00, 0x00, 0x00, 0x00, 0x80, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammin_db.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x6f, 0x38, 0x00, 0x00, 0x00, 0x00, 0x81, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammin_db.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x6f, 0x38, 0x00, 0x00, 0x00, 0x00, 0x82, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammax_db.wu DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x70, 0x38, 0x00, 0x00, 0x00, 0x00, 0x83, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammax_db.du DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x70, 0x38, 0x00, 0x00, 0x00, 0x00, 0x84, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammin_db.wu DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x71, 0x38, 0x00, 0x00, 0x00, 0x00, 0x85, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ammin_db.du DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x71, 0x38, 0x00, 0x00, 0x00, 0x00, 0x86, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// dbar Ud15
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x72, 0x38, 0x00, 0x00, 0x00, 0x00, 0x87, 0x02, 0x01, 0x08, 0x00, 0x0f,
+	// ibar Ud15
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x72, 0x38, 0x00, 0x00, 0x00, 0x00, 0x88, 0x02, 0x01, 0x08, 0x00, 0x0f,
+	// fldgt.s FdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x74, 0x38, 0x00, 0x00, 0x00, 0x00, 0x89, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// fldgt.d FdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x74, 0x38, 0x00, 0x00, 0x00, 0x00, 0x8a, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// fldle.s FdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x75, 0x38, 0x00, 0x00, 0x00, 0x00, 0x8b, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// fldle.d FdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x75, 0x38, 0x00, 0x00, 0x00, 0x00, 0x8c, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// fstgt.s FdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x76, 0x38, 0x00, 0x00, 0x00, 0x00, 0x8d, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// fstgt.d FdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x76, 0x38, 0x00, 0x00, 0x00, 0x00, 0x8e, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// fstle.s FdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x77, 0x38, 0x00, 0x00, 0x00, 0x00, 0x8f, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// fstle.d FdJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x77, 0x38, 0x00, 0x00, 0x00, 0x00, 0x90, 0x02, 0x03, 0x02, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ldgt.b DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x78, 0x38, 0x00, 0x00, 0x00, 0x00, 0x91, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ldgt.h DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x78, 0x38, 0x00, 0x00, 0x00, 0x00, 0x92, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ldgt.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x79, 0x38, 0x00, 0x00, 0x00, 0x00, 0x93, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ldgt.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x79, 0x38, 0x00, 0x00, 0x00, 0x00, 0x94, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ldle.b DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x7a, 0x38, 0x00, 0x00, 0x00, 0x00, 0x95, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ldle.h DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x7a, 0x38, 0x00, 0x00, 0x00, 0x00, 0x96, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ldle.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x7b, 0x38, 0x00, 0x00, 0x00, 0x00, 0x97, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// ldle.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x7b, 0x38, 0x00, 0x00, 0x00, 0x00, 0x98, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// stgt.b DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x7c, 0x38, 0x00, 0x00, 0x00, 0x00, 0x99, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// stgt.h DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x7c, 0x38, 0x00, 0x00, 0x00, 0x00, 0x9a, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// stgt.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x7d, 0x38, 0x00, 0x00, 0x00, 0x00, 0x9b, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// stgt.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x7d, 0x38, 0x00, 0x00, 0x00, 0x00, 0x9c, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// stle.b DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x7e, 0x38, 0x00, 0x00, 0x00, 0x00, 0x9d, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// stle.h DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x7e, 0x38, 0x00, 0x00, 0x00, 0x00, 0x9e, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// stle.w DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x7f, 0x38, 0x00, 0x00, 0x00, 0x00, 0x9f, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// stle.d DJK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x7f, 0x38, 0x00, 0x00, 0x00, 0x00, 0xa0, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// beqz JSd5k16
+	0x00, 0x00, 0x00, 0xfc, 0x00, 0x00, 0x00, 0x40, 0x00, 0x00, 0x00, 0x00, 0xa1, 0x02, 0x02, 0x01, 0x05, 0x05, 0x97, 0x00, 0x05, 0x0a, 0x10, 0x02,
+	// bnez JSd5k16
+	0x00, 0x00, 0x00, 0xfc, 0x00, 0x00, 0x00, 0x44, 0x00, 0x00, 0x00, 0x00, 0xa2, 0x02, 0x02, 0x01, 0x05, 0x05, 0x97, 0x00, 0x05, 0x0a, 0x10, 0x02,
+	// bceqz CjSd5k16
+	0x00, 0x03, 0x00, 0xfc, 0x00, 0x00, 0x00, 0x48, 0x00, 0x00, 0x00, 0x00, 0xa3, 0x02, 0x02, 0x03, 0x05, 0x03, 0x97, 0x00, 0x05, 0x0a, 0x10, 0x02,
+	// bcnez CjSd5k16
+	0x00, 0x03, 0x00, 0xfc, 0x00, 0x01, 0x00, 0x48, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x02, 0x02, 0x03, 0x05, 0x03, 0x97, 0x00, 0x05, 0x0a, 0x10, 0x02,
+	// jiscr0 Sd5k16
+	0xe0, 0x03, 0x00, 0xfc, 0x00, 0x02, 0x00, 0x48, 0x00, 0x00, 0x00, 0x00, 0xa5, 0x02, 0x01, 0x97, 0x00, 0x05, 0x0a, 0x10, 0x02,
+	// jiscr1 Sd5k16
+	0xe0, 0x03, 0x00, 0xfc, 0x00, 0x03, 0x00, 0x48, 0x00, 0x00, 0x00, 0x00, 0xa6, 0x02, 0x01, 0x97, 0x00, 0x05, 0x0a, 0x10, 0x02,
+	// jirl DJSk16
+	0x00, 0x00, 0x00, 0xfc, 0x00, 0x00, 0x00, 0x4c, 0x00, 0x00, 0x00, 0x00, 0xa7, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x10, 0x02,
+	// b Sd10k16
+	0x00, 0x00, 0x00, 0xfc, 0x00, 0x00, 0x00, 0x50, 0x00, 0x00, 0x00, 0x00, 0xa8, 0x02, 0x01, 0x97, 0x00, 0x0a, 0x0a, 0x10, 0x02,
+	// bl Sd10k16
+	0x00, 0x00, 0x00, 0xfc, 0x00, 0x00, 0x00, 0x54, 0x00, 0x00, 0x00, 0x00, 0xa9, 0x02, 0x01, 0x97, 0x00, 0x0a, 0x0a, 0x10, 0x02,
+	// beq DJSk16
+	0x00, 0x00, 0x00, 0xfc, 0x00, 0x00, 0x00, 0x58, 0x00, 0x00, 0x00, 0x00, 0xaa, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x10, 0x02,
+	// bne DJSk16
+	0x00, 0x00, 0x00, 0xfc, 0x00, 0x00, 0x00, 0x5c, 0x00, 0x00, 0x00, 0x00, 0xab, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x10, 0x02,
+	// bgt DJSk16
+	0x00, 0x00, 0x00, 0xfc, 0x00, 0x00, 0x00, 0x60, 0x00, 0x00, 0x00, 0x00, 0xac, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x10, 0x02,
+	// ble DJSk16
+	0x00, 0x00, 0x00, 0xfc, 0x00, 0x00, 0x00, 0x64, 0x00, 0x00, 0x00, 0x00, 0xad, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x10, 0x02,
+	// bgtu DJSk16
+	0x00, 0x00, 0x00, 0xfc, 0x00, 0x00, 0x00, 0x68, 0x00, 0x00, 0x00, 0x00, 0xae, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x10, 0x02,
+	// bleu DJSk16
+	0x00, 0x00, 0x00, 0xfc, 0x00, 0x00, 0x00, 0x6c, 0x00, 0x00, 0x00, 0x00, 0xaf, 0x02, 0x03, 0x01, 0x00, 0x05, 0x01, 0x05, 0x05, 0x87, 0x0a, 0x10, 0x02,
+	// vseq.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x00, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb0, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vseq.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x00, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb1, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vseq.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x01, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb2, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vseq.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x01, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb3, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsle.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x02, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb4, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsle.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x02, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb5, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsle.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x03, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb6, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsle.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x03, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb7, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsle.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x04, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb8, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsle.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x04, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb9, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsle.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x05, 0x70, 0x00, 0x00, 0x00, 0x00, 0xba, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsle.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x05, 0x70, 0x00, 0x00, 0x00, 0x00, 0xbb, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vslt.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x06, 0x70, 0x00, 0x00, 0x00, 0x00, 0xbc, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vslt.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x06, 0x70, 0x00, 0x00, 0x00, 0x00, 0xbd, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vslt.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x07, 0x70, 0x00, 0x00, 0x00, 0x00, 0xbe, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vslt.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x07, 0x70, 0x00, 0x00, 0x00, 0x00, 0xbf, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vslt.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x08, 0x70, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vslt.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x08, 0x70, 0x00, 0x00, 0x00, 0x00, 0xc1, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vslt.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x09, 0x70, 0x00, 0x00, 0x00, 0x00, 0xc2, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vslt.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x09, 0x70, 0x00, 0x00, 0x00, 0x00, 0xc3, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vadd.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0a, 0x70, 0x00, 0x00, 0x00, 0x00, 0xc4, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vadd.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0a, 0x70, 0x00, 0x00, 0x00, 0x00, 0xc5, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vadd.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0b, 0x70, 0x00, 0x00, 0x00, 0x00, 0xc6, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vadd.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0b, 0x70, 0x00, 0x00, 0x00, 0x00, 0xc7, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsub.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0c, 0x70, 0x00, 0x00, 0x00, 0x00, 0xc8, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsub.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0c, 0x70, 0x00, 0x00, 0x00, 0x00, 0xc9, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsub.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0d, 0x70, 0x00, 0x00, 0x00, 0x00, 0xca, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsub.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0d, 0x70, 0x00, 0x00, 0x00, 0x00, 0xcb, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwev.h.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1e, 0x70, 0x00, 0x00, 0x00, 0x00, 0xcc, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwev.w.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1e, 0x70, 0x00, 0x00, 0x00, 0x00, 0xcd, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwev.d.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1f, 0x70, 0x00, 0x00, 0x00, 0x00, 0xce, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwev.q.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1f, 0x70, 0x00, 0x00, 0x00, 0x00, 0xcf, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwev.h.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x20, 0x70, 0x00, 0x00, 0x00, 0x00, 0xd0, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwev.w.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x20, 0x70, 0x00, 0x00, 0x00, 0x00, 0xd1, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwev.d.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x21, 0x70, 0x00, 0x00, 0x00, 0x00, 0xd2, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwev.q.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x21, 0x70, 0x00, 0x00, 0x00, 0x00, 0xd3, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwod.h.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x22, 0x70, 0x00, 0x00, 0x00, 0x00, 0xd4, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwod.w.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x22, 0x70, 0x00, 0x00, 0x00, 0x00, 0xd5, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwod.d.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x23, 0x70, 0x00, 0x00, 0x00, 0x00, 0xd6, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwod.q.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x23, 0x70, 0x00, 0x00, 0x00, 0x00, 0xd7, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwod.h.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x24, 0x70, 0x00, 0x00, 0x00, 0x00, 0xd8, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwod.w.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x24, 0x70, 0x00, 0x00, 0x00, 0x00, 0xd9, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwod.d.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x25, 0x70, 0x00, 0x00, 0x00, 0x00, 0xda, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwod.q.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x25, 0x70, 0x00, 0x00, 0x00, 0x00, 0xdb, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwev.h.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2e, 0x70, 0x00, 0x00, 0x00, 0x00, 0xdc, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwev.w.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2e, 0x70, 0x00, 0x00, 0x00, 0x00, 0xdd, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwev.d.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2f, 0x70, 0x00, 0x00, 0x00, 0x00, 0xde, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwev.q.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2f, 0x70, 0x00, 0x00, 0x00, 0x00, 0xdf, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwev.h.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x30, 0x70, 0x00, 0x00, 0x00, 0x00, 0xe0, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwev.w.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x30, 0x70, 0x00, 0x00, 0x00, 0x00, 0xe1, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwev.d.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x31, 0x70, 0x00, 0x00, 0x00, 0x00, 0xe2, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwev.q.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x31, 0x70, 0x00, 0x00, 0x00, 0x00, 0xe3, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwod.h.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x32, 0x70, 0x00, 0x00, 0x00, 0x00, 0xe4, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwod.w.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x32, 0x70, 0x00, 0x00, 0x00, 0x00, 0xe5, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwod.d.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x33, 0x70, 0x00, 0x00, 0x00, 0x00, 0xe6, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwod.q.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x33, 0x70, 0x00, 0x00, 0x00, 0x00, 0xe7, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwod.h.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x34, 0x70, 0x00, 0x00, 0x00, 0x00, 0xe8, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwod.w.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x34, 0x70, 0x00, 0x00, 0x00, 0x00, 0xe9, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwod.d.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x35, 0x70, 0x00, 0x00, 0x00, 0x00, 0xea, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsubwod.q.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x35, 0x70, 0x00, 0x00, 0x00, 0x00, 0xeb, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwev.h.bu.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x3e, 0x70, 0x00, 0x00, 0x00, 0x00, 0xec, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwev.w.hu.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x3e, 0x70, 0x00, 0x00, 0x00, 0x00, 0xed, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwev.d.wu.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x3f, 0x70, 0x00, 0x00, 0x00, 0x00, 0xee, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwev.q.du.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x3f, 0x70, 0x00, 0x00, 0x00, 0x00, 0xef, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwod.h.bu.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x40, 0x70, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwod.w.hu.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x40, 0x70, 0x00, 0x00, 0x00, 0x00, 0xf1, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwod.d.wu.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x41, 0x70, 0x00, 0x00, 0x00, 0x00, 0xf2, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vaddwod.q.du.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x41, 0x70, 0x00, 0x00, 0x00, 0x00, 0xf3, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsadd.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x46, 0x70, 0x00, 0x00, 0x00, 0x00, 0xf4, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsadd.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x46, 0x70, 0x00, 0x00, 0x00, 0x00, 0xf5, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsadd.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x47, 0x70, 0x00, 0x00, 0x00, 0x00, 0xf6, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsadd.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x47, 0x70, 0x00, 0x00, 0x00, 0x00, 0xf7, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssub.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x48, 0x70, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssub.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x48, 0x70, 0x00, 0x00, 0x00, 0x00, 0xf9, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssub.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x49, 0x70, 0x00, 0x00, 0x00, 0x00, 0xfa, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssub.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x49, 0x70, 0x00, 0x00, 0x00, 0x00, 0xfb, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsadd.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x4a, 0x70, 0x00, 0x00, 0x00, 0x00, 0xfc, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsadd.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x4a, 0x70, 0x00, 0x00, 0x00, 0x00, 0xfd, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsadd.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x4b, 0x70, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsadd.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x4b, 0x70, 0x00, 0x00, 0x00, 0x00, 0xff, 0x02, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssub.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x4c, 0x70, 0x00, 0x00, 0x00, 0x00, 0x00, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssub.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x4c, 0x70, 0x00, 0x00, 0x00, 0x00, 0x01, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssub.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x4d, 0x70, 0x00, 0x00, 0x00, 0x00, 0x02, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssub.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x4d, 0x70, 0x00, 0x00, 0x00, 0x00, 0x03, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhaddw.h.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x54, 0x70, 0x00, 0x00, 0x00, 0x00, 0x04, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhaddw.w.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x54, 0x70, 0x00, 0x00, 0x00, 0x00, 0x05, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhaddw.d.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x55, 0x70, 0x00, 0x00, 0x00, 0x00, 0x06, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhaddw.q.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x55, 0x70, 0x00, 0x00, 0x00, 0x00, 0x07, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhsubw.h.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x56, 0x70, 0x00, 0x00, 0x00, 0x00, 0x08, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhsubw.w.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x56, 0x70, 0x00, 0x00, 0x00, 0x00, 0x09, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhsubw.d.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x57, 0x70, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhsubw.q.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x57, 0x70, 0x00, 0x00, 0x00, 0x00, 0x0b, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhaddw.hu.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x58, 0x70, 0x00, 0x00, 0x00, 0x00, 0x0c, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhaddw.wu.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x58, 0x70, 0x00, 0x00, 0x00, 0x00, 0x0d, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhaddw.du.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x59, 0x70, 0x00, 0x00, 0x00, 0x00, 0x0e, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhaddw.qu.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x59, 0x70, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhsubw.hu.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x5a, 0x70, 0x00, 0x00, 0x00, 0x00, 0x10, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhsubw.wu.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x5a, 0x70, 0x00, 0x00, 0x00, 0x00, 0x11, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhsubw.du.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x5b, 0x70, 0x00, 0x00, 0x00, 0x00, 0x12, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vhsubw.qu.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x5b, 0x70, 0x00, 0x00, 0x00, 0x00, 0x13, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vadda.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x5c, 0x70, 0x00, 0x00, 0x00, 0x00, 0x14, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vadda.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x5c, 0x70, 0x00, 0x00, 0x00, 0x00, 0x15, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vadda.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x5d, 0x70, 0x00, 0x00, 0x00, 0x00, 0x16, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vadda.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x5d, 0x70, 0x00, 0x00, 0x00, 0x00, 0x17, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vabsd.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x60, 0x70, 0x00, 0x00, 0x00, 0x00, 0x18, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vabsd.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x60, 0x70, 0x00, 0x00, 0x00, 0x00, 0x19, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vabsd.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x61, 0x70, 0x00, 0x00, 0x00, 0x00, 0x1a, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vabsd.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x61, 0x70, 0x00, 0x00, 0x00, 0x00, 0x1b, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vabsd.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x62, 0x70, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vabsd.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x62, 0x70, 0x00, 0x00, 0x00, 0x00, 0x1d, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vabsd.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x63, 0x70, 0x00, 0x00, 0x00, 0x00, 0x1e, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vabsd.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x63, 0x70, 0x00, 0x00, 0x00, 0x00, 0x1f, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavg.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x64, 0x70, 0x00, 0x00, 0x00, 0x00, 0x20, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavg.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x64, 0x70, 0x00, 0x00, 0x00, 0x00, 0x21, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavg.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x65, 0x70, 0x00, 0x00, 0x00, 0x00, 0x22, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavg.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x65, 0x70, 0x00, 0x00, 0x00, 0x00, 0x23, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavg.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x66, 0x70, 0x00, 0x00, 0x00, 0x00, 0x24, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavg.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x66, 0x70, 0x00, 0x00, 0x00, 0x00, 0x25, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavg.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x67, 0x70, 0x00, 0x00, 0x00, 0x00, 0x26, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavg.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x67, 0x70, 0x00, 0x00, 0x00, 0x00, 0x27, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavgr.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x68, 0x70, 0x00, 0x00, 0x00, 0x00, 0x28, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavgr.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x68, 0x70, 0x00, 0x00, 0x00, 0x00, 0x29, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavgr.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x69, 0x70, 0x00, 0x00, 0x00, 0x00, 0x2a, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavgr.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x69, 0x70, 0x00, 0x00, 0x00, 0x00, 0x2b, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavgr.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x6a, 0x70, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavgr.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x6a, 0x70, 0x00, 0x00, 0x00, 0x00, 0x2d, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavgr.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x6b, 0x70, 0x00, 0x00, 0x00, 0x00, 0x2e, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vavgr.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x6b, 0x70, 0x00, 0x00, 0x00, 0x00, 0x2f, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmax.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x70, 0x70, 0x00, 0x00, 0x00, 0x00, 0x30, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmax.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x70, 0x70, 0x00, 0x00, 0x00, 0x00, 0x31, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmax.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x71, 0x70, 0x00, 0x00, 0x00, 0x00, 0x32, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmax.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x71, 0x70, 0x00, 0x00, 0x00, 0x00, 0x33, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmin.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x72, 0x70, 0x00, 0x00, 0x00, 0x00, 0x34, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmin.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x72, 0x70, 0x00, 0x00, 0x00, 0x00, 0x35, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmin.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x73, 0x70, 0x00, 0x00, 0x00, 0x00, 0x36, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmin.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x73, 0x70, 0x00, 0x00, 0x00, 0x00, 0x37, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmax.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x74, 0x70, 0x00, 0x00, 0x00, 0x00, 0x38, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmax.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x74, 0x70, 0x00, 0x00, 0x00, 0x00, 0x39, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmax.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x75, 0x70, 0x00, 0x00, 0x00, 0x00, 0x3a, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmax.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x75, 0x70, 0x00, 0x00, 0x00, 0x00, 0x3b, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmin.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x76, 0x70, 0x00, 0x00, 0x00, 0x00, 0x3c, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmin.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x76, 0x70, 0x00, 0x00, 0x00, 0x00, 0x3d, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmin.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x77, 0x70, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmin.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x77, 0x70, 0x00, 0x00, 0x00, 0x00, 0x3f, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmul.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x84, 0x70, 0x00, 0x00, 0x00, 0x00, 0x40, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmul.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x84, 0x70, 0x00, 0x00, 0x00, 0x00, 0x41, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmul.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x85, 0x70, 0x00, 0x00, 0x00, 0x00, 0x42, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmul.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x85, 0x70, 0x00, 0x00, 0x00, 0x00, 0x43, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmuh.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x86, 0x70, 0x00, 0x00, 0x00, 0x00, 0x44, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmuh.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x86, 0x70, 0x00, 0x00, 0x00, 0x00, 0x45, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmuh.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x87, 0x70, 0x00, 0x00, 0x00, 0x00, 0x46, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmuh.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x87, 0x70, 0x00, 0x00, 0x00, 0x00, 0x47, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmuh.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x88, 0x70, 0x00, 0x00, 0x00, 0x00, 0x48, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmuh.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x88, 0x70, 0x00, 0x00, 0x00, 0x00, 0x49, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmuh.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x89, 0x70, 0x00, 0x00, 0x00, 0x00, 0x4a, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmuh.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x89, 0x70, 0x00, 0x00, 0x00, 0x00, 0x4b, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwev.h.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x90, 0x70, 0x00, 0x00, 0x00, 0x00, 0x4c, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwev.w.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x90, 0x70, 0x00, 0x00, 0x00, 0x00, 0x4d, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwev.d.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x91, 0x70, 0x00, 0x00, 0x00, 0x00, 0x4e, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwev.q.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x91, 0x70, 0x00, 0x00, 0x00, 0x00, 0x4f, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwod.h.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x92, 0x70, 0x00, 0x00, 0x00, 0x00, 0x50, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwod.w.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x92, 0x70, 0x00, 0x00, 0x00, 0x00, 0x51, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwod.d.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x93, 0x70, 0x00, 0x00, 0x00, 0x00, 0x52, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwod.q.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x93, 0x70, 0x00, 0x00, 0x00, 0x00, 0x53, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwev.h.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x98, 0x70, 0x00, 0x00, 0x00, 0x00, 0x54, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwev.w.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x98, 0x70, 0x00, 0x00, 0x00, 0x00, 0x55, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwev.d.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x99, 0x70, 0x00, 0x00, 0x00, 0x00, 0x56, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwev.q.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x99, 0x70, 0x00, 0x00, 0x00, 0x00, 0x57, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwod.h.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x9a, 0x70, 0x00, 0x00, 0x00, 0x00, 0x58, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwod.w.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x9a, 0x70, 0x00, 0x00, 0x00, 0x00, 0x59, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwod.d.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x9b, 0x70, 0x00, 0x00, 0x00, 0x00, 0x5a, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwod.q.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x9b, 0x70, 0x00, 0x00, 0x00, 0x00, 0x5b, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwev.h.bu.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa0, 0x70, 0x00, 0x00, 0x00, 0x00, 0x5c, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwev.w.hu.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa0, 0x70, 0x00, 0x00, 0x00, 0x00, 0x5d, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwev.d.wu.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa1, 0x70, 0x00, 0x00, 0x00, 0x00, 0x5e, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwev.q.du.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa1, 0x70, 0x00, 0x00, 0x00, 0x00, 0x5f, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwod.h.bu.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa2, 0x70, 0x00, 0x00, 0x00, 0x00, 0x60, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwod.w.hu.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa2, 0x70, 0x00, 0x00, 0x00, 0x00, 0x61, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwod.d.wu.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa3, 0x70, 0x00, 0x00, 0x00, 0x00, 0x62, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmulwod.q.du.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa3, 0x70, 0x00, 0x00, 0x00, 0x00, 0x63, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmadd.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa8, 0x70, 0x00, 0x00, 0x00, 0x00, 0x64, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmadd.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa8, 0x70, 0x00, 0x00, 0x00, 0x00, 0x65, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmadd.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa9, 0x70, 0x00, 0x00, 0x00, 0x00, 0x66, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmadd.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa9, 0x70, 0x00, 0x00, 0x00, 0x00, 0x67, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmsub.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xaa, 0x70, 0x00, 0x00, 0x00, 0x00, 0x68, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmsub.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xaa, 0x70, 0x00, 0x00, 0x00, 0x00, 0x69, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmsub.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xab, 0x70, 0x00, 0x00, 0x00, 0x00, 0x6a, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmsub.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xab, 0x70, 0x00, 0x00, 0x00, 0x00, 0x6b, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwev.h.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xac, 0x70, 0x00, 0x00, 0x00, 0x00, 0x6c, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwev.w.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xac, 0x70, 0x00, 0x00, 0x00, 0x00, 0x6d, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwev.d.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xad, 0x70, 0x00, 0x00, 0x00, 0x00, 0x6e, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwev.q.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xad, 0x70, 0x00, 0x00, 0x00, 0x00, 0x6f, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwod.h.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xae, 0x70, 0x00, 0x00, 0x00, 0x00, 0x70, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwod.w.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xae, 0x70, 0x00, 0x00, 0x00, 0x00, 0x71, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwod.d.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xaf, 0x70, 0x00, 0x00, 0x00, 0x00, 0x72, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwod.q.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xaf, 0x70, 0x00, 0x00, 0x00, 0x00, 0x73, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwev.h.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xb4, 0x70, 0x00, 0x00, 0x00, 0x00, 0x74, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwev.w.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xb4, 0x70, 0x00, 0x00, 0x00, 0x00, 0x75, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwev.d.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xb5, 0x70, 0x00, 0x00, 0x00, 0x00, 0x76, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwev.q.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xb5, 0x70, 0x00, 0x00, 0x00, 0x00, 0x77, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwod.h.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xb6, 0x70, 0x00, 0x00, 0x00, 0x00, 0x78, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwod.w.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xb6, 0x70, 0x00, 0x00, 0x00, 0x00, 0x79, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwod.d.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xb7, 0x70, 0x00, 0x00, 0x00, 0x00, 0x7a, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwod.q.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xb7, 0x70, 0x00, 0x00, 0x00, 0x00, 0x7b, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwev.h.bu.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xbc, 0x70, 0x00, 0x00, 0x00, 0x00, 0x7c, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwev.w.hu.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xbc, 0x70, 0x00, 0x00, 0x00, 0x00, 0x7d, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwev.d.wu.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xbd, 0x70, 0x00, 0x00, 0x00, 0x00, 0x7e, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwev.q.du.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xbd, 0x70, 0x00, 0x00, 0x00, 0x00, 0x7f, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwod.h.bu.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xbe, 0x70, 0x00, 0x00, 0x00, 0x00, 0x80, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwod.w.hu.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xbe, 0x70, 0x00, 0x00, 0x00, 0x00, 0x81, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwod.d.wu.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xbf, 0x70, 0x00, 0x00, 0x00, 0x00, 0x82, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmaddwod.q.du.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xbf, 0x70, 0x00, 0x00, 0x00, 0x00, 0x83, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vdiv.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe0, 0x70, 0x00, 0x00, 0x00, 0x00, 0x84, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vdiv.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe0, 0x70, 0x00, 0x00, 0x00, 0x00, 0x85, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vdiv.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe1, 0x70, 0x00, 0x00, 0x00, 0x00, 0x86, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vdiv.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe1, 0x70, 0x00, 0x00, 0x00, 0x00, 0x87, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmod.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe2, 0x70, 0x00, 0x00, 0x00, 0x00, 0x88, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmod.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe2, 0x70, 0x00, 0x00, 0x00, 0x00, 0x89, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmod.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe3, 0x70, 0x00, 0x00, 0x00, 0x00, 0x8a, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmod.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe3, 0x70, 0x00, 0x00, 0x00, 0x00, 0x8b, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vdiv.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe4, 0x70, 0x00, 0x00, 0x00, 0x00, 0x8c, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vdiv.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe4, 0x70, 0x00, 0x00, 0x00, 0x00, 0x8d, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vdiv.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe5, 0x70, 0x00, 0x00, 0x00, 0x00, 0x8e, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vdiv.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe5, 0x70, 0x00, 0x00, 0x00, 0x00, 0x8f, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmod.bu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe6, 0x70, 0x00, 0x00, 0x00, 0x00, 0x90, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmod.hu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe6, 0x70, 0x00, 0x00, 0x00, 0x00, 0x91, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmod.wu VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe7, 0x70, 0x00, 0x00, 0x00, 0x00, 0x92, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vmod.du VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe7, 0x70, 0x00, 0x00, 0x00, 0x00, 0x93, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsll.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe8, 0x70, 0x00, 0x00, 0x00, 0x00, 0x94, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsll.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe8, 0x70, 0x00, 0x00, 0x00, 0x00, 0x95, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsll.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe9, 0x70, 0x00, 0x00, 0x00, 0x00, 0x96, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsll.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe9, 0x70, 0x00, 0x00, 0x00, 0x00, 0x97, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrl.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xea, 0x70, 0x00, 0x00, 0x00, 0x00, 0x98, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrl.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xea, 0x70, 0x00, 0x00, 0x00, 0x00, 0x99, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrl.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xeb, 0x70, 0x00, 0x00, 0x00, 0x00, 0x9a, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrl.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xeb, 0x70, 0x00, 0x00, 0x00, 0x00, 0x9b, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsra.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xec, 0x70, 0x00, 0x00, 0x00, 0x00, 0x9c, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsra.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xec, 0x70, 0x00, 0x00, 0x00, 0x00, 0x9d, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsra.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xed, 0x70, 0x00, 0x00, 0x00, 0x00, 0x9e, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsra.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xed, 0x70, 0x00, 0x00, 0x00, 0x00, 0x9f, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vrotr.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xee, 0x70, 0x00, 0x00, 0x00, 0x00, 0xa0, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vrotr.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xee, 0x70, 0x00, 0x00, 0x00, 0x00, 0xa1, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vrotr.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xef, 0x70, 0x00, 0x00, 0x00, 0x00, 0xa2, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vrotr.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xef, 0x70, 0x00, 0x00, 0x00, 0x00, 0xa3, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrlr.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xf0, 0x70, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrlr.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf0, 0x70, 0x00, 0x00, 0x00, 0x00, 0xa5, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrlr.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xf1, 0x70, 0x00, 0x00, 0x00, 0x00, 0xa6, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrlr.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf1, 0x70, 0x00, 0x00, 0x00, 0x00, 0xa7, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrar.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xf2, 0x70, 0x00, 0x00, 0x00, 0x00, 0xa8, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrar.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf2, 0x70, 0x00, 0x00, 0x00, 0x00, 0xa9, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrar.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xf3, 0x70, 0x00, 0x00, 0x00, 0x00, 0xaa, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrar.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf3, 0x70, 0x00, 0x00, 0x00, 0x00, 0xab, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrln.b.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf4, 0x70, 0x00, 0x00, 0x00, 0x00, 0xac, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrln.h.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xf5, 0x70, 0x00, 0x00, 0x00, 0x00, 0xad, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrln.w.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf5, 0x70, 0x00, 0x00, 0x00, 0x00, 0xae, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsran.b.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf6, 0x70, 0x00, 0x00, 0x00, 0x00, 0xaf, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsran.h.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xf7, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb0, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsran.w.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf7, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb1, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrlrn.b.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf8, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb2, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrlrn.h.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xf9, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb3, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrlrn.w.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf9, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb4, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrarn.b.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xfa, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb5, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrarn.h.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xfb, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb6, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsrarn.w.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xfb, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb7, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrln.b.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xfc, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb8, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrln.h.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xfd, 0x70, 0x00, 0x00, 0x00, 0x00, 0xb9, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrln.w.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xfd, 0x70, 0x00, 0x00, 0x00, 0x00, 0xba, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssran.b.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xfe, 0x70, 0x00, 0x00, 0x00, 0x00, 0xbb, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssran.h.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xff, 0x70, 0x00, 0x00, 0x00, 0x00, 0xbc, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssran.w.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xff, 0x70, 0x00, 0x00, 0x00, 0x00, 0xbd, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrlrn.b.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x00, 0x71, 0x00, 0x00, 0x00, 0x00, 0xbe, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrlrn.h.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x01, 0x71, 0x00, 0x00, 0x00, 0x00, 0xbf, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrlrn.w.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x01, 0x71, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrarn.b.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x02, 0x71, 0x00, 0x00, 0x00, 0x00, 0xc1, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrarn.h.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x03, 0x71, 0x00, 0x00, 0x00, 0x00, 0xc2, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrarn.w.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x03, 0x71, 0x00, 0x00, 0x00, 0x00, 0xc3, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrln.bu.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x04, 0x71, 0x00, 0x00, 0x00, 0x00, 0xc4, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrln.hu.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x05, 0x71, 0x00, 0x00, 0x00, 0x00, 0xc5, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrln.wu.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x05, 0x71, 0x00, 0x00, 0x00, 0x00, 0xc6, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssran.bu.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x06, 0x71, 0x00, 0x00, 0x00, 0x00, 0xc7, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssran.hu.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x07, 0x71, 0x00, 0x00, 0x00, 0x00, 0xc8, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssran.wu.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x07, 0x71, 0x00, 0x00, 0x00, 0x00, 0xc9, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrlrn.bu.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x08, 0x71, 0x00, 0x00, 0x00, 0x00, 0xca, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrlrn.hu.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x09, 0x71, 0x00, 0x00, 0x00, 0x00, 0xcb, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrlrn.wu.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x09, 0x71, 0x00, 0x00, 0x00, 0x00, 0xcc, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrarn.bu.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0a, 0x71, 0x00, 0x00, 0x00, 0x00, 0xcd, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrarn.hu.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0b, 0x71, 0x00, 0x00, 0x00, 0x00, 0xce, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vssrarn.wu.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0b, 0x71, 0x00, 0x00, 0x00, 0x00, 0xcf, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vbitclr.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0c, 0x71, 0x00, 0x00, 0x00, 0x00, 0xd0, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vbitclr.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0c, 0x71, 0x00, 0x00, 0x00, 0x00, 0xd1, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vbitclr.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0d, 0x71, 0x00, 0x00, 0x00, 0x00, 0xd2, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vbitclr.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0d, 0x71, 0x00, 0x00, 0x00, 0x00, 0xd3, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vbitset.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0e, 0x71, 0x00, 0x00, 0x00, 0x00, 0xd4, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vbitset.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0e, 0x71, 0x00, 0x00, 0x00, 0x00, 0xd5, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vbitset.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0f, 0x71, 0x00, 0x00, 0x00, 0x00, 0xd6, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vbitset.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0f, 0x71, 0x00, 0x00, 0x00, 0x00, 0xd7, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vbitrev.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x10, 0x71, 0x00, 0x00, 0x00, 0x00, 0xd8, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vbitrev.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x10, 0x71, 0x00, 0x00, 0x00, 0x00, 0xd9, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vbitrev.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x11, 0x71, 0x00, 0x00, 0x00, 0x00, 0xda, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vbitrev.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x11, 0x71, 0x00, 0x00, 0x00, 0x00, 0xdb, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpackev.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x16, 0x71, 0x00, 0x00, 0x00, 0x00, 0xdc, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpackev.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x16, 0x71, 0x00, 0x00, 0x00, 0x00, 0xdd, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpackev.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x17, 0x71, 0x00, 0x00, 0x00, 0x00, 0xde, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpackev.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x17, 0x71, 0x00, 0x00, 0x00, 0x00, 0xdf, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpackod.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x18, 0x71, 0x00, 0x00, 0x00, 0x00, 0xe0, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpackod.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x18, 0x71, 0x00, 0x00, 0x00, 0x00, 0xe1, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpackod.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x19, 0x71, 0x00, 0x00, 0x00, 0x00, 0xe2, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpackod.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x19, 0x71, 0x00, 0x00, 0x00, 0x00, 0xe3, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vilvl.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1a, 0x71, 0x00, 0x00, 0x00, 0x00, 0xe4, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vilvl.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1a, 0x71, 0x00, 0x00, 0x00, 0x00, 0xe5, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vilvl.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1b, 0x71, 0x00, 0x00, 0x00, 0x00, 0xe6, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vilvl.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1b, 0x71, 0x00, 0x00, 0x00, 0x00, 0xe7, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vilvh.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1c, 0x71, 0x00, 0x00, 0x00, 0x00, 0xe8, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vilvh.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1c, 0x71, 0x00, 0x00, 0x00, 0x00, 0xe9, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vilvh.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1d, 0x71, 0x00, 0x00, 0x00, 0x00, 0xea, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vilvh.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1d, 0x71, 0x00, 0x00, 0x00, 0x00, 0xeb, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpickev.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1e, 0x71, 0x00, 0x00, 0x00, 0x00, 0xec, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpickev.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1e, 0x71, 0x00, 0x00, 0x00, 0x00, 0xed, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpickev.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1f, 0x71, 0x00, 0x00, 0x00, 0x00, 0xee, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpickev.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1f, 0x71, 0x00, 0x00, 0x00, 0x00, 0xef, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpickod.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x20, 0x71, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpickod.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x20, 0x71, 0x00, 0x00, 0x00, 0x00, 0xf1, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpickod.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x21, 0x71, 0x00, 0x00, 0x00, 0x00, 0xf2, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vpickod.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x21, 0x71, 0x00, 0x00, 0x00, 0x00, 0xf3, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vreplve.b VdVjK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x22, 0x71, 0x00, 0x00, 0x00, 0x00, 0xf4, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// vreplve.h VdVjK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x22, 0x71, 0x00, 0x00, 0x00, 0x00, 0xf5, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// vreplve.w VdVjK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x23, 0x71, 0x00, 0x00, 0x00, 0x00, 0xf6, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// vreplve.d VdVjK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x23, 0x71, 0x00, 0x00, 0x00, 0x00, 0xf7, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// vand.v VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x26, 0x71, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vor.v VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x26, 0x71, 0x00, 0x00, 0x00, 0x00, 0xf9, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vxor.v VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x27, 0x71, 0x00, 0x00, 0x00, 0x00, 0xfa, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vnor.v VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x27, 0x71, 0x00, 0x00, 0x00, 0x00, 0xfb, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vandn.v VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x28, 0x71, 0x00, 0x00, 0x00, 0x00, 0xfc, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vorn.v VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x28, 0x71, 0x00, 0x00, 0x00, 0x00, 0xfd, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfrstp.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2b, 0x71, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfrstp.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2b, 0x71, 0x00, 0x00, 0x00, 0x00, 0xff, 0x03, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vadd.q VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2d, 0x71, 0x00, 0x00, 0x00, 0x00, 0x00, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsub.q VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2d, 0x71, 0x00, 0x00, 0x00, 0x00, 0x01, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsigncov.b VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2e, 0x71, 0x00, 0x00, 0x00, 0x00, 0x02, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsigncov.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2e, 0x71, 0x00, 0x00, 0x00, 0x00, 0x03, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsigncov.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2f, 0x71, 0x00, 0x00, 0x00, 0x00, 0x04, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vsigncov.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2f, 0x71, 0x00, 0x00, 0x00, 0x00, 0x05, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfadd.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x30, 0x71, 0x00, 0x00, 0x00, 0x00, 0x06, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfadd.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x31, 0x71, 0x00, 0x00, 0x00, 0x00, 0x07, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfsub.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x32, 0x71, 0x00, 0x00, 0x00, 0x00, 0x08, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfsub.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x33, 0x71, 0x00, 0x00, 0x00, 0x00, 0x09, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfmul.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x38, 0x71, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfmul.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x39, 0x71, 0x00, 0x00, 0x00, 0x00, 0x0b, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfdiv.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x3a, 0x71, 0x00, 0x00, 0x00, 0x00, 0x0c, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfdiv.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x3b, 0x71, 0x00, 0x00, 0x00, 0x00, 0x0d, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfmax.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x3c, 0x71, 0x00, 0x00, 0x00, 0x00, 0x0e, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfmax.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x3d, 0x71, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfmin.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x3e, 0x71, 0x00, 0x00, 0x00, 0x00, 0x10, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfmin.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x3f, 0x71, 0x00, 0x00, 0x00, 0x00, 0x11, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfmaxa.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x40, 0x71, 0x00, 0x00, 0x00, 0x00, 0x12, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfmaxa.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x41, 0x71, 0x00, 0x00, 0x00, 0x00, 0x13, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfmina.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x42, 0x71, 0x00, 0x00, 0x00, 0x00, 0x14, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfmina.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x43, 0x71, 0x00, 0x00, 0x00, 0x00, 0x15, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcvt.h.s VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x46, 0x71, 0x00, 0x00, 0x00, 0x00, 0x16, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vfcvt.s.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x46, 0x71, 0x00, 0x00, 0x00, 0x00, 0x17, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vffint.s.l VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x48, 0x71, 0x00, 0x00, 0x00, 0x00, 0x18, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vftint.w.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x49, 0x71, 0x00, 0x00, 0x00, 0x00, 0x19, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vftintrm.w.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x4a, 0x71, 0x00, 0x00, 0x00, 0x00, 0x1a, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vftintrp.w.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x4a, 0x71, 0x00, 0x00, 0x00, 0x00, 0x1b, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vftintrz.w.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x4b, 0x71, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vftintrne.w.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x4b, 0x71, 0x00, 0x00, 0x00, 0x00, 0x1d, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vshuf.h VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x7a, 0x71, 0x00, 0x00, 0x00, 0x00, 0x1e, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vshuf.w VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x7b, 0x71, 0x00, 0x00, 0x00, 0x00, 0x1f, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vshuf.d VdVjVk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x7b, 0x71, 0x00, 0x00, 0x00, 0x00, 0x20, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x05, 0x0a, 0x05,
+	// vseqi.b VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x80, 0x72, 0x00, 0x00, 0x00, 0x00, 0x21, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vseqi.h VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x80, 0x72, 0x00, 0x00, 0x00, 0x00, 0x22, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vseqi.w VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x81, 0x72, 0x00, 0x00, 0x00, 0x00, 0x23, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vseqi.d VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x81, 0x72, 0x00, 0x00, 0x00, 0x00, 0x24, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vslei.b VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x82, 0x72, 0x00, 0x00, 0x00, 0x00, 0x25, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vslei.h VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x82, 0x72, 0x00, 0x00, 0x00, 0x00, 0x26, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vslei.w VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x83, 0x72, 0x00, 0x00, 0x00, 0x00, 0x27, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vslei.d VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x83, 0x72, 0x00, 0x00, 0x00, 0x00, 0x28, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vslei.bu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x84, 0x72, 0x00, 0x00, 0x00, 0x00, 0x29, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vslei.hu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x84, 0x72, 0x00, 0x00, 0x00, 0x00, 0x2a, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vslei.wu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x85, 0x72, 0x00, 0x00, 0x00, 0x00, 0x2b, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vslei.du VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x85, 0x72, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vslti.b VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x86, 0x72, 0x00, 0x00, 0x00, 0x00, 0x2d, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vslti.h VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x86, 0x72, 0x00, 0x00, 0x00, 0x00, 0x2e, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vslti.w VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x87, 0x72, 0x00, 0x00, 0x00, 0x00, 0x2f, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vslti.d VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x87, 0x72, 0x00, 0x00, 0x00, 0x00, 0x30, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vslti.bu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x88, 0x72, 0x00, 0x00, 0x00, 0x00, 0x31, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vslti.hu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x88, 0x72, 0x00, 0x00, 0x00, 0x00, 0x32, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vslti.wu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x89, 0x72, 0x00, 0x00, 0x00, 0x00, 0x33, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vslti.du VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x89, 0x72, 0x00, 0x00, 0x00, 0x00, 0x34, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vaddi.bu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x8a, 0x72, 0x00, 0x00, 0x00, 0x00, 0x35, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vaddi.hu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x8a, 0x72, 0x00, 0x00, 0x00, 0x00, 0x36, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vaddi.wu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x8b, 0x72, 0x00, 0x00, 0x00, 0x00, 0x37, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vaddi.du VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x8b, 0x72, 0x00, 0x00, 0x00, 0x00, 0x38, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vsubi.bu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x8c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x39, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vsubi.hu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x8c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x3a, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vsubi.wu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x8d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x3b, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vsubi.du VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x8d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x3c, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vbsll.v VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x8e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x3d, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vbsrl.v VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x8e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vmaxi.b VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x90, 0x72, 0x00, 0x00, 0x00, 0x00, 0x3f, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vmaxi.h VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x90, 0x72, 0x00, 0x00, 0x00, 0x00, 0x40, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vmaxi.w VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x91, 0x72, 0x00, 0x00, 0x00, 0x00, 0x41, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vmaxi.d VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x91, 0x72, 0x00, 0x00, 0x00, 0x00, 0x42, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vmini.b VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x92, 0x72, 0x00, 0x00, 0x00, 0x00, 0x43, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vmini.h VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x92, 0x72, 0x00, 0x00, 0x00, 0x00, 0x44, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vmini.w VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x93, 0x72, 0x00, 0x00, 0x00, 0x00, 0x45, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vmini.d VdVjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x93, 0x72, 0x00, 0x00, 0x00, 0x00, 0x46, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// vmaxi.bu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x94, 0x72, 0x00, 0x00, 0x00, 0x00, 0x47, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vmaxi.hu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x94, 0x72, 0x00, 0x00, 0x00, 0x00, 0x48, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vmaxi.wu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x95, 0x72, 0x00, 0x00, 0x00, 0x00, 0x49, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vmaxi.du VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x95, 0x72, 0x00, 0x00, 0x00, 0x00, 0x4a, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vmini.bu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x96, 0x72, 0x00, 0x00, 0x00, 0x00, 0x4b, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vmini.hu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x96, 0x72, 0x00, 0x00, 0x00, 0x00, 0x4c, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vmini.wu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x97, 0x72, 0x00, 0x00, 0x00, 0x00, 0x4d, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vmini.du VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x97, 0x72, 0x00, 0x00, 0x00, 0x00, 0x4e, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vfrstpi.b VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x9a, 0x72, 0x00, 0x00, 0x00, 0x00, 0x4f, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vfrstpi.h VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x9a, 0x72, 0x00, 0x00, 0x00, 0x00, 0x50, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vclo.b VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x00, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x51, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vclo.h VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x04, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x52, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vclo.w VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x08, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x53, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vclo.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x0c, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x54, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vclz.b VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x10, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x55, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vclz.h VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x14, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x56, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vclz.w VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x18, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x57, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vclz.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x1c, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x58, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vpcnt.b VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x20, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x59, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vpcnt.h VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x24, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x5a, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vpcnt.w VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x28, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x5b, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vpcnt.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x2c, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x5c, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vneg.b VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x30, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x5d, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vneg.h VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x34, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x5e, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vneg.w VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x38, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x5f, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vneg.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x3c, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x60, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vmskltz.b VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x40, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x61, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vmskltz.h VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x44, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x62, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vmskltz.w VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x48, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x63, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vmskltz.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x4c, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x64, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vmskgez.b VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x50, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x65, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vmsknz.b VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x60, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x66, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vseteqz.v CdVj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0x98, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x67, 0x04, 0x02, 0x03, 0x00, 0x03, 0x05, 0x05, 0x05,
+	// vsetnez.v CdVj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0x9c, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x68, 0x04, 0x02, 0x03, 0x00, 0x03, 0x05, 0x05, 0x05,
+	// vsetanyeqz.b CdVj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xa0, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x69, 0x04, 0x02, 0x03, 0x00, 0x03, 0x05, 0x05, 0x05,
+	// vsetanyeqz.h CdVj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xa4, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x6a, 0x04, 0x02, 0x03, 0x00, 0x03, 0x05, 0x05, 0x05,
+	// vsetanyeqz.w CdVj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xa8, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x6b, 0x04, 0x02, 0x03, 0x00, 0x03, 0x05, 0x05, 0x05,
+	// vsetanyeqz.d CdVj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xac, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x6c, 0x04, 0x02, 0x03, 0x00, 0x03, 0x05, 0x05, 0x05,
+	// vsetallnez.b CdVj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xb0, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x6d, 0x04, 0x02, 0x03, 0x00, 0x03, 0x05, 0x05, 0x05,
+	// vsetallnez.h CdVj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xb4, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x6e, 0x04, 0x02, 0x03, 0x00, 0x03, 0x05, 0x05, 0x05,
+	// vsetallnez.w CdVj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xb8, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x6f, 0x04, 0x02, 0x03, 0x00, 0x03, 0x05, 0x05, 0x05,
+	// vsetallnez.d CdVj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xbc, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x70, 0x04, 0x02, 0x03, 0x00, 0x03, 0x05, 0x05, 0x05,
+	// vflogb.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xc4, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x71, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vflogb.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xc8, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x72, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfclass.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xd4, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x73, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfclass.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xd8, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x74, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfsqrt.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe4, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x75, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfsqrt.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe8, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x76, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfrecip.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xf4, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x77, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfrecip.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xf8, 0x9c, 0x72, 0x00, 0x00, 0x00, 0x00, 0x78, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfrsqrt.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x04, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x79, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfrsqrt.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x08, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x7a, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfrint.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x34, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x7b, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfrint.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x38, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x7c, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfrintrm.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x44, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x7d, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfrintrm.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x48, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x7e, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfrintrp.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x54, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x7f, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfrintrp.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x58, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x80, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfrintrz.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x64, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x81, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfrintrz.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x68, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x82, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfrintrne.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x74, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x83, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfrintrne.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x78, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x84, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfcvtl.s.h VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe8, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x85, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfcvth.s.h VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xec, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x86, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfcvtl.d.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xf0, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x87, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vfcvth.d.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xf4, 0x9d, 0x72, 0x00, 0x00, 0x00, 0x00, 0x88, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vffint.s.w VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x00, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x89, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vffint.s.wu VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x04, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x8a, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vffint.d.l VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x08, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x8b, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vffint.d.lu VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x0c, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x8c, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vffintl.d.w VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x10, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x8d, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vffinth.d.w VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x14, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x8e, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftint.w.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x30, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x8f, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftint.l.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x34, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x90, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrm.w.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x38, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x91, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrm.l.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x3c, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x92, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrp.w.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x40, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x93, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrp.l.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x44, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x94, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrz.w.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x48, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x95, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrz.l.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x4c, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x96, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrne.w.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x50, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x97, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrne.l.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x54, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x98, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftint.wu.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x58, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x99, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftint.lu.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x5c, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x9a, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrz.wu.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x70, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x9b, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrz.lu.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x74, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x9c, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintl.l.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x80, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x9d, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftinth.l.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x84, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x9e, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrml.l.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x88, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0x9f, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrmh.l.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x8c, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0xa0, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrpl.l.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x90, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0xa1, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrph.l.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x94, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0xa2, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrzl.l.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x98, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0xa3, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrzh.l.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x9c, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrnel.l.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xa0, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0xa5, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vftintrneh.l.s VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xa4, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0xa6, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vexth.h.b VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe0, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0xa7, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vexth.w.h VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe4, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0xa8, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vexth.d.w VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe8, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0xa9, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vexth.q.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xec, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0xaa, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vexth.hu.bu VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xf0, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0xab, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vexth.wu.hu VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xf4, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0xac, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vexth.du.wu VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xf8, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0xad, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vexth.qu.du VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xfc, 0x9e, 0x72, 0x00, 0x00, 0x00, 0x00, 0xae, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vreplgr2vr.b VdJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x00, 0x9f, 0x72, 0x00, 0x00, 0x00, 0x00, 0xaf, 0x04, 0x02, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// vreplgr2vr.h VdJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x04, 0x9f, 0x72, 0x00, 0x00, 0x00, 0x00, 0xb0, 0x04, 0x02, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// vreplgr2vr.w VdJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x08, 0x9f, 0x72, 0x00, 0x00, 0x00, 0x00, 0xb1, 0x04, 0x02, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// vreplgr2vr.d VdJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x0c, 0x9f, 0x72, 0x00, 0x00, 0x00, 0x00, 0xb2, 0x04, 0x02, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// vrotri.b VdVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0xa0, 0x72, 0x00, 0x00, 0x00, 0x00, 0xb3, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vrotri.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0xa0, 0x72, 0x00, 0x00, 0x00, 0x00, 0xb4, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vrotri.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa0, 0x72, 0x00, 0x00, 0x00, 0x00, 0xb5, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vrotri.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xa1, 0x72, 0x00, 0x00, 0x00, 0x00, 0xb6, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vsrlri.b VdVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0xa4, 0x72, 0x00, 0x00, 0x00, 0x00, 0xb7, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vsrlri.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0xa4, 0x72, 0x00, 0x00, 0x00, 0x00, 0xb8, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vsrlri.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa4, 0x72, 0x00, 0x00, 0x00, 0x00, 0xb9, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vsrlri.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xa5, 0x72, 0x00, 0x00, 0x00, 0x00, 0xba, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vsrari.b VdVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0xa8, 0x72, 0x00, 0x00, 0x00, 0x00, 0xbb, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vsrari.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0xa8, 0x72, 0x00, 0x00, 0x00, 0x00, 0xbc, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vsrari.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa8, 0x72, 0x00, 0x00, 0x00, 0x00, 0xbd, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vsrari.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xa9, 0x72, 0x00, 0x00, 0x00, 0x00, 0xbe, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vinsgr2vr.b VdJUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x80, 0xeb, 0x72, 0x00, 0x00, 0x00, 0x00, 0xbf, 0x04, 0x03, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vinsgr2vr.h VdJUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0xc0, 0xeb, 0x72, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x04, 0x03, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vinsgr2vr.w VdJUk2
+	0x00, 0xf0, 0xff, 0xff, 0x00, 0xe0, 0xeb, 0x72, 0x00, 0x00, 0x00, 0x00, 0xc1, 0x04, 0x03, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x02,
+	// vinsgr2vr.d VdJUk1
+	0x00, 0xf8, 0xff, 0xff, 0x00, 0xf0, 0xeb, 0x72, 0x00, 0x00, 0x00, 0x00, 0xc2, 0x04, 0x03, 0x05, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x01,
+	// vpickve2gr.b DVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x80, 0xef, 0x72, 0x00, 0x00, 0x00, 0x00, 0xc3, 0x04, 0x03, 0x01, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vpickve2gr.h DVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0xc0, 0xef, 0x72, 0x00, 0x00, 0x00, 0x00, 0xc4, 0x04, 0x03, 0x01, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vpickve2gr.w DVjUk2
+	0x00, 0xf0, 0xff, 0xff, 0x00, 0xe0, 0xef, 0x72, 0x00, 0x00, 0x00, 0x00, 0xc5, 0x04, 0x03, 0x01, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x02,
+	// vpickve2gr.d DVjUk1
+	0x00, 0xf8, 0xff, 0xff, 0x00, 0xf0, 0xef, 0x72, 0x00, 0x00, 0x00, 0x00, 0xc6, 0x04, 0x03, 0x01, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x01,
+	// vpickve2gr.bu DVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x80, 0xf3, 0x72, 0x00, 0x00, 0x00, 0x00, 0xc7, 0x04, 0x03, 0x01, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vpickve2gr.hu DVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0xc0, 0xf3, 0x72, 0x00, 0x00, 0x00, 0x00, 0xc8, 0x04, 0x03, 0x01, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vpickve2gr.wu DVjUk2
+	0x00, 0xf0, 0xff, 0xff, 0x00, 0xe0, 0xf3, 0x72, 0x00, 0x00, 0x00, 0x00, 0xc9, 0x04, 0x03, 0x01, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x02,
+	// vpickve2gr.du DVjUk1
+	0x00, 0xf8, 0xff, 0xff, 0x00, 0xf0, 0xf3, 0x72, 0x00, 0x00, 0x00, 0x00, 0xca, 0x04, 0x03, 0x01, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x01,
+	// vreplvei.b VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x80, 0xf7, 0x72, 0x00, 0x00, 0x00, 0x00, 0xcb, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vreplvei.h VdVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0xc0, 0xf7, 0x72, 0x00, 0x00, 0x00, 0x00, 0xcc, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vreplvei.w VdVjUk2
+	0x00, 0xf0, 0xff, 0xff, 0x00, 0xe0, 0xf7, 0x72, 0x00, 0x00, 0x00, 0x00, 0xcd, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x02,
+	// vreplvei.d VdVjUk1
+	0x00, 0xf8, 0xff, 0xff, 0x00, 0xf0, 0xf7, 0x72, 0x00, 0x00, 0x00, 0x00, 0xce, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x01,
+	// vsllwil.h.b VdVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x08, 0x73, 0x00, 0x00, 0x00, 0x00, 0xcf, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vsllwil.w.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x08, 0x73, 0x00, 0x00, 0x00, 0x00, 0xd0, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vsllwil.d.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x08, 0x73, 0x00, 0x00, 0x00, 0x00, 0xd1, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vextl.q.d VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x00, 0x09, 0x73, 0x00, 0x00, 0x00, 0x00, 0xd2, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vsllwil.hu.bu VdVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x0c, 0x73, 0x00, 0x00, 0x00, 0x00, 0xd3, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vsllwil.wu.hu VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x0c, 0x73, 0x00, 0x00, 0x00, 0x00, 0xd4, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vsllwil.du.wu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0c, 0x73, 0x00, 0x00, 0x00, 0x00, 0xd5, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vextl.qu.du VdVj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x00, 0x0d, 0x73, 0x00, 0x00, 0x00, 0x00, 0xd6, 0x04, 0x02, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05,
+	// vbitclri.b VdVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x10, 0x73, 0x00, 0x00, 0x00, 0x00, 0xd7, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vbitclri.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x10, 0x73, 0x00, 0x00, 0x00, 0x00, 0xd8, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vbitclri.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x10, 0x73, 0x00, 0x00, 0x00, 0x00, 0xd9, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vbitclri.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x11, 0x73, 0x00, 0x00, 0x00, 0x00, 0xda, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vbitseti.b VdVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x14, 0x73, 0x00, 0x00, 0x00, 0x00, 0xdb, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vbitseti.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x14, 0x73, 0x00, 0x00, 0x00, 0x00, 0xdc, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vbitseti.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x14, 0x73, 0x00, 0x00, 0x00, 0x00, 0xdd, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vbitseti.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x15, 0x73, 0x00, 0x00, 0x00, 0x00, 0xde, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vbitrevi.b VdVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x18, 0x73, 0x00, 0x00, 0x00, 0x00, 0xdf, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vbitrevi.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x18, 0x73, 0x00, 0x00, 0x00, 0x00, 0xe0, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vbitrevi.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x18, 0x73, 0x00, 0x00, 0x00, 0x00, 0xe1, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vbitrevi.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x19, 0x73, 0x00, 0x00, 0x00, 0x00, 0xe2, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vsat.b VdVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x24, 0x73, 0x00, 0x00, 0x00, 0x00, 0xe3, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vsat.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x24, 0x73, 0x00, 0x00, 0x00, 0x00, 0xe4, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vsat.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x24, 0x73, 0x00, 0x00, 0x00, 0x00, 0xe5, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vsat.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x25, 0x73, 0x00, 0x00, 0x00, 0x00, 0xe6, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vsat.bu VdVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x28, 0x73, 0x00, 0x00, 0x00, 0x00, 0xe7, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vsat.hu VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x28, 0x73, 0x00, 0x00, 0x00, 0x00, 0xe8, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vsat.wu VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x28, 0x73, 0x00, 0x00, 0x00, 0x00, 0xe9, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vsat.du VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x29, 0x73, 0x00, 0x00, 0x00, 0x00, 0xea, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vslli.b VdVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x2c, 0x73, 0x00, 0x00, 0x00, 0x00, 0xeb, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vslli.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x2c, 0x73, 0x00, 0x00, 0x00, 0x00, 0xec, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vslli.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2c, 0x73, 0x00, 0x00, 0x00, 0x00, 0xed, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vslli.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x2d, 0x73, 0x00, 0x00, 0x00, 0x00, 0xee, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vsrli.b VdVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x30, 0x73, 0x00, 0x00, 0x00, 0x00, 0xef, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vsrli.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x30, 0x73, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vsrli.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x30, 0x73, 0x00, 0x00, 0x00, 0x00, 0xf1, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vsrli.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x31, 0x73, 0x00, 0x00, 0x00, 0x00, 0xf2, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vsrai.b VdVjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x34, 0x73, 0x00, 0x00, 0x00, 0x00, 0xf3, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// vsrai.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x34, 0x73, 0x00, 0x00, 0x00, 0x00, 0xf4, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vsrai.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x34, 0x73, 0x00, 0x00, 0x00, 0x00, 0xf5, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vsrai.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x35, 0x73, 0x00, 0x00, 0x00, 0x00, 0xf6, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vsrlni.b.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x40, 0x73, 0x00, 0x00, 0x00, 0x00, 0xf7, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vsrlni.h.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x40, 0x73, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vsrlni.w.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x41, 0x73, 0x00, 0x00, 0x00, 0x00, 0xf9, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vsrlni.d.q VdVjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x42, 0x73, 0x00, 0x00, 0x00, 0x00, 0xfa, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// vsrlrni.b.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x44, 0x73, 0x00, 0x00, 0x00, 0x00, 0xfb, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vsrlrni.h.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x44, 0x73, 0x00, 0x00, 0x00, 0x00, 0xfc, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vsrlrni.w.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x45, 0x73, 0x00, 0x00, 0x00, 0x00, 0xfd, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vsrlrni.d.q VdVjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x46, 0x73, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// vssrlni.b.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x48, 0x73, 0x00, 0x00, 0x00, 0x00, 0xff, 0x04, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vssrlni.h.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x48, 0x73, 0x00, 0x00, 0x00, 0x00, 0x00, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vssrlni.w.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x49, 0x73, 0x00, 0x00, 0x00, 0x00, 0x01, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vssrlni.d.q VdVjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x4a, 0x73, 0x00, 0x00, 0x00, 0x00, 0x02, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// vssrlni.bu.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x4c, 0x73, 0x00, 0x00, 0x00, 0x00, 0x03, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vssrlni.hu.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x4c, 0x73, 0x00, 0x00, 0x00, 0x00, 0x04, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vssrlni.wu.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x4d, 0x73, 0x00, 0x00, 0x00, 0x00, 0x05, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vssrlni.du.q VdVjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x4e, 0x73, 0x00, 0x00, 0x00, 0x00, 0x06, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// vssrlrni.b.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x50, 0x73, 0x00, 0x00, 0x00, 0x00, 0x07, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vssrlrni.h.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x50, 0x73, 0x00, 0x00, 0x00, 0x00, 0x08, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vssrlrni.w.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x51, 0x73, 0x00, 0x00, 0x00, 0x00, 0x09, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vssrlrni.d.q VdVjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x52, 0x73, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// vssrlrni.bu.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x54, 0x73, 0x00, 0x00, 0x00, 0x00, 0x0b, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vssrlrni.hu.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x54, 0x73, 0x00, 0x00, 0x00, 0x00, 0x0c, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vssrlrni.wu.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x55, 0x73, 0x00, 0x00, 0x00, 0x00, 0x0d, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vssrlrni.du.q VdVjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x56, 0x73, 0x00, 0x00, 0x00, 0x00, 0x0e, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// vsrani.b.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x58, 0x73, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vsrani.h.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x58, 0x73, 0x00, 0x00, 0x00, 0x00, 0x10, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vsrani.w.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x59, 0x73, 0x00, 0x00, 0x00, 0x00, 0x11, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vsrani.d.q VdVjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x5a, 0x73, 0x00, 0x00, 0x00, 0x00, 0x12, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// vsrarni.b.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x5c, 0x73, 0x00, 0x00, 0x00, 0x00, 0x13, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vsrarni.h.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x5c, 0x73, 0x00, 0x00, 0x00, 0x00, 0x14, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vsrarni.w.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x5d, 0x73, 0x00, 0x00, 0x00, 0x00, 0x15, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vsrarni.d.q VdVjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x5e, 0x73, 0x00, 0x00, 0x00, 0x00, 0x16, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// vssrani.b.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x60, 0x73, 0x00, 0x00, 0x00, 0x00, 0x17, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vssrani.h.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x60, 0x73, 0x00, 0x00, 0x00, 0x00, 0x18, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vssrani.w.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x61, 0x73, 0x00, 0x00, 0x00, 0x00, 0x19, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vssrani.d.q VdVjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x62, 0x73, 0x00, 0x00, 0x00, 0x00, 0x1a, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// vssrani.bu.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x64, 0x73, 0x00, 0x00, 0x00, 0x00, 0x1b, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vssrani.hu.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x64, 0x73, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vssrani.wu.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x65, 0x73, 0x00, 0x00, 0x00, 0x00, 0x1d, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vssrani.du.q VdVjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x66, 0x73, 0x00, 0x00, 0x00, 0x00, 0x1e, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// vssrarni.b.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x68, 0x73, 0x00, 0x00, 0x00, 0x00, 0x1f, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vssrarni.h.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x68, 0x73, 0x00, 0x00, 0x00, 0x00, 0x20, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vssrarni.w.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x69, 0x73, 0x00, 0x00, 0x00, 0x00, 0x21, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vssrarni.d.q VdVjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x6a, 0x73, 0x00, 0x00, 0x00, 0x00, 0x22, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// vssrarni.bu.h VdVjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x6c, 0x73, 0x00, 0x00, 0x00, 0x00, 0x23, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// vssrarni.hu.w VdVjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x6c, 0x73, 0x00, 0x00, 0x00, 0x00, 0x24, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// vssrarni.wu.d VdVjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x6d, 0x73, 0x00, 0x00, 0x00, 0x00, 0x25, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// vssrarni.du.q VdVjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x6e, 0x73, 0x00, 0x00, 0x00, 0x00, 0x26, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// vextrins.d VdVjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x80, 0x73, 0x00, 0x00, 0x00, 0x00, 0x27, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// vextrins.w VdVjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x84, 0x73, 0x00, 0x00, 0x00, 0x00, 0x28, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// vextrins.h VdVjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x88, 0x73, 0x00, 0x00, 0x00, 0x00, 0x29, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// vextrins.b VdVjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x8c, 0x73, 0x00, 0x00, 0x00, 0x00, 0x2a, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// vshuf4i.b VdVjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x90, 0x73, 0x00, 0x00, 0x00, 0x00, 0x2b, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// vshuf4i.h VdVjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x94, 0x73, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// vshuf4i.w VdVjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x98, 0x73, 0x00, 0x00, 0x00, 0x00, 0x2d, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// vshuf4i.d VdVjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x9c, 0x73, 0x00, 0x00, 0x00, 0x00, 0x2e, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// vbitseli.b VdVjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xc4, 0x73, 0x00, 0x00, 0x00, 0x00, 0x2f, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// vandi.b VdVjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xd0, 0x73, 0x00, 0x00, 0x00, 0x00, 0x30, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// vori.b VdVjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xd4, 0x73, 0x00, 0x00, 0x00, 0x00, 0x31, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// vxori.b VdVjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xd8, 0x73, 0x00, 0x00, 0x00, 0x00, 0x32, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// vnori.b VdVjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xdc, 0x73, 0x00, 0x00, 0x00, 0x00, 0x33, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// vldi VdSj13
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xe0, 0x73, 0x00, 0x00, 0x00, 0x00, 0x34, 0x05, 0x02, 0x05, 0x00, 0x05, 0x07, 0x05, 0x0d,
+	// vpermi.w VdVjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xe4, 0x73, 0x00, 0x00, 0x00, 0x00, 0x35, 0x05, 0x03, 0x05, 0x00, 0x05, 0x05, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvseq.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x00, 0x74, 0x00, 0x00, 0x00, 0x00, 0x36, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvseq.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x00, 0x74, 0x00, 0x00, 0x00, 0x00, 0x37, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvseq.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x01, 0x74, 0x00, 0x00, 0x00, 0x00, 0x38, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvseq.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x01, 0x74, 0x00, 0x00, 0x00, 0x00, 0x39, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsle.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x02, 0x74, 0x00, 0x00, 0x00, 0x00, 0x3a, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsle.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x02, 0x74, 0x00, 0x00, 0x00, 0x00, 0x3b, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsle.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x03, 0x74, 0x00, 0x00, 0x00, 0x00, 0x3c, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsle.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x03, 0x74, 0x00, 0x00, 0x00, 0x00, 0x3d, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsle.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x04, 0x74, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsle.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x04, 0x74, 0x00, 0x00, 0x00, 0x00, 0x3f, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsle.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x05, 0x74, 0x00, 0x00, 0x00, 0x00, 0x40, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsle.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x05, 0x74, 0x00, 0x00, 0x00, 0x00, 0x41, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvslt.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x06, 0x74, 0x00, 0x00, 0x00, 0x00, 0x42, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvslt.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x06, 0x74, 0x00, 0x00, 0x00, 0x00, 0x43, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvslt.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x07, 0x74, 0x00, 0x00, 0x00, 0x00, 0x44, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvslt.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x07, 0x74, 0x00, 0x00, 0x00, 0x00, 0x45, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvslt.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x08, 0x74, 0x00, 0x00, 0x00, 0x00, 0x46, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvslt.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x08, 0x74, 0x00, 0x00, 0x00, 0x00, 0x47, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvslt.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x09, 0x74, 0x00, 0x00, 0x00, 0x00, 0x48, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvslt.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x09, 0x74, 0x00, 0x00, 0x00, 0x00, 0x49, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvadd.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0a, 0x74, 0x00, 0x00, 0x00, 0x00, 0x4a, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvadd.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0a, 0x74, 0x00, 0x00, 0x00, 0x00, 0x4b, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvadd.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0b, 0x74, 0x00, 0x00, 0x00, 0x00, 0x4c, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvadd.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0b, 0x74, 0x00, 0x00, 0x00, 0x00, 0x4d, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsub.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0c, 0x74, 0x00, 0x00, 0x00, 0x00, 0x4e, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsub.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0c, 0x74, 0x00, 0x00, 0x00, 0x00, 0x4f, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsub.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0d, 0x74, 0x00, 0x00, 0x00, 0x00, 0x50, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsub.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0d, 0x74, 0x00, 0x00, 0x00, 0x00, 0x51, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwev.h.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1e, 0x74, 0x00, 0x00, 0x00, 0x00, 0x52, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwev.w.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1e, 0x74, 0x00, 0x00, 0x00, 0x00, 0x53, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwev.d.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1f, 0x74, 0x00, 0x00, 0x00, 0x00, 0x54, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwev.q.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1f, 0x74, 0x00, 0x00, 0x00, 0x00, 0x55, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwev.h.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x20, 0x74, 0x00, 0x00, 0x00, 0x00, 0x56, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwev.w.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x20, 0x74, 0x00, 0x00, 0x00, 0x00, 0x57, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwev.d.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x21, 0x74, 0x00, 0x00, 0x00, 0x00, 0x58, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwev.q.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x21, 0x74, 0x00, 0x00, 0x00, 0x00, 0x59, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwod.h.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x22, 0x74, 0x00, 0x00, 0x00, 0x00, 0x5a, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwod.w.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x22, 0x74, 0x00, 0x00, 0x00, 0x00, 0x5b, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwod.d.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x23, 0x74, 0x00, 0x00, 0x00, 0x00, 0x5c, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwod.q.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x23, 0x74, 0x00, 0x00, 0x00, 0x00, 0x5d, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwod.h.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x24, 0x74, 0x00, 0x00, 0x00, 0x00, 0x5e, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwod.w.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x24, 0x74, 0x00, 0x00, 0x00, 0x00, 0x5f, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwod.d.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x25, 0x74, 0x00, 0x00, 0x00, 0x00, 0x60, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwod.q.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x25, 0x74, 0x00, 0x00, 0x00, 0x00, 0x61, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwev.h.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2e, 0x74, 0x00, 0x00, 0x00, 0x00, 0x62, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwev.w.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2e, 0x74, 0x00, 0x00, 0x00, 0x00, 0x63, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwev.d.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2f, 0x74, 0x00, 0x00, 0x00, 0x00, 0x64, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwev.q.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2f, 0x74, 0x00, 0x00, 0x00, 0x00, 0x65, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwev.h.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x30, 0x74, 0x00, 0x00, 0x00, 0x00, 0x66, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwev.w.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x30, 0x74, 0x00, 0x00, 0x00, 0x00, 0x67, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwev.d.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x31, 0x74, 0x00, 0x00, 0x00, 0x00, 0x68, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwev.q.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x31, 0x74, 0x00, 0x00, 0x00, 0x00, 0x69, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwod.h.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x32, 0x74, 0x00, 0x00, 0x00, 0x00, 0x6a, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwod.w.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x32, 0x74, 0x00, 0x00, 0x00, 0x00, 0x6b, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwod.d.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x33, 0x74, 0x00, 0x00, 0x00, 0x00, 0x6c, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwod.q.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x33, 0x74, 0x00, 0x00, 0x00, 0x00, 0x6d, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwod.h.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x34, 0x74, 0x00, 0x00, 0x00, 0x00, 0x6e, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwod.w.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x34, 0x74, 0x00, 0x00, 0x00, 0x00, 0x6f, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwod.d.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x35, 0x74, 0x00, 0x00, 0x00, 0x00, 0x70, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsubwod.q.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x35, 0x74, 0x00, 0x00, 0x00, 0x00, 0x71, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwev.h.bu.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x3e, 0x74, 0x00, 0x00, 0x00, 0x00, 0x72, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwev.w.hu.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x3e, 0x74, 0x00, 0x00, 0x00, 0x00, 0x73, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwev.d.wu.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x3f, 0x74, 0x00, 0x00, 0x00, 0x00, 0x74, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwev.q.du.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x3f, 0x74, 0x00, 0x00, 0x00, 0x00, 0x75, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwod.h.bu.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x40, 0x74, 0x00, 0x00, 0x00, 0x00, 0x76, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwod.w.hu.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x40, 0x74, 0x00, 0x00, 0x00, 0x00, 0x77, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwod.d.wu.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x41, 0x74, 0x00, 0x00, 0x00, 0x00, 0x78, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvaddwod.q.du.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x41, 0x74, 0x00, 0x00, 0x00, 0x00, 0x79, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsadd.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x46, 0x74, 0x00, 0x00, 0x00, 0x00, 0x7a, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsadd.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x46, 0x74, 0x00, 0x00, 0x00, 0x00, 0x7b, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsadd.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x47, 0x74, 0x00, 0x00, 0x00, 0x00, 0x7c, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsadd.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x47, 0x74, 0x00, 0x00, 0x00, 0x00, 0x7d, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssub.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x48, 0x74, 0x00, 0x00, 0x00, 0x00, 0x7e, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssub.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x48, 0x74, 0x00, 0x00, 0x00, 0x00, 0x7f, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssub.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x49, 0x74, 0x00, 0x00, 0x00, 0x00, 0x80, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssub.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x49, 0x74, 0x00, 0x00, 0x00, 0x00, 0x81, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsadd.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x4a, 0x74, 0x00, 0x00, 0x00, 0x00, 0x82, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsadd.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x4a, 0x74, 0x00, 0x00, 0x00, 0x00, 0x83, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsadd.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x4b, 0x74, 0x00, 0x00, 0x00, 0x00, 0x84, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsadd.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x4b, 0x74, 0x00, 0x00, 0x00, 0x00, 0x85, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssub.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x4c, 0x74, 0x00, 0x00, 0x00, 0x00, 0x86, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssub.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x4c, 0x74, 0x00, 0x00, 0x00, 0x00, 0x87, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssub.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x4d, 0x74, 0x00, 0x00, 0x00, 0x00, 0x88, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssub.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x4d, 0x74, 0x00, 0x00, 0x00, 0x00, 0x89, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhaddw.h.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x54, 0x74, 0x00, 0x00, 0x00, 0x00, 0x8a, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhaddw.w.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x54, 0x74, 0x00, 0x00, 0x00, 0x00, 0x8b, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhaddw.d.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x55, 0x74, 0x00, 0x00, 0x00, 0x00, 0x8c, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhaddw.q.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x55, 0x74, 0x00, 0x00, 0x00, 0x00, 0x8d, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhsubw.h.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x56, 0x74, 0x00, 0x00, 0x00, 0x00, 0x8e, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhsubw.w.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x56, 0x74, 0x00, 0x00, 0x00, 0x00, 0x8f, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhsubw.d.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x57, 0x74, 0x00, 0x00, 0x00, 0x00, 0x90, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhsubw.q.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x57, 0x74, 0x00, 0x00, 0x00, 0x00, 0x91, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhaddw.hu.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x58, 0x74, 0x00, 0x00, 0x00, 0x00, 0x92, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhaddw.wu.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x58, 0x74, 0x00, 0x00, 0x00, 0x00, 0x93, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhaddw.du.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x59, 0x74, 0x00, 0x00, 0x00, 0x00, 0x94, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhaddw.qu.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x59, 0x74, 0x00, 0x00, 0x00, 0x00, 0x95, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhsubw.hu.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x5a, 0x74, 0x00, 0x00, 0x00, 0x00, 0x96, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhsubw.wu.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x5a, 0x74, 0x00, 0x00, 0x00, 0x00, 0x97, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhsubw.du.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x5b, 0x74, 0x00, 0x00, 0x00, 0x00, 0x98, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvhsubw.qu.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x5b, 0x74, 0x00, 0x00, 0x00, 0x00, 0x99, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvadda.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x5c, 0x74, 0x00, 0x00, 0x00, 0x00, 0x9a, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvadda.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x5c, 0x74, 0x00, 0x00, 0x00, 0x00, 0x9b, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvadda.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x5d, 0x74, 0x00, 0x00, 0x00, 0x00, 0x9c, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvadda.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x5d, 0x74, 0x00, 0x00, 0x00, 0x00, 0x9d, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvabsd.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x60, 0x74, 0x00, 0x00, 0x00, 0x00, 0x9e, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvabsd.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x60, 0x74, 0x00, 0x00, 0x00, 0x00, 0x9f, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvabsd.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x61, 0x74, 0x00, 0x00, 0x00, 0x00, 0xa0, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvabsd.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x61, 0x74, 0x00, 0x00, 0x00, 0x00, 0xa1, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvabsd.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x62, 0x74, 0x00, 0x00, 0x00, 0x00, 0xa2, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvabsd.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x62, 0x74, 0x00, 0x00, 0x00, 0x00, 0xa3, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvabsd.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x63, 0x74, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvabsd.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x63, 0x74, 0x00, 0x00, 0x00, 0x00, 0xa5, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavg.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x64, 0x74, 0x00, 0x00, 0x00, 0x00, 0xa6, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavg.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x64, 0x74, 0x00, 0x00, 0x00, 0x00, 0xa7, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavg.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x65, 0x74, 0x00, 0x00, 0x00, 0x00, 0xa8, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavg.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x65, 0x74, 0x00, 0x00, 0x00, 0x00, 0xa9, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavg.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x66, 0x74, 0x00, 0x00, 0x00, 0x00, 0xaa, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavg.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x66, 0x74, 0x00, 0x00, 0x00, 0x00, 0xab, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavg.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x67, 0x74, 0x00, 0x00, 0x00, 0x00, 0xac, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavg.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x67, 0x74, 0x00, 0x00, 0x00, 0x00, 0xad, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavgr.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x68, 0x74, 0x00, 0x00, 0x00, 0x00, 0xae, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavgr.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x68, 0x74, 0x00, 0x00, 0x00, 0x00, 0xaf, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavgr.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x69, 0x74, 0x00, 0x00, 0x00, 0x00, 0xb0, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavgr.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x69, 0x74, 0x00, 0x00, 0x00, 0x00, 0xb1, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavgr.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x6a, 0x74, 0x00, 0x00, 0x00, 0x00, 0xb2, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavgr.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x6a, 0x74, 0x00, 0x00, 0x00, 0x00, 0xb3, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavgr.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x6b, 0x74, 0x00, 0x00, 0x00, 0x00, 0xb4, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvavgr.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x6b, 0x74, 0x00, 0x00, 0x00, 0x00, 0xb5, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmax.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x70, 0x74, 0x00, 0x00, 0x00, 0x00, 0xb6, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmax.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x70, 0x74, 0x00, 0x00, 0x00, 0x00, 0xb7, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmax.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x71, 0x74, 0x00, 0x00, 0x00, 0x00, 0xb8, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmax.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x71, 0x74, 0x00, 0x00, 0x00, 0x00, 0xb9, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmin.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x72, 0x74, 0x00, 0x00, 0x00, 0x00, 0xba, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmin.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x72, 0x74, 0x00, 0x00, 0x00, 0x00, 0xbb, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmin.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x73, 0x74, 0x00, 0x00, 0x00, 0x00, 0xbc, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmin.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x73, 0x74, 0x00, 0x00, 0x00, 0x00, 0xbd, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmax.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x74, 0x74, 0x00, 0x00, 0x00, 0x00, 0xbe, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmax.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x74, 0x74, 0x00, 0x00, 0x00, 0x00, 0xbf, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmax.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x75, 0x74, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmax.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x75, 0x74, 0x00, 0x00, 0x00, 0x00, 0xc1, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmin.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x76, 0x74, 0x00, 0x00, 0x00, 0x00, 0xc2, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmin.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x76, 0x74, 0x00, 0x00, 0x00, 0x00, 0xc3, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmin.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x77, 0x74, 0x00, 0x00, 0x00, 0x00, 0xc4, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmin.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x77, 0x74, 0x00, 0x00, 0x00, 0x00, 0xc5, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmul.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x84, 0x74, 0x00, 0x00, 0x00, 0x00, 0xc6, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmul.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x84, 0x74, 0x00, 0x00, 0x00, 0x00, 0xc7, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmul.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x85, 0x74, 0x00, 0x00, 0x00, 0x00, 0xc8, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmul.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x85, 0x74, 0x00, 0x00, 0x00, 0x00, 0xc9, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmuh.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x86, 0x74, 0x00, 0x00, 0x00, 0x00, 0xca, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmuh.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x86, 0x74, 0x00, 0x00, 0x00, 0x00, 0xcb, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmuh.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x87, 0x74, 0x00, 0x00, 0x00, 0x00, 0xcc, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmuh.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x87, 0x74, 0x00, 0x00, 0x00, 0x00, 0xcd, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmuh.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x88, 0x74, 0x00, 0x00, 0x00, 0x00, 0xce, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmuh.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x88, 0x74, 0x00, 0x00, 0x00, 0x00, 0xcf, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmuh.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x89, 0x74, 0x00, 0x00, 0x00, 0x00, 0xd0, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmuh.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x89, 0x74, 0x00, 0x00, 0x00, 0x00, 0xd1, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwev.h.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x90, 0x74, 0x00, 0x00, 0x00, 0x00, 0xd2, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwev.w.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x90, 0x74, 0x00, 0x00, 0x00, 0x00, 0xd3, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwev.d.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x91, 0x74, 0x00, 0x00, 0x00, 0x00, 0xd4, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwev.q.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x91, 0x74, 0x00, 0x00, 0x00, 0x00, 0xd5, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwod.h.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x92, 0x74, 0x00, 0x00, 0x00, 0x00, 0xd6, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwod.w.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x92, 0x74, 0x00, 0x00, 0x00, 0x00, 0xd7, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwod.d.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x93, 0x74, 0x00, 0x00, 0x00, 0x00, 0xd8, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwod.q.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x93, 0x74, 0x00, 0x00, 0x00, 0x00, 0xd9, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwev.h.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x98, 0x74, 0x00, 0x00, 0x00, 0x00, 0xda, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwev.w.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x98, 0x74, 0x00, 0x00, 0x00, 0x00, 0xdb, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwev.d.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x99, 0x74, 0x00, 0x00, 0x00, 0x00, 0xdc, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwev.q.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x99, 0x74, 0x00, 0x00, 0x00, 0x00, 0xdd, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwod.h.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x9a, 0x74, 0x00, 0x00, 0x00, 0x00, 0xde, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwod.w.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x9a, 0x74, 0x00, 0x00, 0x00, 0x00, 0xdf, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwod.d.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x9b, 0x74, 0x00, 0x00, 0x00, 0x00, 0xe0, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwod.q.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x9b, 0x74, 0x00, 0x00, 0x00, 0x00, 0xe1, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwev.h.bu.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa0, 0x74, 0x00, 0x00, 0x00, 0x00, 0xe2, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwev.w.hu.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa0, 0x74, 0x00, 0x00, 0x00, 0x00, 0xe3, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwev.d.wu.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa1, 0x74, 0x00, 0x00, 0x00, 0x00, 0xe4, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwev.q.du.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa1, 0x74, 0x00, 0x00, 0x00, 0x00, 0xe5, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwod.h.bu.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa2, 0x74, 0x00, 0x00, 0x00, 0x00, 0xe6, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwod.w.hu.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa2, 0x74, 0x00, 0x00, 0x00, 0x00, 0xe7, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwod.d.wu.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa3, 0x74, 0x00, 0x00, 0x00, 0x00, 0xe8, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmulwod.q.du.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa3, 0x74, 0x00, 0x00, 0x00, 0x00, 0xe9, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmadd.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa8, 0x74, 0x00, 0x00, 0x00, 0x00, 0xea, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmadd.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa8, 0x74, 0x00, 0x00, 0x00, 0x00, 0xeb, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmadd.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xa9, 0x74, 0x00, 0x00, 0x00, 0x00, 0xec, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmadd.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa9, 0x74, 0x00, 0x00, 0x00, 0x00, 0xed, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmsub.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xaa, 0x74, 0x00, 0x00, 0x00, 0x00, 0xee, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmsub.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xaa, 0x74, 0x00, 0x00, 0x00, 0x00, 0xef, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmsub.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xab, 0x74, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmsub.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xab, 0x74, 0x00, 0x00, 0x00, 0x00, 0xf1, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwev.h.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xac, 0x74, 0x00, 0x00, 0x00, 0x00, 0xf2, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwev.w.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xac, 0x74, 0x00, 0x00, 0x00, 0x00, 0xf3, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwev.d.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xad, 0x74, 0x00, 0x00, 0x00, 0x00, 0xf4, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwev.q.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xad, 0x74, 0x00, 0x00, 0x00, 0x00, 0xf5, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwod.h.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xae, 0x74, 0x00, 0x00, 0x00, 0x00, 0xf6, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwod.w.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xae, 0x74, 0x00, 0x00, 0x00, 0x00, 0xf7, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwod.d.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xaf, 0x74, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwod.q.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xaf, 0x74, 0x00, 0x00, 0x00, 0x00, 0xf9, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwev.h.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xb4, 0x74, 0x00, 0x00, 0x00, 0x00, 0xfa, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwev.w.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xb4, 0x74, 0x00, 0x00, 0x00, 0x00, 0xfb, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwev.d.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xb5, 0x74, 0x00, 0x00, 0x00, 0x00, 0xfc, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwev.q.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xb5, 0x74, 0x00, 0x00, 0x00, 0x00, 0xfd, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwod.h.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xb6, 0x74, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwod.w.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xb6, 0x74, 0x00, 0x00, 0x00, 0x00, 0xff, 0x05, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwod.d.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xb7, 0x74, 0x00, 0x00, 0x00, 0x00, 0x00, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwod.q.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xb7, 0x74, 0x00, 0x00, 0x00, 0x00, 0x01, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwev.h.bu.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xbc, 0x74, 0x00, 0x00, 0x00, 0x00, 0x02, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwev.w.hu.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xbc, 0x74, 0x00, 0x00, 0x00, 0x00, 0x03, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwev.d.wu.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xbd, 0x74, 0x00, 0x00, 0x00, 0x00, 0x04, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwev.q.du.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xbd, 0x74, 0x00, 0x00, 0x00, 0x00, 0x05, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwod.h.bu.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xbe, 0x74, 0x00, 0x00, 0x00, 0x00, 0x06, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwod.w.hu.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xbe, 0x74, 0x00, 0x00, 0x00, 0x00, 0x07, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwod.d.wu.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xbf, 0x74, 0x00, 0x00, 0x00, 0x00, 0x08, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmaddwod.q.du.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xbf, 0x74, 0x00, 0x00, 0x00, 0x00, 0x09, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvdiv.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe0, 0x74, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvdiv.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe0, 0x74, 0x00, 0x00, 0x00, 0x00, 0x0b, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvdiv.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe1, 0x74, 0x00, 0x00, 0x00, 0x00, 0x0c, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvdiv.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe1, 0x74, 0x00, 0x00, 0x00, 0x00, 0x0d, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmod.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe2, 0x74, 0x00, 0x00, 0x00, 0x00, 0x0e, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmod.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe2, 0x74, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmod.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe3, 0x74, 0x00, 0x00, 0x00, 0x00, 0x10, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmod.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe3, 0x74, 0x00, 0x00, 0x00, 0x00, 0x11, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvdiv.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe4, 0x74, 0x00, 0x00, 0x00, 0x00, 0x12, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvdiv.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe4, 0x74, 0x00, 0x00, 0x00, 0x00, 0x13, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvdiv.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe5, 0x74, 0x00, 0x00, 0x00, 0x00, 0x14, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvdiv.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe5, 0x74, 0x00, 0x00, 0x00, 0x00, 0x15, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmod.bu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe6, 0x74, 0x00, 0x00, 0x00, 0x00, 0x16, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmod.hu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe6, 0x74, 0x00, 0x00, 0x00, 0x00, 0x17, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmod.wu XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe7, 0x74, 0x00, 0x00, 0x00, 0x00, 0x18, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvmod.du XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe7, 0x74, 0x00, 0x00, 0x00, 0x00, 0x19, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsll.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe8, 0x74, 0x00, 0x00, 0x00, 0x00, 0x1a, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsll.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe8, 0x74, 0x00, 0x00, 0x00, 0x00, 0x1b, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsll.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xe9, 0x74, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsll.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xe9, 0x74, 0x00, 0x00, 0x00, 0x00, 0x1d, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrl.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xea, 0x74, 0x00, 0x00, 0x00, 0x00, 0x1e, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrl.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xea, 0x74, 0x00, 0x00, 0x00, 0x00, 0x1f, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrl.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xeb, 0x74, 0x00, 0x00, 0x00, 0x00, 0x20, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrl.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xeb, 0x74, 0x00, 0x00, 0x00, 0x00, 0x21, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsra.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xec, 0x74, 0x00, 0x00, 0x00, 0x00, 0x22, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsra.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xec, 0x74, 0x00, 0x00, 0x00, 0x00, 0x23, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsra.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xed, 0x74, 0x00, 0x00, 0x00, 0x00, 0x24, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsra.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xed, 0x74, 0x00, 0x00, 0x00, 0x00, 0x25, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvrotr.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xee, 0x74, 0x00, 0x00, 0x00, 0x00, 0x26, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvrotr.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xee, 0x74, 0x00, 0x00, 0x00, 0x00, 0x27, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvrotr.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xef, 0x74, 0x00, 0x00, 0x00, 0x00, 0x28, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvrotr.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xef, 0x74, 0x00, 0x00, 0x00, 0x00, 0x29, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrlr.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xf0, 0x74, 0x00, 0x00, 0x00, 0x00, 0x2a, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrlr.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf0, 0x74, 0x00, 0x00, 0x00, 0x00, 0x2b, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrlr.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xf1, 0x74, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrlr.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf1, 0x74, 0x00, 0x00, 0x00, 0x00, 0x2d, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrar.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xf2, 0x74, 0x00, 0x00, 0x00, 0x00, 0x2e, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrar.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf2, 0x74, 0x00, 0x00, 0x00, 0x00, 0x2f, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrar.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xf3, 0x74, 0x00, 0x00, 0x00, 0x00, 0x30, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrar.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf3, 0x74, 0x00, 0x00, 0x00, 0x00, 0x31, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrln.b.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf4, 0x74, 0x00, 0x00, 0x00, 0x00, 0x32, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrln.h.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xf5, 0x74, 0x00, 0x00, 0x00, 0x00, 0x33, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrln.w.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf5, 0x74, 0x00, 0x00, 0x00, 0x00, 0x34, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsran.b.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf6, 0x74, 0x00, 0x00, 0x00, 0x00, 0x35, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsran.h.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xf7, 0x74, 0x00, 0x00, 0x00, 0x00, 0x36, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsran.w.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf7, 0x74, 0x00, 0x00, 0x00, 0x00, 0x37, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrlrn.b.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf8, 0x74, 0x00, 0x00, 0x00, 0x00, 0x38, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrlrn.h.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xf9, 0x74, 0x00, 0x00, 0x00, 0x00, 0x39, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrlrn.w.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xf9, 0x74, 0x00, 0x00, 0x00, 0x00, 0x3a, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrarn.b.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xfa, 0x74, 0x00, 0x00, 0x00, 0x00, 0x3b, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrarn.h.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xfb, 0x74, 0x00, 0x00, 0x00, 0x00, 0x3c, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsrarn.w.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xfb, 0x74, 0x00, 0x00, 0x00, 0x00, 0x3d, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrln.b.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xfc, 0x74, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrln.h.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xfd, 0x74, 0x00, 0x00, 0x00, 0x00, 0x3f, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrln.w.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xfd, 0x74, 0x00, 0x00, 0x00, 0x00, 0x40, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssran.b.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xfe, 0x74, 0x00, 0x00, 0x00, 0x00, 0x41, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssran.h.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0xff, 0x74, 0x00, 0x00, 0x00, 0x00, 0x42, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssran.w.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xff, 0x74, 0x00, 0x00, 0x00, 0x00, 0x43, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrlrn.b.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x00, 0x75, 0x00, 0x00, 0x00, 0x00, 0x44, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrlrn.h.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x01, 0x75, 0x00, 0x00, 0x00, 0x00, 0x45, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrlrn.w.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x01, 0x75, 0x00, 0x00, 0x00, 0x00, 0x46, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrarn.b.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x02, 0x75, 0x00, 0x00, 0x00, 0x00, 0x47, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrarn.h.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x03, 0x75, 0x00, 0x00, 0x00, 0x00, 0x48, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrarn.w.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x03, 0x75, 0x00, 0x00, 0x00, 0x00, 0x49, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrln.bu.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x04, 0x75, 0x00, 0x00, 0x00, 0x00, 0x4a, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrln.hu.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x05, 0x75, 0x00, 0x00, 0x00, 0x00, 0x4b, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrln.wu.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x05, 0x75, 0x00, 0x00, 0x00, 0x00, 0x4c, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssran.bu.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x06, 0x75, 0x00, 0x00, 0x00, 0x00, 0x4d, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssran.hu.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x07, 0x75, 0x00, 0x00, 0x00, 0x00, 0x4e, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssran.wu.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x07, 0x75, 0x00, 0x00, 0x00, 0x00, 0x4f, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrlrn.bu.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x08, 0x75, 0x00, 0x00, 0x00, 0x00, 0x50, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrlrn.hu.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x09, 0x75, 0x00, 0x00, 0x00, 0x00, 0x51, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrlrn.wu.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x09, 0x75, 0x00, 0x00, 0x00, 0x00, 0x52, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrarn.bu.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0a, 0x75, 0x00, 0x00, 0x00, 0x00, 0x53, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrarn.hu.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0b, 0x75, 0x00, 0x00, 0x00, 0x00, 0x54, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvssrarn.wu.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0b, 0x75, 0x00, 0x00, 0x00, 0x00, 0x55, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvbitclr.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0c, 0x75, 0x00, 0x00, 0x00, 0x00, 0x56, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvbitclr.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0c, 0x75, 0x00, 0x00, 0x00, 0x00, 0x57, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvbitclr.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0d, 0x75, 0x00, 0x00, 0x00, 0x00, 0x58, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvbitclr.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0d, 0x75, 0x00, 0x00, 0x00, 0x00, 0x59, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvbitset.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0e, 0x75, 0x00, 0x00, 0x00, 0x00, 0x5a, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvbitset.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0e, 0x75, 0x00, 0x00, 0x00, 0x00, 0x5b, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvbitset.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x0f, 0x75, 0x00, 0x00, 0x00, 0x00, 0x5c, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvbitset.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0f, 0x75, 0x00, 0x00, 0x00, 0x00, 0x5d, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvbitrev.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x10, 0x75, 0x00, 0x00, 0x00, 0x00, 0x5e, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvbitrev.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x10, 0x75, 0x00, 0x00, 0x00, 0x00, 0x5f, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvbitrev.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x11, 0x75, 0x00, 0x00, 0x00, 0x00, 0x60, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvbitrev.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x11, 0x75, 0x00, 0x00, 0x00, 0x00, 0x61, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpackev.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x16, 0x75, 0x00, 0x00, 0x00, 0x00, 0x62, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpackev.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x16, 0x75, 0x00, 0x00, 0x00, 0x00, 0x63, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpackev.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x17, 0x75, 0x00, 0x00, 0x00, 0x00, 0x64, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpackev.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x17, 0x75, 0x00, 0x00, 0x00, 0x00, 0x65, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpackod.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x18, 0x75, 0x00, 0x00, 0x00, 0x00, 0x66, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpackod.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x18, 0x75, 0x00, 0x00, 0x00, 0x00, 0x67, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpackod.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x19, 0x75, 0x00, 0x00, 0x00, 0x00, 0x68, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpackod.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x19, 0x75, 0x00, 0x00, 0x00, 0x00, 0x69, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvilvl.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1a, 0x75, 0x00, 0x00, 0x00, 0x00, 0x6a, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvilvl.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1a, 0x75, 0x00, 0x00, 0x00, 0x00, 0x6b, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvilvl.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1b, 0x75, 0x00, 0x00, 0x00, 0x00, 0x6c, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvilvl.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1b, 0x75, 0x00, 0x00, 0x00, 0x00, 0x6d, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvilvh.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1c, 0x75, 0x00, 0x00, 0x00, 0x00, 0x6e, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvilvh.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1c, 0x75, 0x00, 0x00, 0x00, 0x00, 0x6f, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvilvh.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1d, 0x75, 0x00, 0x00, 0x00, 0x00, 0x70, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvilvh.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1d, 0x75, 0x00, 0x00, 0x00, 0x00, 0x71, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpickev.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1e, 0x75, 0x00, 0x00, 0x00, 0x00, 0x72, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpickev.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1e, 0x75, 0x00, 0x00, 0x00, 0x00, 0x73, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpickev.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x1f, 0x75, 0x00, 0x00, 0x00, 0x00, 0x74, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpickev.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x1f, 0x75, 0x00, 0x00, 0x00, 0x00, 0x75, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpickod.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x20, 0x75, 0x00, 0x00, 0x00, 0x00, 0x76, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpickod.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x20, 0x75, 0x00, 0x00, 0x00, 0x00, 0x77, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpickod.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x21, 0x75, 0x00, 0x00, 0x00, 0x00, 0x78, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvpickod.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x21, 0x75, 0x00, 0x00, 0x00, 0x00, 0x79, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvreplve.b XdXjK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x22, 0x75, 0x00, 0x00, 0x00, 0x00, 0x7a, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// xvreplve.h XdXjK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x22, 0x75, 0x00, 0x00, 0x00, 0x00, 0x7b, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// xvreplve.w XdXjK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x23, 0x75, 0x00, 0x00, 0x00, 0x00, 0x7c, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// xvreplve.d XdXjK
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x23, 0x75, 0x00, 0x00, 0x00, 0x00, 0x7d, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x01, 0x0a, 0x05,
+	// xvand.v XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x26, 0x75, 0x00, 0x00, 0x00, 0x00, 0x7e, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvor.v XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x26, 0x75, 0x00, 0x00, 0x00, 0x00, 0x7f, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvxor.v XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x27, 0x75, 0x00, 0x00, 0x00, 0x00, 0x80, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvnor.v XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x27, 0x75, 0x00, 0x00, 0x00, 0x00, 0x81, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvandn.v XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x28, 0x75, 0x00, 0x00, 0x00, 0x00, 0x82, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvorn.v XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x28, 0x75, 0x00, 0x00, 0x00, 0x00, 0x83, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfrstp.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2b, 0x75, 0x00, 0x00, 0x00, 0x00, 0x84, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfrstp.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2b, 0x75, 0x00, 0x00, 0x00, 0x00, 0x85, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvadd.q XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2d, 0x75, 0x00, 0x00, 0x00, 0x00, 0x86, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsub.q XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2d, 0x75, 0x00, 0x00, 0x00, 0x00, 0x87, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsigncov.b XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2e, 0x75, 0x00, 0x00, 0x00, 0x00, 0x88, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsigncov.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2e, 0x75, 0x00, 0x00, 0x00, 0x00, 0x89, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsigncov.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x2f, 0x75, 0x00, 0x00, 0x00, 0x00, 0x8a, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvsigncov.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2f, 0x75, 0x00, 0x00, 0x00, 0x00, 0x8b, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfadd.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x30, 0x75, 0x00, 0x00, 0x00, 0x00, 0x8c, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfadd.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x31, 0x75, 0x00, 0x00, 0x00, 0x00, 0x8d, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfsub.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x32, 0x75, 0x00, 0x00, 0x00, 0x00, 0x8e, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfsub.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x33, 0x75, 0x00, 0x00, 0x00, 0x00, 0x8f, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfmul.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x38, 0x75, 0x00, 0x00, 0x00, 0x00, 0x90, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfmul.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x39, 0x75, 0x00, 0x00, 0x00, 0x00, 0x91, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfdiv.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x3a, 0x75, 0x00, 0x00, 0x00, 0x00, 0x92, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfdiv.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x3b, 0x75, 0x00, 0x00, 0x00, 0x00, 0x93, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfmax.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x3c, 0x75, 0x00, 0x00, 0x00, 0x00, 0x94, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfmax.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x3d, 0x75, 0x00, 0x00, 0x00, 0x00, 0x95, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfmin.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x3e, 0x75, 0x00, 0x00, 0x00, 0x00, 0x96, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfmin.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x3f, 0x75, 0x00, 0x00, 0x00, 0x00, 0x97, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfmaxa.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x40, 0x75, 0x00, 0x00, 0x00, 0x00, 0x98, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfmaxa.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x41, 0x75, 0x00, 0x00, 0x00, 0x00, 0x99, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfmina.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x42, 0x75, 0x00, 0x00, 0x00, 0x00, 0x9a, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfmina.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x43, 0x75, 0x00, 0x00, 0x00, 0x00, 0x9b, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcvt.h.s XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x46, 0x75, 0x00, 0x00, 0x00, 0x00, 0x9c, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvfcvt.s.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x46, 0x75, 0x00, 0x00, 0x00, 0x00, 0x9d, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvffint.s.l XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x48, 0x75, 0x00, 0x00, 0x00, 0x00, 0x9e, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvftint.w.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x49, 0x75, 0x00, 0x00, 0x00, 0x00, 0x9f, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvftintrm.w.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x4a, 0x75, 0x00, 0x00, 0x00, 0x00, 0xa0, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvftintrp.w.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x4a, 0x75, 0x00, 0x00, 0x00, 0x00, 0xa1, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvftintrz.w.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x4b, 0x75, 0x00, 0x00, 0x00, 0x00, 0xa2, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvftintrne.w.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x4b, 0x75, 0x00, 0x00, 0x00, 0x00, 0xa3, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvshuf.h XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x7a, 0x75, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvshuf.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x7b, 0x75, 0x00, 0x00, 0x00, 0x00, 0xa5, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvshuf.d XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x7b, 0x75, 0x00, 0x00, 0x00, 0x00, 0xa6, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvperm.w XdXjXk
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x7d, 0x75, 0x00, 0x00, 0x00, 0x00, 0xa7, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x06, 0x0a, 0x05,
+	// xvseqi.b XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x80, 0x76, 0x00, 0x00, 0x00, 0x00, 0xa8, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvseqi.h XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x80, 0x76, 0x00, 0x00, 0x00, 0x00, 0xa9, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvseqi.w XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x81, 0x76, 0x00, 0x00, 0x00, 0x00, 0xaa, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvseqi.d XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x81, 0x76, 0x00, 0x00, 0x00, 0x00, 0xab, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvslei.b XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x82, 0x76, 0x00, 0x00, 0x00, 0x00, 0xac, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvslei.h XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x82, 0x76, 0x00, 0x00, 0x00, 0x00, 0xad, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvslei.w XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x83, 0x76, 0x00, 0x00, 0x00, 0x00, 0xae, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvslei.d XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x83, 0x76, 0x00, 0x00, 0x00, 0x00, 0xaf, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvslei.bu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x84, 0x76, 0x00, 0x00, 0x00, 0x00, 0xb0, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvslei.hu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x84, 0x76, 0x00, 0x00, 0x00, 0x00, 0xb1, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvslei.wu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x85, 0x76, 0x00, 0x00, 0x00, 0x00, 0xb2, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvslei.du XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x85, 0x76, 0x00, 0x00, 0x00, 0x00, 0xb3, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvslti.b XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x86, 0x76, 0x00, 0x00, 0x00, 0x00, 0xb4, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvslti.h XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x86, 0x76, 0x00, 0x00, 0x00, 0x00, 0xb5, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvslti.w XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x87, 0x76, 0x00, 0x00, 0x00, 0x00, 0xb6, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvslti.d XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x87, 0x76, 0x00, 0x00, 0x00, 0x00, 0xb7, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvslti.bu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x88, 0x76, 0x00, 0x00, 0x00, 0x00, 0xb8, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvslti.hu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x88, 0x76, 0x00, 0x00, 0x00, 0x00, 0xb9, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvslti.wu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x89, 0x76, 0x00, 0x00, 0x00, 0x00, 0xba, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvslti.du XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x89, 0x76, 0x00, 0x00, 0x00, 0x00, 0xbb, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvaddi.bu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x8a, 0x76, 0x00, 0x00, 0x00, 0x00, 0xbc, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvaddi.hu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x8a, 0x76, 0x00, 0x00, 0x00, 0x00, 0xbd, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvaddi.wu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x8b, 0x76, 0x00, 0x00, 0x00, 0x00, 0xbe, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvaddi.du XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x8b, 0x76, 0x00, 0x00, 0x00, 0x00, 0xbf, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvsubi.bu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x8c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvsubi.hu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x8c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xc1, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvsubi.wu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x8d, 0x76, 0x00, 0x00, 0x00, 0x00, 0xc2, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvsubi.du XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x8d, 0x76, 0x00, 0x00, 0x00, 0x00, 0xc3, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvbsll.v XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x8e, 0x76, 0x00, 0x00, 0x00, 0x00, 0xc4, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvbsrl.v XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x8e, 0x76, 0x00, 0x00, 0x00, 0x00, 0xc5, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvmaxi.b XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x90, 0x76, 0x00, 0x00, 0x00, 0x00, 0xc6, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvmaxi.h XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x90, 0x76, 0x00, 0x00, 0x00, 0x00, 0xc7, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvmaxi.w XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x91, 0x76, 0x00, 0x00, 0x00, 0x00, 0xc8, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvmaxi.d XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x91, 0x76, 0x00, 0x00, 0x00, 0x00, 0xc9, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvmini.b XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x92, 0x76, 0x00, 0x00, 0x00, 0x00, 0xca, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvmini.h XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x92, 0x76, 0x00, 0x00, 0x00, 0x00, 0xcb, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvmini.w XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x93, 0x76, 0x00, 0x00, 0x00, 0x00, 0xcc, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvmini.d XdXjSk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x93, 0x76, 0x00, 0x00, 0x00, 0x00, 0xcd, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x07, 0x0a, 0x05,
+	// xvmaxi.bu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x94, 0x76, 0x00, 0x00, 0x00, 0x00, 0xce, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvmaxi.hu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x94, 0x76, 0x00, 0x00, 0x00, 0x00, 0xcf, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvmaxi.wu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x95, 0x76, 0x00, 0x00, 0x00, 0x00, 0xd0, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvmaxi.du XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x95, 0x76, 0x00, 0x00, 0x00, 0x00, 0xd1, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvmini.bu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x96, 0x76, 0x00, 0x00, 0x00, 0x00, 0xd2, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvmini.hu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x96, 0x76, 0x00, 0x00, 0x00, 0x00, 0xd3, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvmini.wu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x97, 0x76, 0x00, 0x00, 0x00, 0x00, 0xd4, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvmini.du XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x97, 0x76, 0x00, 0x00, 0x00, 0x00, 0xd5, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvfrstpi.b XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x00, 0x9a, 0x76, 0x00, 0x00, 0x00, 0x00, 0xd6, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvfrstpi.h XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x9a, 0x76, 0x00, 0x00, 0x00, 0x00, 0xd7, 0x06, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvclo.b XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x00, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xd8, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvclo.h XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x04, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xd9, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvclo.w XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x08, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xda, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvclo.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x0c, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xdb, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvclz.b XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x10, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xdc, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvclz.h XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x14, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xdd, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvclz.w XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x18, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xde, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvclz.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x1c, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xdf, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvpcnt.b XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x20, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xe0, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvpcnt.h XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x24, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xe1, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvpcnt.w XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x28, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xe2, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvpcnt.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x2c, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xe3, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvneg.b XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x30, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xe4, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvneg.h XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x34, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xe5, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvneg.w XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x38, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xe6, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvneg.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x3c, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xe7, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvmskltz.b XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x40, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xe8, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvmskltz.h XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x44, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xe9, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvmskltz.w XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x48, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xea, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvmskltz.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x4c, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xeb, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvmskgez.b XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x50, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xec, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvmsknz.b XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x60, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xed, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvseteqz.v CdXj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0x98, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xee, 0x06, 0x02, 0x03, 0x00, 0x03, 0x06, 0x05, 0x05,
+	// xvsetnez.v CdXj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0x9c, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xef, 0x06, 0x02, 0x03, 0x00, 0x03, 0x06, 0x05, 0x05,
+	// xvsetanyeqz.b CdXj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xa0, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x06, 0x02, 0x03, 0x00, 0x03, 0x06, 0x05, 0x05,
+	// xvsetanyeqz.h CdXj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xa4, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xf1, 0x06, 0x02, 0x03, 0x00, 0x03, 0x06, 0x05, 0x05,
+	// xvsetanyeqz.w CdXj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xa8, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xf2, 0x06, 0x02, 0x03, 0x00, 0x03, 0x06, 0x05, 0x05,
+	// xvsetanyeqz.d CdXj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xac, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xf3, 0x06, 0x02, 0x03, 0x00, 0x03, 0x06, 0x05, 0x05,
+	// xvsetallnez.b CdXj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xb0, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xf4, 0x06, 0x02, 0x03, 0x00, 0x03, 0x06, 0x05, 0x05,
+	// xvsetallnez.h CdXj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xb4, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xf5, 0x06, 0x02, 0x03, 0x00, 0x03, 0x06, 0x05, 0x05,
+	// xvsetallnez.w CdXj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xb8, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xf6, 0x06, 0x02, 0x03, 0x00, 0x03, 0x06, 0x05, 0x05,
+	// xvsetallnez.d CdXj
+	0x18, 0xfc, 0xff, 0xff, 0x00, 0xbc, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xf7, 0x06, 0x02, 0x03, 0x00, 0x03, 0x06, 0x05, 0x05,
+	// xvflogb.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xc4, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xf8, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvflogb.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xc8, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xf9, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfclass.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xd4, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xfa, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfclass.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xd8, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xfb, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfsqrt.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe4, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xfc, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfsqrt.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe8, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xfd, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfrecip.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xf4, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xfe, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfrecip.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xf8, 0x9c, 0x76, 0x00, 0x00, 0x00, 0x00, 0xff, 0x06, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfrsqrt.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x04, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x00, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfrsqrt.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x08, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x01, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfrint.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x34, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x02, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfrint.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x38, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x03, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfrintrm.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x44, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x04, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfrintrm.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x48, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x05, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfrintrp.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x54, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x06, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfrintrp.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x58, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x07, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfrintrz.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x64, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x08, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfrintrz.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x68, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x09, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfrintrne.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x74, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x0a, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfrintrne.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x78, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x0b, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfcvtl.s.h XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe8, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x0c, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfcvth.s.h XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xec, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x0d, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfcvtl.d.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xf0, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x0e, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvfcvth.d.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xf4, 0x9d, 0x76, 0x00, 0x00, 0x00, 0x00, 0x0f, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvffint.s.w XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x00, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x10, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvffint.s.wu XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x04, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x11, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvffint.d.l XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x08, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x12, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvffint.d.lu XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x0c, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x13, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvffintl.d.w XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x10, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x14, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvffinth.d.w XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x14, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x15, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftint.w.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x30, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x16, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftint.l.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x34, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x17, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrm.w.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x38, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x18, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrm.l.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x3c, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x19, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrp.w.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x40, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x1a, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrp.l.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x44, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x1b, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrz.w.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x48, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x1c, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrz.l.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x4c, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x1d, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrne.w.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x50, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x1e, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrne.l.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x54, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x1f, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftint.wu.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x58, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x20, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftint.lu.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x5c, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x21, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrz.wu.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x70, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x22, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrz.lu.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x74, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x23, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintl.l.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x80, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x24, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftinth.l.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x84, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x25, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrml.l.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x88, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x26, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrmh.l.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x8c, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x27, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrpl.l.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x90, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x28, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrph.l.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x94, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x29, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrzl.l.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x98, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x2a, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrzh.l.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x9c, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x2b, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrnel.l.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xa0, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x2c, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvftintrneh.l.s XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xa4, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x2d, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvexth.h.b XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe0, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x2e, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvexth.w.h XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe4, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x2f, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvexth.d.w XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe8, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x30, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvexth.q.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xec, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x31, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvexth.hu.bu XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xf0, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x32, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvexth.wu.hu XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xf4, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x33, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvexth.du.wu XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xf8, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x34, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvexth.qu.du XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xfc, 0x9e, 0x76, 0x00, 0x00, 0x00, 0x00, 0x35, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvreplgr2vr.b XdJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x00, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x36, 0x07, 0x02, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// xvreplgr2vr.h XdJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x04, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x37, 0x07, 0x02, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// xvreplgr2vr.w XdJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x08, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x38, 0x07, 0x02, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// xvreplgr2vr.d XdJ
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x0c, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x39, 0x07, 0x02, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05,
+	// vext2xv.h.b XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x10, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x3a, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// vext2xv.w.b XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x14, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x3b, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// vext2xv.d.b XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x18, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x3c, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// vext2xv.w.h XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x1c, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x3d, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// vext2xv.d.h XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x20, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x3e, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// vext2xv.d.w XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x24, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x3f, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// vext2xv.hu.bu XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x28, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x40, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// vext2xv.wu.bu XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x2c, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x41, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// vext2xv.du.bu XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x30, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x42, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// vext2xv.wu.hu XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x34, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x43, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// vext2xv.du.hu XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x38, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x44, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// vext2xv.du.wu XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x3c, 0x9f, 0x76, 0x00, 0x00, 0x00, 0x00, 0x45, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvrotri.b XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0xa0, 0x76, 0x00, 0x00, 0x00, 0x00, 0x46, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvrotri.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0xa0, 0x76, 0x00, 0x00, 0x00, 0x00, 0x47, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvrotri.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa0, 0x76, 0x00, 0x00, 0x00, 0x00, 0x48, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvrotri.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xa1, 0x76, 0x00, 0x00, 0x00, 0x00, 0x49, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvsrlri.b XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0xa4, 0x76, 0x00, 0x00, 0x00, 0x00, 0x4a, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvsrlri.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0xa4, 0x76, 0x00, 0x00, 0x00, 0x00, 0x4b, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvsrlri.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa4, 0x76, 0x00, 0x00, 0x00, 0x00, 0x4c, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvsrlri.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xa5, 0x76, 0x00, 0x00, 0x00, 0x00, 0x4d, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvsrari.b XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0xa8, 0x76, 0x00, 0x00, 0x00, 0x00, 0x4e, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvsrari.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0xa8, 0x76, 0x00, 0x00, 0x00, 0x00, 0x4f, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvsrari.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0xa8, 0x76, 0x00, 0x00, 0x00, 0x00, 0x50, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvsrari.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0xa9, 0x76, 0x00, 0x00, 0x00, 0x00, 0x51, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvinsgr2vr.w XdJUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0xc0, 0xeb, 0x76, 0x00, 0x00, 0x00, 0x00, 0x52, 0x07, 0x03, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvinsgr2vr.d XdJUk2
+	0x00, 0xf0, 0xff, 0xff, 0x00, 0xe0, 0xeb, 0x76, 0x00, 0x00, 0x00, 0x00, 0x53, 0x07, 0x03, 0x06, 0x00, 0x05, 0x01, 0x05, 0x05, 0x08, 0x0a, 0x02,
+	// xvpickve2gr.w DXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0xc0, 0xef, 0x76, 0x00, 0x00, 0x00, 0x00, 0x54, 0x07, 0x03, 0x01, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvpickve2gr.d DXjUk2
+	0x00, 0xf0, 0xff, 0xff, 0x00, 0xe0, 0xef, 0x76, 0x00, 0x00, 0x00, 0x00, 0x55, 0x07, 0x03, 0x01, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x02,
+	// xvpickve2gr.wu DXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0xc0, 0xf3, 0x76, 0x00, 0x00, 0x00, 0x00, 0x56, 0x07, 0x03, 0x01, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvpickve2gr.du DXjUk2
+	0x00, 0xf0, 0xff, 0xff, 0x00, 0xe0, 0xf3, 0x76, 0x00, 0x00, 0x00, 0x00, 0x57, 0x07, 0x03, 0x01, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x02,
+	// xvrepl128vei.b XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x80, 0xf7, 0x76, 0x00, 0x00, 0x00, 0x00, 0x58, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvrepl128vei.h XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0xc0, 0xf7, 0x76, 0x00, 0x00, 0x00, 0x00, 0x59, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvrepl128vei.w XdXjUk2
+	0x00, 0xf0, 0xff, 0xff, 0x00, 0xe0, 0xf7, 0x76, 0x00, 0x00, 0x00, 0x00, 0x5a, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x02,
+	// xvrepl128vei.d XdXjUk1
+	0x00, 0xf8, 0xff, 0xff, 0x00, 0xf0, 0xf7, 0x76, 0x00, 0x00, 0x00, 0x00, 0x5b, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x01,
+	// xvinsve0.w XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0xc0, 0xff, 0x76, 0x00, 0x00, 0x00, 0x00, 0x5c, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvinsve0.d XdXjUk2
+	0x00, 0xf0, 0xff, 0xff, 0x00, 0xe0, 0xff, 0x76, 0x00, 0x00, 0x00, 0x00, 0x5d, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x02,
+	// xvpickve.w XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0xc0, 0x03, 0x77, 0x00, 0x00, 0x00, 0x00, 0x5e, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvpickve.d XdXjUk2
+	0x00, 0xf0, 0xff, 0xff, 0x00, 0xe0, 0x03, 0x77, 0x00, 0x00, 0x00, 0x00, 0x5f, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x02,
+	// xvreplve0.b XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x00, 0x07, 0x77, 0x00, 0x00, 0x00, 0x00, 0x60, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvreplve0.h XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x80, 0x07, 0x77, 0x00, 0x00, 0x00, 0x00, 0x61, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvreplve0.w XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xc0, 0x07, 0x77, 0x00, 0x00, 0x00, 0x00, 0x62, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvreplve0.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xe0, 0x07, 0x77, 0x00, 0x00, 0x00, 0x00, 0x63, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvreplve0.q XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0xf0, 0x07, 0x77, 0x00, 0x00, 0x00, 0x00, 0x64, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvsllwil.h.b XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x08, 0x77, 0x00, 0x00, 0x00, 0x00, 0x65, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvsllwil.w.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x08, 0x77, 0x00, 0x00, 0x00, 0x00, 0x66, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvsllwil.d.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x08, 0x77, 0x00, 0x00, 0x00, 0x00, 0x67, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvextl.q.d XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x00, 0x09, 0x77, 0x00, 0x00, 0x00, 0x00, 0x68, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvsllwil.hu.bu XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x0c, 0x77, 0x00, 0x00, 0x00, 0x00, 0x69, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvsllwil.wu.hu XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x0c, 0x77, 0x00, 0x00, 0x00, 0x00, 0x6a, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvsllwil.du.wu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x0c, 0x77, 0x00, 0x00, 0x00, 0x00, 0x6b, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvextl.qu.du XdXj
+	0x00, 0xfc, 0xff, 0xff, 0x00, 0x00, 0x0d, 0x77, 0x00, 0x00, 0x00, 0x00, 0x6c, 0x07, 0x02, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05,
+	// xvbitclri.b XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x10, 0x77, 0x00, 0x00, 0x00, 0x00, 0x6d, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvbitclri.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x10, 0x77, 0x00, 0x00, 0x00, 0x00, 0x6e, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvbitclri.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x10, 0x77, 0x00, 0x00, 0x00, 0x00, 0x6f, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvbitclri.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x11, 0x77, 0x00, 0x00, 0x00, 0x00, 0x70, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvbitseti.b XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x14, 0x77, 0x00, 0x00, 0x00, 0x00, 0x71, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvbitseti.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x14, 0x77, 0x00, 0x00, 0x00, 0x00, 0x72, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvbitseti.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x14, 0x77, 0x00, 0x00, 0x00, 0x00, 0x73, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvbitseti.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x15, 0x77, 0x00, 0x00, 0x00, 0x00, 0x74, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvbitrevi.b XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x18, 0x77, 0x00, 0x00, 0x00, 0x00, 0x75, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvbitrevi.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x18, 0x77, 0x00, 0x00, 0x00, 0x00, 0x76, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvbitrevi.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x18, 0x77, 0x00, 0x00, 0x00, 0x00, 0x77, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvbitrevi.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x19, 0x77, 0x00, 0x00, 0x00, 0x00, 0x78, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvsat.b XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x24, 0x77, 0x00, 0x00, 0x00, 0x00, 0x79, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvsat.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x24, 0x77, 0x00, 0x00, 0x00, 0x00, 0x7a, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvsat.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x24, 0x77, 0x00, 0x00, 0x00, 0x00, 0x7b, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvsat.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x25, 0x77, 0x00, 0x00, 0x00, 0x00, 0x7c, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvsat.bu XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x28, 0x77, 0x00, 0x00, 0x00, 0x00, 0x7d, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvsat.hu XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x28, 0x77, 0x00, 0x00, 0x00, 0x00, 0x7e, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvsat.wu XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x28, 0x77, 0x00, 0x00, 0x00, 0x00, 0x7f, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvsat.du XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x29, 0x77, 0x00, 0x00, 0x00, 0x00, 0x80, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvslli.b XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x2c, 0x77, 0x00, 0x00, 0x00, 0x00, 0x81, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvslli.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x2c, 0x77, 0x00, 0x00, 0x00, 0x00, 0x82, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvslli.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x2c, 0x77, 0x00, 0x00, 0x00, 0x00, 0x83, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvslli.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x2d, 0x77, 0x00, 0x00, 0x00, 0x00, 0x84, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvsrli.b XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x30, 0x77, 0x00, 0x00, 0x00, 0x00, 0x85, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvsrli.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x30, 0x77, 0x00, 0x00, 0x00, 0x00, 0x86, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvsrli.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x30, 0x77, 0x00, 0x00, 0x00, 0x00, 0x87, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvsrli.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x31, 0x77, 0x00, 0x00, 0x00, 0x00, 0x88, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvsrai.b XdXjUk3
+	0x00, 0xe0, 0xff, 0xff, 0x00, 0x20, 0x34, 0x77, 0x00, 0x00, 0x00, 0x00, 0x89, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x03,
+	// xvsrai.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x34, 0x77, 0x00, 0x00, 0x00, 0x00, 0x8a, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvsrai.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x34, 0x77, 0x00, 0x00, 0x00, 0x00, 0x8b, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvsrai.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x35, 0x77, 0x00, 0x00, 0x00, 0x00, 0x8c, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvsrlni.b.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x40, 0x77, 0x00, 0x00, 0x00, 0x00, 0x8d, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvsrlni.h.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x40, 0x77, 0x00, 0x00, 0x00, 0x00, 0x8e, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvsrlni.w.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x41, 0x77, 0x00, 0x00, 0x00, 0x00, 0x8f, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvsrlni.d.q XdXjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x42, 0x77, 0x00, 0x00, 0x00, 0x00, 0x90, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// xvsrlrni.b.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x44, 0x77, 0x00, 0x00, 0x00, 0x00, 0x91, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvsrlrni.h.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x44, 0x77, 0x00, 0x00, 0x00, 0x00, 0x92, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvsrlrni.w.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x45, 0x77, 0x00, 0x00, 0x00, 0x00, 0x93, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvsrlrni.d.q XdXjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x46, 0x77, 0x00, 0x00, 0x00, 0x00, 0x94, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// xvssrlni.b.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x48, 0x77, 0x00, 0x00, 0x00, 0x00, 0x95, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvssrlni.h.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x48, 0x77, 0x00, 0x00, 0x00, 0x00, 0x96, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvssrlni.w.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x49, 0x77, 0x00, 0x00, 0x00, 0x00, 0x97, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvssrlni.d.q XdXjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x4a, 0x77, 0x00, 0x00, 0x00, 0x00, 0x98, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// xvssrlni.bu.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x4c, 0x77, 0x00, 0x00, 0x00, 0x00, 0x99, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvssrlni.hu.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x4c, 0x77, 0x00, 0x00, 0x00, 0x00, 0x9a, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvssrlni.wu.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x4d, 0x77, 0x00, 0x00, 0x00, 0x00, 0x9b, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvssrlni.du.q XdXjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x4e, 0x77, 0x00, 0x00, 0x00, 0x00, 0x9c, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// xvssrlrni.b.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x50, 0x77, 0x00, 0x00, 0x00, 0x00, 0x9d, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvssrlrni.h.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x50, 0x77, 0x00, 0x00, 0x00, 0x00, 0x9e, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvssrlrni.w.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x51, 0x77, 0x00, 0x00, 0x00, 0x00, 0x9f, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvssrlrni.d.q XdXjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x52, 0x77, 0x00, 0x00, 0x00, 0x00, 0xa0, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// xvssrlrni.bu.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x54, 0x77, 0x00, 0x00, 0x00, 0x00, 0xa1, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvssrlrni.hu.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x54, 0x77, 0x00, 0x00, 0x00, 0x00, 0xa2, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvssrlrni.wu.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x55, 0x77, 0x00, 0x00, 0x00, 0x00, 0xa3, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvssrlrni.du.q XdXjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x56, 0x77, 0x00, 0x00, 0x00, 0x00, 0xa4, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// xvsrani.b.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x58, 0x77, 0x00, 0x00, 0x00, 0x00, 0xa5, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvsrani.h.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x58, 0x77, 0x00, 0x00, 0x00, 0x00, 0xa6, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvsrani.w.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x59, 0x77, 0x00, 0x00, 0x00, 0x00, 0xa7, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvsrani.d.q XdXjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x5a, 0x77, 0x00, 0x00, 0x00, 0x00, 0xa8, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// xvsrarni.b.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x5c, 0x77, 0x00, 0x00, 0x00, 0x00, 0xa9, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvsrarni.h.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x5c, 0x77, 0x00, 0x00, 0x00, 0x00, 0xaa, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvsrarni.w.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x5d, 0x77, 0x00, 0x00, 0x00, 0x00, 0xab, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvsrarni.d.q XdXjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x5e, 0x77, 0x00, 0x00, 0x00, 0x00, 0xac, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// xvssrani.b.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x60, 0x77, 0x00, 0x00, 0x00, 0x00, 0xad, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvssrani.h.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x60, 0x77, 0x00, 0x00, 0x00, 0x00, 0xae, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvssrani.w.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x61, 0x77, 0x00, 0x00, 0x00, 0x00, 0xaf, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvssrani.d.q XdXjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x62, 0x77, 0x00, 0x00, 0x00, 0x00, 0xb0, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// xvssrani.bu.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x64, 0x77, 0x00, 0x00, 0x00, 0x00, 0xb1, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvssrani.hu.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x64, 0x77, 0x00, 0x00, 0x00, 0x00, 0xb2, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvssrani.wu.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x65, 0x77, 0x00, 0x00, 0x00, 0x00, 0xb3, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvssrani.du.q XdXjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x66, 0x77, 0x00, 0x00, 0x00, 0x00, 0xb4, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// xvssrarni.b.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x68, 0x77, 0x00, 0x00, 0x00, 0x00, 0xb5, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvssrarni.h.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x68, 0x77, 0x00, 0x00, 0x00, 0x00, 0xb6, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvssrarni.w.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x69, 0x77, 0x00, 0x00, 0x00, 0x00, 0xb7, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvssrarni.d.q XdXjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x6a, 0x77, 0x00, 0x00, 0x00, 0x00, 0xb8, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// xvssrarni.bu.h XdXjUk4
+	0x00, 0xc0, 0xff, 0xff, 0x00, 0x40, 0x6c, 0x77, 0x00, 0x00, 0x00, 0x00, 0xb9, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x04,
+	// xvssrarni.hu.w XdXjUk5
+	0x00, 0x80, 0xff, 0xff, 0x00, 0x80, 0x6c, 0x77, 0x00, 0x00, 0x00, 0x00, 0xba, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x05,
+	// xvssrarni.wu.d XdXjUk6
+	0x00, 0x00, 0xff, 0xff, 0x00, 0x00, 0x6d, 0x77, 0x00, 0x00, 0x00, 0x00, 0xbb, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x06,
+	// xvssrarni.du.q XdXjUk7
+	0x00, 0x00, 0xfe, 0xff, 0x00, 0x00, 0x6e, 0x77, 0x00, 0x00, 0x00, 0x00, 0xbc, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x07,
+	// xvextrins.d XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x80, 0x77, 0x00, 0x00, 0x00, 0x00, 0xbd, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvextrins.w XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x84, 0x77, 0x00, 0x00, 0x00, 0x00, 0xbe, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvextrins.h XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x88, 0x77, 0x00, 0x00, 0x00, 0x00, 0xbf, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvextrins.b XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x8c, 0x77, 0x00, 0x00, 0x00, 0x00, 0xc0, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvshuf4i.b XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x90, 0x77, 0x00, 0x00, 0x00, 0x00, 0xc1, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvshuf4i.h XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x94, 0x77, 0x00, 0x00, 0x00, 0x00, 0xc2, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvshuf4i.w XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x98, 0x77, 0x00, 0x00, 0x00, 0x00, 0xc3, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvshuf4i.d XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0x9c, 0x77, 0x00, 0x00, 0x00, 0x00, 0xc4, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvbitseli.b XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xc4, 0x77, 0x00, 0x00, 0x00, 0x00, 0xc5, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvandi.b XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xd0, 0x77, 0x00, 0x00, 0x00, 0x00, 0xc6, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvori.b XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xd4, 0x77, 0x00, 0x00, 0x00, 0x00, 0xc7, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvxori.b XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xd8, 0x77, 0x00, 0x00, 0x00, 0x00, 0xc8, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvnori.b XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xdc, 0x77, 0x00, 0x00, 0x00, 0x00, 0xc9, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvldi XdSj13
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xe0, 0x77, 0x00, 0x00, 0x00, 0x00, 0xca, 0x07, 0x02, 0x06, 0x00, 0x05, 0x07, 0x05, 0x0d,
+	// xvpermi.w XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xe4, 0x77, 0x00, 0x00, 0x00, 0x00, 0xcb, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvpermi.d XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xe8, 0x77, 0x00, 0x00, 0x00, 0x00, 0xcc, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+	// xvpermi.q XdXjUk8
+	0x00, 0x00, 0xfc, 0xff, 0x00, 0x00, 0xec, 0x77, 0x00, 0x00, 0x00, 0x00, 0xcd, 0x07, 0x03, 0x06, 0x00, 0x05, 0x06, 0x05, 0x05, 0x08, 0x0a, 0x08,
+}
+
+// indexStarts[v] is the start of the descriptor offsets in indexOffsets
+// for the words with v as the top 10 bits.
+var indexStarts = [...]uint32{
+	0, 205, 254, 255, 256, 335, 335, 335, 335, 336, 337, 338, 339, 340, 341, 342,
+	343, 344, 345, 346, 347, 348, 349, 350, 351, 352, 377, 377, 377, 377, 377, 377,
+	377, 379, 381, 383, 385, 387, 389, 391, 393, 395, 397, 399, 401, 401, 401, 401,
+	401, 445, 489, 533, 533, 536, 538, 538, 538, 538, 538, 538, 538, 538, 538, 538,
+	538, 539, 540, 541, 542, 543, 544, 545, 546, 547, 548, 549, 550, 551, 552, 553,
+	554, 555, 556, 557, 558, 559, 560, 561, 562, 563, 564, 565, 566, 567, 568, 569,
+	570, 571, 572, 573, 574, 575, 576, 577, 578, 579, 580, 581, 582, 583, 584, 585,
+	586, 587, 588, 589, 590, 591, 592, 593, 594, 595, 596, 597, 598, 599, 600, 601,
+	602, 603, 604, 605, 606, 607, 608, 609, 610, 611, 612, 613, 614, 615, 616, 617,
+	618, 619, 620, 621, 622, 623, 624, 625, 626, 627, 628, 629, 630, 631, 632, 633,
+	634, 635, 636, 637, 638, 639, 640, 641, 642, 643, 644, 645, 646, 647, 648, 649,
+	650, 651, 652, 653, 654, 654, 654, 654, 654, 655, 656, 657, 658, 659, 660, 661,
+	662, 664, 665, 666, 666, 668, 669, 670, 670, 672, 673, 674, 674, 676, 677, 678,
+	679, 679, 679, 679, 679, 679, 679, 679, 679, 679, 679, 679, 679, 679, 679, 679,
+	679, 695, 761, 761, 761, 761, 761, 761, 761, 761, 761, 761, 761, 761, 761, 761,
+	761, 761, 761, 761, 761, 761, 761, 761, 761, 761, 761, 761, 761, 761, 761, 761,
+	761, 762, 763, 764, 765, 766, 767, 768, 769, 770, 771, 772, 773, 774, 775, 776,
+	777, 778, 779, 780, 781, 782, 783, 784, 785, 786, 787, 788, 789, 790, 791, 792,
+	793, 797, 801, 805, 809, 813, 817, 821, 825, 829, 833, 837, 841, 845, 849, 853,
+	857, 858, 859, 860, 861, 862, 863, 864, 865, 866, 867, 868, 869, 870, 871, 872,
+	873, 874, 875, 876, 877, 878, 879, 880, 881, 882, 883, 884, 885, 886, 887, 888,
+	889, 890, 891, 892, 893, 894, 895, 896, 897, 898, 899, 900, 901, 902, 903, 904,
+	905, 906, 907, 908, 909, 910, 911, 912, 913, 914, 915, 916, 917, 918, 919, 920,
+	921, 922, 923, 924, 925, 926, 927, 928, 929, 930, 931, 932, 933, 934, 935, 936,
+	937, 938, 939, 940, 941, 942, 943, 944, 945, 946, 947, 948, 949, 950, 951, 952,
+	953, 954, 955, 956, 957, 958, 959, 960, 961, 962, 963, 964, 965, 966, 967, 968,
+	969, 970, 971, 972, 973, 974, 975, 976, 977, 978, 979, 980, 981, 982, 983, 984,
+	985, 986, 987, 988, 989, 990, 991, 992, 993, 994, 995, 996, 997, 998, 999, 1000,
+	1001, 1065, 1145, 1213, 1271, 1355, 1370, 1370, 1370, 1370, 1370, 1528, 1544, 1584, 1632, 1640,
+	1647, 1711, 1791, 1859, 1917, 2001, 2017, 2017, 2017, 2017, 2017, 2187, 2199, 2246, 2294, 2302,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311, 2311,
+	2311,
+}
+
+var indexOffsets = [...]uint32{
+	// 0x000
+	768, 783, 798, 813, 546, 564, 624, 642, 660, 678, 696, 714, 732, 750, 0, 21, 2592, 2613, 4422, 4443, 4464, 4485, 42, 63, 84, 105, 126, 147, 168, 189, 210, 231, 252, 273, 294, 315, 336, 357, 378, 399, 420, 441, 462, 483, 504, 525, 582, 603, 828, 849, 2994, 3015, 3036, 3057, 3078, 3099, 3120, 3141, 3162, 3183, 3204, 3225, 3246, 3267, 3288, 3309, 3330, 3351, 3372, 3393, 3414, 3435, 3456, 3477, 3498, 3519, 3540, 3561, 3582, 3603, 3624, 3645, 3666, 3687, 3708, 3729, 3750, 3771, 3792, 3813, 3834, 3855, 3876, 3897, 3918, 3939, 3960, 3981, 4002, 4023, 4044, 4065, 4086, 4107, 4128, 4149, 4170, 4191, 4212, 4233, 4254, 4275, 4296, 4317, 4338, 4359, 4380, 4401, 2568, 2634, 2658, 2682, 2706, 2730, 2754, 2778, 2802, 2826, 2850, 2874, 2898, 2922, 2946, 2970, 980, 1004, 1028, 1052, 1076, 1100, 1124, 1148, 1172, 1196, 1220, 1244, 1268, 1292, 1316, 1340, 1364, 1388, 1412, 1436, 1460, 1484, 1508, 1532, 1556, 1580, 1604, 1628, 1652, 1676, 1700, 1724, 1748, 1772, 1796, 1820, 1844, 1868, 1892, 1916, 1940, 1964, 1988, 2012, 2036, 2060, 2084, 2108, 2132, 2156, 2180, 2198, 2216, 2234, 2280, 2304, 2328, 2352, 2376, 2400, 2424, 2448, 2472, 2496, 2520, 2544, 870, 898, 926, 2252, 953,
+	// 0x001
+	4842, 4863, 4884, 4905, 4926, 4947, 4968, 4989, 5010, 5031, 5052, 5073, 5094, 5115, 5136, 5157, 5178, 5199, 5220, 5241, 5262, 5283, 5304, 5325, 5346, 5367, 5388, 5409, 4650, 4746, 5454, 5475, 5496, 5517, 4674, 4770, 4506, 4554, 4602, 4698, 4794, 4530, 4578, 4626, 4722, 4818, 5430, 5538, 5565,
+	// 0x002
+	5592,
+	// 0x003
+	5619,
+	// 0x004
+	6630, 6651, 6672, 6693, 6126, 6147, 6168, 6189, 6210, 6231, 6252, 6273, 6294, 6315, 6336, 6357, 6378, 6399, 6420, 6441, 6462, 6483, 6504, 6525, 6546, 6567, 6588, 6609, 6714, 6735, 6780, 6801, 6822, 6843, 6864, 6885, 6906, 6927, 6948, 6969, 6990, 7011, 7032, 7053, 7074, 7095, 7116, 7137, 7158, 7179, 7200, 7221, 7242, 7263, 7284, 7305, 7326, 7347, 5646, 5670, 5694, 5718, 5742, 5766, 5790, 5814, 5838, 5862, 5886, 5910, 5934, 5958, 5982, 6006, 6030, 6054, 6078, 6102, 6756,
+	// 0x008
+	7368,
+	// 0x009
+	7392,
+	// 0x00a
+	7416,
+	// 0x00b
+	7440,
+	// 0x00c
+	7464,
+	// 0x00d
+	7488,
+	// 0x00e
+	7512,
+	// 0x00f
+	7536,
+	// 0x010
+	7560,
+	// 0x011
+	7560,
+	// 0x012
+	7560,
+	// 0x013
+	7560,
+	// 0x014
+	7584,
+	// 0x015
+	7584,
+	// 0x016
+	7584,
+	// 0x017
+	7584,
+	// 0x018
+	7608,
+	// 0x019
+	7845, 7860, 7875, 7890, 7905, 7920, 7935, 7950, 7965, 7980, 7995, 8010, 8025, 7677, 7698, 7719, 7740, 7761, 7782, 7803, 7824, 7656, 8040, 8058, 7632,
+	// 0x020
+	8082, 8109,
+	// 0x021
+	8136, 8163,
+	// 0x022
+	8190, 8217,
+	// 0x023
+	8244, 8271,
+	// 0x024
+	8298, 8325,
+	// 0x025
+	8352, 8379,
+	// 0x026
+	8406, 8433,
+	// 0x027
+	8460, 8487,
+	// 0x028
+	8514, 8541,
+	// 0x029
+	8568, 8595,
+	// 0x02a
+	8622, 8649,
+	// 0x02b
+	8676, 8703,
+	// 0x030
+	8730, 8754, 8778, 8802, 8826, 8850, 8874, 8898, 8922, 8946, 8970, 8994, 9018, 9042, 9066, 9090, 9114, 9138, 9162, 9186, 9210, 9234, 9258, 9282, 9306, 9330, 9354, 9378, 9402, 9426, 9450, 9474, 9498, 9522, 9546, 9570, 9594, 9618, 9642, 9666, 9690, 9714, 9738, 9762,
+	// 0x031
+	9786, 9810, 9834, 9858, 9882, 9906, 9930, 9954, 9978, 10002, 10026, 10050, 10074, 10098, 10122, 10146, 10170, 10194, 10218, 10242, 10266, 10290, 10314, 10338, 10362, 10386, 10410, 10434, 10458, 10482, 10506, 10530, 10554, 10578, 10602, 10626, 10650, 10674, 10698, 10722, 10746, 10770, 10794, 10818,
+	// 0x032
+	10842, 10866, 10890, 10914, 10938, 10962, 10986, 11010, 11034, 11058, 11082, 11106, 11130, 11154, 11178, 11202, 11226, 11250, 11274, 11298, 11322, 11346, 11370, 11394, 11418, 11442, 11466, 11490, 11514, 11538, 11562, 11586, 11610, 11634, 11658, 11682, 11706, 11730, 11754, 11778, 11802, 11826, 11850, 11874,
+	// 0x034
+	11898, 11925, 11952,
+	// 0x035
+	11979, 12006,
+	// 0x040
+	12033,
+	// 0x041
+	12033,
+	// 0x042
+	12033,
+	// 0x043
+	12033,
+	// 0x044
+	12033,
+	// 0x045
+	12033,
+	// 0x046
+	12033,
+	// 0x047
+	12033,
+	// 0x048
+	12033,
+	// 0x049
+	12033,
+	// 0x04a
+	12033,
+	// 0x04b
+	12033,
+	// 0x04c
+	12033,
+	// 0x04d
+	12033,
+	// 0x04e
+	12033,
+	// 0x04f
+	12033,
+	// 0x050
+	12057,
+	// 0x051
+	12057,
+	// 0x052
+	12057,
+	// 0x053
+	12057,
+	// 0x054
+	12057,
+	// 0x055
+	12057,
+	// 0x056
+	12057,
+	// 0x057
+	12057,
+	// 0x058
+	12078,
+	// 0x059
+	12078,
+	// 0x05a
+	12078,
+	// 0x05b
+	12078,
+	// 0x05c
+	12078,
+	// 0x05d
+	12078,
+	// 0x05e
+	12078,
+	// 0x05f
+	12078,
+	// 0x060
+	12099,
+	// 0x061
+	12099,
+	// 0x062
+	12099,
+	// 0x063
+	12099,
+	// 0x064
+	12099,
+	// 0x065
+	12099,
+	// 0x066
+	12099,
+	// 0x067
+	12099,
+	// 0x068
+	12120,
+	// 0x069
+	12120,
+	// 0x06a
+	12120,
+	// 0x06b
+	12120,
+	// 0x06c
+	12120,
+	// 0x06d
+	12120,
+	// 0x06e
+	12120,
+	// 0x06f
+	12120,
+	// 0x070
+	12141,
+	// 0x071
+	12141,
+	// 0x072
+	12141,
+	// 0x073
+	12141,
+	// 0x074
+	12141,
+	// 0x075
+	12141,
+	// 0x076
+	12141,
+	// 0x077
+	12141,
+	// 0x078
+	12162,
+	// 0x079
+	12162,
+	// 0x07a
+	12162,
+	// 0x07b
+	12162,
+	// 0x07c
+	12162,
+	// 0x07d
+	12162,
+	// 0x07e
+	12162,
+	// 0x07f
+	12162,
+	// 0x080
+	12183,
+	// 0x081
+	12183,
+	// 0x082
+	12183,
+	// 0x083
+	12183,
+	// 0x084
+	12208,
+	// 0x085
+	12208,
+	// 0x086
+	12208,
+	// 0x087
+	12208,
+	// 0x088
+	12233,
+	// 0x089
+	12233,
+	// 0x08a
+	12233,
+	// 0x08b
+	12233,
+	// 0x08c
+	12258,
+	// 0x08d
+	12258,
+	// 0x08e
+	12258,
+	// 0x08f
+	12258,
+	// 0x090
+	12283,
+	// 0x091
+	12283,
+	// 0x092
+	12283,
+	// 0x093
+	12283,
+	// 0x094
+	12308,
+	// 0x095
+	12308,
+	// 0x096
+	12308,
+	// 0x097
+	12308,
+	// 0x098
+	12333,
+	// 0x099
+	12333,
+	// 0x09a
+	12333,
+	// 0x09b
+	12333,
+	// 0x09c
+	12358,
+	// 0x09d
+	12358,
+	// 0x09e
+	12358,
+	// 0x09f
+	12358,
+	// 0x0a0
+	12383,
+	// 0x0a1
+	12407,
+	// 0x0a2
+	12431,
+	// 0x0a3
+	12455,
+	// 0x0a4
+	12479,
+	// 0x0a5
+	12503,
+	// 0x0a6
+	12527,
+	// 0x0a7
+	12551,
+	// 0x0a8
+	12575,
+	// 0x0a9
+	12599,
+	// 0x0aa
+	12623,
+	// 0x0ab
+	12647,
+	// 0x0ac
+	12671,
+	// 0x0ad
+	12695,
+	// 0x0ae
+	12719,
+	// 0x0af
+	12743,
+	// 0x0b0
+	12767,
+	// 0x0b1
+	12791,
+	// 0x0b2
+	12815,
+	// 0x0b3
+	12839,
+	// 0x0b8
+	12863,
+	// 0x0b9
+	12887,
+	// 0x0ba
+	12911,
+	// 0x0bb
+	12935,
+	// 0x0bc
+	12959,
+	// 0x0bd
+	12983,
+	// 0x0be
+	13007,
+	// 0x0bf
+	13031,
+	// 0x0c0
+	13055, 13080,
+	// 0x0c1
+	13105,
+	// 0x0c2
+	13130,
+	// 0x0c4
+	13154, 13182,
+	// 0x0c5
+	13210,
+	// 0x0c6
+	13238,
+	// 0x0c8
+	13265, 13290,
+	// 0x0c9
+	13315,
+	// 0x0ca
+	13340,
+	// 0x0cc
+	13364, 13392,
+	// 0x0cd
+	13420,
+	// 0x0ce
+	13448,
+	// 0x0cf
+	13448,
+	// 0x0e0
+	13475, 13499, 13523, 13547, 13571, 13595, 13619, 13643, 13667, 13691, 13715, 13739, 13763, 13787, 13811, 13835,
+	// 0x0e1
+	13859, 13883, 13907, 13931, 13955, 13979, 14003, 14027, 14051, 14075, 14099, 14123, 14147, 14171, 14195, 14219, 14243, 14267, 14291, 14315, 14339, 14363, 14387, 14411, 14435, 14459, 14483, 14507, 14531, 14555, 14579, 14603, 14627, 14651, 14675, 14699, 14723, 14747, 14771, 14795, 14819, 14837, 14855, 14879, 14903, 14927, 14951, 14975, 14999, 15023, 15047, 15071, 15095, 15119, 15143, 15167, 15191, 15215, 15239, 15263, 15287, 15311, 15335, 15359, 15383, 15407,
+	// 0x100
+	15431,
+	// 0x101
+	15431,
+	// 0x102
+	15431,
+	// 0x103
+	15431,
+	// 0x104
+	15431,
+	// 0x105
+	15431,
+	// 0x106
+	15431,
+	// 0x107
+	15431,
+	// 0x108
+	15431,
+	// 0x109
+	15431,
+	// 0x10a
+	15431,
+	// 0x10b
+	15431,
+	// 0x10c
+	15431,
+	// 0x10d
+	15431,
+	// 0x10e
+	15431,
+	// 0x10f
+	15431,
+	// 0x110
+	15455,
+	// 0x111
+	15455,
+	// 0x112
+	15455,
+	// 0x113
+	15455,
+	// 0x114
+	15455,
+	// 0x115
+	15455,
+	// 0x116
+	15455,
+	// 0x117
+	15455,
+	// 0x118
+	15455,
+	// 0x119
+	15455,
+	// 0x11a
+	15455,
+	// 0x11b
+	15455,
+	// 0x11c
+	15455,
+	// 0x11d
+	15455,
+	// 0x11e
+	15455,
+	// 0x11f
+	15455,
+	// 0x120
+	15527, 15548, 15479, 15503,
+	// 0x121
+	15527, 15548, 15479, 15503,
+	// 0x122
+	15527, 15548, 15479, 15503,
+	// 0x123
+	15527, 15548, 15479, 15503,
+	// 0x124
+	15527, 15548, 15479, 15503,
+	// 0x125
+	15527, 15548, 15479, 15503,
+	// 0x126
+	15527, 15548, 15479, 15503,
+	// 0x127
+	15527, 15548, 15479, 15503,
+	// 0x128
+	15527, 15548, 15479, 15503,
+	// 0x129
+	15527, 15548, 15479, 15503,
+	// 0x12a
+	15527, 15548, 15479, 15503,
+	// 0x12b
+	15527, 15548, 15479, 15503,
+	// 0x12c
+	15527, 15548, 15479, 15503,
+	// 0x12d
+	15527, 15548, 15479, 15503,
+	// 0x12e
+	15527, 15548, 15479, 15503,
+	// 0x12f
+	15527, 15548, 15479, 15503,
+	// 0x130
+	15569,
+	// 0x131
+	15569,
+	// 0x132
+	15569,
+	// 0x133
+	15569,
+	// 0x134
+	15569,
+	// 0x135
+	15569,
+	// 0x136
+	15569,
+	// 0x137
+	15569,
+	// 0x138
+	15569,
+	// 0x139
+	15569,
+	// 0x13a
+	15569,
+	// 0x13b
+	15569,
+	// 0x13c
+	15569,
+	// 0x13d
+	15569,
+	// 0x13e
+	15569,
+	// 0x13f
+	15569,
+	// 0x140
+	15594,
+	// 0x141
+	15594,
+	// 0x142
+	15594,
+	// 0x143
+	15594,
+	// 0x144
+	15594,
+	// 0x145
+	15594,
+	// 0x146
+	15594,
+	// 0x147
+	15594,
+	// 0x148
+	15594,
+	// 0x149
+	15594,
+	// 0x14a
+	15594,
+	// 0x14b
+	15594,
+	// 0x14c
+	15594,
+	// 0x14d
+	15594,
+	// 0x14e
+	15594,
+	// 0x14f
+	15594,
+	// 0x150
+	15615,
+	// 0x151
+	15615,
+	// 0x152
+	15615,
+	// 0x153
+	15615,
+	// 0x154
+	15615,
+	// 0x155
+	15615,
+	// 0x156
+	15615,
+	// 0x157
+	15615,
+	// 0x158
+	15615,
+	// 0x159
+	15615,
+	// 0x15a
+	15615,
+	// 0x15b
+	15615,
+	// 0x15c
+	15615,
+	// 0x15d
+	15615,
+	// 0x15e
+	15615,
+	// 0x15f
+	15615,
+	// 0x160
+	15636,
+	// 0x161
+	15636,
+	// 0x162
+	15636,
+	// 0x163
+	15636,
+	// 0x164
+	15636,
+	// 0x165
+	15636,
+	// 0x166
+	15636,
+	// 0x167
+	15636,
+	// 0x168
+	15636,
+	// 0x169
+	15636,
+	// 0x16a
+	15636,
+	// 0x16b
+	15636,
+	// 0x16c
+	15636,
+	// 0x16d
+	15636,
+	// 0x16e
+	15636,
+	// 0x16f
+	15636,
+	// 0x170
+	15661,
+	// 0x171
+	15661,
+	// 0x172
+	15661,
+	// 0x173
+	15661,
+	// 0x174
+	15661,
+	// 0x175
+	15661,
+	// 0x176
+	15661,
+	// 0x177
+	15661,
+	// 0x178
+	15661,
+	// 0x179
+	15661,
+	// 0x17a
+	15661,
+	// 0x17b
+	15661,
+	// 0x17c
+	15661,
+	// 0x17d
+	15661,
+	// 0x17e
+	15661,
+	// 0x17f
+	15661,
+	// 0x180
+	15686,
+	// 0x181
+	15686,
+	// 0x182
+	15686,
+	// 0x183
+	15686,
+	// 0x184
+	15686,
+	// 0x185
+	15686,
+	// 0x186
+	15686,
+	// 0x187
+	15686,
+	// 0x188
+	15686,
+	// 0x189
+	15686,
+	// 0x18a
+	15686,
+	// 0x18b
+	15686,
+	// 0x18c
+	15686,
+	// 0x18d
+	15686,
+	// 0x18e
+	15686,
+	// 0x18f
+	15686,
+	// 0x190
+	15711,
+	// 0x191
+	15711,
+	// 0x192
+	15711,
+	// 0x193
+	15711,
+	// 0x194
+	15711,
+	// 0x195
+	15711,
+	// 0x196
+	15711,
+	// 0x197
+	15711,
+	// 0x198
+	15711,
+	// 0x199
+	15711,
+	// 0x19a
+	15711,
+	// 0x19b
+	15711,
+	// 0x19c
+	15711,
+	// 0x19d
+	15711,
+	// 0x19e
+	15711,
+	// 0x19f
+	15711,
+	// 0x1a0
+	15736,
+	// 0x1a1
+	15736,
+	// 0x1a2
+	15736,
+	// 0x1a3
+	15736,
+	// 0x1a4
+	15736,
+	// 0x1a5
+	15736,
+	// 0x1a6
+	15736,
+	// 0x1a7
+	15736,
+	// 0x1a8
+	15736,
+	// 0x1a9
+	15736,
+	// 0x1aa
+	15736,
+	// 0x1ab
+	15736,
+	// 0x1ac
+	15736,
+	// 0x1ad
+	15736,
+	// 0x1ae
+	15736,
+	// 0x1af
+	15736,
+	// 0x1b0
+	15761,
+	// 0x1b1
+	15761,
+	// 0x1b2
+	15761,
+	// 0x1b3
+	15761,
+	// 0x1b4
+	15761,
+	// 0x1b5
+	15761,
+	// 0x1b6
+	15761,
+	// 0x1b7
+	15761,
+	// 0x1b8
+	15761,
+	// 0x1b9
+	15761,
+	// 0x1ba
+	15761,
+	// 0x1bb
+	15761,
+	// 0x1bc
+	15761,
+	// 0x1bd
+	15761,
+	// 0x1be
+	15761,
+	// 0x1bf
+	15761,
+	// 0x1c0
+	15786, 15810, 15834, 15858, 15882, 15906, 15930, 15954, 15978, 16002, 16026, 16050, 16074, 16098, 16122, 16146, 16170, 16194, 16218, 16242, 16266, 16290, 16314, 16338, 16362, 16386, 16410, 16434, 16458, 16482, 16506, 16530, 16554, 16578, 16602, 16626, 16650, 16674, 16698, 16722, 16746, 16770, 16794, 16818, 16842, 16866, 16890, 16914, 16938, 16962, 16986, 17010, 17034, 17058, 17082, 17106, 17130, 17154, 17178, 17202, 17226, 17250, 17274, 17298,
+	// 0x1c1
+	17322, 17346, 17370, 17394, 17418, 17442, 17466, 17490, 17514, 17538, 17562, 17586, 17610, 17634, 17658, 17682, 17706, 17730, 17754, 17778, 17802, 17826, 17850, 17874, 17898, 17922, 17946, 17970, 17994, 18018, 18042, 18066, 18090, 18114, 18138, 18162, 18186, 18210, 18234, 18258, 18282, 18306, 18330, 18354, 18378, 18402, 18426, 18450, 18474, 18498, 18522, 18546, 18570, 18594, 18618, 18642, 18666, 18690, 18714, 18738, 18762, 18786, 18810, 18834, 18858, 18882, 18906, 18930, 18954, 18978, 19002, 19026, 19050, 19074, 19098, 19122, 19146, 19170, 19194, 19218,
+	// 0x1c2
+	19242, 19266, 19290, 19314, 19338, 19362, 19386, 19410, 19434, 19458, 19482, 19506, 19530, 19554, 19578, 19602, 19626, 19650, 19674, 19698, 19722, 19746, 19770, 19794, 19818, 19842, 19866, 19890, 19914, 19938, 19962, 19986, 20010, 20034, 20058, 20082, 20106, 20130, 20154, 20178, 20202, 20226, 20250, 20274, 20298, 20322, 20346, 20370, 20394, 20418, 20442, 20466, 20490, 20514, 20538, 20562, 20586, 20610, 20634, 20658, 20682, 20706, 20730, 20754, 20778, 20802, 20826, 20850,
+	// 0x1c3
+	20874, 20898, 20922, 20946, 20970, 20994, 21018, 21042, 21066, 21090, 21114, 21138, 21162, 21186, 21210, 21234, 21258, 21282, 21306, 21330, 21354, 21378, 21402, 21426, 21450, 21474, 21498, 21522, 21546, 21570, 21594, 21618, 21642, 21666, 21690, 21714, 21738, 21762, 21786, 21810, 21834, 21858, 21882, 21906, 21930, 21954, 21978, 22002, 22026, 22050, 22074, 22098, 22122, 22146, 22170, 22194, 22218, 22242,
+	// 0x1c4
+	22266, 22290, 22314, 22338, 22362, 22386, 22410, 22434, 22458, 22482, 22506, 22530, 22554, 22578, 22602, 22626, 22650, 22674, 22698, 22722, 22746, 22770, 22794, 22818, 22842, 22866, 22890, 22914, 22938, 22962, 22986, 23010, 23034, 23058, 23082, 23106, 23130, 23154, 23178, 23202, 23226, 23250, 23274, 23298, 23322, 23346, 23370, 23394, 23418, 23442, 23466, 23490, 23514, 23538, 23562, 23586, 23610, 23634, 23658, 23682, 23706, 23730, 23754, 23778, 23802, 23826, 23850, 23874, 23898, 23922, 23946, 23970, 23994, 24018, 24042, 24066, 24090, 24114, 24138, 24162, 24186, 24210, 24234, 24258,
+	// 0x1c5
+	24282, 24306, 24330, 24354, 24378, 24402, 24426, 24450, 24474, 24498, 24522, 24546, 24570, 24594, 24618,
+	// 0x1ca
+	26256, 26277, 26298, 26319, 26340, 26361, 26382, 26403, 26424, 26445, 25794, 25815, 25836, 25857, 25878, 25899, 25920, 25941, 25962, 25983, 26004, 26025, 26046, 26067, 26088, 26109, 26130, 26151, 26172, 26193, 26214, 26235, 26466, 26487, 26508, 26529, 26550, 26571, 26592, 26613, 26634, 26655, 26676, 26697, 26718, 26739, 26760, 26781, 26802, 26823, 26844, 26865, 26886, 26907, 26928, 26949, 26970, 26991, 27012, 27033, 27054, 27075, 27096, 27117, 27138, 27159, 27180, 27201, 27222, 27243, 27264, 27285, 27306, 27327, 27348, 27369, 27390, 27411, 27432, 27453, 27474, 27495, 27516, 27537, 27558, 27579, 27600, 27621, 27642, 27663, 27684, 27705, 27726, 27747, 27768, 27789, 27810, 27831, 27852, 27948, 28044, 27876, 27972, 28068, 24642, 24666, 24690, 24714, 24738, 24762, 24786, 24810, 24834, 24858, 24882, 24906, 24930, 24954, 24978, 25002, 25026, 25050, 25074, 25098, 25122, 25146, 25170, 25194, 25218, 25242, 25266, 25290, 25314, 25338, 25362, 25386, 25410, 25434, 25458, 25482, 25506, 25530, 25554, 25578, 25602, 25626, 25650, 25674, 25698, 25722, 25746, 25770, 27900, 27996, 28092, 27924, 28020, 28116,
+	// 0x1cb
+	28212, 28308, 28404, 28500, 28188, 28284, 28380, 28476, 28164, 28260, 28356, 28452, 28140, 28236, 28332, 28428,
+	// 0x1cc
+	28596, 28689, 28524, 28617, 28710, 28806, 28902, 28998, 29094, 29190, 29286, 29382, 28548, 28641, 28734, 28830, 28926, 29022, 29118, 29214, 29310, 29406, 28572, 28665, 28758, 28854, 28950, 29046, 29142, 29238, 29334, 29430, 28782, 28878, 28974, 29070, 29166, 29262, 29358, 29454,
+	// 0x1cd
+	29478, 29574, 29670, 29766, 29862, 29958, 30054, 30150, 30246, 30342, 30438, 30534, 29502, 29598, 29694, 29790, 29886, 29982, 30078, 30174, 30270, 30366, 30462, 30558, 29526, 29622, 29718, 29814, 29910, 30006, 30102, 30198, 30294, 30390, 30486, 30582, 29550, 29646, 29742, 29838, 29934, 30030, 30126, 30222, 30318, 30414, 30510, 30606,
+	// 0x1ce
+	30630, 30654, 30678, 30702, 30726, 30750, 30774, 30798,
+	// 0x1cf
+	30822, 30846, 30870, 30894, 30918, 30942, 30963,
+	// 0x1d0
+	30987, 31011, 31035, 31059, 31083, 31107, 31131, 31155, 31179, 31203, 31227, 31251, 31275, 31299, 31323, 31347, 31371, 31395, 31419, 31443, 31467, 31491, 31515, 31539, 31563, 31587, 31611, 31635, 31659, 31683, 31707, 31731, 31755, 31779, 31803, 31827, 31851, 31875, 31899, 31923, 31947, 31971, 31995, 32019, 32043, 32067, 32091, 32115, 32139, 32163, 32187, 32211, 32235, 32259, 32283, 32307, 32331, 32355, 32379, 32403, 32427, 32451, 32475, 32499,
+	// 0x1d1
+	32523, 32547, 32571, 32595, 32619, 32643, 32667, 32691, 32715, 32739, 32763, 32787, 32811, 32835, 32859, 32883, 32907, 32931, 32955, 32979, 33003, 33027, 33051, 33075, 33099, 33123, 33147, 33171, 33195, 33219, 33243, 33267, 33291, 33315, 33339, 33363, 33387, 33411, 33435, 33459, 33483, 33507, 33531, 33555, 33579, 33603, 33627, 33651, 33675, 33699, 33723, 33747, 33771, 33795, 33819, 33843, 33867, 33891, 33915, 33939, 33963, 33987, 34011, 34035, 34059, 34083, 34107, 34131, 34155, 34179, 34203, 34227, 34251, 34275, 34299, 34323, 34347, 34371, 34395, 34419,
+	// 0x1d2
+	34443, 34467, 34491, 34515, 34539, 34563, 34587, 34611, 34635, 34659, 34683, 34707, 34731, 34755, 34779, 34803, 34827, 34851, 34875, 34899, 34923, 34947, 34971, 34995, 35019, 35043, 35067, 35091, 35115, 35139, 35163, 35187, 35211, 35235, 35259, 35283, 35307, 35331, 35355, 35379, 35403, 35427, 35451, 35475, 35499, 35523, 35547, 35571, 35595, 35619, 35643, 35667, 35691, 35715, 35739, 35763, 35787, 35811, 35835, 35859, 35883, 35907, 35931, 35955, 35979, 36003, 36027, 36051,
+	// 0x1d3
+	36075, 36099, 36123, 36147, 36171, 36195, 36219, 36243, 36267, 36291, 36315, 36339, 36363, 36387, 36411, 36435, 36459, 36483, 36507, 36531, 36555, 36579, 36603, 36627, 36651, 36675, 36699, 36723, 36747, 36771, 36795, 36819, 36843, 36867, 36891, 36915, 36939, 36963, 36987, 37011, 37035, 37059, 37083, 37107, 37131, 37155, 37179, 37203, 37227, 37251, 37275, 37299, 37323, 37347, 37371, 37395, 37419, 37443,
+	// 0x1d4
+	37467, 37491, 37515, 37539, 37563, 37587, 37611, 37635, 37659, 37683, 37707, 37731, 37755, 37779, 37803, 37827, 37851, 37875, 37899, 37923, 37947, 37971, 37995, 38019, 38043, 38067, 38091, 38115, 38139, 38163, 38187, 38211, 38235, 38259, 38283, 38307, 38331, 38355, 38379, 38403, 38427, 38451, 38475, 38499, 38523, 38547, 38571, 38595, 38619, 38643, 38667, 38691, 38715, 38739, 38763, 38787, 38811, 38835, 38859, 38883, 38907, 38931, 38955, 38979, 39003, 39027, 39051, 39075, 39099, 39123, 39147, 39171, 39195, 39219, 39243, 39267, 39291, 39315, 39339, 39363, 39387, 39411, 39435, 39459,
+	// 0x1d5
+	39483, 39507, 39531, 39555, 39579, 39603, 39627, 39651, 39675, 39699, 39723, 39747, 39771, 39795, 39819, 39843,
+	// 0x1da
+	41481, 41502, 41523, 41544, 41565, 41586, 41607, 41628, 41649, 41670, 41019, 41040, 41061, 41082, 41103, 41124, 41145, 41166, 41187, 41208, 41229, 41250, 41271, 41292, 41313, 41334, 41355, 41376, 41397, 41418, 41439, 41460, 41691, 41712, 41733, 41754, 41775, 41796, 41817, 41838, 41859, 41880, 41901, 41922, 41943, 41964, 41985, 42006, 42027, 42048, 42069, 42090, 42111, 42132, 42153, 42174, 42195, 42216, 42237, 42258, 42279, 42300, 42321, 42342, 42363, 42384, 42405, 42426, 42447, 42468, 42489, 42510, 42531, 42552, 42573, 42594, 42615, 42636, 42657, 42678, 42699, 42720, 42741, 42762, 42783, 42804, 42825, 42846, 42867, 42888, 42909, 42930, 42951, 42972, 42993, 43014, 43035, 43056, 43077, 43098, 43119, 43140, 43161, 43182, 43203, 43224, 43245, 43266, 43287, 43308, 43329, 43425, 43521, 43353, 43449, 43545, 39867, 39891, 39915, 39939, 39963, 39987, 40011, 40035, 40059, 40083, 40107, 40131, 40155, 40179, 40203, 40227, 40251, 40275, 40299, 40323, 40347, 40371, 40395, 40419, 40443, 40467, 40491, 40515, 40539, 40563, 40587, 40611, 40635, 40659, 40683, 40707, 40731, 40755, 40779, 40803, 40827, 40851, 40875, 40899, 40923, 40947, 40971, 40995, 43377, 43473, 43569, 43401, 43497, 43593,
+	// 0x1db
+	43833, 43641, 43689, 43737, 43809, 43881, 43617, 43665, 43713, 43785, 43857, 43761,
+	// 0x1dc
+	43953, 43974, 43995, 44016, 44037, 44130, 44223, 43929, 43905, 44058, 44151, 44244, 44340, 44436, 44532, 44628, 44724, 44820, 44916, 44082, 44175, 44268, 44364, 44460, 44556, 44652, 44748, 44844, 44940, 44106, 44199, 44292, 44388, 44484, 44580, 44676, 44772, 44868, 44964, 44316, 44412, 44508, 44604, 44700, 44796, 44892, 44988,
+	// 0x1dd
+	45012, 45108, 45204, 45300, 45396, 45492, 45588, 45684, 45780, 45876, 45972, 46068, 45036, 45132, 45228, 45324, 45420, 45516, 45612, 45708, 45804, 45900, 45996, 46092, 45060, 45156, 45252, 45348, 45444, 45540, 45636, 45732, 45828, 45924, 46020, 46116, 45084, 45180, 45276, 45372, 45468, 45564, 45660, 45756, 45852, 45948, 46044, 46140,
+	// 0x1de
+	46164, 46188, 46212, 46236, 46260, 46284, 46308, 46332,
+	// 0x1df
+	46356, 46380, 46404, 46428, 46452, 46476, 46497, 46521, 46545,
+}
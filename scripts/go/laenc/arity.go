@@ -0,0 +1,11 @@
+package laenc
+
+// Arity returns the number of operands taken by the insn with the given
+// mnemonic, for checking the operand count before parsing the operands.
+func Arity(mnemonic string) (int, bool) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, false
+	}
+	return insnFormatArities[insn.fmt], true
+}
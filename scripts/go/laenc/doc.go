@@ -0,0 +1,27 @@
+// Package laenc is a standalone LoongArch instruction encoder, generated from
+// the instruction descriptions in this repository.
+//
+// All operands are passed in the canonical order, and are validated before
+// encoding, so that an out-of-range operand results in an error instead of a
+// corrupt instruction word. The encoders of the formats with only register
+// operands, like that of add.w, check them inline for speed; see
+// BenchmarkEncodeRegOnly.
+//
+// For type safety, every format also has an encoder taking the registers as
+// distinct types per register class, like EncodeDJSk12 taking GPReg, so that
+// passing e.g. an FPReg in place of a GPReg fails to compile. The register
+// types can only be constructed with valid register numbers.
+//
+// EncodeWithReloc encodes an instruction with its relocatable operand left to
+// the linker, and EncodeAddrIdiom composes such instructions into the
+// sequences the la.* assembler macros expand to.
+//
+// The operand fields of already encoded instructions can be read and patched
+// in place with the accessors named after the fields, like GetRk and SetRk for
+// the register at bits 14..10, or GetSd5k16 for the sign-extended offset of
+// beqz; GetField and SetField look them up by name.
+package laenc
+
+//go:generate sh -c "go run ../genlaenc -typed-regs ../../../*.txt > insns.go"
+//go:generate sh -c "go run ../genlamacros ../../../*.txt > macros.go"
+//go:generate sh -c "go run ../genlafields ../../../*.txt > fields.go"
@@ -0,0 +1,512 @@
+package laenc
+
+import (
+	"math/rand"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestEncode(t *testing.T) {
+	testcases := []struct {
+		mnemonic string
+		operands []int64
+		expected uint32
+	}{
+		{mnemonic: "add.w", operands: []int64{3, 4, 5}, expected: 0x00101483},
+		{mnemonic: "addi.d", operands: []int64{4, 5, -16}, expected: 0x02ffc0a4},
+		{mnemonic: "beqz", operands: []int64{4, -1}, expected: 0x43fffc9f},
+		{mnemonic: "b", operands: []int64{-0x2000000}, expected: 0x50000200},
+		{mnemonic: "eret", operands: nil, expected: 0x06483800},
+	}
+
+	for _, tc := range testcases {
+		actual, err := Encode(tc.mnemonic, tc.operands...)
+		assert.NoError(t, err)
+		assert.Equal(t, tc.expected, actual, tc.mnemonic)
+	}
+}
+
+func TestEncodeErrors(t *testing.T) {
+	testcases := []struct {
+		mnemonic string
+		operands []int64
+		errMsg   string
+	}{
+		{
+			mnemonic: "addi.d",
+			operands: []int64{4, 5, 2048},
+			errMsg:   "operand 2 (sk12): signed immediate 2048 out of range [-2048, 2047]",
+		},
+		{
+			mnemonic: "addi.d",
+			operands: []int64{4, 32, 0},
+			errMsg:   "operand 1 (j): integer register 32 out of range [0, 31]",
+		},
+		{
+			mnemonic: "andi",
+			operands: []int64{4, 5, -1},
+			errMsg:   "operand 2 (uk12): unsigned immediate -1 out of range [0, 4095]",
+		},
+		{
+			mnemonic: "fcmp.caf.s",
+			operands: []int64{8, 1, 2},
+			errMsg:   "operand 0 (cd): FCC register 8 out of range [0, 7]",
+		},
+		{
+			mnemonic: "beqz",
+			operands: []int64{4, -0x100001},
+			errMsg:   "operand 1 (sd5k16): signed immediate -1048577 out of range [-1048576, 1048575]",
+		},
+		{
+			mnemonic: "vpickve2gr.b",
+			operands: []int64{4, 5, 16},
+			errMsg:   "operand 2 (uk4): element index 16 out of range [0, 15]",
+		},
+		{
+			mnemonic: "cacop",
+			operands: []int64{4, 32, 0},
+			errMsg:   "operand 1 (ud5): cache operation code 32 out of range [0, 31]",
+		},
+		{
+			mnemonic: "cacop",
+			operands: []int64{4, 0, 2048},
+			errMsg:   "operand 2 (sk12): signed immediate 2048 out of range [-2048, 2047]",
+		},
+		{
+			mnemonic: "vextrins.b",
+			operands: []int64{4, 5, 256},
+			errMsg:   "operand 2 (uk8): unsigned immediate 256 out of range [0, 255]",
+		},
+		{
+			mnemonic: "add.w",
+			operands: []int64{1, 2, -1},
+			errMsg:   "operand 2 (k): integer register -1 out of range [0, 31]",
+		},
+		{
+			mnemonic: "add.w",
+			operands: []int64{1, 2},
+			errMsg:   "add.w: want 3 operand(s), got 2",
+		},
+		{
+			mnemonic: "foo",
+			operands: nil,
+			errMsg:   `unknown mnemonic "foo"`,
+		},
+	}
+
+	for _, tc := range testcases {
+		actual, err := Encode(tc.mnemonic, tc.operands...)
+		assert.EqualError(t, err, tc.errMsg)
+		assert.Equal(t, uint32(0), actual)
+	}
+
+	_, err := Encode("addi.d", 4, 5, 2048)
+	var operandErr *OperandError
+	assert.ErrorAs(t, err, &operandErr)
+	assert.Equal(t, 2, operandErr.Index)
+	assert.Equal(t, int64(-2048), operandErr.Min)
+	assert.Equal(t, int64(2047), operandErr.Max)
+}
+
+// BenchmarkEncodeRegOnly compares the encoder of the register-only format
+// DJK, with its inline checks, against validating then encoding separately,
+// as done for the other formats.
+func BenchmarkEncodeRegOnly(b *testing.B) {
+	const bits = 0x00100000 // add.w
+
+	b.Run("fused", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			r := int64(i & 0x1f)
+			if _, err := encodeDJK(bits, r, r, r); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("generic", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			r := int64(i & 0x1f)
+			if err := validateDJK(r, r, r); err != nil {
+				b.Fatal(err)
+			}
+			_ = bits | (uint32(r) & 0x1f) | (uint32(r)&0x1f)<<5 | (uint32(r)&0x1f)<<10
+		}
+	})
+
+	b.Run("Encode", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			r := int64(i & 0x1f)
+			if _, err := Encode("add.w", r, r, r); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
+
+func TestEncodeMatchesInterpretiveEncoder(t *testing.T) {
+	descs := common.Builtin()
+	assert.Equal(t, len(descs), len(insns))
+
+	rng := rand.New(rand.NewSource(1))
+	for _, d := range descs {
+		args := make([]int64, len(d.Format.Args))
+		for i, a := range d.Format.Args {
+			args[i] = a.MinValue() + rng.Int63n(a.MaxValue()-a.MinValue()+1)
+		}
+
+		actual, err := Encode(d.Mnemonic, args...)
+		assert.NoError(t, err)
+		assert.Equal(t, d.Encode(args), actual, "%s %v", d.Mnemonic, args)
+	}
+}
+
+func TestEncodeWithReloc(t *testing.T) {
+	// la.got $a0, sym
+	//   pcalau12i $a0, %got_pc_hi20(sym)
+	//   ld.d      $a0, $a0, %got_pc_lo12(sym)
+	word, reloc, err := EncodeWithReloc("pcalau12i", []int64{4, 0}, "sym", RelocGOTPCHi20)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0x1a000004), word)
+	assert.Equal(t, RelocRecord{Type: RelocGOTPCHi20, Sym: "sym", Addend: 0, Operand: 1}, reloc)
+
+	word, reloc, err = EncodeWithReloc("ld.d", []int64{4, 4, 8}, "sym", RelocGOTPCLo12)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0x28c00084), word)
+	assert.Equal(t, RelocRecord{Type: RelocGOTPCLo12, Sym: "sym", Addend: 8, Operand: 2}, reloc)
+	assert.Equal(t, "R_LARCH_GOT_PC_LO12", reloc.Type.String())
+
+	_, _, err = EncodeWithReloc("ld.d", []int64{4, 4, 0}, "sym", RelocGOTPCHi20)
+	assert.EqualError(t, err, "ld.d: cannot apply R_LARCH_GOT_PC_HI20: operand is 12 bits wide, relocation fills in 20 bits")
+
+	_, _, err = EncodeWithReloc("add.d", []int64{4, 4, 5}, "sym", RelocAbsLo12)
+	var relocErr *RelocError
+	assert.ErrorAs(t, err, &relocErr)
+	assert.Equal(t, "no relocatable operand", relocErr.Reason)
+
+	_, _, err = EncodeWithReloc("ld.d", []int64{4, 32, 0}, "sym", RelocGOTPCLo12)
+	assert.EqualError(t, err, "operand 1 (j): integer register 32 out of range [0, 31]")
+}
+
+func TestEncodeAddrIdiom(t *testing.T) {
+	// GNU as, la.local $a0, sym; objdump -dr:
+	//
+	//	0:	1a000004 	pcalau12i   	$a0, 0
+	//			0: R_LARCH_PCALA_HI20	sym
+	//	4:	02c00084 	addi.d      	$a0, $a0, 0
+	//			4: R_LARCH_PCALA_LO12	sym
+	words, relocs, err := EncodeAddrIdiom(IdiomLaLocal, 4, "sym", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{0x1a000004, 0x02c00084}, words)
+	assert.Equal(t, []RelocRecord{
+		{Type: RelocPCALAHi20, Sym: "sym", Addend: 0, Operand: 1},
+		{Type: RelocPCALALo12, Sym: "sym", Addend: 0, Operand: 2},
+	}, relocs)
+
+	// GNU as, la.got $t0, sym+16:
+	//
+	//	0:	1a00000c 	pcalau12i   	$t0, 0
+	//			0: R_LARCH_GOT_PC_HI20	sym+0x10
+	//	4:	28c0018c 	ld.d        	$t0, $t0, 0
+	//			4: R_LARCH_GOT_PC_LO12	sym+0x10
+	words, relocs, err = EncodeAddrIdiom(IdiomLaGlobal, 12, "sym", 16)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{0x1a00000c, 0x28c0018c}, words)
+	assert.Equal(t, []RelocRecord{
+		{Type: RelocGOTPCHi20, Sym: "sym", Addend: 16, Operand: 1},
+		{Type: RelocGOTPCLo12, Sym: "sym", Addend: 16, Operand: 2},
+	}, relocs)
+
+	// la.pcrel is an alias of la.local
+	pcrelWords, pcrelRelocs, err := EncodeAddrIdiom(IdiomLaPcrel, 4, "sym", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{0x1a000004, 0x02c00084}, pcrelWords)
+	assert.Equal(t, RelocPCALAHi20, pcrelRelocs[0].Type)
+
+	_, _, err = EncodeAddrIdiom(IdiomLaLocal, 32, "sym", 0)
+	assert.EqualError(t, err, "operand 0 (d): integer register 32 out of range [0, 31]")
+}
+
+func TestExpandMacro(t *testing.T) {
+	// the expansions by GNU as, for $a0 = $r4 and $a1 = $r5
+	testcases := []struct {
+		name     string
+		operands []int64
+		expected []uint32
+	}{
+		{name: "nop", expected: []uint32{0x03400000}},
+		{name: "move", operands: []int64{4, 5}, expected: []uint32{0x001500a4}},
+		{name: "ret", expected: []uint32{0x4c000020}},
+		// bltz $a0, 16
+		{name: "bltz", operands: []int64{4, 4}, expected: []uint32{0x60001080}},
+		{name: "li.w", operands: []int64{4, -1}, expected: []uint32{0x02bffc04}},
+		{name: "li.w", operands: []int64{4, 0x800}, expected: []uint32{0x03a00004}},
+		{name: "li.w", operands: []int64{4, 0x12345678}, expected: []uint32{0x142468a4, 0x0399e084}},
+		{
+			name:     "li.d",
+			operands: []int64{4, 0x123456789abcdef0},
+			expected: []uint32{0x153579a4, 0x03bbc084, 0x168acf04, 0x03048c84},
+		},
+	}
+
+	for _, tc := range testcases {
+		words, relocs, err := ExpandMacro(tc.name, "", tc.operands...)
+		assert.NoError(t, err, tc.name)
+		assert.Equal(t, tc.expected, words, "%s %v", tc.name, tc.operands)
+		assert.Equal(t, make([]RelocRecord, len(tc.expected)), relocs, tc.name)
+	}
+
+	// la.abs $a0, sym+4
+	words, relocs, err := ExpandMacro("la.abs", "sym", 4, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint32{0x14000004, 0x03800084, 0x16000004, 0x03000084}, words)
+	assert.Equal(t, []RelocRecord{
+		{Type: RelocAbsHi20, Sym: "sym", Addend: 4, Operand: 1},
+		{Type: RelocAbsLo12, Sym: "sym", Addend: 4, Operand: 2},
+		{Type: RelocAbs64Lo20, Sym: "sym", Addend: 4, Operand: 1},
+		{Type: RelocAbs64Hi12, Sym: "sym", Addend: 4, Operand: 2},
+	}, relocs)
+
+	_, _, err = ExpandMacro("li.w", "", 4, 1<<40)
+	assert.EqualError(t, err, "operand 1 (v): signed immediate 1099511627776 out of range [-2147483648, 2147483647]")
+
+	_, _, err = ExpandMacro("move", "", 4)
+	assert.EqualError(t, err, "move: want 2 operand(s), got 1")
+
+	_, _, err = ExpandMacro("li.q", "", 4, 0)
+	assert.EqualError(t, err, `unknown mnemonic "li.q"`)
+}
+
+func TestExpandMacroLiD(t *testing.T) {
+	// the expansion of li.d reconstructs the value, by simulating the insns
+	rng := rand.New(rand.NewSource(1))
+	for i := 0; i < 1000; i++ {
+		v := rng.Int63() >> rng.Intn(63)
+		if rng.Intn(2) == 0 {
+			v = -v
+		}
+
+		words, _, err := ExpandMacro("li.d", "", 4, v)
+		assert.NoError(t, err)
+
+		var rd int64
+		for _, w := range words {
+			switch {
+			case w&0xffc00000 == 0x02800000: // addi.w
+				rd = int64(int32(w<<10) >> 20)
+			case w&0xffc00000 == 0x03800000: // ori
+				rd |= int64(w>>10) & 0xfff
+			case w&0xfe000000 == 0x14000000: // lu12i.w
+				rd = int64(int32(w<<7)>>12) << 12
+			case w&0xfe000000 == 0x16000000: // cu32i.d
+				rd = rd&0xffffffff | int64(int32(w<<7)>>12)<<32
+			case w&0xffc00000 == 0x03000000: // cu52i.d
+				rd = rd&0xfffffffffffff | int64(w>>10)<<52
+			default:
+				t.Fatalf("unexpected insn %08x", w)
+			}
+		}
+		assert.Equal(t, v, rd, "%x", v)
+	}
+}
+
+func TestFormatFnTables(t *testing.T) {
+	// every format has an entry, skipping insnFormatUnknown
+	for f := insnFormat(1); int(f) < len(insnFormatArities); f++ {
+		assert.Contains(t, formatValidators, f)
+		assert.Contains(t, formatEncoders, f)
+	}
+	assert.Len(t, formatValidators, len(insnFormatArities)-1)
+	assert.Len(t, formatEncoders, len(insnFormatArities)-1)
+
+	// and the tables agree with Encode
+	rng := rand.New(rand.NewSource(1))
+	for mnemonic, insn := range insns {
+		operands := make([]int64, insnFormatArities[insn.fmt])
+		for i := range operands {
+			operands[i] = int64(rng.Intn(4))
+		}
+
+		expected, expectedErr := Encode(mnemonic, operands...)
+		actual, err := formatEncoders[insn.fmt](insn.bits, operands)
+		assert.Equal(t, expected, actual, mnemonic)
+		assert.Equal(t, expectedErr == nil, err == nil, mnemonic)
+		assert.Equal(t, err == nil, formatValidators[insn.fmt](operands) == nil, mnemonic)
+	}
+}
+
+func mustGPReg(t *testing.T, n uint) GPReg {
+	r, err := NewGPReg(n)
+	assert.NoError(t, err)
+	return r
+}
+
+func TestTypedEncoders(t *testing.T) {
+	r4, r5 := mustGPReg(t, 4), mustGPReg(t, 5)
+
+	word, err := EncodeDJSk12("addi.d", r4, r5, -16)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0x02ffc0a4), word)
+
+	fcc7, err := NewFCCReg(7)
+	assert.NoError(t, err)
+	f5, err := NewFPReg(5)
+	assert.NoError(t, err)
+	f6, err := NewFPReg(6)
+	assert.NoError(t, err)
+	word, err = EncodeCdFjFk("fcmp.caf.s", fcc7, f5, f6)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(0x0c1018a7), word)
+
+	_, err = EncodeDJSk12("add.w", r4, r5, 0)
+	assert.EqualError(t, err, "add.w: not of format DJSk12")
+
+	_, err = EncodeDJSk12("foo", r4, r5, 0)
+	assert.EqualError(t, err, `unknown mnemonic "foo"`)
+
+	_, err = EncodeDJSk12("addi.d", r4, r5, 2048)
+	assert.EqualError(t, err, "operand 2 (sk12): signed immediate 2048 out of range [-2048, 2047]")
+}
+
+func TestNewRegErrors(t *testing.T) {
+	_, err := NewGPReg(32)
+	assert.EqualError(t, err, "integer register 32 out of range [0, 31]")
+
+	_, err = NewFCCReg(8)
+	assert.EqualError(t, err, "FCC register 8 out of range [0, 7]")
+
+	_, err = NewScratchReg(4)
+	var regErr *RegisterError
+	assert.ErrorAs(t, err, &regErr)
+	assert.Equal(t, uint(3), regErr.Max)
+
+	r, err := NewXReg(31)
+	assert.NoError(t, err)
+	assert.Equal(t, uint(31), r.Num())
+}
+
+// TestTypedEncodersRejectMixedRegs checks that passing a register of the
+// wrong class to a typed encoder doesn't compile.
+func TestTypedEncodersRejectMixedRegs(t *testing.T) {
+	goTool, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go tool not found")
+	}
+
+	// the error messages differ a bit between Go versions
+	testcases := []struct {
+		stmt   string
+		errMsg string
+	}{
+		{
+			stmt:   "f, _ := laenc.NewFPReg(1)\n\tr, _ := laenc.NewGPReg(2)\n\t_, _ = laenc.EncodeDJK(\"add.w\", f, r, r)",
+			errMsg: "cannot use f",
+		},
+		{
+			stmt:   "_, _ = laenc.EncodeDJK(\"add.w\", 1, 2, 3)",
+			errMsg: "cannot use 1",
+		},
+		{
+			stmt:   "_ = laenc.GPReg{n: 32}",
+			errMsg: "field n in struct literal",
+		},
+	}
+
+	for _, tc := range testcases {
+		dir := t.TempDir()
+		src := "package main\n\nimport \"github.com/loongson-community/loongarch-opcodes/scripts/go/laenc\"\n\nfunc main() {\n\t" + tc.stmt + "\n}\n"
+		path := filepath.Join(dir, "main.go")
+		assert.NoError(t, os.WriteFile(path, []byte(src), 0644))
+
+		out, err := exec.Command(goTool, "vet", path).CombinedOutput()
+		assert.Error(t, err, tc.stmt)
+		assert.Contains(t, string(out), tc.errMsg, tc.stmt)
+	}
+}
+
+func TestFieldsRoundTrip(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for name, f := range fields {
+		min, max := int64(0), int64(1)<<f.width-1
+		if f.signed {
+			min, max = -(max+1)/2, max/2
+		}
+
+		for _, v := range []int64{min, max, min + rng.Int63n(max-min+1)} {
+			for _, word := range []uint32{0, 0xffffffff, rng.Uint32()} {
+				set := f.set(word, v)
+				assert.Equal(t, v, f.get(set), "%s = %d in %08x", name, v, word)
+
+				// the other bits are kept
+				cleared := f.set(word, 0)
+				assert.Equal(t, word&cleared, set&cleared, name)
+				assert.Equal(t, word|^f.set(0xffffffff, 0), set|^f.set(0xffffffff, 0), name)
+			}
+		}
+
+		// out-of-range values are truncated
+		assert.Equal(t, f.set(0, min), f.set(0, min+(max-min+1)), name)
+	}
+
+	word, err := Encode("add.w", 3, 4, 5)
+	assert.NoError(t, err)
+	assert.Equal(t, uint32(5), GetRk(word))
+	assert.Equal(t, uint32(0x00101c83), SetRk(word, 7))
+
+	word, ok := SetField("sd5k16", 0x40000000, -1)
+	assert.True(t, ok)
+	assert.Equal(t, uint32(0x43fffc1f), word)
+	v, ok := GetField("sd5k16", word)
+	assert.True(t, ok)
+	assert.Equal(t, int64(-1), v)
+
+	_, ok = GetField("foo", word)
+	assert.False(t, ok)
+}
+
+// TestFieldsMatchInterpretiveDecoder checks the accessors against
+// common.Arg.Extract for every arg of every insn.
+func TestFieldsMatchInterpretiveDecoder(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, d := range common.Builtin() {
+		word := d.Word | rng.Uint32()&^d.FixedMask()
+		for _, a := range d.Format.Args {
+			name := strings.ToLower(a.CanonicalRepr())
+			if !a.Kind.IsImm() {
+				name = "r" + name[len(name)-1:]
+				switch a.Kind {
+				case common.ArgKindFCCReg:
+					name = "c" + name[1:]
+				case common.ArgKindScratchReg:
+					name = "t" + name[1:]
+				}
+			}
+
+			v, ok := GetField(name, word)
+			if assert.True(t, ok, "%s: %s", d.Mnemonic, name) {
+				assert.Equal(t, a.Extract(word), v, "%s: %s", d.Mnemonic, name)
+			}
+		}
+	}
+}
+
+func TestArity(t *testing.T) {
+	for _, d := range common.Builtin() {
+		n, ok := Arity(d.Mnemonic)
+		assert.True(t, ok, d.Mnemonic)
+		assert.Equal(t, d.Format.ArgCount(), n, d.Mnemonic)
+	}
+
+	n, ok := Arity("tlbclr")
+	assert.True(t, ok)
+	assert.Zero(t, n)
+
+	_, ok = Arity("foo")
+	assert.False(t, ok)
+}
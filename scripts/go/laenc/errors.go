@@ -0,0 +1,88 @@
+package laenc
+
+import "fmt"
+
+type UnknownMnemonicError struct {
+	Mnemonic string
+}
+
+func (e *UnknownMnemonicError) Error() string {
+	return fmt.Sprintf("unknown mnemonic %q", e.Mnemonic)
+}
+
+type ArityError struct {
+	Mnemonic string
+	Want     int
+	Got      int
+}
+
+func (e *ArityError) Error() string {
+	return fmt.Sprintf("%s: want %d operand(s), got %d", e.Mnemonic, e.Want, e.Got)
+}
+
+// OperandError is returned when an operand is out of its valid range.
+type OperandError struct {
+	// Index is the 0-based index of the operand in canonical order.
+	Index int
+	// Name is the operand's name in the format's canonical representation,
+	// e.g. "sk12".
+	Name  string
+	Kind  string
+	Value int64
+	Min   int64
+	Max   int64
+}
+
+func (e *OperandError) Error() string {
+	return fmt.Sprintf(
+		"operand %d (%s): %s %d out of range [%d, %d]",
+		e.Index,
+		e.Name,
+		e.Kind,
+		e.Value,
+		e.Min,
+		e.Max,
+	)
+}
+
+// AlignmentError is returned when an operand taken shifted left, as written in
+// assembly, is not a multiple of the amount it is scaled by.
+type AlignmentError struct {
+	Index    int
+	Name     string
+	Value    int64
+	Multiple int64
+}
+
+func (e *AlignmentError) Error() string {
+	return fmt.Sprintf(
+		"operand %d (%s): %d is not a multiple of %d",
+		e.Index,
+		e.Name,
+		e.Value,
+		e.Multiple,
+	)
+}
+
+// RegisterError is returned when constructing a register with a number out
+// of the range of its register class.
+type RegisterError struct {
+	Kind string
+	Num  uint
+	Max  uint
+}
+
+func (e *RegisterError) Error() string {
+	return fmt.Sprintf("%s %d out of range [0, %d]", e.Kind, e.Num, e.Max)
+}
+
+// FormatMismatchError is returned by the typed per-format encoders when the
+// instruction is not of their format.
+type FormatMismatchError struct {
+	Mnemonic string
+	Format   string
+}
+
+func (e *FormatMismatchError) Error() string {
+	return fmt.Sprintf("%s: not of format %s", e.Mnemonic, e.Format)
+}
@@ -0,0 +1,30 @@
+package laenc
+
+type fieldAccessor struct {
+	width  uint
+	signed bool
+	get    func(word uint32) int64
+	set    func(word uint32, v int64) uint32
+}
+
+// GetField returns the value of the operand field with the given name of the
+// insn word, like the Get function of the field, e.g. GetField("rk", word)
+// for GetRk(word). The names are those of the Get functions in lower case.
+func GetField(name string, word uint32) (int64, bool) {
+	f, ok := fields[name]
+	if !ok {
+		return 0, false
+	}
+	return f.get(word), true
+}
+
+// SetField returns the insn word with the operand field of the given name set
+// to v, truncated to the width of the field, like the Set function of the
+// field.
+func SetField(name string, word uint32, v int64) (uint32, bool) {
+	f, ok := fields[name]
+	if !ok {
+		return 0, false
+	}
+	return f.set(word, v), true
+}
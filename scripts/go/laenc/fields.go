@@ -0,0 +1,784 @@
+// Code generated by genlafields from loongson-community/loongarch-opcodes; DO NOT EDIT.
+
+package laenc
+
+// GetCa returns the ca field of the insn word, at bits 17..15.
+func GetCa(word uint32) uint32 {
+	return word >> 15 & 0x7
+}
+
+// SetCa returns the insn word with the ca field set to v, truncated to
+// its 3 bits.
+func SetCa(word uint32, v uint32) uint32 {
+	return word&^0x00038000 | (v&0x7)<<15
+}
+
+// GetCd returns the cd field of the insn word, at bits 2..0.
+func GetCd(word uint32) uint32 {
+	return word & 0x7
+}
+
+// SetCd returns the insn word with the cd field set to v, truncated to
+// its 3 bits.
+func SetCd(word uint32, v uint32) uint32 {
+	return word&^0x00000007 | v&0x7
+}
+
+// GetCj returns the cj field of the insn word, at bits 7..5.
+func GetCj(word uint32) uint32 {
+	return word >> 5 & 0x7
+}
+
+// SetCj returns the insn word with the cj field set to v, truncated to
+// its 3 bits.
+func SetCj(word uint32, v uint32) uint32 {
+	return word&^0x000000e0 | (v&0x7)<<5
+}
+
+// GetRa returns the ra field of the insn word, at bits 19..15.
+func GetRa(word uint32) uint32 {
+	return word >> 15 & 0x1f
+}
+
+// SetRa returns the insn word with the ra field set to v, truncated to
+// its 5 bits.
+func SetRa(word uint32, v uint32) uint32 {
+	return word&^0x000f8000 | (v&0x1f)<<15
+}
+
+// GetRd returns the rd field of the insn word, at bits 4..0.
+func GetRd(word uint32) uint32 {
+	return word & 0x1f
+}
+
+// SetRd returns the insn word with the rd field set to v, truncated to
+// its 5 bits.
+func SetRd(word uint32, v uint32) uint32 {
+	return word&^0x0000001f | v&0x1f
+}
+
+// GetRj returns the rj field of the insn word, at bits 9..5.
+func GetRj(word uint32) uint32 {
+	return word >> 5 & 0x1f
+}
+
+// SetRj returns the insn word with the rj field set to v, truncated to
+// its 5 bits.
+func SetRj(word uint32, v uint32) uint32 {
+	return word&^0x000003e0 | (v&0x1f)<<5
+}
+
+// GetRk returns the rk field of the insn word, at bits 14..10.
+func GetRk(word uint32) uint32 {
+	return word >> 10 & 0x1f
+}
+
+// SetRk returns the insn word with the rk field set to v, truncated to
+// its 5 bits.
+func SetRk(word uint32, v uint32) uint32 {
+	return word&^0x00007c00 | (v&0x1f)<<10
+}
+
+// GetSd10k16 returns the sd10k16 field of the insn word, at bits 9..0 and 25..10,
+// sign-extended.
+func GetSd10k16(word uint32) int32 {
+	return int32(((word&0x3ff)<<16|word>>10&0xffff)<<6) >> 6
+}
+
+// SetSd10k16 returns the insn word with the sd10k16 field set to v, truncated to
+// its 26 bits.
+func SetSd10k16(word uint32, v int32) uint32 {
+	return word&^0x03ffffff | uint32(v)>>16&0x3ff | (uint32(v)&0xffff)<<10
+}
+
+// GetSd5k16 returns the sd5k16 field of the insn word, at bits 4..0 and 25..10,
+// sign-extended.
+func GetSd5k16(word uint32) int32 {
+	return int32(((word&0x1f)<<16|word>>10&0xffff)<<11) >> 11
+}
+
+// SetSd5k16 returns the insn word with the sd5k16 field set to v, truncated to
+// its 21 bits.
+func SetSd5k16(word uint32, v int32) uint32 {
+	return word&^0x03fffc1f | uint32(v)>>16&0x1f | (uint32(v)&0xffff)<<10
+}
+
+// GetSj13 returns the sj13 field of the insn word, at bits 17..5,
+// sign-extended.
+func GetSj13(word uint32) int32 {
+	return int32(word>>5&0x1fff<<19) >> 19
+}
+
+// SetSj13 returns the insn word with the sj13 field set to v, truncated to
+// its 13 bits.
+func SetSj13(word uint32, v int32) uint32 {
+	return word&^0x0003ffe0 | (uint32(v)&0x1fff)<<5
+}
+
+// GetSj20 returns the sj20 field of the insn word, at bits 24..5,
+// sign-extended.
+func GetSj20(word uint32) int32 {
+	return int32(word>>5&0xfffff<<12) >> 12
+}
+
+// SetSj20 returns the insn word with the sj20 field set to v, truncated to
+// its 20 bits.
+func SetSj20(word uint32, v int32) uint32 {
+	return word&^0x01ffffe0 | (uint32(v)&0xfffff)<<5
+}
+
+// GetSk10 returns the sk10 field of the insn word, at bits 19..10,
+// sign-extended.
+func GetSk10(word uint32) int32 {
+	return int32(word>>10&0x3ff<<22) >> 22
+}
+
+// SetSk10 returns the insn word with the sk10 field set to v, truncated to
+// its 10 bits.
+func SetSk10(word uint32, v int32) uint32 {
+	return word&^0x000ffc00 | (uint32(v)&0x3ff)<<10
+}
+
+// GetSk11 returns the sk11 field of the insn word, at bits 20..10,
+// sign-extended.
+func GetSk11(word uint32) int32 {
+	return int32(word>>10&0x7ff<<21) >> 21
+}
+
+// SetSk11 returns the insn word with the sk11 field set to v, truncated to
+// its 11 bits.
+func SetSk11(word uint32, v int32) uint32 {
+	return word&^0x001ffc00 | (uint32(v)&0x7ff)<<10
+}
+
+// GetSk12 returns the sk12 field of the insn word, at bits 21..10,
+// sign-extended.
+func GetSk12(word uint32) int32 {
+	return int32(word>>10&0xfff<<20) >> 20
+}
+
+// SetSk12 returns the insn word with the sk12 field set to v, truncated to
+// its 12 bits.
+func SetSk12(word uint32, v int32) uint32 {
+	return word&^0x003ffc00 | (uint32(v)&0xfff)<<10
+}
+
+// GetSk14 returns the sk14 field of the insn word, at bits 23..10,
+// sign-extended.
+func GetSk14(word uint32) int32 {
+	return int32(word>>10&0x3fff<<18) >> 18
+}
+
+// SetSk14 returns the insn word with the sk14 field set to v, truncated to
+// its 14 bits.
+func SetSk14(word uint32, v int32) uint32 {
+	return word&^0x00fffc00 | (uint32(v)&0x3fff)<<10
+}
+
+// GetSk16 returns the sk16 field of the insn word, at bits 25..10,
+// sign-extended.
+func GetSk16(word uint32) int32 {
+	return int32(word>>10&0xffff<<16) >> 16
+}
+
+// SetSk16 returns the insn word with the sk16 field set to v, truncated to
+// its 16 bits.
+func SetSk16(word uint32, v int32) uint32 {
+	return word&^0x03fffc00 | (uint32(v)&0xffff)<<10
+}
+
+// GetSk5 returns the sk5 field of the insn word, at bits 14..10,
+// sign-extended.
+func GetSk5(word uint32) int32 {
+	return int32(word>>10&0x1f<<27) >> 27
+}
+
+// SetSk5 returns the insn word with the sk5 field set to v, truncated to
+// its 5 bits.
+func SetSk5(word uint32, v int32) uint32 {
+	return word&^0x00007c00 | (uint32(v)&0x1f)<<10
+}
+
+// GetSk8 returns the sk8 field of the insn word, at bits 17..10,
+// sign-extended.
+func GetSk8(word uint32) int32 {
+	return int32(word>>10&0xff<<24) >> 24
+}
+
+// SetSk8 returns the insn word with the sk8 field set to v, truncated to
+// its 8 bits.
+func SetSk8(word uint32, v int32) uint32 {
+	return word&^0x0003fc00 | (uint32(v)&0xff)<<10
+}
+
+// GetSk9 returns the sk9 field of the insn word, at bits 18..10,
+// sign-extended.
+func GetSk9(word uint32) int32 {
+	return int32(word>>10&0x1ff<<23) >> 23
+}
+
+// SetSk9 returns the insn word with the sk9 field set to v, truncated to
+// its 9 bits.
+func SetSk9(word uint32, v int32) uint32 {
+	return word&^0x0007fc00 | (uint32(v)&0x1ff)<<10
+}
+
+// GetTd returns the td field of the insn word, at bits 1..0.
+func GetTd(word uint32) uint32 {
+	return word & 0x3
+}
+
+// SetTd returns the insn word with the td field set to v, truncated to
+// its 2 bits.
+func SetTd(word uint32, v uint32) uint32 {
+	return word&^0x00000003 | v&0x3
+}
+
+// GetTj returns the tj field of the insn word, at bits 6..5.
+func GetTj(word uint32) uint32 {
+	return word >> 5 & 0x3
+}
+
+// SetTj returns the insn word with the tj field set to v, truncated to
+// its 2 bits.
+func SetTj(word uint32, v uint32) uint32 {
+	return word&^0x00000060 | (v&0x3)<<5
+}
+
+// GetUa2 returns the ua2 field of the insn word, at bits 16..15.
+func GetUa2(word uint32) uint32 {
+	return word >> 15 & 0x3
+}
+
+// SetUa2 returns the insn word with the ua2 field set to v, truncated to
+// its 2 bits.
+func SetUa2(word uint32, v uint32) uint32 {
+	return word&^0x00018000 | (v&0x3)<<15
+}
+
+// GetUa3 returns the ua3 field of the insn word, at bits 17..15.
+func GetUa3(word uint32) uint32 {
+	return word >> 15 & 0x7
+}
+
+// SetUa3 returns the insn word with the ua3 field set to v, truncated to
+// its 3 bits.
+func SetUa3(word uint32, v uint32) uint32 {
+	return word&^0x00038000 | (v&0x7)<<15
+}
+
+// GetUd15 returns the ud15 field of the insn word, at bits 14..0.
+func GetUd15(word uint32) uint32 {
+	return word & 0x7fff
+}
+
+// SetUd15 returns the insn word with the ud15 field set to v, truncated to
+// its 15 bits.
+func SetUd15(word uint32, v uint32) uint32 {
+	return word&^0x00007fff | v&0x7fff
+}
+
+// GetUd4 returns the ud4 field of the insn word, at bits 3..0.
+func GetUd4(word uint32) uint32 {
+	return word & 0xf
+}
+
+// SetUd4 returns the insn word with the ud4 field set to v, truncated to
+// its 4 bits.
+func SetUd4(word uint32, v uint32) uint32 {
+	return word&^0x0000000f | v&0xf
+}
+
+// GetUd5 returns the ud5 field of the insn word, at bits 4..0.
+func GetUd5(word uint32) uint32 {
+	return word & 0x1f
+}
+
+// SetUd5 returns the insn word with the ud5 field set to v, truncated to
+// its 5 bits.
+func SetUd5(word uint32, v uint32) uint32 {
+	return word&^0x0000001f | v&0x1f
+}
+
+// GetUj3 returns the uj3 field of the insn word, at bits 7..5.
+func GetUj3(word uint32) uint32 {
+	return word >> 5 & 0x7
+}
+
+// SetUj3 returns the insn word with the uj3 field set to v, truncated to
+// its 3 bits.
+func SetUj3(word uint32, v uint32) uint32 {
+	return word&^0x000000e0 | (v&0x7)<<5
+}
+
+// GetUj5 returns the uj5 field of the insn word, at bits 9..5.
+func GetUj5(word uint32) uint32 {
+	return word >> 5 & 0x1f
+}
+
+// SetUj5 returns the insn word with the uj5 field set to v, truncated to
+// its 5 bits.
+func SetUj5(word uint32, v uint32) uint32 {
+	return word&^0x000003e0 | (v&0x1f)<<5
+}
+
+// GetUk1 returns the uk1 field of the insn word, at bits 10..10.
+func GetUk1(word uint32) uint32 {
+	return word >> 10 & 0x1
+}
+
+// SetUk1 returns the insn word with the uk1 field set to v, truncated to
+// its 1 bits.
+func SetUk1(word uint32, v uint32) uint32 {
+	return word&^0x00000400 | (v&0x1)<<10
+}
+
+// GetUk12 returns the uk12 field of the insn word, at bits 21..10.
+func GetUk12(word uint32) uint32 {
+	return word >> 10 & 0xfff
+}
+
+// SetUk12 returns the insn word with the uk12 field set to v, truncated to
+// its 12 bits.
+func SetUk12(word uint32, v uint32) uint32 {
+	return word&^0x003ffc00 | (v&0xfff)<<10
+}
+
+// GetUk14 returns the uk14 field of the insn word, at bits 23..10.
+func GetUk14(word uint32) uint32 {
+	return word >> 10 & 0x3fff
+}
+
+// SetUk14 returns the insn word with the uk14 field set to v, truncated to
+// its 14 bits.
+func SetUk14(word uint32, v uint32) uint32 {
+	return word&^0x00fffc00 | (v&0x3fff)<<10
+}
+
+// GetUk2 returns the uk2 field of the insn word, at bits 11..10.
+func GetUk2(word uint32) uint32 {
+	return word >> 10 & 0x3
+}
+
+// SetUk2 returns the insn word with the uk2 field set to v, truncated to
+// its 2 bits.
+func SetUk2(word uint32, v uint32) uint32 {
+	return word&^0x00000c00 | (v&0x3)<<10
+}
+
+// GetUk3 returns the uk3 field of the insn word, at bits 12..10.
+func GetUk3(word uint32) uint32 {
+	return word >> 10 & 0x7
+}
+
+// SetUk3 returns the insn word with the uk3 field set to v, truncated to
+// its 3 bits.
+func SetUk3(word uint32, v uint32) uint32 {
+	return word&^0x00001c00 | (v&0x7)<<10
+}
+
+// GetUk4 returns the uk4 field of the insn word, at bits 13..10.
+func GetUk4(word uint32) uint32 {
+	return word >> 10 & 0xf
+}
+
+// SetUk4 returns the insn word with the uk4 field set to v, truncated to
+// its 4 bits.
+func SetUk4(word uint32, v uint32) uint32 {
+	return word&^0x00003c00 | (v&0xf)<<10
+}
+
+// GetUk5 returns the uk5 field of the insn word, at bits 14..10.
+func GetUk5(word uint32) uint32 {
+	return word >> 10 & 0x1f
+}
+
+// SetUk5 returns the insn word with the uk5 field set to v, truncated to
+// its 5 bits.
+func SetUk5(word uint32, v uint32) uint32 {
+	return word&^0x00007c00 | (v&0x1f)<<10
+}
+
+// GetUk6 returns the uk6 field of the insn word, at bits 15..10.
+func GetUk6(word uint32) uint32 {
+	return word >> 10 & 0x3f
+}
+
+// SetUk6 returns the insn word with the uk6 field set to v, truncated to
+// its 6 bits.
+func SetUk6(word uint32, v uint32) uint32 {
+	return word&^0x0000fc00 | (v&0x3f)<<10
+}
+
+// GetUk7 returns the uk7 field of the insn word, at bits 16..10.
+func GetUk7(word uint32) uint32 {
+	return word >> 10 & 0x7f
+}
+
+// SetUk7 returns the insn word with the uk7 field set to v, truncated to
+// its 7 bits.
+func SetUk7(word uint32, v uint32) uint32 {
+	return word&^0x0001fc00 | (v&0x7f)<<10
+}
+
+// GetUk8 returns the uk8 field of the insn word, at bits 17..10.
+func GetUk8(word uint32) uint32 {
+	return word >> 10 & 0xff
+}
+
+// SetUk8 returns the insn word with the uk8 field set to v, truncated to
+// its 8 bits.
+func SetUk8(word uint32, v uint32) uint32 {
+	return word&^0x0003fc00 | (v&0xff)<<10
+}
+
+// GetUm5 returns the um5 field of the insn word, at bits 20..16.
+func GetUm5(word uint32) uint32 {
+	return word >> 16 & 0x1f
+}
+
+// SetUm5 returns the insn word with the um5 field set to v, truncated to
+// its 5 bits.
+func SetUm5(word uint32, v uint32) uint32 {
+	return word&^0x001f0000 | (v&0x1f)<<16
+}
+
+// GetUm6 returns the um6 field of the insn word, at bits 21..16.
+func GetUm6(word uint32) uint32 {
+	return word >> 16 & 0x3f
+}
+
+// SetUm6 returns the insn word with the um6 field set to v, truncated to
+// its 6 bits.
+func SetUm6(word uint32, v uint32) uint32 {
+	return word&^0x003f0000 | (v&0x3f)<<16
+}
+
+// GetUn1 returns the un1 field of the insn word, at bits 18..18.
+func GetUn1(word uint32) uint32 {
+	return word >> 18 & 0x1
+}
+
+// SetUn1 returns the insn word with the un1 field set to v, truncated to
+// its 1 bits.
+func SetUn1(word uint32, v uint32) uint32 {
+	return word&^0x00040000 | (v&0x1)<<18
+}
+
+// GetUn2 returns the un2 field of the insn word, at bits 19..18.
+func GetUn2(word uint32) uint32 {
+	return word >> 18 & 0x3
+}
+
+// SetUn2 returns the insn word with the un2 field set to v, truncated to
+// its 2 bits.
+func SetUn2(word uint32, v uint32) uint32 {
+	return word&^0x000c0000 | (v&0x3)<<18
+}
+
+// GetUn3 returns the un3 field of the insn word, at bits 20..18.
+func GetUn3(word uint32) uint32 {
+	return word >> 18 & 0x7
+}
+
+// SetUn3 returns the insn word with the un3 field set to v, truncated to
+// its 3 bits.
+func SetUn3(word uint32, v uint32) uint32 {
+	return word&^0x001c0000 | (v&0x7)<<18
+}
+
+// GetUn4 returns the un4 field of the insn word, at bits 21..18.
+func GetUn4(word uint32) uint32 {
+	return word >> 18 & 0xf
+}
+
+// SetUn4 returns the insn word with the un4 field set to v, truncated to
+// its 4 bits.
+func SetUn4(word uint32, v uint32) uint32 {
+	return word&^0x003c0000 | (v&0xf)<<18
+}
+
+// GetUn5 returns the un5 field of the insn word, at bits 22..18.
+func GetUn5(word uint32) uint32 {
+	return word >> 18 & 0x1f
+}
+
+// SetUn5 returns the insn word with the un5 field set to v, truncated to
+// its 5 bits.
+func SetUn5(word uint32, v uint32) uint32 {
+	return word&^0x007c0000 | (v&0x1f)<<18
+}
+
+// fields maps the names of the fields in lower case to their accessors.
+var fields = map[string]fieldAccessor{
+	"ca": {
+		width:  3,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetCa(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetCa(word, uint32(v)) },
+	},
+	"cd": {
+		width:  3,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetCd(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetCd(word, uint32(v)) },
+	},
+	"cj": {
+		width:  3,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetCj(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetCj(word, uint32(v)) },
+	},
+	"ra": {
+		width:  5,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetRa(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetRa(word, uint32(v)) },
+	},
+	"rd": {
+		width:  5,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetRd(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetRd(word, uint32(v)) },
+	},
+	"rj": {
+		width:  5,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetRj(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetRj(word, uint32(v)) },
+	},
+	"rk": {
+		width:  5,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetRk(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetRk(word, uint32(v)) },
+	},
+	"sd10k16": {
+		width:  26,
+		signed: true,
+		get:    func(word uint32) int64 { return int64(GetSd10k16(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetSd10k16(word, int32(v)) },
+	},
+	"sd5k16": {
+		width:  21,
+		signed: true,
+		get:    func(word uint32) int64 { return int64(GetSd5k16(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetSd5k16(word, int32(v)) },
+	},
+	"sj13": {
+		width:  13,
+		signed: true,
+		get:    func(word uint32) int64 { return int64(GetSj13(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetSj13(word, int32(v)) },
+	},
+	"sj20": {
+		width:  20,
+		signed: true,
+		get:    func(word uint32) int64 { return int64(GetSj20(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetSj20(word, int32(v)) },
+	},
+	"sk10": {
+		width:  10,
+		signed: true,
+		get:    func(word uint32) int64 { return int64(GetSk10(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetSk10(word, int32(v)) },
+	},
+	"sk11": {
+		width:  11,
+		signed: true,
+		get:    func(word uint32) int64 { return int64(GetSk11(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetSk11(word, int32(v)) },
+	},
+	"sk12": {
+		width:  12,
+		signed: true,
+		get:    func(word uint32) int64 { return int64(GetSk12(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetSk12(word, int32(v)) },
+	},
+	"sk14": {
+		width:  14,
+		signed: true,
+		get:    func(word uint32) int64 { return int64(GetSk14(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetSk14(word, int32(v)) },
+	},
+	"sk16": {
+		width:  16,
+		signed: true,
+		get:    func(word uint32) int64 { return int64(GetSk16(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetSk16(word, int32(v)) },
+	},
+	"sk5": {
+		width:  5,
+		signed: true,
+		get:    func(word uint32) int64 { return int64(GetSk5(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetSk5(word, int32(v)) },
+	},
+	"sk8": {
+		width:  8,
+		signed: true,
+		get:    func(word uint32) int64 { return int64(GetSk8(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetSk8(word, int32(v)) },
+	},
+	"sk9": {
+		width:  9,
+		signed: true,
+		get:    func(word uint32) int64 { return int64(GetSk9(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetSk9(word, int32(v)) },
+	},
+	"td": {
+		width:  2,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetTd(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetTd(word, uint32(v)) },
+	},
+	"tj": {
+		width:  2,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetTj(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetTj(word, uint32(v)) },
+	},
+	"ua2": {
+		width:  2,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUa2(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUa2(word, uint32(v)) },
+	},
+	"ua3": {
+		width:  3,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUa3(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUa3(word, uint32(v)) },
+	},
+	"ud15": {
+		width:  15,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUd15(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUd15(word, uint32(v)) },
+	},
+	"ud4": {
+		width:  4,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUd4(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUd4(word, uint32(v)) },
+	},
+	"ud5": {
+		width:  5,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUd5(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUd5(word, uint32(v)) },
+	},
+	"uj3": {
+		width:  3,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUj3(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUj3(word, uint32(v)) },
+	},
+	"uj5": {
+		width:  5,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUj5(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUj5(word, uint32(v)) },
+	},
+	"uk1": {
+		width:  1,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUk1(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUk1(word, uint32(v)) },
+	},
+	"uk12": {
+		width:  12,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUk12(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUk12(word, uint32(v)) },
+	},
+	"uk14": {
+		width:  14,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUk14(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUk14(word, uint32(v)) },
+	},
+	"uk2": {
+		width:  2,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUk2(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUk2(word, uint32(v)) },
+	},
+	"uk3": {
+		width:  3,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUk3(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUk3(word, uint32(v)) },
+	},
+	"uk4": {
+		width:  4,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUk4(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUk4(word, uint32(v)) },
+	},
+	"uk5": {
+		width:  5,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUk5(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUk5(word, uint32(v)) },
+	},
+	"uk6": {
+		width:  6,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUk6(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUk6(word, uint32(v)) },
+	},
+	"uk7": {
+		width:  7,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUk7(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUk7(word, uint32(v)) },
+	},
+	"uk8": {
+		width:  8,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUk8(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUk8(word, uint32(v)) },
+	},
+	"um5": {
+		width:  5,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUm5(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUm5(word, uint32(v)) },
+	},
+	"um6": {
+		width:  6,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUm6(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUm6(word, uint32(v)) },
+	},
+	"un1": {
+		width:  1,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUn1(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUn1(word, uint32(v)) },
+	},
+	"un2": {
+		width:  2,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUn2(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUn2(word, uint32(v)) },
+	},
+	"un3": {
+		width:  3,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUn3(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUn3(word, uint32(v)) },
+	},
+	"un4": {
+		width:  4,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUn4(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUn4(word, uint32(v)) },
+	},
+	"un5": {
+		width:  5,
+		signed: false,
+		get:    func(word uint32) int64 { return int64(GetUn5(word)) },
+		set:    func(word uint32, v int64) uint32 { return SetUn5(word, uint32(v)) },
+	},
+}
@@ -0,0 +1,7827 @@
+// Code generated by genlaenc from loongson-community/loongarch-opcodes; DO NOT EDIT.
+
+package laenc
+
+type insnFormat int
+
+const (
+	insnFormatUnknown insnFormat = iota
+	insnFormatCdFj
+	insnFormatCdFjFk
+	insnFormatCdJ
+	insnFormatCdVj
+	insnFormatCdXj
+	insnFormatCjSd5k16
+	insnFormatD
+	insnFormatDCj
+	insnFormatDFj
+	insnFormatDJ
+	insnFormatDJK
+	insnFormatDJKUa2
+	insnFormatDJKUa3
+	insnFormatDJSk12
+	insnFormatDJSk14
+	insnFormatDJSk16
+	insnFormatDJSk5
+	insnFormatDJUk12
+	insnFormatDJUk14
+	insnFormatDJUk3
+	insnFormatDJUk4
+	insnFormatDJUk5
+	insnFormatDJUk5Um5
+	insnFormatDJUk6
+	insnFormatDJUk6Um6
+	insnFormatDJUk8
+	insnFormatDSj20
+	insnFormatDTj
+	insnFormatDUj5
+	insnFormatDUj5Uk8
+	insnFormatDUk4
+	insnFormatDUk8
+	insnFormatDVjUk1
+	insnFormatDVjUk2
+	insnFormatDVjUk3
+	insnFormatDVjUk4
+	insnFormatDXjUk2
+	insnFormatDXjUk3
+	insnFormatEMPTY
+	insnFormatFdCj
+	insnFormatFdFj
+	insnFormatFdFjFk
+	insnFormatFdFjFkCa
+	insnFormatFdFjFkFa
+	insnFormatFdJ
+	insnFormatFdJK
+	insnFormatFdJSk12
+	insnFormatJ
+	insnFormatJK
+	insnFormatJKUd4
+	insnFormatJKUd5
+	insnFormatJSd5k16
+	insnFormatJUd4Uk5
+	insnFormatJUd5
+	insnFormatJUd5Sk12
+	insnFormatJUk3
+	insnFormatJUk4
+	insnFormatJUk5
+	insnFormatJUk6
+	insnFormatJUk8
+	insnFormatSd10k16
+	insnFormatSd5k16
+	insnFormatTdJ
+	insnFormatUd15
+	insnFormatUj3
+	insnFormatVdJ
+	insnFormatVdJK
+	insnFormatVdJSk10
+	insnFormatVdJSk11
+	insnFormatVdJSk12
+	insnFormatVdJSk8Un1
+	insnFormatVdJSk8Un2
+	insnFormatVdJSk8Un3
+	insnFormatVdJSk8Un4
+	insnFormatVdJSk9
+	insnFormatVdJUk1
+	insnFormatVdJUk2
+	insnFormatVdJUk3
+	insnFormatVdJUk4
+	insnFormatVdSj13
+	insnFormatVdVj
+	insnFormatVdVjK
+	insnFormatVdVjSk5
+	insnFormatVdVjUk1
+	insnFormatVdVjUk2
+	insnFormatVdVjUk3
+	insnFormatVdVjUk4
+	insnFormatVdVjUk5
+	insnFormatVdVjUk6
+	insnFormatVdVjUk7
+	insnFormatVdVjUk8
+	insnFormatVdVjVk
+	insnFormatVdVjVkVa
+	insnFormatXdJ
+	insnFormatXdJK
+	insnFormatXdJSk10
+	insnFormatXdJSk11
+	insnFormatXdJSk12
+	insnFormatXdJSk8Un2
+	insnFormatXdJSk8Un3
+	insnFormatXdJSk8Un4
+	insnFormatXdJSk8Un5
+	insnFormatXdJSk9
+	insnFormatXdJUk2
+	insnFormatXdJUk3
+	insnFormatXdSj13
+	insnFormatXdXj
+	insnFormatXdXjK
+	insnFormatXdXjSk5
+	insnFormatXdXjUk1
+	insnFormatXdXjUk2
+	insnFormatXdXjUk3
+	insnFormatXdXjUk4
+	insnFormatXdXjUk5
+	insnFormatXdXjUk6
+	insnFormatXdXjUk7
+	insnFormatXdXjUk8
+	insnFormatXdXjXk
+	insnFormatXdXjXkXa
+)
+
+var insnFormatArities = [...]int{
+	insnFormatCdFj:      2,
+	insnFormatCdFjFk:    3,
+	insnFormatCdJ:       2,
+	insnFormatCdVj:      2,
+	insnFormatCdXj:      2,
+	insnFormatCjSd5k16:  2,
+	insnFormatD:         1,
+	insnFormatDCj:       2,
+	insnFormatDFj:       2,
+	insnFormatDJ:        2,
+	insnFormatDJK:       3,
+	insnFormatDJKUa2:    4,
+	insnFormatDJKUa3:    4,
+	insnFormatDJSk12:    3,
+	insnFormatDJSk14:    3,
+	insnFormatDJSk16:    3,
+	insnFormatDJSk5:     3,
+	insnFormatDJUk12:    3,
+	insnFormatDJUk14:    3,
+	insnFormatDJUk3:     3,
+	insnFormatDJUk4:     3,
+	insnFormatDJUk5:     3,
+	insnFormatDJUk5Um5:  4,
+	insnFormatDJUk6:     3,
+	insnFormatDJUk6Um6:  4,
+	insnFormatDJUk8:     3,
+	insnFormatDSj20:     2,
+	insnFormatDTj:       2,
+	insnFormatDUj5:      2,
+	insnFormatDUj5Uk8:   3,
+	insnFormatDUk4:      2,
+	insnFormatDUk8:      2,
+	insnFormatDVjUk1:    3,
+	insnFormatDVjUk2:    3,
+	insnFormatDVjUk3:    3,
+	insnFormatDVjUk4:    3,
+	insnFormatDXjUk2:    3,
+	insnFormatDXjUk3:    3,
+	insnFormatEMPTY:     0,
+	insnFormatFdCj:      2,
+	insnFormatFdFj:      2,
+	insnFormatFdFjFk:    3,
+	insnFormatFdFjFkCa:  4,
+	insnFormatFdFjFkFa:  4,
+	insnFormatFdJ:       2,
+	insnFormatFdJK:      3,
+	insnFormatFdJSk12:   3,
+	insnFormatJ:         1,
+	insnFormatJK:        2,
+	insnFormatJKUd4:     3,
+	insnFormatJKUd5:     3,
+	insnFormatJSd5k16:   2,
+	insnFormatJUd4Uk5:   3,
+	insnFormatJUd5:      2,
+	insnFormatJUd5Sk12:  3,
+	insnFormatJUk3:      2,
+	insnFormatJUk4:      2,
+	insnFormatJUk5:      2,
+	insnFormatJUk6:      2,
+	insnFormatJUk8:      2,
+	insnFormatSd10k16:   1,
+	insnFormatSd5k16:    1,
+	insnFormatTdJ:       2,
+	insnFormatUd15:      1,
+	insnFormatUj3:       1,
+	insnFormatVdJ:       2,
+	insnFormatVdJK:      3,
+	insnFormatVdJSk10:   3,
+	insnFormatVdJSk11:   3,
+	insnFormatVdJSk12:   3,
+	insnFormatVdJSk8Un1: 4,
+	insnFormatVdJSk8Un2: 4,
+	insnFormatVdJSk8Un3: 4,
+	insnFormatVdJSk8Un4: 4,
+	insnFormatVdJSk9:    3,
+	insnFormatVdJUk1:    3,
+	insnFormatVdJUk2:    3,
+	insnFormatVdJUk3:    3,
+	insnFormatVdJUk4:    3,
+	insnFormatVdSj13:    2,
+	insnFormatVdVj:      2,
+	insnFormatVdVjK:     3,
+	insnFormatVdVjSk5:   3,
+	insnFormatVdVjUk1:   3,
+	insnFormatVdVjUk2:   3,
+	insnFormatVdVjUk3:   3,
+	insnFormatVdVjUk4:   3,
+	insnFormatVdVjUk5:   3,
+	insnFormatVdVjUk6:   3,
+	insnFormatVdVjUk7:   3,
+	insnFormatVdVjUk8:   3,
+	insnFormatVdVjVk:    3,
+	insnFormatVdVjVkVa:  4,
+	insnFormatXdJ:       2,
+	insnFormatXdJK:      3,
+	insnFormatXdJSk10:   3,
+	insnFormatXdJSk11:   3,
+	insnFormatXdJSk12:   3,
+	insnFormatXdJSk8Un2: 4,
+	insnFormatXdJSk8Un3: 4,
+	insnFormatXdJSk8Un4: 4,
+	insnFormatXdJSk8Un5: 4,
+	insnFormatXdJSk9:    3,
+	insnFormatXdJUk2:    3,
+	insnFormatXdJUk3:    3,
+	insnFormatXdSj13:    2,
+	insnFormatXdXj:      2,
+	insnFormatXdXjK:     3,
+	insnFormatXdXjSk5:   3,
+	insnFormatXdXjUk1:   3,
+	insnFormatXdXjUk2:   3,
+	insnFormatXdXjUk3:   3,
+	insnFormatXdXjUk4:   3,
+	insnFormatXdXjUk5:   3,
+	insnFormatXdXjUk6:   3,
+	insnFormatXdXjUk7:   3,
+	insnFormatXdXjUk8:   3,
+	insnFormatXdXjXk:    3,
+	insnFormatXdXjXkXa:  4,
+}
+
+func validateCdFj(cd, fj int64) error {
+	if err := wantFCCReg(0, "cd", cd); err != nil {
+		return err
+	}
+	if err := wantFPReg(1, "fj", fj); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeCdFj(bits uint32, cd, fj int64) (uint32, error) {
+	if uint64(cd) < 8 && uint64(fj) < 32 {
+		return bits | (uint32(cd) & 0x7) | (uint32(fj)&0x1f)<<5, nil
+	}
+	return 0, validateCdFj(cd, fj)
+}
+
+func validateCdFjFk(cd, fj, fk int64) error {
+	if err := wantFCCReg(0, "cd", cd); err != nil {
+		return err
+	}
+	if err := wantFPReg(1, "fj", fj); err != nil {
+		return err
+	}
+	if err := wantFPReg(2, "fk", fk); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeCdFjFk(bits uint32, cd, fj, fk int64) (uint32, error) {
+	if uint64(cd) < 8 && uint64(fj) < 32 && uint64(fk) < 32 {
+		return bits | (uint32(cd) & 0x7) | (uint32(fj)&0x1f)<<5 | (uint32(fk)&0x1f)<<10, nil
+	}
+	return 0, validateCdFjFk(cd, fj, fk)
+}
+
+func validateCdJ(cd, j int64) error {
+	if err := wantFCCReg(0, "cd", cd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeCdJ(bits uint32, cd, j int64) (uint32, error) {
+	if uint64(cd) < 8 && uint64(j) < 32 {
+		return bits | (uint32(cd) & 0x7) | (uint32(j)&0x1f)<<5, nil
+	}
+	return 0, validateCdJ(cd, j)
+}
+
+func validateCdVj(cd, vj int64) error {
+	if err := wantFCCReg(0, "cd", cd); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeCdVj(bits uint32, cd, vj int64) (uint32, error) {
+	if uint64(cd) < 8 && uint64(vj) < 32 {
+		return bits | (uint32(cd) & 0x7) | (uint32(vj)&0x1f)<<5, nil
+	}
+	return 0, validateCdVj(cd, vj)
+}
+
+func validateCdXj(cd, xj int64) error {
+	if err := wantFCCReg(0, "cd", cd); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeCdXj(bits uint32, cd, xj int64) (uint32, error) {
+	if uint64(cd) < 8 && uint64(xj) < 32 {
+		return bits | (uint32(cd) & 0x7) | (uint32(xj)&0x1f)<<5, nil
+	}
+	return 0, validateCdXj(cd, xj)
+}
+
+func validateCjSd5k16(cj, sd5k16 int64) error {
+	if err := wantFCCReg(0, "cj", cj); err != nil {
+		return err
+	}
+	if err := wantSignedImm(1, "sd5k16", sd5k16, 21); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeCjSd5k16(bits uint32, cj, sd5k16 int64) (uint32, error) {
+	if err := validateCjSd5k16(cj, sd5k16); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(cj)&0x7)<<5 | (uint32(sd5k16) >> 16 & 0x1f) | (uint32(sd5k16)&0xffff)<<10, nil
+}
+
+func validateD(d int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeD(bits uint32, d int64) (uint32, error) {
+	if uint64(d) < 32 {
+		return bits | (uint32(d) & 0x1f), nil
+	}
+	return 0, validateD(d)
+}
+
+func validateDCj(d, cj int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantFCCReg(1, "cj", cj); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDCj(bits uint32, d, cj int64) (uint32, error) {
+	if uint64(d) < 32 && uint64(cj) < 8 {
+		return bits | (uint32(d) & 0x1f) | (uint32(cj)&0x7)<<5, nil
+	}
+	return 0, validateDCj(d, cj)
+}
+
+func validateDFj(d, fj int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantFPReg(1, "fj", fj); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDFj(bits uint32, d, fj int64) (uint32, error) {
+	if uint64(d) < 32 && uint64(fj) < 32 {
+		return bits | (uint32(d) & 0x1f) | (uint32(fj)&0x1f)<<5, nil
+	}
+	return 0, validateDFj(d, fj)
+}
+
+func validateDJ(d, j int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJ(bits uint32, d, j int64) (uint32, error) {
+	if uint64(d) < 32 && uint64(j) < 32 {
+		return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5, nil
+	}
+	return 0, validateDJ(d, j)
+}
+
+func validateDJK(d, j, k int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantIntReg(2, "k", k); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJK(bits uint32, d, j, k int64) (uint32, error) {
+	if uint64(d) < 32 && uint64(j) < 32 && uint64(k) < 32 {
+		return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(k)&0x1f)<<10, nil
+	}
+	return 0, validateDJK(d, j, k)
+}
+
+func validateDJKUa2(d, j, k, ua2 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantIntReg(2, "k", k); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(3, "ua2", ua2, 2); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJKUa2(bits uint32, d, j, k, ua2 int64) (uint32, error) {
+	if err := validateDJKUa2(d, j, k, ua2); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(k)&0x1f)<<10 | (uint32(ua2)&0x3)<<15, nil
+}
+
+func validateDJKUa3(d, j, k, ua3 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantIntReg(2, "k", k); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(3, "ua3", ua3, 3); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJKUa3(bits uint32, d, j, k, ua3 int64) (uint32, error) {
+	if err := validateDJKUa3(d, j, k, ua3); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(k)&0x1f)<<10 | (uint32(ua3)&0x7)<<15, nil
+}
+
+func validateDJSk12(d, j, sk12 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk12", sk12, 12); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJSk12(bits uint32, d, j, sk12 int64) (uint32, error) {
+	if err := validateDJSk12(d, j, sk12); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk12)&0xfff)<<10, nil
+}
+
+func validateDJSk14(d, j, sk14 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk14", sk14, 14); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJSk14(bits uint32, d, j, sk14 int64) (uint32, error) {
+	if err := validateDJSk14(d, j, sk14); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk14)&0x3fff)<<10, nil
+}
+
+func validateDJSk16(d, j, sk16 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk16", sk16, 16); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJSk16(bits uint32, d, j, sk16 int64) (uint32, error) {
+	if err := validateDJSk16(d, j, sk16); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk16)&0xffff)<<10, nil
+}
+
+func validateDJSk5(d, j, sk5 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk5", sk5, 5); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJSk5(bits uint32, d, j, sk5 int64) (uint32, error) {
+	if err := validateDJSk5(d, j, sk5); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk5)&0x1f)<<10, nil
+}
+
+func validateDJUk12(d, j, uk12 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk12", uk12, 12); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJUk12(bits uint32, d, j, uk12 int64) (uint32, error) {
+	if err := validateDJUk12(d, j, uk12); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(uk12)&0xfff)<<10, nil
+}
+
+func validateDJUk14(d, j, uk14 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk14", uk14, 14); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJUk14(bits uint32, d, j, uk14 int64) (uint32, error) {
+	if err := validateDJUk14(d, j, uk14); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(uk14)&0x3fff)<<10, nil
+}
+
+func validateDJUk3(d, j, uk3 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk3", uk3, 3); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJUk3(bits uint32, d, j, uk3 int64) (uint32, error) {
+	if err := validateDJUk3(d, j, uk3); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(uk3)&0x7)<<10, nil
+}
+
+func validateDJUk4(d, j, uk4 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk4", uk4, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJUk4(bits uint32, d, j, uk4 int64) (uint32, error) {
+	if err := validateDJUk4(d, j, uk4); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(uk4)&0xf)<<10, nil
+}
+
+func validateDJUk5(d, j, uk5 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk5", uk5, 5); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJUk5(bits uint32, d, j, uk5 int64) (uint32, error) {
+	if err := validateDJUk5(d, j, uk5); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(uk5)&0x1f)<<10, nil
+}
+
+func validateDJUk5Um5(d, j, uk5, um5 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk5", uk5, 5); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(3, "um5", um5, 5); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJUk5Um5(bits uint32, d, j, uk5, um5 int64) (uint32, error) {
+	if err := validateDJUk5Um5(d, j, uk5, um5); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(uk5)&0x1f)<<10 | (uint32(um5)&0x1f)<<16, nil
+}
+
+func validateDJUk6(d, j, uk6 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk6", uk6, 6); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJUk6(bits uint32, d, j, uk6 int64) (uint32, error) {
+	if err := validateDJUk6(d, j, uk6); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(uk6)&0x3f)<<10, nil
+}
+
+func validateDJUk6Um6(d, j, uk6, um6 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk6", uk6, 6); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(3, "um6", um6, 6); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJUk6Um6(bits uint32, d, j, uk6, um6 int64) (uint32, error) {
+	if err := validateDJUk6Um6(d, j, uk6, um6); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(uk6)&0x3f)<<10 | (uint32(um6)&0x3f)<<16, nil
+}
+
+func validateDJUk8(d, j, uk8 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk8", uk8, 8); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDJUk8(bits uint32, d, j, uk8 int64) (uint32, error) {
+	if err := validateDJUk8(d, j, uk8); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(uk8)&0xff)<<10, nil
+}
+
+func validateDSj20(d, sj20 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantSignedImm(1, "sj20", sj20, 20); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDSj20(bits uint32, d, sj20 int64) (uint32, error) {
+	if err := validateDSj20(d, sj20); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(sj20)&0xfffff)<<5, nil
+}
+
+func validateDTj(d, tj int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantScratchReg(1, "tj", tj); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDTj(bits uint32, d, tj int64) (uint32, error) {
+	if uint64(d) < 32 && uint64(tj) < 4 {
+		return bits | (uint32(d) & 0x1f) | (uint32(tj)&0x3)<<5, nil
+	}
+	return 0, validateDTj(d, tj)
+}
+
+func validateDUj5(d, uj5 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(1, "uj5", uj5, 5); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDUj5(bits uint32, d, uj5 int64) (uint32, error) {
+	if err := validateDUj5(d, uj5); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(uj5)&0x1f)<<5, nil
+}
+
+func validateDUj5Uk8(d, uj5, uk8 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(1, "uj5", uj5, 5); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk8", uk8, 8); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDUj5Uk8(bits uint32, d, uj5, uk8 int64) (uint32, error) {
+	if err := validateDUj5Uk8(d, uj5, uk8); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(uj5)&0x1f)<<5 | (uint32(uk8)&0xff)<<10, nil
+}
+
+func validateDUk4(d, uk4 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(1, "uk4", uk4, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDUk4(bits uint32, d, uk4 int64) (uint32, error) {
+	if err := validateDUk4(d, uk4); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(uk4)&0xf)<<10, nil
+}
+
+func validateDUk8(d, uk8 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(1, "uk8", uk8, 8); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDUk8(bits uint32, d, uk8 int64) (uint32, error) {
+	if err := validateDUk8(d, uk8); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(uk8)&0xff)<<10, nil
+}
+
+func validateDVjUk1(d, vj, uk1 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk1", uk1, 1); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDVjUk1(bits uint32, d, vj, uk1 int64) (uint32, error) {
+	if err := validateDVjUk1(d, vj, uk1); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(uk1)&0x1)<<10, nil
+}
+
+func validateDVjUk2(d, vj, uk2 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk2", uk2, 2); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDVjUk2(bits uint32, d, vj, uk2 int64) (uint32, error) {
+	if err := validateDVjUk2(d, vj, uk2); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(uk2)&0x3)<<10, nil
+}
+
+func validateDVjUk3(d, vj, uk3 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk3", uk3, 3); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDVjUk3(bits uint32, d, vj, uk3 int64) (uint32, error) {
+	if err := validateDVjUk3(d, vj, uk3); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(uk3)&0x7)<<10, nil
+}
+
+func validateDVjUk4(d, vj, uk4 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk4", uk4, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDVjUk4(bits uint32, d, vj, uk4 int64) (uint32, error) {
+	if err := validateDVjUk4(d, vj, uk4); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(uk4)&0xf)<<10, nil
+}
+
+func validateDXjUk2(d, xj, uk2 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk2", uk2, 2); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDXjUk2(bits uint32, d, xj, uk2 int64) (uint32, error) {
+	if err := validateDXjUk2(d, xj, uk2); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(xj)&0x1f)<<5 | (uint32(uk2)&0x3)<<10, nil
+}
+
+func validateDXjUk3(d, xj, uk3 int64) error {
+	if err := wantIntReg(0, "d", d); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk3", uk3, 3); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeDXjUk3(bits uint32, d, xj, uk3 int64) (uint32, error) {
+	if err := validateDXjUk3(d, xj, uk3); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(d) & 0x1f) | (uint32(xj)&0x1f)<<5 | (uint32(uk3)&0x7)<<10, nil
+}
+
+func validateEMPTY() error {
+	return nil
+}
+
+func encodeEMPTY(bits uint32) (uint32, error) {
+	return bits, nil
+}
+
+func validateFdCj(fd, cj int64) error {
+	if err := wantFPReg(0, "fd", fd); err != nil {
+		return err
+	}
+	if err := wantFCCReg(1, "cj", cj); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeFdCj(bits uint32, fd, cj int64) (uint32, error) {
+	if uint64(fd) < 32 && uint64(cj) < 8 {
+		return bits | (uint32(fd) & 0x1f) | (uint32(cj)&0x7)<<5, nil
+	}
+	return 0, validateFdCj(fd, cj)
+}
+
+func validateFdFj(fd, fj int64) error {
+	if err := wantFPReg(0, "fd", fd); err != nil {
+		return err
+	}
+	if err := wantFPReg(1, "fj", fj); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeFdFj(bits uint32, fd, fj int64) (uint32, error) {
+	if uint64(fd) < 32 && uint64(fj) < 32 {
+		return bits | (uint32(fd) & 0x1f) | (uint32(fj)&0x1f)<<5, nil
+	}
+	return 0, validateFdFj(fd, fj)
+}
+
+func validateFdFjFk(fd, fj, fk int64) error {
+	if err := wantFPReg(0, "fd", fd); err != nil {
+		return err
+	}
+	if err := wantFPReg(1, "fj", fj); err != nil {
+		return err
+	}
+	if err := wantFPReg(2, "fk", fk); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeFdFjFk(bits uint32, fd, fj, fk int64) (uint32, error) {
+	if uint64(fd) < 32 && uint64(fj) < 32 && uint64(fk) < 32 {
+		return bits | (uint32(fd) & 0x1f) | (uint32(fj)&0x1f)<<5 | (uint32(fk)&0x1f)<<10, nil
+	}
+	return 0, validateFdFjFk(fd, fj, fk)
+}
+
+func validateFdFjFkCa(fd, fj, fk, ca int64) error {
+	if err := wantFPReg(0, "fd", fd); err != nil {
+		return err
+	}
+	if err := wantFPReg(1, "fj", fj); err != nil {
+		return err
+	}
+	if err := wantFPReg(2, "fk", fk); err != nil {
+		return err
+	}
+	if err := wantFCCReg(3, "ca", ca); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeFdFjFkCa(bits uint32, fd, fj, fk, ca int64) (uint32, error) {
+	if uint64(fd) < 32 && uint64(fj) < 32 && uint64(fk) < 32 && uint64(ca) < 8 {
+		return bits | (uint32(fd) & 0x1f) | (uint32(fj)&0x1f)<<5 | (uint32(fk)&0x1f)<<10 | (uint32(ca)&0x7)<<15, nil
+	}
+	return 0, validateFdFjFkCa(fd, fj, fk, ca)
+}
+
+func validateFdFjFkFa(fd, fj, fk, fa int64) error {
+	if err := wantFPReg(0, "fd", fd); err != nil {
+		return err
+	}
+	if err := wantFPReg(1, "fj", fj); err != nil {
+		return err
+	}
+	if err := wantFPReg(2, "fk", fk); err != nil {
+		return err
+	}
+	if err := wantFPReg(3, "fa", fa); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeFdFjFkFa(bits uint32, fd, fj, fk, fa int64) (uint32, error) {
+	if uint64(fd) < 32 && uint64(fj) < 32 && uint64(fk) < 32 && uint64(fa) < 32 {
+		return bits | (uint32(fd) & 0x1f) | (uint32(fj)&0x1f)<<5 | (uint32(fk)&0x1f)<<10 | (uint32(fa)&0x1f)<<15, nil
+	}
+	return 0, validateFdFjFkFa(fd, fj, fk, fa)
+}
+
+func validateFdJ(fd, j int64) error {
+	if err := wantFPReg(0, "fd", fd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeFdJ(bits uint32, fd, j int64) (uint32, error) {
+	if uint64(fd) < 32 && uint64(j) < 32 {
+		return bits | (uint32(fd) & 0x1f) | (uint32(j)&0x1f)<<5, nil
+	}
+	return 0, validateFdJ(fd, j)
+}
+
+func validateFdJK(fd, j, k int64) error {
+	if err := wantFPReg(0, "fd", fd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantIntReg(2, "k", k); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeFdJK(bits uint32, fd, j, k int64) (uint32, error) {
+	if uint64(fd) < 32 && uint64(j) < 32 && uint64(k) < 32 {
+		return bits | (uint32(fd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(k)&0x1f)<<10, nil
+	}
+	return 0, validateFdJK(fd, j, k)
+}
+
+func validateFdJSk12(fd, j, sk12 int64) error {
+	if err := wantFPReg(0, "fd", fd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk12", sk12, 12); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeFdJSk12(bits uint32, fd, j, sk12 int64) (uint32, error) {
+	if err := validateFdJSk12(fd, j, sk12); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(fd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk12)&0xfff)<<10, nil
+}
+
+func validateJ(j int64) error {
+	if err := wantIntReg(0, "j", j); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeJ(bits uint32, j int64) (uint32, error) {
+	if uint64(j) < 32 {
+		return bits | (uint32(j)&0x1f)<<5, nil
+	}
+	return 0, validateJ(j)
+}
+
+func validateJK(j, k int64) error {
+	if err := wantIntReg(0, "j", j); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "k", k); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeJK(bits uint32, j, k int64) (uint32, error) {
+	if uint64(j) < 32 && uint64(k) < 32 {
+		return bits | (uint32(j)&0x1f)<<5 | (uint32(k)&0x1f)<<10, nil
+	}
+	return 0, validateJK(j, k)
+}
+
+func validateJKUd4(j, k, ud4 int64) error {
+	if err := wantIntReg(0, "j", j); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "k", k); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "ud4", ud4, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeJKUd4(bits uint32, j, k, ud4 int64) (uint32, error) {
+	if err := validateJKUd4(j, k, ud4); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(j)&0x1f)<<5 | (uint32(k)&0x1f)<<10 | (uint32(ud4) & 0xf), nil
+}
+
+func validateJKUd5(j, k, ud5 int64) error {
+	if err := wantIntReg(0, "j", j); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "k", k); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "ud5", ud5, 5); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeJKUd5(bits uint32, j, k, ud5 int64) (uint32, error) {
+	if err := validateJKUd5(j, k, ud5); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(j)&0x1f)<<5 | (uint32(k)&0x1f)<<10 | (uint32(ud5) & 0x1f), nil
+}
+
+func validateJSd5k16(j, sd5k16 int64) error {
+	if err := wantIntReg(0, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(1, "sd5k16", sd5k16, 21); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeJSd5k16(bits uint32, j, sd5k16 int64) (uint32, error) {
+	if err := validateJSd5k16(j, sd5k16); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(j)&0x1f)<<5 | (uint32(sd5k16) >> 16 & 0x1f) | (uint32(sd5k16)&0xffff)<<10, nil
+}
+
+func validateJUd4Uk5(j, ud4, uk5 int64) error {
+	if err := wantIntReg(0, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(1, "ud4", ud4, 4); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk5", uk5, 5); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeJUd4Uk5(bits uint32, j, ud4, uk5 int64) (uint32, error) {
+	if err := validateJUd4Uk5(j, ud4, uk5); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(j)&0x1f)<<5 | (uint32(ud4) & 0xf) | (uint32(uk5)&0x1f)<<10, nil
+}
+
+func validateJUd5(j, ud5 int64) error {
+	if err := wantIntReg(0, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(1, "ud5", ud5, 5); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeJUd5(bits uint32, j, ud5 int64) (uint32, error) {
+	if err := validateJUd5(j, ud5); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(j)&0x1f)<<5 | (uint32(ud5) & 0x1f), nil
+}
+
+func validateJUd5Sk12(j, ud5, sk12 int64) error {
+	if err := wantIntReg(0, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(1, "ud5", ud5, 5); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk12", sk12, 12); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeJUd5Sk12(bits uint32, j, ud5, sk12 int64) (uint32, error) {
+	if err := validateJUd5Sk12(j, ud5, sk12); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(j)&0x1f)<<5 | (uint32(ud5) & 0x1f) | (uint32(sk12)&0xfff)<<10, nil
+}
+
+func validateJUk3(j, uk3 int64) error {
+	if err := wantIntReg(0, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(1, "uk3", uk3, 3); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeJUk3(bits uint32, j, uk3 int64) (uint32, error) {
+	if err := validateJUk3(j, uk3); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(j)&0x1f)<<5 | (uint32(uk3)&0x7)<<10, nil
+}
+
+func validateJUk4(j, uk4 int64) error {
+	if err := wantIntReg(0, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(1, "uk4", uk4, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeJUk4(bits uint32, j, uk4 int64) (uint32, error) {
+	if err := validateJUk4(j, uk4); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(j)&0x1f)<<5 | (uint32(uk4)&0xf)<<10, nil
+}
+
+func validateJUk5(j, uk5 int64) error {
+	if err := wantIntReg(0, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(1, "uk5", uk5, 5); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeJUk5(bits uint32, j, uk5 int64) (uint32, error) {
+	if err := validateJUk5(j, uk5); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(j)&0x1f)<<5 | (uint32(uk5)&0x1f)<<10, nil
+}
+
+func validateJUk6(j, uk6 int64) error {
+	if err := wantIntReg(0, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(1, "uk6", uk6, 6); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeJUk6(bits uint32, j, uk6 int64) (uint32, error) {
+	if err := validateJUk6(j, uk6); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(j)&0x1f)<<5 | (uint32(uk6)&0x3f)<<10, nil
+}
+
+func validateJUk8(j, uk8 int64) error {
+	if err := wantIntReg(0, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(1, "uk8", uk8, 8); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeJUk8(bits uint32, j, uk8 int64) (uint32, error) {
+	if err := validateJUk8(j, uk8); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(j)&0x1f)<<5 | (uint32(uk8)&0xff)<<10, nil
+}
+
+func validateSd10k16(sd10k16 int64) error {
+	if err := wantSignedImm(0, "sd10k16", sd10k16, 26); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeSd10k16(bits uint32, sd10k16 int64) (uint32, error) {
+	if err := validateSd10k16(sd10k16); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(sd10k16) >> 16 & 0x3ff) | (uint32(sd10k16)&0xffff)<<10, nil
+}
+
+func validateSd5k16(sd5k16 int64) error {
+	if err := wantSignedImm(0, "sd5k16", sd5k16, 21); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeSd5k16(bits uint32, sd5k16 int64) (uint32, error) {
+	if err := validateSd5k16(sd5k16); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(sd5k16) >> 16 & 0x1f) | (uint32(sd5k16)&0xffff)<<10, nil
+}
+
+func validateTdJ(td, j int64) error {
+	if err := wantScratchReg(0, "td", td); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeTdJ(bits uint32, td, j int64) (uint32, error) {
+	if uint64(td) < 4 && uint64(j) < 32 {
+		return bits | (uint32(td) & 0x3) | (uint32(j)&0x1f)<<5, nil
+	}
+	return 0, validateTdJ(td, j)
+}
+
+func validateUd15(ud15 int64) error {
+	if err := wantUnsignedImm(0, "ud15", ud15, 15); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeUd15(bits uint32, ud15 int64) (uint32, error) {
+	if err := validateUd15(ud15); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(ud15) & 0x7fff), nil
+}
+
+func validateUj3(uj3 int64) error {
+	if err := wantUnsignedImm(0, "uj3", uj3, 3); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeUj3(bits uint32, uj3 int64) (uint32, error) {
+	if err := validateUj3(uj3); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(uj3)&0x7)<<5, nil
+}
+
+func validateVdJ(vd, j int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdJ(bits uint32, vd, j int64) (uint32, error) {
+	if uint64(vd) < 32 && uint64(j) < 32 {
+		return bits | (uint32(vd) & 0x1f) | (uint32(j)&0x1f)<<5, nil
+	}
+	return 0, validateVdJ(vd, j)
+}
+
+func validateVdJK(vd, j, k int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantIntReg(2, "k", k); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdJK(bits uint32, vd, j, k int64) (uint32, error) {
+	if uint64(vd) < 32 && uint64(j) < 32 && uint64(k) < 32 {
+		return bits | (uint32(vd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(k)&0x1f)<<10, nil
+	}
+	return 0, validateVdJK(vd, j, k)
+}
+
+func validateVdJSk10(vd, j, sk10 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk10", sk10, 10); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdJSk10(bits uint32, vd, j, sk10 int64) (uint32, error) {
+	if err := validateVdJSk10(vd, j, sk10); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk10)&0x3ff)<<10, nil
+}
+
+func validateVdJSk11(vd, j, sk11 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk11", sk11, 11); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdJSk11(bits uint32, vd, j, sk11 int64) (uint32, error) {
+	if err := validateVdJSk11(vd, j, sk11); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk11)&0x7ff)<<10, nil
+}
+
+func validateVdJSk12(vd, j, sk12 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk12", sk12, 12); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdJSk12(bits uint32, vd, j, sk12 int64) (uint32, error) {
+	if err := validateVdJSk12(vd, j, sk12); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk12)&0xfff)<<10, nil
+}
+
+func validateVdJSk8Un1(vd, j, sk8, un1 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk8", sk8, 8); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(3, "un1", un1, 1); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdJSk8Un1(bits uint32, vd, j, sk8, un1 int64) (uint32, error) {
+	if err := validateVdJSk8Un1(vd, j, sk8, un1); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk8)&0xff)<<10 | (uint32(un1)&0x1)<<18, nil
+}
+
+func validateVdJSk8Un2(vd, j, sk8, un2 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk8", sk8, 8); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(3, "un2", un2, 2); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdJSk8Un2(bits uint32, vd, j, sk8, un2 int64) (uint32, error) {
+	if err := validateVdJSk8Un2(vd, j, sk8, un2); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk8)&0xff)<<10 | (uint32(un2)&0x3)<<18, nil
+}
+
+func validateVdJSk8Un3(vd, j, sk8, un3 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk8", sk8, 8); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(3, "un3", un3, 3); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdJSk8Un3(bits uint32, vd, j, sk8, un3 int64) (uint32, error) {
+	if err := validateVdJSk8Un3(vd, j, sk8, un3); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk8)&0xff)<<10 | (uint32(un3)&0x7)<<18, nil
+}
+
+func validateVdJSk8Un4(vd, j, sk8, un4 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk8", sk8, 8); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(3, "un4", un4, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdJSk8Un4(bits uint32, vd, j, sk8, un4 int64) (uint32, error) {
+	if err := validateVdJSk8Un4(vd, j, sk8, un4); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk8)&0xff)<<10 | (uint32(un4)&0xf)<<18, nil
+}
+
+func validateVdJSk9(vd, j, sk9 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk9", sk9, 9); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdJSk9(bits uint32, vd, j, sk9 int64) (uint32, error) {
+	if err := validateVdJSk9(vd, j, sk9); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk9)&0x1ff)<<10, nil
+}
+
+func validateVdJUk1(vd, j, uk1 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk1", uk1, 1); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdJUk1(bits uint32, vd, j, uk1 int64) (uint32, error) {
+	if err := validateVdJUk1(vd, j, uk1); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(uk1)&0x1)<<10, nil
+}
+
+func validateVdJUk2(vd, j, uk2 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk2", uk2, 2); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdJUk2(bits uint32, vd, j, uk2 int64) (uint32, error) {
+	if err := validateVdJUk2(vd, j, uk2); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(uk2)&0x3)<<10, nil
+}
+
+func validateVdJUk3(vd, j, uk3 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk3", uk3, 3); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdJUk3(bits uint32, vd, j, uk3 int64) (uint32, error) {
+	if err := validateVdJUk3(vd, j, uk3); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(uk3)&0x7)<<10, nil
+}
+
+func validateVdJUk4(vd, j, uk4 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk4", uk4, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdJUk4(bits uint32, vd, j, uk4 int64) (uint32, error) {
+	if err := validateVdJUk4(vd, j, uk4); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(uk4)&0xf)<<10, nil
+}
+
+func validateVdSj13(vd, sj13 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantSignedImm(1, "sj13", sj13, 13); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdSj13(bits uint32, vd, sj13 int64) (uint32, error) {
+	if err := validateVdSj13(vd, sj13); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(sj13)&0x1fff)<<5, nil
+}
+
+func validateVdVj(vd, vj int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdVj(bits uint32, vd, vj int64) (uint32, error) {
+	if uint64(vd) < 32 && uint64(vj) < 32 {
+		return bits | (uint32(vd) & 0x1f) | (uint32(vj)&0x1f)<<5, nil
+	}
+	return 0, validateVdVj(vd, vj)
+}
+
+func validateVdVjK(vd, vj, k int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantIntReg(2, "k", k); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdVjK(bits uint32, vd, vj, k int64) (uint32, error) {
+	if uint64(vd) < 32 && uint64(vj) < 32 && uint64(k) < 32 {
+		return bits | (uint32(vd) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(k)&0x1f)<<10, nil
+	}
+	return 0, validateVdVjK(vd, vj, k)
+}
+
+func validateVdVjSk5(vd, vj, sk5 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk5", sk5, 5); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdVjSk5(bits uint32, vd, vj, sk5 int64) (uint32, error) {
+	if err := validateVdVjSk5(vd, vj, sk5); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(sk5)&0x1f)<<10, nil
+}
+
+func validateVdVjUk1(vd, vj, uk1 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk1", uk1, 1); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdVjUk1(bits uint32, vd, vj, uk1 int64) (uint32, error) {
+	if err := validateVdVjUk1(vd, vj, uk1); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(uk1)&0x1)<<10, nil
+}
+
+func validateVdVjUk2(vd, vj, uk2 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk2", uk2, 2); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdVjUk2(bits uint32, vd, vj, uk2 int64) (uint32, error) {
+	if err := validateVdVjUk2(vd, vj, uk2); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(uk2)&0x3)<<10, nil
+}
+
+func validateVdVjUk3(vd, vj, uk3 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk3", uk3, 3); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdVjUk3(bits uint32, vd, vj, uk3 int64) (uint32, error) {
+	if err := validateVdVjUk3(vd, vj, uk3); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(uk3)&0x7)<<10, nil
+}
+
+func validateVdVjUk4(vd, vj, uk4 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk4", uk4, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdVjUk4(bits uint32, vd, vj, uk4 int64) (uint32, error) {
+	if err := validateVdVjUk4(vd, vj, uk4); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(uk4)&0xf)<<10, nil
+}
+
+func validateVdVjUk5(vd, vj, uk5 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk5", uk5, 5); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdVjUk5(bits uint32, vd, vj, uk5 int64) (uint32, error) {
+	if err := validateVdVjUk5(vd, vj, uk5); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(uk5)&0x1f)<<10, nil
+}
+
+func validateVdVjUk6(vd, vj, uk6 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk6", uk6, 6); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdVjUk6(bits uint32, vd, vj, uk6 int64) (uint32, error) {
+	if err := validateVdVjUk6(vd, vj, uk6); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(uk6)&0x3f)<<10, nil
+}
+
+func validateVdVjUk7(vd, vj, uk7 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk7", uk7, 7); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdVjUk7(bits uint32, vd, vj, uk7 int64) (uint32, error) {
+	if err := validateVdVjUk7(vd, vj, uk7); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(uk7)&0x7f)<<10, nil
+}
+
+func validateVdVjUk8(vd, vj, uk8 int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk8", uk8, 8); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdVjUk8(bits uint32, vd, vj, uk8 int64) (uint32, error) {
+	if err := validateVdVjUk8(vd, vj, uk8); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(vd) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(uk8)&0xff)<<10, nil
+}
+
+func validateVdVjVk(vd, vj, vk int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantVReg(2, "vk", vk); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdVjVk(bits uint32, vd, vj, vk int64) (uint32, error) {
+	if uint64(vd) < 32 && uint64(vj) < 32 && uint64(vk) < 32 {
+		return bits | (uint32(vd) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(vk)&0x1f)<<10, nil
+	}
+	return 0, validateVdVjVk(vd, vj, vk)
+}
+
+func validateVdVjVkVa(vd, vj, vk, va int64) error {
+	if err := wantVReg(0, "vd", vd); err != nil {
+		return err
+	}
+	if err := wantVReg(1, "vj", vj); err != nil {
+		return err
+	}
+	if err := wantVReg(2, "vk", vk); err != nil {
+		return err
+	}
+	if err := wantVReg(3, "va", va); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeVdVjVkVa(bits uint32, vd, vj, vk, va int64) (uint32, error) {
+	if uint64(vd) < 32 && uint64(vj) < 32 && uint64(vk) < 32 && uint64(va) < 32 {
+		return bits | (uint32(vd) & 0x1f) | (uint32(vj)&0x1f)<<5 | (uint32(vk)&0x1f)<<10 | (uint32(va)&0x1f)<<15, nil
+	}
+	return 0, validateVdVjVkVa(vd, vj, vk, va)
+}
+
+func validateXdJ(xd, j int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdJ(bits uint32, xd, j int64) (uint32, error) {
+	if uint64(xd) < 32 && uint64(j) < 32 {
+		return bits | (uint32(xd) & 0x1f) | (uint32(j)&0x1f)<<5, nil
+	}
+	return 0, validateXdJ(xd, j)
+}
+
+func validateXdJK(xd, j, k int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantIntReg(2, "k", k); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdJK(bits uint32, xd, j, k int64) (uint32, error) {
+	if uint64(xd) < 32 && uint64(j) < 32 && uint64(k) < 32 {
+		return bits | (uint32(xd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(k)&0x1f)<<10, nil
+	}
+	return 0, validateXdJK(xd, j, k)
+}
+
+func validateXdJSk10(xd, j, sk10 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk10", sk10, 10); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdJSk10(bits uint32, xd, j, sk10 int64) (uint32, error) {
+	if err := validateXdJSk10(xd, j, sk10); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk10)&0x3ff)<<10, nil
+}
+
+func validateXdJSk11(xd, j, sk11 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk11", sk11, 11); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdJSk11(bits uint32, xd, j, sk11 int64) (uint32, error) {
+	if err := validateXdJSk11(xd, j, sk11); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk11)&0x7ff)<<10, nil
+}
+
+func validateXdJSk12(xd, j, sk12 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk12", sk12, 12); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdJSk12(bits uint32, xd, j, sk12 int64) (uint32, error) {
+	if err := validateXdJSk12(xd, j, sk12); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk12)&0xfff)<<10, nil
+}
+
+func validateXdJSk8Un2(xd, j, sk8, un2 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk8", sk8, 8); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(3, "un2", un2, 2); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdJSk8Un2(bits uint32, xd, j, sk8, un2 int64) (uint32, error) {
+	if err := validateXdJSk8Un2(xd, j, sk8, un2); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk8)&0xff)<<10 | (uint32(un2)&0x3)<<18, nil
+}
+
+func validateXdJSk8Un3(xd, j, sk8, un3 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk8", sk8, 8); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(3, "un3", un3, 3); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdJSk8Un3(bits uint32, xd, j, sk8, un3 int64) (uint32, error) {
+	if err := validateXdJSk8Un3(xd, j, sk8, un3); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk8)&0xff)<<10 | (uint32(un3)&0x7)<<18, nil
+}
+
+func validateXdJSk8Un4(xd, j, sk8, un4 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk8", sk8, 8); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(3, "un4", un4, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdJSk8Un4(bits uint32, xd, j, sk8, un4 int64) (uint32, error) {
+	if err := validateXdJSk8Un4(xd, j, sk8, un4); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk8)&0xff)<<10 | (uint32(un4)&0xf)<<18, nil
+}
+
+func validateXdJSk8Un5(xd, j, sk8, un5 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk8", sk8, 8); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(3, "un5", un5, 5); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdJSk8Un5(bits uint32, xd, j, sk8, un5 int64) (uint32, error) {
+	if err := validateXdJSk8Un5(xd, j, sk8, un5); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk8)&0xff)<<10 | (uint32(un5)&0x1f)<<18, nil
+}
+
+func validateXdJSk9(xd, j, sk9 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk9", sk9, 9); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdJSk9(bits uint32, xd, j, sk9 int64) (uint32, error) {
+	if err := validateXdJSk9(xd, j, sk9); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(sk9)&0x1ff)<<10, nil
+}
+
+func validateXdJUk2(xd, j, uk2 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk2", uk2, 2); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdJUk2(bits uint32, xd, j, uk2 int64) (uint32, error) {
+	if err := validateXdJUk2(xd, j, uk2); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(uk2)&0x3)<<10, nil
+}
+
+func validateXdJUk3(xd, j, uk3 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantIntReg(1, "j", j); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk3", uk3, 3); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdJUk3(bits uint32, xd, j, uk3 int64) (uint32, error) {
+	if err := validateXdJUk3(xd, j, uk3); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(j)&0x1f)<<5 | (uint32(uk3)&0x7)<<10, nil
+}
+
+func validateXdSj13(xd, sj13 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantSignedImm(1, "sj13", sj13, 13); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdSj13(bits uint32, xd, sj13 int64) (uint32, error) {
+	if err := validateXdSj13(xd, sj13); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(sj13)&0x1fff)<<5, nil
+}
+
+func validateXdXj(xd, xj int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdXj(bits uint32, xd, xj int64) (uint32, error) {
+	if uint64(xd) < 32 && uint64(xj) < 32 {
+		return bits | (uint32(xd) & 0x1f) | (uint32(xj)&0x1f)<<5, nil
+	}
+	return 0, validateXdXj(xd, xj)
+}
+
+func validateXdXjK(xd, xj, k int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	if err := wantIntReg(2, "k", k); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdXjK(bits uint32, xd, xj, k int64) (uint32, error) {
+	if uint64(xd) < 32 && uint64(xj) < 32 && uint64(k) < 32 {
+		return bits | (uint32(xd) & 0x1f) | (uint32(xj)&0x1f)<<5 | (uint32(k)&0x1f)<<10, nil
+	}
+	return 0, validateXdXjK(xd, xj, k)
+}
+
+func validateXdXjSk5(xd, xj, sk5 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	if err := wantSignedImm(2, "sk5", sk5, 5); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdXjSk5(bits uint32, xd, xj, sk5 int64) (uint32, error) {
+	if err := validateXdXjSk5(xd, xj, sk5); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(xj)&0x1f)<<5 | (uint32(sk5)&0x1f)<<10, nil
+}
+
+func validateXdXjUk1(xd, xj, uk1 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk1", uk1, 1); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdXjUk1(bits uint32, xd, xj, uk1 int64) (uint32, error) {
+	if err := validateXdXjUk1(xd, xj, uk1); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(xj)&0x1f)<<5 | (uint32(uk1)&0x1)<<10, nil
+}
+
+func validateXdXjUk2(xd, xj, uk2 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk2", uk2, 2); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdXjUk2(bits uint32, xd, xj, uk2 int64) (uint32, error) {
+	if err := validateXdXjUk2(xd, xj, uk2); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(xj)&0x1f)<<5 | (uint32(uk2)&0x3)<<10, nil
+}
+
+func validateXdXjUk3(xd, xj, uk3 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk3", uk3, 3); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdXjUk3(bits uint32, xd, xj, uk3 int64) (uint32, error) {
+	if err := validateXdXjUk3(xd, xj, uk3); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(xj)&0x1f)<<5 | (uint32(uk3)&0x7)<<10, nil
+}
+
+func validateXdXjUk4(xd, xj, uk4 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk4", uk4, 4); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdXjUk4(bits uint32, xd, xj, uk4 int64) (uint32, error) {
+	if err := validateXdXjUk4(xd, xj, uk4); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(xj)&0x1f)<<5 | (uint32(uk4)&0xf)<<10, nil
+}
+
+func validateXdXjUk5(xd, xj, uk5 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk5", uk5, 5); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdXjUk5(bits uint32, xd, xj, uk5 int64) (uint32, error) {
+	if err := validateXdXjUk5(xd, xj, uk5); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(xj)&0x1f)<<5 | (uint32(uk5)&0x1f)<<10, nil
+}
+
+func validateXdXjUk6(xd, xj, uk6 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk6", uk6, 6); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdXjUk6(bits uint32, xd, xj, uk6 int64) (uint32, error) {
+	if err := validateXdXjUk6(xd, xj, uk6); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(xj)&0x1f)<<5 | (uint32(uk6)&0x3f)<<10, nil
+}
+
+func validateXdXjUk7(xd, xj, uk7 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk7", uk7, 7); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdXjUk7(bits uint32, xd, xj, uk7 int64) (uint32, error) {
+	if err := validateXdXjUk7(xd, xj, uk7); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(xj)&0x1f)<<5 | (uint32(uk7)&0x7f)<<10, nil
+}
+
+func validateXdXjUk8(xd, xj, uk8 int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	if err := wantUnsignedImm(2, "uk8", uk8, 8); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdXjUk8(bits uint32, xd, xj, uk8 int64) (uint32, error) {
+	if err := validateXdXjUk8(xd, xj, uk8); err != nil {
+		return 0, err
+	}
+
+	return bits | (uint32(xd) & 0x1f) | (uint32(xj)&0x1f)<<5 | (uint32(uk8)&0xff)<<10, nil
+}
+
+func validateXdXjXk(xd, xj, xk int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	if err := wantXReg(2, "xk", xk); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdXjXk(bits uint32, xd, xj, xk int64) (uint32, error) {
+	if uint64(xd) < 32 && uint64(xj) < 32 && uint64(xk) < 32 {
+		return bits | (uint32(xd) & 0x1f) | (uint32(xj)&0x1f)<<5 | (uint32(xk)&0x1f)<<10, nil
+	}
+	return 0, validateXdXjXk(xd, xj, xk)
+}
+
+func validateXdXjXkXa(xd, xj, xk, xa int64) error {
+	if err := wantXReg(0, "xd", xd); err != nil {
+		return err
+	}
+	if err := wantXReg(1, "xj", xj); err != nil {
+		return err
+	}
+	if err := wantXReg(2, "xk", xk); err != nil {
+		return err
+	}
+	if err := wantXReg(3, "xa", xa); err != nil {
+		return err
+	}
+	return nil
+}
+
+func encodeXdXjXkXa(bits uint32, xd, xj, xk, xa int64) (uint32, error) {
+	if uint64(xd) < 32 && uint64(xj) < 32 && uint64(xk) < 32 && uint64(xa) < 32 {
+		return bits | (uint32(xd) & 0x1f) | (uint32(xj)&0x1f)<<5 | (uint32(xk)&0x1f)<<10 | (uint32(xa)&0x1f)<<15, nil
+	}
+	return 0, validateXdXjXkXa(xd, xj, xk, xa)
+}
+
+// Encode encodes the instruction with the given mnemonic and operands, in
+// the canonical operand order.
+func Encode(mnemonic string, operands ...int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+
+	if arity := insnFormatArities[insn.fmt]; len(operands) != arity {
+		return 0, &ArityError{Mnemonic: mnemonic, Want: arity, Got: len(operands)}
+	}
+
+	result, err := encodeInsn(insn, operands)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+func encodeInsn(insn insn, operands []int64) (uint32, error) {
+	switch insn.fmt {
+	case insnFormatCdFj:
+		return encodeCdFj(insn.bits, operands[0], operands[1])
+	case insnFormatCdFjFk:
+		return encodeCdFjFk(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatCdJ:
+		return encodeCdJ(insn.bits, operands[0], operands[1])
+	case insnFormatCdVj:
+		return encodeCdVj(insn.bits, operands[0], operands[1])
+	case insnFormatCdXj:
+		return encodeCdXj(insn.bits, operands[0], operands[1])
+	case insnFormatCjSd5k16:
+		return encodeCjSd5k16(insn.bits, operands[0], operands[1])
+	case insnFormatD:
+		return encodeD(insn.bits, operands[0])
+	case insnFormatDCj:
+		return encodeDCj(insn.bits, operands[0], operands[1])
+	case insnFormatDFj:
+		return encodeDFj(insn.bits, operands[0], operands[1])
+	case insnFormatDJ:
+		return encodeDJ(insn.bits, operands[0], operands[1])
+	case insnFormatDJK:
+		return encodeDJK(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDJKUa2:
+		return encodeDJKUa2(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	case insnFormatDJKUa3:
+		return encodeDJKUa3(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	case insnFormatDJSk12:
+		return encodeDJSk12(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDJSk14:
+		return encodeDJSk14(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDJSk16:
+		return encodeDJSk16(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDJSk5:
+		return encodeDJSk5(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDJUk12:
+		return encodeDJUk12(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDJUk14:
+		return encodeDJUk14(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDJUk3:
+		return encodeDJUk3(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDJUk4:
+		return encodeDJUk4(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDJUk5:
+		return encodeDJUk5(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDJUk5Um5:
+		return encodeDJUk5Um5(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	case insnFormatDJUk6:
+		return encodeDJUk6(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDJUk6Um6:
+		return encodeDJUk6Um6(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	case insnFormatDJUk8:
+		return encodeDJUk8(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDSj20:
+		return encodeDSj20(insn.bits, operands[0], operands[1])
+	case insnFormatDTj:
+		return encodeDTj(insn.bits, operands[0], operands[1])
+	case insnFormatDUj5:
+		return encodeDUj5(insn.bits, operands[0], operands[1])
+	case insnFormatDUj5Uk8:
+		return encodeDUj5Uk8(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDUk4:
+		return encodeDUk4(insn.bits, operands[0], operands[1])
+	case insnFormatDUk8:
+		return encodeDUk8(insn.bits, operands[0], operands[1])
+	case insnFormatDVjUk1:
+		return encodeDVjUk1(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDVjUk2:
+		return encodeDVjUk2(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDVjUk3:
+		return encodeDVjUk3(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDVjUk4:
+		return encodeDVjUk4(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDXjUk2:
+		return encodeDXjUk2(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatDXjUk3:
+		return encodeDXjUk3(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatEMPTY:
+		return encodeEMPTY(insn.bits)
+	case insnFormatFdCj:
+		return encodeFdCj(insn.bits, operands[0], operands[1])
+	case insnFormatFdFj:
+		return encodeFdFj(insn.bits, operands[0], operands[1])
+	case insnFormatFdFjFk:
+		return encodeFdFjFk(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatFdFjFkCa:
+		return encodeFdFjFkCa(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	case insnFormatFdFjFkFa:
+		return encodeFdFjFkFa(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	case insnFormatFdJ:
+		return encodeFdJ(insn.bits, operands[0], operands[1])
+	case insnFormatFdJK:
+		return encodeFdJK(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatFdJSk12:
+		return encodeFdJSk12(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatJ:
+		return encodeJ(insn.bits, operands[0])
+	case insnFormatJK:
+		return encodeJK(insn.bits, operands[0], operands[1])
+	case insnFormatJKUd4:
+		return encodeJKUd4(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatJKUd5:
+		return encodeJKUd5(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatJSd5k16:
+		return encodeJSd5k16(insn.bits, operands[0], operands[1])
+	case insnFormatJUd4Uk5:
+		return encodeJUd4Uk5(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatJUd5:
+		return encodeJUd5(insn.bits, operands[0], operands[1])
+	case insnFormatJUd5Sk12:
+		return encodeJUd5Sk12(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatJUk3:
+		return encodeJUk3(insn.bits, operands[0], operands[1])
+	case insnFormatJUk4:
+		return encodeJUk4(insn.bits, operands[0], operands[1])
+	case insnFormatJUk5:
+		return encodeJUk5(insn.bits, operands[0], operands[1])
+	case insnFormatJUk6:
+		return encodeJUk6(insn.bits, operands[0], operands[1])
+	case insnFormatJUk8:
+		return encodeJUk8(insn.bits, operands[0], operands[1])
+	case insnFormatSd10k16:
+		return encodeSd10k16(insn.bits, operands[0])
+	case insnFormatSd5k16:
+		return encodeSd5k16(insn.bits, operands[0])
+	case insnFormatTdJ:
+		return encodeTdJ(insn.bits, operands[0], operands[1])
+	case insnFormatUd15:
+		return encodeUd15(insn.bits, operands[0])
+	case insnFormatUj3:
+		return encodeUj3(insn.bits, operands[0])
+	case insnFormatVdJ:
+		return encodeVdJ(insn.bits, operands[0], operands[1])
+	case insnFormatVdJK:
+		return encodeVdJK(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdJSk10:
+		return encodeVdJSk10(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdJSk11:
+		return encodeVdJSk11(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdJSk12:
+		return encodeVdJSk12(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdJSk8Un1:
+		return encodeVdJSk8Un1(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	case insnFormatVdJSk8Un2:
+		return encodeVdJSk8Un2(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	case insnFormatVdJSk8Un3:
+		return encodeVdJSk8Un3(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	case insnFormatVdJSk8Un4:
+		return encodeVdJSk8Un4(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	case insnFormatVdJSk9:
+		return encodeVdJSk9(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdJUk1:
+		return encodeVdJUk1(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdJUk2:
+		return encodeVdJUk2(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdJUk3:
+		return encodeVdJUk3(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdJUk4:
+		return encodeVdJUk4(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdSj13:
+		return encodeVdSj13(insn.bits, operands[0], operands[1])
+	case insnFormatVdVj:
+		return encodeVdVj(insn.bits, operands[0], operands[1])
+	case insnFormatVdVjK:
+		return encodeVdVjK(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdVjSk5:
+		return encodeVdVjSk5(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdVjUk1:
+		return encodeVdVjUk1(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdVjUk2:
+		return encodeVdVjUk2(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdVjUk3:
+		return encodeVdVjUk3(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdVjUk4:
+		return encodeVdVjUk4(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdVjUk5:
+		return encodeVdVjUk5(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdVjUk6:
+		return encodeVdVjUk6(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdVjUk7:
+		return encodeVdVjUk7(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdVjUk8:
+		return encodeVdVjUk8(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdVjVk:
+		return encodeVdVjVk(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatVdVjVkVa:
+		return encodeVdVjVkVa(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	case insnFormatXdJ:
+		return encodeXdJ(insn.bits, operands[0], operands[1])
+	case insnFormatXdJK:
+		return encodeXdJK(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdJSk10:
+		return encodeXdJSk10(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdJSk11:
+		return encodeXdJSk11(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdJSk12:
+		return encodeXdJSk12(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdJSk8Un2:
+		return encodeXdJSk8Un2(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	case insnFormatXdJSk8Un3:
+		return encodeXdJSk8Un3(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	case insnFormatXdJSk8Un4:
+		return encodeXdJSk8Un4(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	case insnFormatXdJSk8Un5:
+		return encodeXdJSk8Un5(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	case insnFormatXdJSk9:
+		return encodeXdJSk9(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdJUk2:
+		return encodeXdJUk2(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdJUk3:
+		return encodeXdJUk3(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdSj13:
+		return encodeXdSj13(insn.bits, operands[0], operands[1])
+	case insnFormatXdXj:
+		return encodeXdXj(insn.bits, operands[0], operands[1])
+	case insnFormatXdXjK:
+		return encodeXdXjK(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdXjSk5:
+		return encodeXdXjSk5(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdXjUk1:
+		return encodeXdXjUk1(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdXjUk2:
+		return encodeXdXjUk2(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdXjUk3:
+		return encodeXdXjUk3(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdXjUk4:
+		return encodeXdXjUk4(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdXjUk5:
+		return encodeXdXjUk5(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdXjUk6:
+		return encodeXdXjUk6(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdXjUk7:
+		return encodeXdXjUk7(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdXjUk8:
+		return encodeXdXjUk8(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdXjXk:
+		return encodeXdXjXk(insn.bits, operands[0], operands[1], operands[2])
+	case insnFormatXdXjXkXa:
+		return encodeXdXjXkXa(insn.bits, operands[0], operands[1], operands[2], operands[3])
+	default:
+		panic("should never happen: unknown insn format")
+	}
+}
+
+// formatValidators maps formats to their validators, taking the operands as
+// a slice, whose length must be the arity of the format.
+var formatValidators = map[insnFormat]func(operands []int64) error{
+	insnFormatCdFj: func(operands []int64) error {
+		return validateCdFj(operands[0], operands[1])
+	},
+	insnFormatCdFjFk: func(operands []int64) error {
+		return validateCdFjFk(operands[0], operands[1], operands[2])
+	},
+	insnFormatCdJ: func(operands []int64) error {
+		return validateCdJ(operands[0], operands[1])
+	},
+	insnFormatCdVj: func(operands []int64) error {
+		return validateCdVj(operands[0], operands[1])
+	},
+	insnFormatCdXj: func(operands []int64) error {
+		return validateCdXj(operands[0], operands[1])
+	},
+	insnFormatCjSd5k16: func(operands []int64) error {
+		return validateCjSd5k16(operands[0], operands[1])
+	},
+	insnFormatD: func(operands []int64) error {
+		return validateD(operands[0])
+	},
+	insnFormatDCj: func(operands []int64) error {
+		return validateDCj(operands[0], operands[1])
+	},
+	insnFormatDFj: func(operands []int64) error {
+		return validateDFj(operands[0], operands[1])
+	},
+	insnFormatDJ: func(operands []int64) error {
+		return validateDJ(operands[0], operands[1])
+	},
+	insnFormatDJK: func(operands []int64) error {
+		return validateDJK(operands[0], operands[1], operands[2])
+	},
+	insnFormatDJKUa2: func(operands []int64) error {
+		return validateDJKUa2(operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatDJKUa3: func(operands []int64) error {
+		return validateDJKUa3(operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatDJSk12: func(operands []int64) error {
+		return validateDJSk12(operands[0], operands[1], operands[2])
+	},
+	insnFormatDJSk14: func(operands []int64) error {
+		return validateDJSk14(operands[0], operands[1], operands[2])
+	},
+	insnFormatDJSk16: func(operands []int64) error {
+		return validateDJSk16(operands[0], operands[1], operands[2])
+	},
+	insnFormatDJSk5: func(operands []int64) error {
+		return validateDJSk5(operands[0], operands[1], operands[2])
+	},
+	insnFormatDJUk12: func(operands []int64) error {
+		return validateDJUk12(operands[0], operands[1], operands[2])
+	},
+	insnFormatDJUk14: func(operands []int64) error {
+		return validateDJUk14(operands[0], operands[1], operands[2])
+	},
+	insnFormatDJUk3: func(operands []int64) error {
+		return validateDJUk3(operands[0], operands[1], operands[2])
+	},
+	insnFormatDJUk4: func(operands []int64) error {
+		return validateDJUk4(operands[0], operands[1], operands[2])
+	},
+	insnFormatDJUk5: func(operands []int64) error {
+		return validateDJUk5(operands[0], operands[1], operands[2])
+	},
+	insnFormatDJUk5Um5: func(operands []int64) error {
+		return validateDJUk5Um5(operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatDJUk6: func(operands []int64) error {
+		return validateDJUk6(operands[0], operands[1], operands[2])
+	},
+	insnFormatDJUk6Um6: func(operands []int64) error {
+		return validateDJUk6Um6(operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatDJUk8: func(operands []int64) error {
+		return validateDJUk8(operands[0], operands[1], operands[2])
+	},
+	insnFormatDSj20: func(operands []int64) error {
+		return validateDSj20(operands[0], operands[1])
+	},
+	insnFormatDTj: func(operands []int64) error {
+		return validateDTj(operands[0], operands[1])
+	},
+	insnFormatDUj5: func(operands []int64) error {
+		return validateDUj5(operands[0], operands[1])
+	},
+	insnFormatDUj5Uk8: func(operands []int64) error {
+		return validateDUj5Uk8(operands[0], operands[1], operands[2])
+	},
+	insnFormatDUk4: func(operands []int64) error {
+		return validateDUk4(operands[0], operands[1])
+	},
+	insnFormatDUk8: func(operands []int64) error {
+		return validateDUk8(operands[0], operands[1])
+	},
+	insnFormatDVjUk1: func(operands []int64) error {
+		return validateDVjUk1(operands[0], operands[1], operands[2])
+	},
+	insnFormatDVjUk2: func(operands []int64) error {
+		return validateDVjUk2(operands[0], operands[1], operands[2])
+	},
+	insnFormatDVjUk3: func(operands []int64) error {
+		return validateDVjUk3(operands[0], operands[1], operands[2])
+	},
+	insnFormatDVjUk4: func(operands []int64) error {
+		return validateDVjUk4(operands[0], operands[1], operands[2])
+	},
+	insnFormatDXjUk2: func(operands []int64) error {
+		return validateDXjUk2(operands[0], operands[1], operands[2])
+	},
+	insnFormatDXjUk3: func(operands []int64) error {
+		return validateDXjUk3(operands[0], operands[1], operands[2])
+	},
+	insnFormatEMPTY: func(operands []int64) error {
+		return validateEMPTY()
+	},
+	insnFormatFdCj: func(operands []int64) error {
+		return validateFdCj(operands[0], operands[1])
+	},
+	insnFormatFdFj: func(operands []int64) error {
+		return validateFdFj(operands[0], operands[1])
+	},
+	insnFormatFdFjFk: func(operands []int64) error {
+		return validateFdFjFk(operands[0], operands[1], operands[2])
+	},
+	insnFormatFdFjFkCa: func(operands []int64) error {
+		return validateFdFjFkCa(operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatFdFjFkFa: func(operands []int64) error {
+		return validateFdFjFkFa(operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatFdJ: func(operands []int64) error {
+		return validateFdJ(operands[0], operands[1])
+	},
+	insnFormatFdJK: func(operands []int64) error {
+		return validateFdJK(operands[0], operands[1], operands[2])
+	},
+	insnFormatFdJSk12: func(operands []int64) error {
+		return validateFdJSk12(operands[0], operands[1], operands[2])
+	},
+	insnFormatJ: func(operands []int64) error {
+		return validateJ(operands[0])
+	},
+	insnFormatJK: func(operands []int64) error {
+		return validateJK(operands[0], operands[1])
+	},
+	insnFormatJKUd4: func(operands []int64) error {
+		return validateJKUd4(operands[0], operands[1], operands[2])
+	},
+	insnFormatJKUd5: func(operands []int64) error {
+		return validateJKUd5(operands[0], operands[1], operands[2])
+	},
+	insnFormatJSd5k16: func(operands []int64) error {
+		return validateJSd5k16(operands[0], operands[1])
+	},
+	insnFormatJUd4Uk5: func(operands []int64) error {
+		return validateJUd4Uk5(operands[0], operands[1], operands[2])
+	},
+	insnFormatJUd5: func(operands []int64) error {
+		return validateJUd5(operands[0], operands[1])
+	},
+	insnFormatJUd5Sk12: func(operands []int64) error {
+		return validateJUd5Sk12(operands[0], operands[1], operands[2])
+	},
+	insnFormatJUk3: func(operands []int64) error {
+		return validateJUk3(operands[0], operands[1])
+	},
+	insnFormatJUk4: func(operands []int64) error {
+		return validateJUk4(operands[0], operands[1])
+	},
+	insnFormatJUk5: func(operands []int64) error {
+		return validateJUk5(operands[0], operands[1])
+	},
+	insnFormatJUk6: func(operands []int64) error {
+		return validateJUk6(operands[0], operands[1])
+	},
+	insnFormatJUk8: func(operands []int64) error {
+		return validateJUk8(operands[0], operands[1])
+	},
+	insnFormatSd10k16: func(operands []int64) error {
+		return validateSd10k16(operands[0])
+	},
+	insnFormatSd5k16: func(operands []int64) error {
+		return validateSd5k16(operands[0])
+	},
+	insnFormatTdJ: func(operands []int64) error {
+		return validateTdJ(operands[0], operands[1])
+	},
+	insnFormatUd15: func(operands []int64) error {
+		return validateUd15(operands[0])
+	},
+	insnFormatUj3: func(operands []int64) error {
+		return validateUj3(operands[0])
+	},
+	insnFormatVdJ: func(operands []int64) error {
+		return validateVdJ(operands[0], operands[1])
+	},
+	insnFormatVdJK: func(operands []int64) error {
+		return validateVdJK(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJSk10: func(operands []int64) error {
+		return validateVdJSk10(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJSk11: func(operands []int64) error {
+		return validateVdJSk11(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJSk12: func(operands []int64) error {
+		return validateVdJSk12(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJSk8Un1: func(operands []int64) error {
+		return validateVdJSk8Un1(operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatVdJSk8Un2: func(operands []int64) error {
+		return validateVdJSk8Un2(operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatVdJSk8Un3: func(operands []int64) error {
+		return validateVdJSk8Un3(operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatVdJSk8Un4: func(operands []int64) error {
+		return validateVdJSk8Un4(operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatVdJSk9: func(operands []int64) error {
+		return validateVdJSk9(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJUk1: func(operands []int64) error {
+		return validateVdJUk1(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJUk2: func(operands []int64) error {
+		return validateVdJUk2(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJUk3: func(operands []int64) error {
+		return validateVdJUk3(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJUk4: func(operands []int64) error {
+		return validateVdJUk4(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdSj13: func(operands []int64) error {
+		return validateVdSj13(operands[0], operands[1])
+	},
+	insnFormatVdVj: func(operands []int64) error {
+		return validateVdVj(operands[0], operands[1])
+	},
+	insnFormatVdVjK: func(operands []int64) error {
+		return validateVdVjK(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjSk5: func(operands []int64) error {
+		return validateVdVjSk5(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk1: func(operands []int64) error {
+		return validateVdVjUk1(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk2: func(operands []int64) error {
+		return validateVdVjUk2(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk3: func(operands []int64) error {
+		return validateVdVjUk3(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk4: func(operands []int64) error {
+		return validateVdVjUk4(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk5: func(operands []int64) error {
+		return validateVdVjUk5(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk6: func(operands []int64) error {
+		return validateVdVjUk6(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk7: func(operands []int64) error {
+		return validateVdVjUk7(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk8: func(operands []int64) error {
+		return validateVdVjUk8(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjVk: func(operands []int64) error {
+		return validateVdVjVk(operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjVkVa: func(operands []int64) error {
+		return validateVdVjVkVa(operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatXdJ: func(operands []int64) error {
+		return validateXdJ(operands[0], operands[1])
+	},
+	insnFormatXdJK: func(operands []int64) error {
+		return validateXdJK(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdJSk10: func(operands []int64) error {
+		return validateXdJSk10(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdJSk11: func(operands []int64) error {
+		return validateXdJSk11(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdJSk12: func(operands []int64) error {
+		return validateXdJSk12(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdJSk8Un2: func(operands []int64) error {
+		return validateXdJSk8Un2(operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatXdJSk8Un3: func(operands []int64) error {
+		return validateXdJSk8Un3(operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatXdJSk8Un4: func(operands []int64) error {
+		return validateXdJSk8Un4(operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatXdJSk8Un5: func(operands []int64) error {
+		return validateXdJSk8Un5(operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatXdJSk9: func(operands []int64) error {
+		return validateXdJSk9(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdJUk2: func(operands []int64) error {
+		return validateXdJUk2(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdJUk3: func(operands []int64) error {
+		return validateXdJUk3(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdSj13: func(operands []int64) error {
+		return validateXdSj13(operands[0], operands[1])
+	},
+	insnFormatXdXj: func(operands []int64) error {
+		return validateXdXj(operands[0], operands[1])
+	},
+	insnFormatXdXjK: func(operands []int64) error {
+		return validateXdXjK(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjSk5: func(operands []int64) error {
+		return validateXdXjSk5(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk1: func(operands []int64) error {
+		return validateXdXjUk1(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk2: func(operands []int64) error {
+		return validateXdXjUk2(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk3: func(operands []int64) error {
+		return validateXdXjUk3(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk4: func(operands []int64) error {
+		return validateXdXjUk4(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk5: func(operands []int64) error {
+		return validateXdXjUk5(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk6: func(operands []int64) error {
+		return validateXdXjUk6(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk7: func(operands []int64) error {
+		return validateXdXjUk7(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk8: func(operands []int64) error {
+		return validateXdXjUk8(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjXk: func(operands []int64) error {
+		return validateXdXjXk(operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjXkXa: func(operands []int64) error {
+		return validateXdXjXkXa(operands[0], operands[1], operands[2], operands[3])
+	},
+}
+
+// formatEncoders maps formats to their encoders, taking the operands as a
+// slice, whose length must be the arity of the format.
+var formatEncoders = map[insnFormat]func(bits uint32, operands []int64) (uint32, error){
+	insnFormatCdFj: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeCdFj(bits, operands[0], operands[1])
+	},
+	insnFormatCdFjFk: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeCdFjFk(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatCdJ: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeCdJ(bits, operands[0], operands[1])
+	},
+	insnFormatCdVj: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeCdVj(bits, operands[0], operands[1])
+	},
+	insnFormatCdXj: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeCdXj(bits, operands[0], operands[1])
+	},
+	insnFormatCjSd5k16: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeCjSd5k16(bits, operands[0], operands[1])
+	},
+	insnFormatD: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeD(bits, operands[0])
+	},
+	insnFormatDCj: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDCj(bits, operands[0], operands[1])
+	},
+	insnFormatDFj: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDFj(bits, operands[0], operands[1])
+	},
+	insnFormatDJ: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJ(bits, operands[0], operands[1])
+	},
+	insnFormatDJK: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJK(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDJKUa2: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJKUa2(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatDJKUa3: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJKUa3(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatDJSk12: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJSk12(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDJSk14: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJSk14(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDJSk16: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJSk16(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDJSk5: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJSk5(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDJUk12: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJUk12(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDJUk14: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJUk14(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDJUk3: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJUk3(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDJUk4: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJUk4(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDJUk5: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJUk5(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDJUk5Um5: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJUk5Um5(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatDJUk6: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJUk6(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDJUk6Um6: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJUk6Um6(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatDJUk8: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDJUk8(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDSj20: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDSj20(bits, operands[0], operands[1])
+	},
+	insnFormatDTj: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDTj(bits, operands[0], operands[1])
+	},
+	insnFormatDUj5: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDUj5(bits, operands[0], operands[1])
+	},
+	insnFormatDUj5Uk8: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDUj5Uk8(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDUk4: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDUk4(bits, operands[0], operands[1])
+	},
+	insnFormatDUk8: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDUk8(bits, operands[0], operands[1])
+	},
+	insnFormatDVjUk1: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDVjUk1(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDVjUk2: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDVjUk2(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDVjUk3: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDVjUk3(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDVjUk4: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDVjUk4(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDXjUk2: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDXjUk2(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatDXjUk3: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeDXjUk3(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatEMPTY: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeEMPTY(bits)
+	},
+	insnFormatFdCj: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeFdCj(bits, operands[0], operands[1])
+	},
+	insnFormatFdFj: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeFdFj(bits, operands[0], operands[1])
+	},
+	insnFormatFdFjFk: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeFdFjFk(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatFdFjFkCa: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeFdFjFkCa(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatFdFjFkFa: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeFdFjFkFa(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatFdJ: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeFdJ(bits, operands[0], operands[1])
+	},
+	insnFormatFdJK: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeFdJK(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatFdJSk12: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeFdJSk12(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatJ: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeJ(bits, operands[0])
+	},
+	insnFormatJK: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeJK(bits, operands[0], operands[1])
+	},
+	insnFormatJKUd4: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeJKUd4(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatJKUd5: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeJKUd5(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatJSd5k16: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeJSd5k16(bits, operands[0], operands[1])
+	},
+	insnFormatJUd4Uk5: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeJUd4Uk5(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatJUd5: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeJUd5(bits, operands[0], operands[1])
+	},
+	insnFormatJUd5Sk12: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeJUd5Sk12(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatJUk3: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeJUk3(bits, operands[0], operands[1])
+	},
+	insnFormatJUk4: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeJUk4(bits, operands[0], operands[1])
+	},
+	insnFormatJUk5: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeJUk5(bits, operands[0], operands[1])
+	},
+	insnFormatJUk6: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeJUk6(bits, operands[0], operands[1])
+	},
+	insnFormatJUk8: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeJUk8(bits, operands[0], operands[1])
+	},
+	insnFormatSd10k16: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeSd10k16(bits, operands[0])
+	},
+	insnFormatSd5k16: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeSd5k16(bits, operands[0])
+	},
+	insnFormatTdJ: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeTdJ(bits, operands[0], operands[1])
+	},
+	insnFormatUd15: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeUd15(bits, operands[0])
+	},
+	insnFormatUj3: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeUj3(bits, operands[0])
+	},
+	insnFormatVdJ: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdJ(bits, operands[0], operands[1])
+	},
+	insnFormatVdJK: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdJK(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJSk10: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdJSk10(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJSk11: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdJSk11(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJSk12: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdJSk12(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJSk8Un1: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdJSk8Un1(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatVdJSk8Un2: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdJSk8Un2(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatVdJSk8Un3: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdJSk8Un3(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatVdJSk8Un4: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdJSk8Un4(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatVdJSk9: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdJSk9(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJUk1: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdJUk1(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJUk2: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdJUk2(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJUk3: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdJUk3(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdJUk4: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdJUk4(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdSj13: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdSj13(bits, operands[0], operands[1])
+	},
+	insnFormatVdVj: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdVj(bits, operands[0], operands[1])
+	},
+	insnFormatVdVjK: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdVjK(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjSk5: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdVjSk5(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk1: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdVjUk1(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk2: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdVjUk2(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk3: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdVjUk3(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk4: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdVjUk4(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk5: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdVjUk5(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk6: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdVjUk6(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk7: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdVjUk7(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjUk8: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdVjUk8(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjVk: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdVjVk(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatVdVjVkVa: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeVdVjVkVa(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatXdJ: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdJ(bits, operands[0], operands[1])
+	},
+	insnFormatXdJK: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdJK(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdJSk10: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdJSk10(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdJSk11: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdJSk11(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdJSk12: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdJSk12(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdJSk8Un2: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdJSk8Un2(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatXdJSk8Un3: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdJSk8Un3(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatXdJSk8Un4: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdJSk8Un4(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatXdJSk8Un5: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdJSk8Un5(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+	insnFormatXdJSk9: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdJSk9(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdJUk2: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdJUk2(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdJUk3: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdJUk3(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdSj13: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdSj13(bits, operands[0], operands[1])
+	},
+	insnFormatXdXj: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdXj(bits, operands[0], operands[1])
+	},
+	insnFormatXdXjK: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdXjK(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjSk5: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdXjSk5(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk1: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdXjUk1(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk2: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdXjUk2(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk3: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdXjUk3(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk4: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdXjUk4(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk5: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdXjUk5(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk6: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdXjUk6(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk7: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdXjUk7(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjUk8: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdXjUk8(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjXk: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdXjXk(bits, operands[0], operands[1], operands[2])
+	},
+	insnFormatXdXjXkXa: func(bits uint32, operands []int64) (uint32, error) {
+		return encodeXdXjXkXa(bits, operands[0], operands[1], operands[2], operands[3])
+	},
+}
+
+// operandRole is an operand with a role, like the element index of
+// vpickve2gr.b, described in the errors by the role instead of the kind.
+type operandRole struct {
+	idx  int
+	kind string
+}
+
+type insn struct {
+	bits uint32
+	fmt  insnFormat
+	// role is nil if no operand has a role.
+	role *operandRole
+}
+
+var insns = map[string]insn{
+	"movgr2scr":        {bits: 0x00000800, fmt: insnFormatTdJ},
+	"movscr2gr":        {bits: 0x00000c00, fmt: insnFormatDTj},
+	"clo.w":            {bits: 0x00001000, fmt: insnFormatDJ},
+	"clz.w":            {bits: 0x00001400, fmt: insnFormatDJ},
+	"cto.w":            {bits: 0x00001800, fmt: insnFormatDJ},
+	"ctz.w":            {bits: 0x00001c00, fmt: insnFormatDJ},
+	"clo.d":            {bits: 0x00002000, fmt: insnFormatDJ},
+	"clz.d":            {bits: 0x00002400, fmt: insnFormatDJ},
+	"cto.d":            {bits: 0x00002800, fmt: insnFormatDJ},
+	"ctz.d":            {bits: 0x00002c00, fmt: insnFormatDJ},
+	"revb.2h":          {bits: 0x00003000, fmt: insnFormatDJ},
+	"revb.4h":          {bits: 0x00003400, fmt: insnFormatDJ},
+	"revb.2w":          {bits: 0x00003800, fmt: insnFormatDJ},
+	"revb.d":           {bits: 0x00003c00, fmt: insnFormatDJ},
+	"revh.2w":          {bits: 0x00004000, fmt: insnFormatDJ},
+	"revh.d":           {bits: 0x00004400, fmt: insnFormatDJ},
+	"revbit.4b":        {bits: 0x00004800, fmt: insnFormatDJ},
+	"revbit.8b":        {bits: 0x00004c00, fmt: insnFormatDJ},
+	"revbit.w":         {bits: 0x00005000, fmt: insnFormatDJ},
+	"revbit.d":         {bits: 0x00005400, fmt: insnFormatDJ},
+	"sext.h":           {bits: 0x00005800, fmt: insnFormatDJ},
+	"sext.b":           {bits: 0x00005c00, fmt: insnFormatDJ},
+	"rdtimel.w":        {bits: 0x00006000, fmt: insnFormatDJ},
+	"rdtimeh.w":        {bits: 0x00006400, fmt: insnFormatDJ},
+	"rdtime.d":         {bits: 0x00006800, fmt: insnFormatDJ},
+	"cpucfg":           {bits: 0x00006c00, fmt: insnFormatDJ},
+	"x86mttop":         {bits: 0x00007000, fmt: insnFormatUj3},
+	"x86mftop":         {bits: 0x00007400, fmt: insnFormatD},
+	"x86setloope":      {bits: 0x00007800, fmt: insnFormatDJ},
+	"x86setloopne":     {bits: 0x00007c00, fmt: insnFormatDJ},
+	"x86inc.b":         {bits: 0x00008000, fmt: insnFormatJ},
+	"x86inc.h":         {bits: 0x00008001, fmt: insnFormatJ},
+	"x86inc.w":         {bits: 0x00008002, fmt: insnFormatJ},
+	"x86inc.d":         {bits: 0x00008003, fmt: insnFormatJ},
+	"x86dec.b":         {bits: 0x00008004, fmt: insnFormatJ},
+	"x86dec.h":         {bits: 0x00008005, fmt: insnFormatJ},
+	"x86dec.w":         {bits: 0x00008006, fmt: insnFormatJ},
+	"x86dec.d":         {bits: 0x00008007, fmt: insnFormatJ},
+	"x86settm":         {bits: 0x00008008, fmt: insnFormatEMPTY},
+	"x86inctop":        {bits: 0x00008009, fmt: insnFormatEMPTY},
+	"x86clrtm":         {bits: 0x00008028, fmt: insnFormatEMPTY},
+	"x86dectop":        {bits: 0x00008029, fmt: insnFormatEMPTY},
+	"asrtle":           {bits: 0x00010000, fmt: insnFormatJK},
+	"asrtgt":           {bits: 0x00018000, fmt: insnFormatJK},
+	"sladd.w":          {bits: 0x00040000, fmt: insnFormatDJKUa2},
+	"sladd.wu":         {bits: 0x00060000, fmt: insnFormatDJKUa2},
+	"catpick.w":        {bits: 0x00080000, fmt: insnFormatDJKUa2},
+	"catpick.d":        {bits: 0x000c0000, fmt: insnFormatDJKUa3},
+	"add.w":            {bits: 0x00100000, fmt: insnFormatDJK},
+	"add.d":            {bits: 0x00108000, fmt: insnFormatDJK},
+	"sub.w":            {bits: 0x00110000, fmt: insnFormatDJK},
+	"sub.d":            {bits: 0x00118000, fmt: insnFormatDJK},
+	"slt":              {bits: 0x00120000, fmt: insnFormatDJK},
+	"sltu":             {bits: 0x00128000, fmt: insnFormatDJK},
+	"maskeqz":          {bits: 0x00130000, fmt: insnFormatDJK},
+	"masknez":          {bits: 0x00138000, fmt: insnFormatDJK},
+	"nor":              {bits: 0x00140000, fmt: insnFormatDJK},
+	"and":              {bits: 0x00148000, fmt: insnFormatDJK},
+	"or":               {bits: 0x00150000, fmt: insnFormatDJK},
+	"xor":              {bits: 0x00158000, fmt: insnFormatDJK},
+	"orn":              {bits: 0x00160000, fmt: insnFormatDJK},
+	"andn":             {bits: 0x00168000, fmt: insnFormatDJK},
+	"sll.w":            {bits: 0x00170000, fmt: insnFormatDJK},
+	"srl.w":            {bits: 0x00178000, fmt: insnFormatDJK},
+	"sra.w":            {bits: 0x00180000, fmt: insnFormatDJK},
+	"sll.d":            {bits: 0x00188000, fmt: insnFormatDJK},
+	"srl.d":            {bits: 0x00190000, fmt: insnFormatDJK},
+	"sra.d":            {bits: 0x00198000, fmt: insnFormatDJK},
+	"rotr.b":           {bits: 0x001a0000, fmt: insnFormatDJK},
+	"rotr.h":           {bits: 0x001a8000, fmt: insnFormatDJK},
+	"rotr.w":           {bits: 0x001b0000, fmt: insnFormatDJK},
+	"rotr.d":           {bits: 0x001b8000, fmt: insnFormatDJK},
+	"mul.w":            {bits: 0x001c0000, fmt: insnFormatDJK},
+	"mulh.w":           {bits: 0x001c8000, fmt: insnFormatDJK},
+	"mulh.wu":          {bits: 0x001d0000, fmt: insnFormatDJK},
+	"mul.d":            {bits: 0x001d8000, fmt: insnFormatDJK},
+	"mulh.d":           {bits: 0x001e0000, fmt: insnFormatDJK},
+	"mulh.du":          {bits: 0x001e8000, fmt: insnFormatDJK},
+	"mulw.d.w":         {bits: 0x001f0000, fmt: insnFormatDJK},
+	"mulw.d.wu":        {bits: 0x001f8000, fmt: insnFormatDJK},
+	"div.w":            {bits: 0x00200000, fmt: insnFormatDJK},
+	"mod.w":            {bits: 0x00208000, fmt: insnFormatDJK},
+	"div.wu":           {bits: 0x00210000, fmt: insnFormatDJK},
+	"mod.wu":           {bits: 0x00218000, fmt: insnFormatDJK},
+	"div.d":            {bits: 0x00220000, fmt: insnFormatDJK},
+	"mod.d":            {bits: 0x00228000, fmt: insnFormatDJK},
+	"div.du":           {bits: 0x00230000, fmt: insnFormatDJK},
+	"mod.du":           {bits: 0x00238000, fmt: insnFormatDJK},
+	"crc.w.b.w":        {bits: 0x00240000, fmt: insnFormatDJK},
+	"crc.w.h.w":        {bits: 0x00248000, fmt: insnFormatDJK},
+	"crc.w.w.w":        {bits: 0x00250000, fmt: insnFormatDJK},
+	"crc.w.d.w":        {bits: 0x00258000, fmt: insnFormatDJK},
+	"crcc.w.b.w":       {bits: 0x00260000, fmt: insnFormatDJK},
+	"crcc.w.h.w":       {bits: 0x00268000, fmt: insnFormatDJK},
+	"crcc.w.w.w":       {bits: 0x00270000, fmt: insnFormatDJK},
+	"crcc.w.d.w":       {bits: 0x00278000, fmt: insnFormatDJK},
+	"addu12i.w":        {bits: 0x00290000, fmt: insnFormatDJSk5},
+	"addu12i.d":        {bits: 0x00298000, fmt: insnFormatDJSk5},
+	"break":            {bits: 0x002a0000, fmt: insnFormatUd15},
+	"dbgcall":          {bits: 0x002a8000, fmt: insnFormatUd15},
+	"syscall":          {bits: 0x002b0000, fmt: insnFormatUd15},
+	"hypcall":          {bits: 0x002b8000, fmt: insnFormatUd15},
+	"sladd.d":          {bits: 0x002c0000, fmt: insnFormatDJKUa2},
+	"adc.b":            {bits: 0x00300000, fmt: insnFormatDJK},
+	"adc.h":            {bits: 0x00308000, fmt: insnFormatDJK},
+	"adc.w":            {bits: 0x00310000, fmt: insnFormatDJK},
+	"adc.d":            {bits: 0x00318000, fmt: insnFormatDJK},
+	"sbc.b":            {bits: 0x00320000, fmt: insnFormatDJK},
+	"sbc.h":            {bits: 0x00328000, fmt: insnFormatDJK},
+	"sbc.w":            {bits: 0x00330000, fmt: insnFormatDJK},
+	"sbc.d":            {bits: 0x00338000, fmt: insnFormatDJK},
+	"rcr.b":            {bits: 0x00340000, fmt: insnFormatDJK},
+	"rcr.h":            {bits: 0x00348000, fmt: insnFormatDJK},
+	"rcr.w":            {bits: 0x00350000, fmt: insnFormatDJK},
+	"rcr.d":            {bits: 0x00358000, fmt: insnFormatDJK},
+	"armmove":          {bits: 0x00364000, fmt: insnFormatDJUk4},
+	"x86setj":          {bits: 0x00368000, fmt: insnFormatDUk4},
+	"armsetj":          {bits: 0x0036c000, fmt: insnFormatDUk4},
+	"armadd.w":         {bits: 0x00370010, fmt: insnFormatJKUd4},
+	"armsub.w":         {bits: 0x00378010, fmt: insnFormatJKUd4},
+	"armadc.w":         {bits: 0x00380010, fmt: insnFormatJKUd4},
+	"armsbc.w":         {bits: 0x00388010, fmt: insnFormatJKUd4},
+	"armand.w":         {bits: 0x00390010, fmt: insnFormatJKUd4},
+	"armor.w":          {bits: 0x00398010, fmt: insnFormatJKUd4},
+	"armxor.w":         {bits: 0x003a0010, fmt: insnFormatJKUd4},
+	"armsll.w":         {bits: 0x003a8010, fmt: insnFormatJKUd4},
+	"armsrl.w":         {bits: 0x003b0010, fmt: insnFormatJKUd4},
+	"armsra.w":         {bits: 0x003b8010, fmt: insnFormatJKUd4},
+	"armrotr.w":        {bits: 0x003c0010, fmt: insnFormatJKUd4},
+	"armslli.w":        {bits: 0x003c8010, fmt: insnFormatJUd4Uk5},
+	"armsrli.w":        {bits: 0x003d0010, fmt: insnFormatJUd4Uk5},
+	"armsrai.w":        {bits: 0x003d8010, fmt: insnFormatJUd4Uk5},
+	"armrotri.w":       {bits: 0x003e0010, fmt: insnFormatJUd4Uk5},
+	"x86mul.b":         {bits: 0x003e8000, fmt: insnFormatJK},
+	"x86mul.h":         {bits: 0x003e8001, fmt: insnFormatJK},
+	"x86mul.w":         {bits: 0x003e8002, fmt: insnFormatJK},
+	"x86mul.d":         {bits: 0x003e8003, fmt: insnFormatJK},
+	"x86mul.bu":        {bits: 0x003e8004, fmt: insnFormatJK},
+	"x86mul.hu":        {bits: 0x003e8005, fmt: insnFormatJK},
+	"x86mul.wu":        {bits: 0x003e8006, fmt: insnFormatJK},
+	"x86mul.du":        {bits: 0x003e8007, fmt: insnFormatJK},
+	"x86add.wu":        {bits: 0x003f0000, fmt: insnFormatJK},
+	"x86add.du":        {bits: 0x003f0001, fmt: insnFormatJK},
+	"x86sub.wu":        {bits: 0x003f0002, fmt: insnFormatJK},
+	"x86sub.du":        {bits: 0x003f0003, fmt: insnFormatJK},
+	"x86add.b":         {bits: 0x003f0004, fmt: insnFormatJK},
+	"x86add.h":         {bits: 0x003f0005, fmt: insnFormatJK},
+	"x86add.w":         {bits: 0x003f0006, fmt: insnFormatJK},
+	"x86add.d":         {bits: 0x003f0007, fmt: insnFormatJK},
+	"x86sub.b":         {bits: 0x003f0008, fmt: insnFormatJK},
+	"x86sub.h":         {bits: 0x003f0009, fmt: insnFormatJK},
+	"x86sub.w":         {bits: 0x003f000a, fmt: insnFormatJK},
+	"x86sub.d":         {bits: 0x003f000b, fmt: insnFormatJK},
+	"x86adc.b":         {bits: 0x003f000c, fmt: insnFormatJK},
+	"x86adc.h":         {bits: 0x003f000d, fmt: insnFormatJK},
+	"x86adc.w":         {bits: 0x003f000e, fmt: insnFormatJK},
+	"x86adc.d":         {bits: 0x003f000f, fmt: insnFormatJK},
+	"x86sbc.b":         {bits: 0x003f0010, fmt: insnFormatJK},
+	"x86sbc.h":         {bits: 0x003f0011, fmt: insnFormatJK},
+	"x86sbc.w":         {bits: 0x003f0012, fmt: insnFormatJK},
+	"x86sbc.d":         {bits: 0x003f0013, fmt: insnFormatJK},
+	"x86sll.b":         {bits: 0x003f0014, fmt: insnFormatJK},
+	"x86sll.h":         {bits: 0x003f0015, fmt: insnFormatJK},
+	"x86sll.w":         {bits: 0x003f0016, fmt: insnFormatJK},
+	"x86sll.d":         {bits: 0x003f0017, fmt: insnFormatJK},
+	"x86srl.b":         {bits: 0x003f0018, fmt: insnFormatJK},
+	"x86srl.h":         {bits: 0x003f0019, fmt: insnFormatJK},
+	"x86srl.w":         {bits: 0x003f001a, fmt: insnFormatJK},
+	"x86srl.d":         {bits: 0x003f001b, fmt: insnFormatJK},
+	"x86sra.b":         {bits: 0x003f001c, fmt: insnFormatJK},
+	"x86sra.h":         {bits: 0x003f001d, fmt: insnFormatJK},
+	"x86sra.w":         {bits: 0x003f001e, fmt: insnFormatJK},
+	"x86sra.d":         {bits: 0x003f001f, fmt: insnFormatJK},
+	"x86rotr.b":        {bits: 0x003f8000, fmt: insnFormatJK},
+	"x86rotr.h":        {bits: 0x003f8001, fmt: insnFormatJK},
+	"x86rotr.d":        {bits: 0x003f8002, fmt: insnFormatJK},
+	"x86rotr.w":        {bits: 0x003f8003, fmt: insnFormatJK},
+	"x86rotl.b":        {bits: 0x003f8004, fmt: insnFormatJK},
+	"x86rotl.h":        {bits: 0x003f8005, fmt: insnFormatJK},
+	"x86rotl.w":        {bits: 0x003f8006, fmt: insnFormatJK},
+	"x86rotl.d":        {bits: 0x003f8007, fmt: insnFormatJK},
+	"x86rcr.b":         {bits: 0x003f8008, fmt: insnFormatJK},
+	"x86rcr.h":         {bits: 0x003f8009, fmt: insnFormatJK},
+	"x86rcr.w":         {bits: 0x003f800a, fmt: insnFormatJK},
+	"x86rcr.d":         {bits: 0x003f800b, fmt: insnFormatJK},
+	"x86rcl.b":         {bits: 0x003f800c, fmt: insnFormatJK},
+	"x86rcl.h":         {bits: 0x003f800d, fmt: insnFormatJK},
+	"x86rcl.w":         {bits: 0x003f800e, fmt: insnFormatJK},
+	"x86rcl.d":         {bits: 0x003f800f, fmt: insnFormatJK},
+	"x86and.b":         {bits: 0x003f8010, fmt: insnFormatJK},
+	"x86and.h":         {bits: 0x003f8011, fmt: insnFormatJK},
+	"x86and.w":         {bits: 0x003f8012, fmt: insnFormatJK},
+	"x86and.d":         {bits: 0x003f8013, fmt: insnFormatJK},
+	"x86or.b":          {bits: 0x003f8014, fmt: insnFormatJK},
+	"x86or.h":          {bits: 0x003f8015, fmt: insnFormatJK},
+	"x86or.w":          {bits: 0x003f8016, fmt: insnFormatJK},
+	"x86or.d":          {bits: 0x003f8017, fmt: insnFormatJK},
+	"x86xor.b":         {bits: 0x003f8018, fmt: insnFormatJK},
+	"x86xor.h":         {bits: 0x003f8019, fmt: insnFormatJK},
+	"x86xor.w":         {bits: 0x003f801a, fmt: insnFormatJK},
+	"x86xor.d":         {bits: 0x003f801b, fmt: insnFormatJK},
+	"armnot.w":         {bits: 0x003fc01c, fmt: insnFormatJUk4},
+	"armmov.w":         {bits: 0x003fc01d, fmt: insnFormatJUk4},
+	"armmov.d":         {bits: 0x003fc01e, fmt: insnFormatJUk4},
+	"armrrx.w":         {bits: 0x003fc01f, fmt: insnFormatJUk4},
+	"slli.w":           {bits: 0x00408000, fmt: insnFormatDJUk5},
+	"slli.d":           {bits: 0x00410000, fmt: insnFormatDJUk6},
+	"srli.w":           {bits: 0x00448000, fmt: insnFormatDJUk5},
+	"srli.d":           {bits: 0x00450000, fmt: insnFormatDJUk6},
+	"srai.w":           {bits: 0x00488000, fmt: insnFormatDJUk5},
+	"srai.d":           {bits: 0x00490000, fmt: insnFormatDJUk6},
+	"rotri.b":          {bits: 0x004c2000, fmt: insnFormatDJUk3},
+	"rotri.h":          {bits: 0x004c4000, fmt: insnFormatDJUk4},
+	"rotri.w":          {bits: 0x004c8000, fmt: insnFormatDJUk5},
+	"rotri.d":          {bits: 0x004d0000, fmt: insnFormatDJUk6},
+	"rcri.b":           {bits: 0x00502000, fmt: insnFormatDJUk3},
+	"rcri.h":           {bits: 0x00504000, fmt: insnFormatDJUk4},
+	"rcri.w":           {bits: 0x00508000, fmt: insnFormatDJUk5},
+	"rcri.d":           {bits: 0x00510000, fmt: insnFormatDJUk6},
+	"x86slli.b":        {bits: 0x00542000, fmt: insnFormatJUk3},
+	"x86srli.b":        {bits: 0x00542004, fmt: insnFormatJUk3},
+	"x86srai.b":        {bits: 0x00542008, fmt: insnFormatJUk3},
+	"x86rotri.b":       {bits: 0x0054200c, fmt: insnFormatJUk3},
+	"x86rcri.b":        {bits: 0x00542010, fmt: insnFormatJUk3},
+	"x86rotli.b":       {bits: 0x00542014, fmt: insnFormatJUk3},
+	"x86rcli.b":        {bits: 0x00542018, fmt: insnFormatJUk3},
+	"x86slli.h":        {bits: 0x00544001, fmt: insnFormatJUk4},
+	"x86srli.h":        {bits: 0x00544005, fmt: insnFormatJUk4},
+	"x86srai.h":        {bits: 0x00544009, fmt: insnFormatJUk4},
+	"x86rotri.h":       {bits: 0x0054400d, fmt: insnFormatJUk4},
+	"x86rcri.h":        {bits: 0x00544011, fmt: insnFormatJUk4},
+	"x86rotli.h":       {bits: 0x00544015, fmt: insnFormatJUk4},
+	"x86rcli.h":        {bits: 0x00544019, fmt: insnFormatJUk4},
+	"x86slli.w":        {bits: 0x00548002, fmt: insnFormatJUk5},
+	"x86srli.w":        {bits: 0x00548006, fmt: insnFormatJUk5},
+	"x86srai.w":        {bits: 0x0054800a, fmt: insnFormatJUk5},
+	"x86rotri.w":       {bits: 0x0054800e, fmt: insnFormatJUk5},
+	"x86rcri.w":        {bits: 0x00548012, fmt: insnFormatJUk5},
+	"x86rotli.w":       {bits: 0x00548016, fmt: insnFormatJUk5},
+	"x86rcli.w":        {bits: 0x0054801a, fmt: insnFormatJUk5},
+	"x86slli.d":        {bits: 0x00550003, fmt: insnFormatJUk6},
+	"x86srli.d":        {bits: 0x00550007, fmt: insnFormatJUk6},
+	"x86srai.d":        {bits: 0x0055000b, fmt: insnFormatJUk6},
+	"x86rotri.d":       {bits: 0x0055000f, fmt: insnFormatJUk6},
+	"x86rcri.d":        {bits: 0x00550013, fmt: insnFormatJUk6},
+	"x86rotli.d":       {bits: 0x00550017, fmt: insnFormatJUk6},
+	"x86rcli.d":        {bits: 0x0055001b, fmt: insnFormatJUk6},
+	"x86settag":        {bits: 0x00580000, fmt: insnFormatDUj5Uk8},
+	"x86mfflag":        {bits: 0x005c0000, fmt: insnFormatDUk8},
+	"x86mtflag":        {bits: 0x005c0020, fmt: insnFormatDUk8},
+	"armmfflag":        {bits: 0x005c0040, fmt: insnFormatDUk8},
+	"armmtflag":        {bits: 0x005c0060, fmt: insnFormatDUk8},
+	"bstrins.w":        {bits: 0x00600000, fmt: insnFormatDJUk5Um5},
+	"bstrpick.w":       {bits: 0x00608000, fmt: insnFormatDJUk5Um5},
+	"bstrins.d":        {bits: 0x00800000, fmt: insnFormatDJUk6Um6},
+	"bstrpick.d":       {bits: 0x00c00000, fmt: insnFormatDJUk6Um6},
+	"fadd.s":           {bits: 0x01008000, fmt: insnFormatFdFjFk},
+	"fadd.d":           {bits: 0x01010000, fmt: insnFormatFdFjFk},
+	"fsub.s":           {bits: 0x01028000, fmt: insnFormatFdFjFk},
+	"fsub.d":           {bits: 0x01030000, fmt: insnFormatFdFjFk},
+	"fmul.s":           {bits: 0x01048000, fmt: insnFormatFdFjFk},
+	"fmul.d":           {bits: 0x01050000, fmt: insnFormatFdFjFk},
+	"fdiv.s":           {bits: 0x01068000, fmt: insnFormatFdFjFk},
+	"fdiv.d":           {bits: 0x01070000, fmt: insnFormatFdFjFk},
+	"fmax.s":           {bits: 0x01088000, fmt: insnFormatFdFjFk},
+	"fmax.d":           {bits: 0x01090000, fmt: insnFormatFdFjFk},
+	"fmin.s":           {bits: 0x010a8000, fmt: insnFormatFdFjFk},
+	"fmin.d":           {bits: 0x010b0000, fmt: insnFormatFdFjFk},
+	"fmaxa.s":          {bits: 0x010c8000, fmt: insnFormatFdFjFk},
+	"fmaxa.d":          {bits: 0x010d0000, fmt: insnFormatFdFjFk},
+	"fmina.s":          {bits: 0x010e8000, fmt: insnFormatFdFjFk},
+	"fmina.d":          {bits: 0x010f0000, fmt: insnFormatFdFjFk},
+	"fscaleb.s":        {bits: 0x01108000, fmt: insnFormatFdFjFk},
+	"fscaleb.d":        {bits: 0x01110000, fmt: insnFormatFdFjFk},
+	"fcopysign.s":      {bits: 0x01128000, fmt: insnFormatFdFjFk},
+	"fcopysign.d":      {bits: 0x01130000, fmt: insnFormatFdFjFk},
+	"fabs.s":           {bits: 0x01140400, fmt: insnFormatFdFj},
+	"fabs.d":           {bits: 0x01140800, fmt: insnFormatFdFj},
+	"fneg.s":           {bits: 0x01141400, fmt: insnFormatFdFj},
+	"fneg.d":           {bits: 0x01141800, fmt: insnFormatFdFj},
+	"flogb.s":          {bits: 0x01142400, fmt: insnFormatFdFj},
+	"flogb.d":          {bits: 0x01142800, fmt: insnFormatFdFj},
+	"fclass.s":         {bits: 0x01143400, fmt: insnFormatFdFj},
+	"fclass.d":         {bits: 0x01143800, fmt: insnFormatFdFj},
+	"fsqrt.s":          {bits: 0x01144400, fmt: insnFormatFdFj},
+	"fsqrt.d":          {bits: 0x01144800, fmt: insnFormatFdFj},
+	"frecip.s":         {bits: 0x01145400, fmt: insnFormatFdFj},
+	"frecip.d":         {bits: 0x01145800, fmt: insnFormatFdFj},
+	"frsqrt.s":         {bits: 0x01146400, fmt: insnFormatFdFj},
+	"frsqrt.d":         {bits: 0x01146800, fmt: insnFormatFdFj},
+	"fmov.s":           {bits: 0x01149400, fmt: insnFormatFdFj},
+	"fmov.d":           {bits: 0x01149800, fmt: insnFormatFdFj},
+	"movgr2fr.w":       {bits: 0x0114a400, fmt: insnFormatFdJ},
+	"movgr2fr.d":       {bits: 0x0114a800, fmt: insnFormatFdJ},
+	"movgr2frh.w":      {bits: 0x0114ac00, fmt: insnFormatFdJ},
+	"movfr2gr.s":       {bits: 0x0114b400, fmt: insnFormatDFj},
+	"movfr2gr.d":       {bits: 0x0114b800, fmt: insnFormatDFj},
+	"movfrh2gr.s":      {bits: 0x0114bc00, fmt: insnFormatDFj},
+	"fcsrwr":           {bits: 0x0114c000, fmt: insnFormatJUd5},
+	"fcsrrd":           {bits: 0x0114c800, fmt: insnFormatDUj5},
+	"movfr2fcc":        {bits: 0x0114d000, fmt: insnFormatCdFj},
+	"movfcc2fr":        {bits: 0x0114d400, fmt: insnFormatFdCj},
+	"movgr2fcc":        {bits: 0x0114d800, fmt: insnFormatCdJ},
+	"movfcc2gr":        {bits: 0x0114dc00, fmt: insnFormatDCj},
+	"fcvt.ld.d":        {bits: 0x0114e000, fmt: insnFormatFdFj},
+	"fcvt.ud.d":        {bits: 0x0114e400, fmt: insnFormatFdFj},
+	"fcvt.d.ld":        {bits: 0x01150000, fmt: insnFormatFdFjFk},
+	"fcvt.s.d":         {bits: 0x01191800, fmt: insnFormatFdFj},
+	"fcvt.d.s":         {bits: 0x01192400, fmt: insnFormatFdFj},
+	"ftintrm.w.s":      {bits: 0x011a0400, fmt: insnFormatFdFj},
+	"ftintrm.w.d":      {bits: 0x011a0800, fmt: insnFormatFdFj},
+	"ftintrm.l.s":      {bits: 0x011a2400, fmt: insnFormatFdFj},
+	"ftintrm.l.d":      {bits: 0x011a2800, fmt: insnFormatFdFj},
+	"ftintrp.w.s":      {bits: 0x011a4400, fmt: insnFormatFdFj},
+	"ftintrp.w.d":      {bits: 0x011a4800, fmt: insnFormatFdFj},
+	"ftintrp.l.s":      {bits: 0x011a6400, fmt: insnFormatFdFj},
+	"ftintrp.l.d":      {bits: 0x011a6800, fmt: insnFormatFdFj},
+	"ftintrz.w.s":      {bits: 0x011a8400, fmt: insnFormatFdFj},
+	"ftintrz.w.d":      {bits: 0x011a8800, fmt: insnFormatFdFj},
+	"ftintrz.l.s":      {bits: 0x011aa400, fmt: insnFormatFdFj},
+	"ftintrz.l.d":      {bits: 0x011aa800, fmt: insnFormatFdFj},
+	"ftintrne.w.s":     {bits: 0x011ac400, fmt: insnFormatFdFj},
+	"ftintrne.w.d":     {bits: 0x011ac800, fmt: insnFormatFdFj},
+	"ftintrne.l.s":     {bits: 0x011ae400, fmt: insnFormatFdFj},
+	"ftintrne.l.d":     {bits: 0x011ae800, fmt: insnFormatFdFj},
+	"ftint.w.s":        {bits: 0x011b0400, fmt: insnFormatFdFj},
+	"ftint.w.d":        {bits: 0x011b0800, fmt: insnFormatFdFj},
+	"ftint.l.s":        {bits: 0x011b2400, fmt: insnFormatFdFj},
+	"ftint.l.d":        {bits: 0x011b2800, fmt: insnFormatFdFj},
+	"ffint.s.w":        {bits: 0x011d1000, fmt: insnFormatFdFj},
+	"ffint.s.l":        {bits: 0x011d1800, fmt: insnFormatFdFj},
+	"ffint.d.w":        {bits: 0x011d2000, fmt: insnFormatFdFj},
+	"ffint.d.l":        {bits: 0x011d2800, fmt: insnFormatFdFj},
+	"frint.s":          {bits: 0x011e4400, fmt: insnFormatFdFj},
+	"frint.d":          {bits: 0x011e4800, fmt: insnFormatFdFj},
+	"slti":             {bits: 0x02000000, fmt: insnFormatDJSk12},
+	"sltui":            {bits: 0x02400000, fmt: insnFormatDJSk12},
+	"addi.w":           {bits: 0x02800000, fmt: insnFormatDJSk12},
+	"addi.d":           {bits: 0x02c00000, fmt: insnFormatDJSk12},
+	"cu52i.d":          {bits: 0x03000000, fmt: insnFormatDJSk12},
+	"andi":             {bits: 0x03400000, fmt: insnFormatDJUk12},
+	"ori":              {bits: 0x03800000, fmt: insnFormatDJUk12},
+	"xori":             {bits: 0x03c00000, fmt: insnFormatDJUk12},
+	"csrxchg":          {bits: 0x04000000, fmt: insnFormatDJUk14},
+	"gcsrxchg":         {bits: 0x05000000, fmt: insnFormatDJUk14},
+	"cacop":            {bits: 0x06000000, fmt: insnFormatJUd5Sk12, role: &operandRole{idx: 1, kind: "cache operation code"}},
+	"lddir":            {bits: 0x06400000, fmt: insnFormatDJUk8},
+	"ldpte":            {bits: 0x06440000, fmt: insnFormatJUk8},
+	"iocsrrd.b":        {bits: 0x06480000, fmt: insnFormatDJ},
+	"iocsrrd.h":        {bits: 0x06480400, fmt: insnFormatDJ},
+	"iocsrrd.w":        {bits: 0x06480800, fmt: insnFormatDJ},
+	"iocsrrd.d":        {bits: 0x06480c00, fmt: insnFormatDJ},
+	"iocsrwr.b":        {bits: 0x06481000, fmt: insnFormatDJ},
+	"iocsrwr.h":        {bits: 0x06481400, fmt: insnFormatDJ},
+	"iocsrwr.w":        {bits: 0x06481800, fmt: insnFormatDJ},
+	"iocsrwr.d":        {bits: 0x06481c00, fmt: insnFormatDJ},
+	"tlbclr":           {bits: 0x06482000, fmt: insnFormatEMPTY},
+	"gtlbclr":          {bits: 0x06482001, fmt: insnFormatEMPTY},
+	"tlbflush":         {bits: 0x06482400, fmt: insnFormatEMPTY},
+	"gtlbflush":        {bits: 0x06482401, fmt: insnFormatEMPTY},
+	"tlbsrch":          {bits: 0x06482800, fmt: insnFormatEMPTY},
+	"gtlbsrch":         {bits: 0x06482801, fmt: insnFormatEMPTY},
+	"tlbrd":            {bits: 0x06482c00, fmt: insnFormatEMPTY},
+	"gtlbrd":           {bits: 0x06482c01, fmt: insnFormatEMPTY},
+	"tlbwr":            {bits: 0x06483000, fmt: insnFormatEMPTY},
+	"gtlbwr":           {bits: 0x06483001, fmt: insnFormatEMPTY},
+	"tlbfill":          {bits: 0x06483400, fmt: insnFormatEMPTY},
+	"gtlbfill":         {bits: 0x06483401, fmt: insnFormatEMPTY},
+	"eret":             {bits: 0x06483800, fmt: insnFormatEMPTY},
+	"idle":             {bits: 0x06488000, fmt: insnFormatUd15},
+	"tlbinv":           {bits: 0x06498000, fmt: insnFormatJKUd5},
+	"fmadd.s":          {bits: 0x08100000, fmt: insnFormatFdFjFkFa},
+	"fmadd.d":          {bits: 0x08200000, fmt: insnFormatFdFjFkFa},
+	"fmsub.s":          {bits: 0x08500000, fmt: insnFormatFdFjFkFa},
+	"fmsub.d":          {bits: 0x08600000, fmt: insnFormatFdFjFkFa},
+	"fnmadd.s":         {bits: 0x08900000, fmt: insnFormatFdFjFkFa},
+	"fnmadd.d":         {bits: 0x08a00000, fmt: insnFormatFdFjFkFa},
+	"fnmsub.s":         {bits: 0x08d00000, fmt: insnFormatFdFjFkFa},
+	"fnmsub.d":         {bits: 0x08e00000, fmt: insnFormatFdFjFkFa},
+	"vfmadd.s":         {bits: 0x09100000, fmt: insnFormatVdVjVkVa},
+	"vfmadd.d":         {bits: 0x09200000, fmt: insnFormatVdVjVkVa},
+	"vfmsub.s":         {bits: 0x09500000, fmt: insnFormatVdVjVkVa},
+	"vfmsub.d":         {bits: 0x09600000, fmt: insnFormatVdVjVkVa},
+	"vfnmadd.s":        {bits: 0x09900000, fmt: insnFormatVdVjVkVa},
+	"vfnmadd.d":        {bits: 0x09a00000, fmt: insnFormatVdVjVkVa},
+	"vfnmsub.s":        {bits: 0x09d00000, fmt: insnFormatVdVjVkVa},
+	"vfnmsub.d":        {bits: 0x09e00000, fmt: insnFormatVdVjVkVa},
+	"xvfmadd.s":        {bits: 0x0a100000, fmt: insnFormatXdXjXkXa},
+	"xvfmadd.d":        {bits: 0x0a200000, fmt: insnFormatXdXjXkXa},
+	"xvfmsub.s":        {bits: 0x0a500000, fmt: insnFormatXdXjXkXa},
+	"xvfmsub.d":        {bits: 0x0a600000, fmt: insnFormatXdXjXkXa},
+	"xvfnmadd.s":       {bits: 0x0a900000, fmt: insnFormatXdXjXkXa},
+	"xvfnmadd.d":       {bits: 0x0aa00000, fmt: insnFormatXdXjXkXa},
+	"xvfnmsub.s":       {bits: 0x0ad00000, fmt: insnFormatXdXjXkXa},
+	"xvfnmsub.d":       {bits: 0x0ae00000, fmt: insnFormatXdXjXkXa},
+	"fcmp.caf.s":       {bits: 0x0c100000, fmt: insnFormatCdFjFk},
+	"fcmp.saf.s":       {bits: 0x0c108000, fmt: insnFormatCdFjFk},
+	"fcmp.clt.s":       {bits: 0x0c110000, fmt: insnFormatCdFjFk},
+	"fcmp.slt.s":       {bits: 0x0c118000, fmt: insnFormatCdFjFk},
+	"fcmp.ceq.s":       {bits: 0x0c120000, fmt: insnFormatCdFjFk},
+	"fcmp.seq.s":       {bits: 0x0c128000, fmt: insnFormatCdFjFk},
+	"fcmp.cle.s":       {bits: 0x0c130000, fmt: insnFormatCdFjFk},
+	"fcmp.sle.s":       {bits: 0x0c138000, fmt: insnFormatCdFjFk},
+	"fcmp.cun.s":       {bits: 0x0c140000, fmt: insnFormatCdFjFk},
+	"fcmp.sun.s":       {bits: 0x0c148000, fmt: insnFormatCdFjFk},
+	"fcmp.cult.s":      {bits: 0x0c150000, fmt: insnFormatCdFjFk},
+	"fcmp.sult.s":      {bits: 0x0c158000, fmt: insnFormatCdFjFk},
+	"fcmp.cueq.s":      {bits: 0x0c160000, fmt: insnFormatCdFjFk},
+	"fcmp.sueq.s":      {bits: 0x0c168000, fmt: insnFormatCdFjFk},
+	"fcmp.cule.s":      {bits: 0x0c170000, fmt: insnFormatCdFjFk},
+	"fcmp.sule.s":      {bits: 0x0c178000, fmt: insnFormatCdFjFk},
+	"fcmp.cne.s":       {bits: 0x0c180000, fmt: insnFormatCdFjFk},
+	"fcmp.sne.s":       {bits: 0x0c188000, fmt: insnFormatCdFjFk},
+	"fcmp.cor.s":       {bits: 0x0c1a0000, fmt: insnFormatCdFjFk},
+	"fcmp.sor.s":       {bits: 0x0c1a8000, fmt: insnFormatCdFjFk},
+	"fcmp.cune.s":      {bits: 0x0c1c0000, fmt: insnFormatCdFjFk},
+	"fcmp.sune.s":      {bits: 0x0c1c8000, fmt: insnFormatCdFjFk},
+	"fcmp.caf.d":       {bits: 0x0c200000, fmt: insnFormatCdFjFk},
+	"fcmp.saf.d":       {bits: 0x0c208000, fmt: insnFormatCdFjFk},
+	"fcmp.clt.d":       {bits: 0x0c210000, fmt: insnFormatCdFjFk},
+	"fcmp.slt.d":       {bits: 0x0c218000, fmt: insnFormatCdFjFk},
+	"fcmp.ceq.d":       {bits: 0x0c220000, fmt: insnFormatCdFjFk},
+	"fcmp.seq.d":       {bits: 0x0c228000, fmt: insnFormatCdFjFk},
+	"fcmp.cle.d":       {bits: 0x0c230000, fmt: insnFormatCdFjFk},
+	"fcmp.sle.d":       {bits: 0x0c238000, fmt: insnFormatCdFjFk},
+	"fcmp.cun.d":       {bits: 0x0c240000, fmt: insnFormatCdFjFk},
+	"fcmp.sun.d":       {bits: 0x0c248000, fmt: insnFormatCdFjFk},
+	"fcmp.cult.d":      {bits: 0x0c250000, fmt: insnFormatCdFjFk},
+	"fcmp.sult.d":      {bits: 0x0c258000, fmt: insnFormatCdFjFk},
+	"fcmp.cueq.d":      {bits: 0x0c260000, fmt: insnFormatCdFjFk},
+	"fcmp.sueq.d":      {bits: 0x0c268000, fmt: insnFormatCdFjFk},
+	"fcmp.cule.d":      {bits: 0x0c270000, fmt: insnFormatCdFjFk},
+	"fcmp.sule.d":      {bits: 0x0c278000, fmt: insnFormatCdFjFk},
+	"fcmp.cne.d":       {bits: 0x0c280000, fmt: insnFormatCdFjFk},
+	"fcmp.sne.d":       {bits: 0x0c288000, fmt: insnFormatCdFjFk},
+	"fcmp.cor.d":       {bits: 0x0c2a0000, fmt: insnFormatCdFjFk},
+	"fcmp.sor.d":       {bits: 0x0c2a8000, fmt: insnFormatCdFjFk},
+	"fcmp.cune.d":      {bits: 0x0c2c0000, fmt: insnFormatCdFjFk},
+	"fcmp.sune.d":      {bits: 0x0c2c8000, fmt: insnFormatCdFjFk},
+	"vfcmp.caf.s":      {bits: 0x0c500000, fmt: insnFormatVdVjVk},
+	"vfcmp.saf.s":      {bits: 0x0c508000, fmt: insnFormatVdVjVk},
+	"vfcmp.clt.s":      {bits: 0x0c510000, fmt: insnFormatVdVjVk},
+	"vfcmp.slt.s":      {bits: 0x0c518000, fmt: insnFormatVdVjVk},
+	"vfcmp.ceq.s":      {bits: 0x0c520000, fmt: insnFormatVdVjVk},
+	"vfcmp.seq.s":      {bits: 0x0c528000, fmt: insnFormatVdVjVk},
+	"vfcmp.cle.s":      {bits: 0x0c530000, fmt: insnFormatVdVjVk},
+	"vfcmp.sle.s":      {bits: 0x0c538000, fmt: insnFormatVdVjVk},
+	"vfcmp.cun.s":      {bits: 0x0c540000, fmt: insnFormatVdVjVk},
+	"vfcmp.sun.s":      {bits: 0x0c548000, fmt: insnFormatVdVjVk},
+	"vfcmp.cult.s":     {bits: 0x0c550000, fmt: insnFormatVdVjVk},
+	"vfcmp.sult.s":     {bits: 0x0c558000, fmt: insnFormatVdVjVk},
+	"vfcmp.cueq.s":     {bits: 0x0c560000, fmt: insnFormatVdVjVk},
+	"vfcmp.sueq.s":     {bits: 0x0c568000, fmt: insnFormatVdVjVk},
+	"vfcmp.cule.s":     {bits: 0x0c570000, fmt: insnFormatVdVjVk},
+	"vfcmp.sule.s":     {bits: 0x0c578000, fmt: insnFormatVdVjVk},
+	"vfcmp.cne.s":      {bits: 0x0c580000, fmt: insnFormatVdVjVk},
+	"vfcmp.sne.s":      {bits: 0x0c588000, fmt: insnFormatVdVjVk},
+	"vfcmp.cor.s":      {bits: 0x0c5a0000, fmt: insnFormatVdVjVk},
+	"vfcmp.sor.s":      {bits: 0x0c5a8000, fmt: insnFormatVdVjVk},
+	"vfcmp.cune.s":     {bits: 0x0c5c0000, fmt: insnFormatVdVjVk},
+	"vfcmp.sune.s":     {bits: 0x0c5c8000, fmt: insnFormatVdVjVk},
+	"vfcmp.caf.d":      {bits: 0x0c600000, fmt: insnFormatVdVjVk},
+	"vfcmp.saf.d":      {bits: 0x0c608000, fmt: insnFormatVdVjVk},
+	"vfcmp.clt.d":      {bits: 0x0c610000, fmt: insnFormatVdVjVk},
+	"vfcmp.slt.d":      {bits: 0x0c618000, fmt: insnFormatVdVjVk},
+	"vfcmp.ceq.d":      {bits: 0x0c620000, fmt: insnFormatVdVjVk},
+	"vfcmp.seq.d":      {bits: 0x0c628000, fmt: insnFormatVdVjVk},
+	"vfcmp.cle.d":      {bits: 0x0c630000, fmt: insnFormatVdVjVk},
+	"vfcmp.sle.d":      {bits: 0x0c638000, fmt: insnFormatVdVjVk},
+	"vfcmp.cun.d":      {bits: 0x0c640000, fmt: insnFormatVdVjVk},
+	"vfcmp.sun.d":      {bits: 0x0c648000, fmt: insnFormatVdVjVk},
+	"vfcmp.cult.d":     {bits: 0x0c650000, fmt: insnFormatVdVjVk},
+	"vfcmp.sult.d":     {bits: 0x0c658000, fmt: insnFormatVdVjVk},
+	"vfcmp.cueq.d":     {bits: 0x0c660000, fmt: insnFormatVdVjVk},
+	"vfcmp.sueq.d":     {bits: 0x0c668000, fmt: insnFormatVdVjVk},
+	"vfcmp.cule.d":     {bits: 0x0c670000, fmt: insnFormatVdVjVk},
+	"vfcmp.sule.d":     {bits: 0x0c678000, fmt: insnFormatVdVjVk},
+	"vfcmp.cne.d":      {bits: 0x0c680000, fmt: insnFormatVdVjVk},
+	"vfcmp.sne.d":      {bits: 0x0c688000, fmt: insnFormatVdVjVk},
+	"vfcmp.cor.d":      {bits: 0x0c6a0000, fmt: insnFormatVdVjVk},
+	"vfcmp.sor.d":      {bits: 0x0c6a8000, fmt: insnFormatVdVjVk},
+	"vfcmp.cune.d":     {bits: 0x0c6c0000, fmt: insnFormatVdVjVk},
+	"vfcmp.sune.d":     {bits: 0x0c6c8000, fmt: insnFormatVdVjVk},
+	"xvfcmp.caf.s":     {bits: 0x0c900000, fmt: insnFormatXdXjXk},
+	"xvfcmp.saf.s":     {bits: 0x0c908000, fmt: insnFormatXdXjXk},
+	"xvfcmp.clt.s":     {bits: 0x0c910000, fmt: insnFormatXdXjXk},
+	"xvfcmp.slt.s":     {bits: 0x0c918000, fmt: insnFormatXdXjXk},
+	"xvfcmp.ceq.s":     {bits: 0x0c920000, fmt: insnFormatXdXjXk},
+	"xvfcmp.seq.s":     {bits: 0x0c928000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cle.s":     {bits: 0x0c930000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sle.s":     {bits: 0x0c938000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cun.s":     {bits: 0x0c940000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sun.s":     {bits: 0x0c948000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cult.s":    {bits: 0x0c950000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sult.s":    {bits: 0x0c958000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cueq.s":    {bits: 0x0c960000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sueq.s":    {bits: 0x0c968000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cule.s":    {bits: 0x0c970000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sule.s":    {bits: 0x0c978000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cne.s":     {bits: 0x0c980000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sne.s":     {bits: 0x0c988000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cor.s":     {bits: 0x0c9a0000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sor.s":     {bits: 0x0c9a8000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cune.s":    {bits: 0x0c9c0000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sune.s":    {bits: 0x0c9c8000, fmt: insnFormatXdXjXk},
+	"xvfcmp.caf.d":     {bits: 0x0ca00000, fmt: insnFormatXdXjXk},
+	"xvfcmp.saf.d":     {bits: 0x0ca08000, fmt: insnFormatXdXjXk},
+	"xvfcmp.clt.d":     {bits: 0x0ca10000, fmt: insnFormatXdXjXk},
+	"xvfcmp.slt.d":     {bits: 0x0ca18000, fmt: insnFormatXdXjXk},
+	"xvfcmp.ceq.d":     {bits: 0x0ca20000, fmt: insnFormatXdXjXk},
+	"xvfcmp.seq.d":     {bits: 0x0ca28000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cle.d":     {bits: 0x0ca30000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sle.d":     {bits: 0x0ca38000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cun.d":     {bits: 0x0ca40000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sun.d":     {bits: 0x0ca48000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cult.d":    {bits: 0x0ca50000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sult.d":    {bits: 0x0ca58000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cueq.d":    {bits: 0x0ca60000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sueq.d":    {bits: 0x0ca68000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cule.d":    {bits: 0x0ca70000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sule.d":    {bits: 0x0ca78000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cne.d":     {bits: 0x0ca80000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sne.d":     {bits: 0x0ca88000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cor.d":     {bits: 0x0caa0000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sor.d":     {bits: 0x0caa8000, fmt: insnFormatXdXjXk},
+	"xvfcmp.cune.d":    {bits: 0x0cac0000, fmt: insnFormatXdXjXk},
+	"xvfcmp.sune.d":    {bits: 0x0cac8000, fmt: insnFormatXdXjXk},
+	"fsel":             {bits: 0x0d000000, fmt: insnFormatFdFjFkCa},
+	"vbitsel.v":        {bits: 0x0d100000, fmt: insnFormatVdVjVkVa},
+	"xvbitsel.v":       {bits: 0x0d200000, fmt: insnFormatXdXjXkXa},
+	"vshuf.b":          {bits: 0x0d500000, fmt: insnFormatVdVjVkVa},
+	"xvshuf.b":         {bits: 0x0d600000, fmt: insnFormatXdXjXkXa},
+	"addu16i.d":        {bits: 0x10000000, fmt: insnFormatDJSk16},
+	"lu12i.w":          {bits: 0x14000000, fmt: insnFormatDSj20},
+	"cu32i.d":          {bits: 0x16000000, fmt: insnFormatDSj20},
+	"pcaddu2i":         {bits: 0x18000000, fmt: insnFormatDSj20},
+	"pcalau12i":        {bits: 0x1a000000, fmt: insnFormatDSj20},
+	"pcaddu12i":        {bits: 0x1c000000, fmt: insnFormatDSj20},
+	"pcaddu18i":        {bits: 0x1e000000, fmt: insnFormatDSj20},
+	"ll.w":             {bits: 0x20000000, fmt: insnFormatDJSk14},
+	"sc.w":             {bits: 0x21000000, fmt: insnFormatDJSk14},
+	"ll.d":             {bits: 0x22000000, fmt: insnFormatDJSk14},
+	"sc.d":             {bits: 0x23000000, fmt: insnFormatDJSk14},
+	"ldox4.w":          {bits: 0x24000000, fmt: insnFormatDJSk14},
+	"stox4.w":          {bits: 0x25000000, fmt: insnFormatDJSk14},
+	"ldox4.d":          {bits: 0x26000000, fmt: insnFormatDJSk14},
+	"stox4.d":          {bits: 0x27000000, fmt: insnFormatDJSk14},
+	"ld.b":             {bits: 0x28000000, fmt: insnFormatDJSk12},
+	"ld.h":             {bits: 0x28400000, fmt: insnFormatDJSk12},
+	"ld.w":             {bits: 0x28800000, fmt: insnFormatDJSk12},
+	"ld.d":             {bits: 0x28c00000, fmt: insnFormatDJSk12},
+	"st.b":             {bits: 0x29000000, fmt: insnFormatDJSk12},
+	"st.h":             {bits: 0x29400000, fmt: insnFormatDJSk12},
+	"st.w":             {bits: 0x29800000, fmt: insnFormatDJSk12},
+	"st.d":             {bits: 0x29c00000, fmt: insnFormatDJSk12},
+	"ld.bu":            {bits: 0x2a000000, fmt: insnFormatDJSk12},
+	"ld.hu":            {bits: 0x2a400000, fmt: insnFormatDJSk12},
+	"ld.wu":            {bits: 0x2a800000, fmt: insnFormatDJSk12},
+	"preld":            {bits: 0x2ac00000, fmt: insnFormatJUd5Sk12},
+	"fld.s":            {bits: 0x2b000000, fmt: insnFormatFdJSk12},
+	"fst.s":            {bits: 0x2b400000, fmt: insnFormatFdJSk12},
+	"fld.d":            {bits: 0x2b800000, fmt: insnFormatFdJSk12},
+	"fst.d":            {bits: 0x2bc00000, fmt: insnFormatFdJSk12},
+	"vld":              {bits: 0x2c000000, fmt: insnFormatVdJSk12},
+	"vst":              {bits: 0x2c400000, fmt: insnFormatVdJSk12},
+	"xvld":             {bits: 0x2c800000, fmt: insnFormatXdJSk12},
+	"xvst":             {bits: 0x2cc00000, fmt: insnFormatXdJSk12},
+	"ldl.w":            {bits: 0x2e000000, fmt: insnFormatDJSk12},
+	"ldr.w":            {bits: 0x2e400000, fmt: insnFormatDJSk12},
+	"ldl.d":            {bits: 0x2e800000, fmt: insnFormatDJSk12},
+	"ldr.d":            {bits: 0x2ec00000, fmt: insnFormatDJSk12},
+	"stl.w":            {bits: 0x2f000000, fmt: insnFormatDJSk12},
+	"str.w":            {bits: 0x2f400000, fmt: insnFormatDJSk12},
+	"stl.d":            {bits: 0x2f800000, fmt: insnFormatDJSk12},
+	"str.d":            {bits: 0x2fc00000, fmt: insnFormatDJSk12},
+	"vldrepl.d":        {bits: 0x30100000, fmt: insnFormatVdJSk9},
+	"vldrepl.w":        {bits: 0x30200000, fmt: insnFormatVdJSk10},
+	"vldrepl.h":        {bits: 0x30400000, fmt: insnFormatVdJSk11},
+	"vldrepl.b":        {bits: 0x30800000, fmt: insnFormatVdJSk12},
+	"vstelm.d":         {bits: 0x31100000, fmt: insnFormatVdJSk8Un1},
+	"vstelm.w":         {bits: 0x31200000, fmt: insnFormatVdJSk8Un2},
+	"vstelm.h":         {bits: 0x31400000, fmt: insnFormatVdJSk8Un3},
+	"vstelm.b":         {bits: 0x31800000, fmt: insnFormatVdJSk8Un4},
+	"xvldrepl.d":       {bits: 0x32100000, fmt: insnFormatXdJSk9},
+	"xvldrepl.w":       {bits: 0x32200000, fmt: insnFormatXdJSk10},
+	"xvldrepl.h":       {bits: 0x32400000, fmt: insnFormatXdJSk11},
+	"xvldrepl.b":       {bits: 0x32800000, fmt: insnFormatXdJSk12},
+	"xvstelm.d":        {bits: 0x33100000, fmt: insnFormatXdJSk8Un2},
+	"xvstelm.w":        {bits: 0x33200000, fmt: insnFormatXdJSk8Un3},
+	"xvstelm.h":        {bits: 0x33400000, fmt: insnFormatXdJSk8Un4},
+	"xvstelm.b":        {bits: 0x33800000, fmt: insnFormatXdJSk8Un5},
+	"ldx.b":            {bits: 0x38000000, fmt: insnFormatDJK},
+	"ldx.h":            {bits: 0x38040000, fmt: insnFormatDJK},
+	"ldx.w":            {bits: 0x38080000, fmt: insnFormatDJK},
+	"ldx.d":            {bits: 0x380c0000, fmt: insnFormatDJK},
+	"stx.b":            {bits: 0x38100000, fmt: insnFormatDJK},
+	"stx.h":            {bits: 0x38140000, fmt: insnFormatDJK},
+	"stx.w":            {bits: 0x38180000, fmt: insnFormatDJK},
+	"stx.d":            {bits: 0x381c0000, fmt: insnFormatDJK},
+	"ldx.bu":           {bits: 0x38200000, fmt: insnFormatDJK},
+	"ldx.hu":           {bits: 0x38240000, fmt: insnFormatDJK},
+	"ldx.wu":           {bits: 0x38280000, fmt: insnFormatDJK},
+	"preldx":           {bits: 0x382c0000, fmt: insnFormatJKUd5},
+	"fldx.s":           {bits: 0x38300000, fmt: insnFormatFdJK},
+	"fldx.d":           {bits: 0x38340000, fmt: insnFormatFdJK},
+	"fstx.s":           {bits: 0x38380000, fmt: insnFormatFdJK},
+	"fstx.d":           {bits: 0x383c0000, fmt: insnFormatFdJK},
+	"vldx":             {bits: 0x38400000, fmt: insnFormatVdJK},
+	"vstx":             {bits: 0x38440000, fmt: insnFormatVdJK},
+	"xvldx":            {bits: 0x38480000, fmt: insnFormatXdJK},
+	"xvstx":            {bits: 0x384c0000, fmt: insnFormatXdJK},
+	"amswap.w":         {bits: 0x38600000, fmt: insnFormatDJK},
+	"amswap.d":         {bits: 0x38608000, fmt: insnFormatDJK},
+	"amadd.w":          {bits: 0x38610000, fmt: insnFormatDJK},
+	"amadd.d":          {bits: 0x38618000, fmt: insnFormatDJK},
+	"amand.w":          {bits: 0x38620000, fmt: insnFormatDJK},
+	"amand.d":          {bits: 0x38628000, fmt: insnFormatDJK},
+	"amor.w":           {bits: 0x38630000, fmt: insnFormatDJK},
+	"amor.d":           {bits: 0x38638000, fmt: insnFormatDJK},
+	"amxor.w":          {bits: 0x38640000, fmt: insnFormatDJK},
+	"amxor.d":          {bits: 0x38648000, fmt: insnFormatDJK},
+	"ammax.w":          {bits: 0x38650000, fmt: insnFormatDJK},
+	"ammax.d":          {bits: 0x38658000, fmt: insnFormatDJK},
+	"ammin.w":          {bits: 0x38660000, fmt: insnFormatDJK},
+	"ammin.d":          {bits: 0x38668000, fmt: insnFormatDJK},
+	"ammax.wu":         {bits: 0x38670000, fmt: insnFormatDJK},
+	"ammax.du":         {bits: 0x38678000, fmt: insnFormatDJK},
+	"ammin.wu":         {bits: 0x38680000, fmt: insnFormatDJK},
+	"ammin.du":         {bits: 0x38688000, fmt: insnFormatDJK},
+	"amswap_db.w":      {bits: 0x38690000, fmt: insnFormatDJK},
+	"amswap_db.d":      {bits: 0x38698000, fmt: insnFormatDJK},
+	"amadd_db.w":       {bits: 0x386a0000, fmt: insnFormatDJK},
+	"amadd_db.d":       {bits: 0x386a8000, fmt: insnFormatDJK},
+	"amand_db.w":       {bits: 0x386b0000, fmt: insnFormatDJK},
+	"amand_db.d":       {bits: 0x386b8000, fmt: insnFormatDJK},
+	"amor_db.w":        {bits: 0x386c0000, fmt: insnFormatDJK},
+	"amor_db.d":        {bits: 0x386c8000, fmt: insnFormatDJK},
+	"amxor_db.w":       {bits: 0x386d0000, fmt: insnFormatDJK},
+	"amxor_db.d":       {bits: 0x386d8000, fmt: insnFormatDJK},
+	"ammax_db.w":       {bits: 0x386e0000, fmt: insnFormatDJK},
+	"ammax_db.d":       {bits: 0x386e8000, fmt: insnFormatDJK},
+	"ammin_db.w":       {bits: 0x386f0000, fmt: insnFormatDJK},
+	"ammin_db.d":       {bits: 0x386f8000, fmt: insnFormatDJK},
+	"ammax_db.wu":      {bits: 0x38700000, fmt: insnFormatDJK},
+	"ammax_db.du":      {bits: 0x38708000, fmt: insnFormatDJK},
+	"ammin_db.wu":      {bits: 0x38710000, fmt: insnFormatDJK},
+	"ammin_db.du":      {bits: 0x38718000, fmt: insnFormatDJK},
+	"dbar":             {bits: 0x38720000, fmt: insnFormatUd15},
+	"ibar":             {bits: 0x38728000, fmt: insnFormatUd15},
+	"fldgt.s":          {bits: 0x38740000, fmt: insnFormatFdJK},
+	"fldgt.d":          {bits: 0x38748000, fmt: insnFormatFdJK},
+	"fldle.s":          {bits: 0x38750000, fmt: insnFormatFdJK},
+	"fldle.d":          {bits: 0x38758000, fmt: insnFormatFdJK},
+	"fstgt.s":          {bits: 0x38760000, fmt: insnFormatFdJK},
+	"fstgt.d":          {bits: 0x38768000, fmt: insnFormatFdJK},
+	"fstle.s":          {bits: 0x38770000, fmt: insnFormatFdJK},
+	"fstle.d":          {bits: 0x38778000, fmt: insnFormatFdJK},
+	"ldgt.b":           {bits: 0x38780000, fmt: insnFormatDJK},
+	"ldgt.h":           {bits: 0x38788000, fmt: insnFormatDJK},
+	"ldgt.w":           {bits: 0x38790000, fmt: insnFormatDJK},
+	"ldgt.d":           {bits: 0x38798000, fmt: insnFormatDJK},
+	"ldle.b":           {bits: 0x387a0000, fmt: insnFormatDJK},
+	"ldle.h":           {bits: 0x387a8000, fmt: insnFormatDJK},
+	"ldle.w":           {bits: 0x387b0000, fmt: insnFormatDJK},
+	"ldle.d":           {bits: 0x387b8000, fmt: insnFormatDJK},
+	"stgt.b":           {bits: 0x387c0000, fmt: insnFormatDJK},
+	"stgt.h":           {bits: 0x387c8000, fmt: insnFormatDJK},
+	"stgt.w":           {bits: 0x387d0000, fmt: insnFormatDJK},
+	"stgt.d":           {bits: 0x387d8000, fmt: insnFormatDJK},
+	"stle.b":           {bits: 0x387e0000, fmt: insnFormatDJK},
+	"stle.h":           {bits: 0x387e8000, fmt: insnFormatDJK},
+	"stle.w":           {bits: 0x387f0000, fmt: insnFormatDJK},
+	"stle.d":           {bits: 0x387f8000, fmt: insnFormatDJK},
+	"beqz":             {bits: 0x40000000, fmt: insnFormatJSd5k16},
+	"bnez":             {bits: 0x44000000, fmt: insnFormatJSd5k16},
+	"bceqz":            {bits: 0x48000000, fmt: insnFormatCjSd5k16},
+	"bcnez":            {bits: 0x48000100, fmt: insnFormatCjSd5k16},
+	"jiscr0":           {bits: 0x48000200, fmt: insnFormatSd5k16},
+	"jiscr1":           {bits: 0x48000300, fmt: insnFormatSd5k16},
+	"jirl":             {bits: 0x4c000000, fmt: insnFormatDJSk16},
+	"b":                {bits: 0x50000000, fmt: insnFormatSd10k16},
+	"bl":               {bits: 0x54000000, fmt: insnFormatSd10k16},
+	"beq":              {bits: 0x58000000, fmt: insnFormatDJSk16},
+	"bne":              {bits: 0x5c000000, fmt: insnFormatDJSk16},
+	"bgt":              {bits: 0x60000000, fmt: insnFormatDJSk16},
+	"ble":              {bits: 0x64000000, fmt: insnFormatDJSk16},
+	"bgtu":             {bits: 0x68000000, fmt: insnFormatDJSk16},
+	"bleu":             {bits: 0x6c000000, fmt: insnFormatDJSk16},
+	"vseq.b":           {bits: 0x70000000, fmt: insnFormatVdVjVk},
+	"vseq.h":           {bits: 0x70008000, fmt: insnFormatVdVjVk},
+	"vseq.w":           {bits: 0x70010000, fmt: insnFormatVdVjVk},
+	"vseq.d":           {bits: 0x70018000, fmt: insnFormatVdVjVk},
+	"vsle.b":           {bits: 0x70020000, fmt: insnFormatVdVjVk},
+	"vsle.h":           {bits: 0x70028000, fmt: insnFormatVdVjVk},
+	"vsle.w":           {bits: 0x70030000, fmt: insnFormatVdVjVk},
+	"vsle.d":           {bits: 0x70038000, fmt: insnFormatVdVjVk},
+	"vsle.bu":          {bits: 0x70040000, fmt: insnFormatVdVjVk},
+	"vsle.hu":          {bits: 0x70048000, fmt: insnFormatVdVjVk},
+	"vsle.wu":          {bits: 0x70050000, fmt: insnFormatVdVjVk},
+	"vsle.du":          {bits: 0x70058000, fmt: insnFormatVdVjVk},
+	"vslt.b":           {bits: 0x70060000, fmt: insnFormatVdVjVk},
+	"vslt.h":           {bits: 0x70068000, fmt: insnFormatVdVjVk},
+	"vslt.w":           {bits: 0x70070000, fmt: insnFormatVdVjVk},
+	"vslt.d":           {bits: 0x70078000, fmt: insnFormatVdVjVk},
+	"vslt.bu":          {bits: 0x70080000, fmt: insnFormatVdVjVk},
+	"vslt.hu":          {bits: 0x70088000, fmt: insnFormatVdVjVk},
+	"vslt.wu":          {bits: 0x70090000, fmt: insnFormatVdVjVk},
+	"vslt.du":          {bits: 0x70098000, fmt: insnFormatVdVjVk},
+	"vadd.b":           {bits: 0x700a0000, fmt: insnFormatVdVjVk},
+	"vadd.h":           {bits: 0x700a8000, fmt: insnFormatVdVjVk},
+	"vadd.w":           {bits: 0x700b0000, fmt: insnFormatVdVjVk},
+	"vadd.d":           {bits: 0x700b8000, fmt: insnFormatVdVjVk},
+	"vsub.b":           {bits: 0x700c0000, fmt: insnFormatVdVjVk},
+	"vsub.h":           {bits: 0x700c8000, fmt: insnFormatVdVjVk},
+	"vsub.w":           {bits: 0x700d0000, fmt: insnFormatVdVjVk},
+	"vsub.d":           {bits: 0x700d8000, fmt: insnFormatVdVjVk},
+	"vaddwev.h.b":      {bits: 0x701e0000, fmt: insnFormatVdVjVk},
+	"vaddwev.w.h":      {bits: 0x701e8000, fmt: insnFormatVdVjVk},
+	"vaddwev.d.w":      {bits: 0x701f0000, fmt: insnFormatVdVjVk},
+	"vaddwev.q.d":      {bits: 0x701f8000, fmt: insnFormatVdVjVk},
+	"vsubwev.h.b":      {bits: 0x70200000, fmt: insnFormatVdVjVk},
+	"vsubwev.w.h":      {bits: 0x70208000, fmt: insnFormatVdVjVk},
+	"vsubwev.d.w":      {bits: 0x70210000, fmt: insnFormatVdVjVk},
+	"vsubwev.q.d":      {bits: 0x70218000, fmt: insnFormatVdVjVk},
+	"vaddwod.h.b":      {bits: 0x70220000, fmt: insnFormatVdVjVk},
+	"vaddwod.w.h":      {bits: 0x70228000, fmt: insnFormatVdVjVk},
+	"vaddwod.d.w":      {bits: 0x70230000, fmt: insnFormatVdVjVk},
+	"vaddwod.q.d":      {bits: 0x70238000, fmt: insnFormatVdVjVk},
+	"vsubwod.h.b":      {bits: 0x70240000, fmt: insnFormatVdVjVk},
+	"vsubwod.w.h":      {bits: 0x70248000, fmt: insnFormatVdVjVk},
+	"vsubwod.d.w":      {bits: 0x70250000, fmt: insnFormatVdVjVk},
+	"vsubwod.q.d":      {bits: 0x70258000, fmt: insnFormatVdVjVk},
+	"vaddwev.h.bu":     {bits: 0x702e0000, fmt: insnFormatVdVjVk},
+	"vaddwev.w.hu":     {bits: 0x702e8000, fmt: insnFormatVdVjVk},
+	"vaddwev.d.wu":     {bits: 0x702f0000, fmt: insnFormatVdVjVk},
+	"vaddwev.q.du":     {bits: 0x702f8000, fmt: insnFormatVdVjVk},
+	"vsubwev.h.bu":     {bits: 0x70300000, fmt: insnFormatVdVjVk},
+	"vsubwev.w.hu":     {bits: 0x70308000, fmt: insnFormatVdVjVk},
+	"vsubwev.d.wu":     {bits: 0x70310000, fmt: insnFormatVdVjVk},
+	"vsubwev.q.du":     {bits: 0x70318000, fmt: insnFormatVdVjVk},
+	"vaddwod.h.bu":     {bits: 0x70320000, fmt: insnFormatVdVjVk},
+	"vaddwod.w.hu":     {bits: 0x70328000, fmt: insnFormatVdVjVk},
+	"vaddwod.d.wu":     {bits: 0x70330000, fmt: insnFormatVdVjVk},
+	"vaddwod.q.du":     {bits: 0x70338000, fmt: insnFormatVdVjVk},
+	"vsubwod.h.bu":     {bits: 0x70340000, fmt: insnFormatVdVjVk},
+	"vsubwod.w.hu":     {bits: 0x70348000, fmt: insnFormatVdVjVk},
+	"vsubwod.d.wu":     {bits: 0x70350000, fmt: insnFormatVdVjVk},
+	"vsubwod.q.du":     {bits: 0x70358000, fmt: insnFormatVdVjVk},
+	"vaddwev.h.bu.b":   {bits: 0x703e0000, fmt: insnFormatVdVjVk},
+	"vaddwev.w.hu.h":   {bits: 0x703e8000, fmt: insnFormatVdVjVk},
+	"vaddwev.d.wu.w":   {bits: 0x703f0000, fmt: insnFormatVdVjVk},
+	"vaddwev.q.du.d":   {bits: 0x703f8000, fmt: insnFormatVdVjVk},
+	"vaddwod.h.bu.b":   {bits: 0x70400000, fmt: insnFormatVdVjVk},
+	"vaddwod.w.hu.h":   {bits: 0x70408000, fmt: insnFormatVdVjVk},
+	"vaddwod.d.wu.w":   {bits: 0x70410000, fmt: insnFormatVdVjVk},
+	"vaddwod.q.du.d":   {bits: 0x70418000, fmt: insnFormatVdVjVk},
+	"vsadd.b":          {bits: 0x70460000, fmt: insnFormatVdVjVk},
+	"vsadd.h":          {bits: 0x70468000, fmt: insnFormatVdVjVk},
+	"vsadd.w":          {bits: 0x70470000, fmt: insnFormatVdVjVk},
+	"vsadd.d":          {bits: 0x70478000, fmt: insnFormatVdVjVk},
+	"vssub.b":          {bits: 0x70480000, fmt: insnFormatVdVjVk},
+	"vssub.h":          {bits: 0x70488000, fmt: insnFormatVdVjVk},
+	"vssub.w":          {bits: 0x70490000, fmt: insnFormatVdVjVk},
+	"vssub.d":          {bits: 0x70498000, fmt: insnFormatVdVjVk},
+	"vsadd.bu":         {bits: 0x704a0000, fmt: insnFormatVdVjVk},
+	"vsadd.hu":         {bits: 0x704a8000, fmt: insnFormatVdVjVk},
+	"vsadd.wu":         {bits: 0x704b0000, fmt: insnFormatVdVjVk},
+	"vsadd.du":         {bits: 0x704b8000, fmt: insnFormatVdVjVk},
+	"vssub.bu":         {bits: 0x704c0000, fmt: insnFormatVdVjVk},
+	"vssub.hu":         {bits: 0x704c8000, fmt: insnFormatVdVjVk},
+	"vssub.wu":         {bits: 0x704d0000, fmt: insnFormatVdVjVk},
+	"vssub.du":         {bits: 0x704d8000, fmt: insnFormatVdVjVk},
+	"vhaddw.h.b":       {bits: 0x70540000, fmt: insnFormatVdVjVk},
+	"vhaddw.w.h":       {bits: 0x70548000, fmt: insnFormatVdVjVk},
+	"vhaddw.d.w":       {bits: 0x70550000, fmt: insnFormatVdVjVk},
+	"vhaddw.q.d":       {bits: 0x70558000, fmt: insnFormatVdVjVk},
+	"vhsubw.h.b":       {bits: 0x70560000, fmt: insnFormatVdVjVk},
+	"vhsubw.w.h":       {bits: 0x70568000, fmt: insnFormatVdVjVk},
+	"vhsubw.d.w":       {bits: 0x70570000, fmt: insnFormatVdVjVk},
+	"vhsubw.q.d":       {bits: 0x70578000, fmt: insnFormatVdVjVk},
+	"vhaddw.hu.bu":     {bits: 0x70580000, fmt: insnFormatVdVjVk},
+	"vhaddw.wu.hu":     {bits: 0x70588000, fmt: insnFormatVdVjVk},
+	"vhaddw.du.wu":     {bits: 0x70590000, fmt: insnFormatVdVjVk},
+	"vhaddw.qu.du":     {bits: 0x70598000, fmt: insnFormatVdVjVk},
+	"vhsubw.hu.bu":     {bits: 0x705a0000, fmt: insnFormatVdVjVk},
+	"vhsubw.wu.hu":     {bits: 0x705a8000, fmt: insnFormatVdVjVk},
+	"vhsubw.du.wu":     {bits: 0x705b0000, fmt: insnFormatVdVjVk},
+	"vhsubw.qu.du":     {bits: 0x705b8000, fmt: insnFormatVdVjVk},
+	"vadda.b":          {bits: 0x705c0000, fmt: insnFormatVdVjVk},
+	"vadda.h":          {bits: 0x705c8000, fmt: insnFormatVdVjVk},
+	"vadda.w":          {bits: 0x705d0000, fmt: insnFormatVdVjVk},
+	"vadda.d":          {bits: 0x705d8000, fmt: insnFormatVdVjVk},
+	"vabsd.b":          {bits: 0x70600000, fmt: insnFormatVdVjVk},
+	"vabsd.h":          {bits: 0x70608000, fmt: insnFormatVdVjVk},
+	"vabsd.w":          {bits: 0x70610000, fmt: insnFormatVdVjVk},
+	"vabsd.d":          {bits: 0x70618000, fmt: insnFormatVdVjVk},
+	"vabsd.bu":         {bits: 0x70620000, fmt: insnFormatVdVjVk},
+	"vabsd.hu":         {bits: 0x70628000, fmt: insnFormatVdVjVk},
+	"vabsd.wu":         {bits: 0x70630000, fmt: insnFormatVdVjVk},
+	"vabsd.du":         {bits: 0x70638000, fmt: insnFormatVdVjVk},
+	"vavg.b":           {bits: 0x70640000, fmt: insnFormatVdVjVk},
+	"vavg.h":           {bits: 0x70648000, fmt: insnFormatVdVjVk},
+	"vavg.w":           {bits: 0x70650000, fmt: insnFormatVdVjVk},
+	"vavg.d":           {bits: 0x70658000, fmt: insnFormatVdVjVk},
+	"vavg.bu":          {bits: 0x70660000, fmt: insnFormatVdVjVk},
+	"vavg.hu":          {bits: 0x70668000, fmt: insnFormatVdVjVk},
+	"vavg.wu":          {bits: 0x70670000, fmt: insnFormatVdVjVk},
+	"vavg.du":          {bits: 0x70678000, fmt: insnFormatVdVjVk},
+	"vavgr.b":          {bits: 0x70680000, fmt: insnFormatVdVjVk},
+	"vavgr.h":          {bits: 0x70688000, fmt: insnFormatVdVjVk},
+	"vavgr.w":          {bits: 0x70690000, fmt: insnFormatVdVjVk},
+	"vavgr.d":          {bits: 0x70698000, fmt: insnFormatVdVjVk},
+	"vavgr.bu":         {bits: 0x706a0000, fmt: insnFormatVdVjVk},
+	"vavgr.hu":         {bits: 0x706a8000, fmt: insnFormatVdVjVk},
+	"vavgr.wu":         {bits: 0x706b0000, fmt: insnFormatVdVjVk},
+	"vavgr.du":         {bits: 0x706b8000, fmt: insnFormatVdVjVk},
+	"vmax.b":           {bits: 0x70700000, fmt: insnFormatVdVjVk},
+	"vmax.h":           {bits: 0x70708000, fmt: insnFormatVdVjVk},
+	"vmax.w":           {bits: 0x70710000, fmt: insnFormatVdVjVk},
+	"vmax.d":           {bits: 0x70718000, fmt: insnFormatVdVjVk},
+	"vmin.b":           {bits: 0x70720000, fmt: insnFormatVdVjVk},
+	"vmin.h":           {bits: 0x70728000, fmt: insnFormatVdVjVk},
+	"vmin.w":           {bits: 0x70730000, fmt: insnFormatVdVjVk},
+	"vmin.d":           {bits: 0x70738000, fmt: insnFormatVdVjVk},
+	"vmax.bu":          {bits: 0x70740000, fmt: insnFormatVdVjVk},
+	"vmax.hu":          {bits: 0x70748000, fmt: insnFormatVdVjVk},
+	"vmax.wu":          {bits: 0x70750000, fmt: insnFormatVdVjVk},
+	"vmax.du":          {bits: 0x70758000, fmt: insnFormatVdVjVk},
+	"vmin.bu":          {bits: 0x70760000, fmt: insnFormatVdVjVk},
+	"vmin.hu":          {bits: 0x70768000, fmt: insnFormatVdVjVk},
+	"vmin.wu":          {bits: 0x70770000, fmt: insnFormatVdVjVk},
+	"vmin.du":          {bits: 0x70778000, fmt: insnFormatVdVjVk},
+	"vmul.b":           {bits: 0x70840000, fmt: insnFormatVdVjVk},
+	"vmul.h":           {bits: 0x70848000, fmt: insnFormatVdVjVk},
+	"vmul.w":           {bits: 0x70850000, fmt: insnFormatVdVjVk},
+	"vmul.d":           {bits: 0x70858000, fmt: insnFormatVdVjVk},
+	"vmuh.b":           {bits: 0x70860000, fmt: insnFormatVdVjVk},
+	"vmuh.h":           {bits: 0x70868000, fmt: insnFormatVdVjVk},
+	"vmuh.w":           {bits: 0x70870000, fmt: insnFormatVdVjVk},
+	"vmuh.d":           {bits: 0x70878000, fmt: insnFormatVdVjVk},
+	"vmuh.bu":          {bits: 0x70880000, fmt: insnFormatVdVjVk},
+	"vmuh.hu":          {bits: 0x70888000, fmt: insnFormatVdVjVk},
+	"vmuh.wu":          {bits: 0x70890000, fmt: insnFormatVdVjVk},
+	"vmuh.du":          {bits: 0x70898000, fmt: insnFormatVdVjVk},
+	"vmulwev.h.b":      {bits: 0x70900000, fmt: insnFormatVdVjVk},
+	"vmulwev.w.h":      {bits: 0x70908000, fmt: insnFormatVdVjVk},
+	"vmulwev.d.w":      {bits: 0x70910000, fmt: insnFormatVdVjVk},
+	"vmulwev.q.d":      {bits: 0x70918000, fmt: insnFormatVdVjVk},
+	"vmulwod.h.b":      {bits: 0x70920000, fmt: insnFormatVdVjVk},
+	"vmulwod.w.h":      {bits: 0x70928000, fmt: insnFormatVdVjVk},
+	"vmulwod.d.w":      {bits: 0x70930000, fmt: insnFormatVdVjVk},
+	"vmulwod.q.d":      {bits: 0x70938000, fmt: insnFormatVdVjVk},
+	"vmulwev.h.bu":     {bits: 0x70980000, fmt: insnFormatVdVjVk},
+	"vmulwev.w.hu":     {bits: 0x70988000, fmt: insnFormatVdVjVk},
+	"vmulwev.d.wu":     {bits: 0x70990000, fmt: insnFormatVdVjVk},
+	"vmulwev.q.du":     {bits: 0x70998000, fmt: insnFormatVdVjVk},
+	"vmulwod.h.bu":     {bits: 0x709a0000, fmt: insnFormatVdVjVk},
+	"vmulwod.w.hu":     {bits: 0x709a8000, fmt: insnFormatVdVjVk},
+	"vmulwod.d.wu":     {bits: 0x709b0000, fmt: insnFormatVdVjVk},
+	"vmulwod.q.du":     {bits: 0x709b8000, fmt: insnFormatVdVjVk},
+	"vmulwev.h.bu.b":   {bits: 0x70a00000, fmt: insnFormatVdVjVk},
+	"vmulwev.w.hu.h":   {bits: 0x70a08000, fmt: insnFormatVdVjVk},
+	"vmulwev.d.wu.w":   {bits: 0x70a10000, fmt: insnFormatVdVjVk},
+	"vmulwev.q.du.d":   {bits: 0x70a18000, fmt: insnFormatVdVjVk},
+	"vmulwod.h.bu.b":   {bits: 0x70a20000, fmt: insnFormatVdVjVk},
+	"vmulwod.w.hu.h":   {bits: 0x70a28000, fmt: insnFormatVdVjVk},
+	"vmulwod.d.wu.w":   {bits: 0x70a30000, fmt: insnFormatVdVjVk},
+	"vmulwod.q.du.d":   {bits: 0x70a38000, fmt: insnFormatVdVjVk},
+	"vmadd.b":          {bits: 0x70a80000, fmt: insnFormatVdVjVk},
+	"vmadd.h":          {bits: 0x70a88000, fmt: insnFormatVdVjVk},
+	"vmadd.w":          {bits: 0x70a90000, fmt: insnFormatVdVjVk},
+	"vmadd.d":          {bits: 0x70a98000, fmt: insnFormatVdVjVk},
+	"vmsub.b":          {bits: 0x70aa0000, fmt: insnFormatVdVjVk},
+	"vmsub.h":          {bits: 0x70aa8000, fmt: insnFormatVdVjVk},
+	"vmsub.w":          {bits: 0x70ab0000, fmt: insnFormatVdVjVk},
+	"vmsub.d":          {bits: 0x70ab8000, fmt: insnFormatVdVjVk},
+	"vmaddwev.h.b":     {bits: 0x70ac0000, fmt: insnFormatVdVjVk},
+	"vmaddwev.w.h":     {bits: 0x70ac8000, fmt: insnFormatVdVjVk},
+	"vmaddwev.d.w":     {bits: 0x70ad0000, fmt: insnFormatVdVjVk},
+	"vmaddwev.q.d":     {bits: 0x70ad8000, fmt: insnFormatVdVjVk},
+	"vmaddwod.h.b":     {bits: 0x70ae0000, fmt: insnFormatVdVjVk},
+	"vmaddwod.w.h":     {bits: 0x70ae8000, fmt: insnFormatVdVjVk},
+	"vmaddwod.d.w":     {bits: 0x70af0000, fmt: insnFormatVdVjVk},
+	"vmaddwod.q.d":     {bits: 0x70af8000, fmt: insnFormatVdVjVk},
+	"vmaddwev.h.bu":    {bits: 0x70b40000, fmt: insnFormatVdVjVk},
+	"vmaddwev.w.hu":    {bits: 0x70b48000, fmt: insnFormatVdVjVk},
+	"vmaddwev.d.wu":    {bits: 0x70b50000, fmt: insnFormatVdVjVk},
+	"vmaddwev.q.du":    {bits: 0x70b58000, fmt: insnFormatVdVjVk},
+	"vmaddwod.h.bu":    {bits: 0x70b60000, fmt: insnFormatVdVjVk},
+	"vmaddwod.w.hu":    {bits: 0x70b68000, fmt: insnFormatVdVjVk},
+	"vmaddwod.d.wu":    {bits: 0x70b70000, fmt: insnFormatVdVjVk},
+	"vmaddwod.q.du":    {bits: 0x70b78000, fmt: insnFormatVdVjVk},
+	"vmaddwev.h.bu.b":  {bits: 0x70bc0000, fmt: insnFormatVdVjVk},
+	"vmaddwev.w.hu.h":  {bits: 0x70bc8000, fmt: insnFormatVdVjVk},
+	"vmaddwev.d.wu.w":  {bits: 0x70bd0000, fmt: insnFormatVdVjVk},
+	"vmaddwev.q.du.d":  {bits: 0x70bd8000, fmt: insnFormatVdVjVk},
+	"vmaddwod.h.bu.b":  {bits: 0x70be0000, fmt: insnFormatVdVjVk},
+	"vmaddwod.w.hu.h":  {bits: 0x70be8000, fmt: insnFormatVdVjVk},
+	"vmaddwod.d.wu.w":  {bits: 0x70bf0000, fmt: insnFormatVdVjVk},
+	"vmaddwod.q.du.d":  {bits: 0x70bf8000, fmt: insnFormatVdVjVk},
+	"vdiv.b":           {bits: 0x70e00000, fmt: insnFormatVdVjVk},
+	"vdiv.h":           {bits: 0x70e08000, fmt: insnFormatVdVjVk},
+	"vdiv.w":           {bits: 0x70e10000, fmt: insnFormatVdVjVk},
+	"vdiv.d":           {bits: 0x70e18000, fmt: insnFormatVdVjVk},
+	"vmod.b":           {bits: 0x70e20000, fmt: insnFormatVdVjVk},
+	"vmod.h":           {bits: 0x70e28000, fmt: insnFormatVdVjVk},
+	"vmod.w":           {bits: 0x70e30000, fmt: insnFormatVdVjVk},
+	"vmod.d":           {bits: 0x70e38000, fmt: insnFormatVdVjVk},
+	"vdiv.bu":          {bits: 0x70e40000, fmt: insnFormatVdVjVk},
+	"vdiv.hu":          {bits: 0x70e48000, fmt: insnFormatVdVjVk},
+	"vdiv.wu":          {bits: 0x70e50000, fmt: insnFormatVdVjVk},
+	"vdiv.du":          {bits: 0x70e58000, fmt: insnFormatVdVjVk},
+	"vmod.bu":          {bits: 0x70e60000, fmt: insnFormatVdVjVk},
+	"vmod.hu":          {bits: 0x70e68000, fmt: insnFormatVdVjVk},
+	"vmod.wu":          {bits: 0x70e70000, fmt: insnFormatVdVjVk},
+	"vmod.du":          {bits: 0x70e78000, fmt: insnFormatVdVjVk},
+	"vsll.b":           {bits: 0x70e80000, fmt: insnFormatVdVjVk},
+	"vsll.h":           {bits: 0x70e88000, fmt: insnFormatVdVjVk},
+	"vsll.w":           {bits: 0x70e90000, fmt: insnFormatVdVjVk},
+	"vsll.d":           {bits: 0x70e98000, fmt: insnFormatVdVjVk},
+	"vsrl.b":           {bits: 0x70ea0000, fmt: insnFormatVdVjVk},
+	"vsrl.h":           {bits: 0x70ea8000, fmt: insnFormatVdVjVk},
+	"vsrl.w":           {bits: 0x70eb0000, fmt: insnFormatVdVjVk},
+	"vsrl.d":           {bits: 0x70eb8000, fmt: insnFormatVdVjVk},
+	"vsra.b":           {bits: 0x70ec0000, fmt: insnFormatVdVjVk},
+	"vsra.h":           {bits: 0x70ec8000, fmt: insnFormatVdVjVk},
+	"vsra.w":           {bits: 0x70ed0000, fmt: insnFormatVdVjVk},
+	"vsra.d":           {bits: 0x70ed8000, fmt: insnFormatVdVjVk},
+	"vrotr.b":          {bits: 0x70ee0000, fmt: insnFormatVdVjVk},
+	"vrotr.h":          {bits: 0x70ee8000, fmt: insnFormatVdVjVk},
+	"vrotr.w":          {bits: 0x70ef0000, fmt: insnFormatVdVjVk},
+	"vrotr.d":          {bits: 0x70ef8000, fmt: insnFormatVdVjVk},
+	"vsrlr.b":          {bits: 0x70f00000, fmt: insnFormatVdVjVk},
+	"vsrlr.h":          {bits: 0x70f08000, fmt: insnFormatVdVjVk},
+	"vsrlr.w":          {bits: 0x70f10000, fmt: insnFormatVdVjVk},
+	"vsrlr.d":          {bits: 0x70f18000, fmt: insnFormatVdVjVk},
+	"vsrar.b":          {bits: 0x70f20000, fmt: insnFormatVdVjVk},
+	"vsrar.h":          {bits: 0x70f28000, fmt: insnFormatVdVjVk},
+	"vsrar.w":          {bits: 0x70f30000, fmt: insnFormatVdVjVk},
+	"vsrar.d":          {bits: 0x70f38000, fmt: insnFormatVdVjVk},
+	"vsrln.b.h":        {bits: 0x70f48000, fmt: insnFormatVdVjVk},
+	"vsrln.h.w":        {bits: 0x70f50000, fmt: insnFormatVdVjVk},
+	"vsrln.w.d":        {bits: 0x70f58000, fmt: insnFormatVdVjVk},
+	"vsran.b.h":        {bits: 0x70f68000, fmt: insnFormatVdVjVk},
+	"vsran.h.w":        {bits: 0x70f70000, fmt: insnFormatVdVjVk},
+	"vsran.w.d":        {bits: 0x70f78000, fmt: insnFormatVdVjVk},
+	"vsrlrn.b.h":       {bits: 0x70f88000, fmt: insnFormatVdVjVk},
+	"vsrlrn.h.w":       {bits: 0x70f90000, fmt: insnFormatVdVjVk},
+	"vsrlrn.w.d":       {bits: 0x70f98000, fmt: insnFormatVdVjVk},
+	"vsrarn.b.h":       {bits: 0x70fa8000, fmt: insnFormatVdVjVk},
+	"vsrarn.h.w":       {bits: 0x70fb0000, fmt: insnFormatVdVjVk},
+	"vsrarn.w.d":       {bits: 0x70fb8000, fmt: insnFormatVdVjVk},
+	"vssrln.b.h":       {bits: 0x70fc8000, fmt: insnFormatVdVjVk},
+	"vssrln.h.w":       {bits: 0x70fd0000, fmt: insnFormatVdVjVk},
+	"vssrln.w.d":       {bits: 0x70fd8000, fmt: insnFormatVdVjVk},
+	"vssran.b.h":       {bits: 0x70fe8000, fmt: insnFormatVdVjVk},
+	"vssran.h.w":       {bits: 0x70ff0000, fmt: insnFormatVdVjVk},
+	"vssran.w.d":       {bits: 0x70ff8000, fmt: insnFormatVdVjVk},
+	"vssrlrn.b.h":      {bits: 0x71008000, fmt: insnFormatVdVjVk},
+	"vssrlrn.h.w":      {bits: 0x71010000, fmt: insnFormatVdVjVk},
+	"vssrlrn.w.d":      {bits: 0x71018000, fmt: insnFormatVdVjVk},
+	"vssrarn.b.h":      {bits: 0x71028000, fmt: insnFormatVdVjVk},
+	"vssrarn.h.w":      {bits: 0x71030000, fmt: insnFormatVdVjVk},
+	"vssrarn.w.d":      {bits: 0x71038000, fmt: insnFormatVdVjVk},
+	"vssrln.bu.h":      {bits: 0x71048000, fmt: insnFormatVdVjVk},
+	"vssrln.hu.w":      {bits: 0x71050000, fmt: insnFormatVdVjVk},
+	"vssrln.wu.d":      {bits: 0x71058000, fmt: insnFormatVdVjVk},
+	"vssran.bu.h":      {bits: 0x71068000, fmt: insnFormatVdVjVk},
+	"vssran.hu.w":      {bits: 0x71070000, fmt: insnFormatVdVjVk},
+	"vssran.wu.d":      {bits: 0x71078000, fmt: insnFormatVdVjVk},
+	"vssrlrn.bu.h":     {bits: 0x71088000, fmt: insnFormatVdVjVk},
+	"vssrlrn.hu.w":     {bits: 0x71090000, fmt: insnFormatVdVjVk},
+	"vssrlrn.wu.d":     {bits: 0x71098000, fmt: insnFormatVdVjVk},
+	"vssrarn.bu.h":     {bits: 0x710a8000, fmt: insnFormatVdVjVk},
+	"vssrarn.hu.w":     {bits: 0x710b0000, fmt: insnFormatVdVjVk},
+	"vssrarn.wu.d":     {bits: 0x710b8000, fmt: insnFormatVdVjVk},
+	"vbitclr.b":        {bits: 0x710c0000, fmt: insnFormatVdVjVk},
+	"vbitclr.h":        {bits: 0x710c8000, fmt: insnFormatVdVjVk},
+	"vbitclr.w":        {bits: 0x710d0000, fmt: insnFormatVdVjVk},
+	"vbitclr.d":        {bits: 0x710d8000, fmt: insnFormatVdVjVk},
+	"vbitset.b":        {bits: 0x710e0000, fmt: insnFormatVdVjVk},
+	"vbitset.h":        {bits: 0x710e8000, fmt: insnFormatVdVjVk},
+	"vbitset.w":        {bits: 0x710f0000, fmt: insnFormatVdVjVk},
+	"vbitset.d":        {bits: 0x710f8000, fmt: insnFormatVdVjVk},
+	"vbitrev.b":        {bits: 0x71100000, fmt: insnFormatVdVjVk},
+	"vbitrev.h":        {bits: 0x71108000, fmt: insnFormatVdVjVk},
+	"vbitrev.w":        {bits: 0x71110000, fmt: insnFormatVdVjVk},
+	"vbitrev.d":        {bits: 0x71118000, fmt: insnFormatVdVjVk},
+	"vpackev.b":        {bits: 0x71160000, fmt: insnFormatVdVjVk},
+	"vpackev.h":        {bits: 0x71168000, fmt: insnFormatVdVjVk},
+	"vpackev.w":        {bits: 0x71170000, fmt: insnFormatVdVjVk},
+	"vpackev.d":        {bits: 0x71178000, fmt: insnFormatVdVjVk},
+	"vpackod.b":        {bits: 0x71180000, fmt: insnFormatVdVjVk},
+	"vpackod.h":        {bits: 0x71188000, fmt: insnFormatVdVjVk},
+	"vpackod.w":        {bits: 0x71190000, fmt: insnFormatVdVjVk},
+	"vpackod.d":        {bits: 0x71198000, fmt: insnFormatVdVjVk},
+	"vilvl.b":          {bits: 0x711a0000, fmt: insnFormatVdVjVk},
+	"vilvl.h":          {bits: 0x711a8000, fmt: insnFormatVdVjVk},
+	"vilvl.w":          {bits: 0x711b0000, fmt: insnFormatVdVjVk},
+	"vilvl.d":          {bits: 0x711b8000, fmt: insnFormatVdVjVk},
+	"vilvh.b":          {bits: 0x711c0000, fmt: insnFormatVdVjVk},
+	"vilvh.h":          {bits: 0x711c8000, fmt: insnFormatVdVjVk},
+	"vilvh.w":          {bits: 0x711d0000, fmt: insnFormatVdVjVk},
+	"vilvh.d":          {bits: 0x711d8000, fmt: insnFormatVdVjVk},
+	"vpickev.b":        {bits: 0x711e0000, fmt: insnFormatVdVjVk},
+	"vpickev.h":        {bits: 0x711e8000, fmt: insnFormatVdVjVk},
+	"vpickev.w":        {bits: 0x711f0000, fmt: insnFormatVdVjVk},
+	"vpickev.d":        {bits: 0x711f8000, fmt: insnFormatVdVjVk},
+	"vpickod.b":        {bits: 0x71200000, fmt: insnFormatVdVjVk},
+	"vpickod.h":        {bits: 0x71208000, fmt: insnFormatVdVjVk},
+	"vpickod.w":        {bits: 0x71210000, fmt: insnFormatVdVjVk},
+	"vpickod.d":        {bits: 0x71218000, fmt: insnFormatVdVjVk},
+	"vreplve.b":        {bits: 0x71220000, fmt: insnFormatVdVjK},
+	"vreplve.h":        {bits: 0x71228000, fmt: insnFormatVdVjK},
+	"vreplve.w":        {bits: 0x71230000, fmt: insnFormatVdVjK},
+	"vreplve.d":        {bits: 0x71238000, fmt: insnFormatVdVjK},
+	"vand.v":           {bits: 0x71260000, fmt: insnFormatVdVjVk},
+	"vor.v":            {bits: 0x71268000, fmt: insnFormatVdVjVk},
+	"vxor.v":           {bits: 0x71270000, fmt: insnFormatVdVjVk},
+	"vnor.v":           {bits: 0x71278000, fmt: insnFormatVdVjVk},
+	"vandn.v":          {bits: 0x71280000, fmt: insnFormatVdVjVk},
+	"vorn.v":           {bits: 0x71288000, fmt: insnFormatVdVjVk},
+	"vfrstp.b":         {bits: 0x712b0000, fmt: insnFormatVdVjVk},
+	"vfrstp.h":         {bits: 0x712b8000, fmt: insnFormatVdVjVk},
+	"vadd.q":           {bits: 0x712d0000, fmt: insnFormatVdVjVk},
+	"vsub.q":           {bits: 0x712d8000, fmt: insnFormatVdVjVk},
+	"vsigncov.b":       {bits: 0x712e0000, fmt: insnFormatVdVjVk},
+	"vsigncov.h":       {bits: 0x712e8000, fmt: insnFormatVdVjVk},
+	"vsigncov.w":       {bits: 0x712f0000, fmt: insnFormatVdVjVk},
+	"vsigncov.d":       {bits: 0x712f8000, fmt: insnFormatVdVjVk},
+	"vfadd.s":          {bits: 0x71308000, fmt: insnFormatVdVjVk},
+	"vfadd.d":          {bits: 0x71310000, fmt: insnFormatVdVjVk},
+	"vfsub.s":          {bits: 0x71328000, fmt: insnFormatVdVjVk},
+	"vfsub.d":          {bits: 0x71330000, fmt: insnFormatVdVjVk},
+	"vfmul.s":          {bits: 0x71388000, fmt: insnFormatVdVjVk},
+	"vfmul.d":          {bits: 0x71390000, fmt: insnFormatVdVjVk},
+	"vfdiv.s":          {bits: 0x713a8000, fmt: insnFormatVdVjVk},
+	"vfdiv.d":          {bits: 0x713b0000, fmt: insnFormatVdVjVk},
+	"vfmax.s":          {bits: 0x713c8000, fmt: insnFormatVdVjVk},
+	"vfmax.d":          {bits: 0x713d0000, fmt: insnFormatVdVjVk},
+	"vfmin.s":          {bits: 0x713e8000, fmt: insnFormatVdVjVk},
+	"vfmin.d":          {bits: 0x713f0000, fmt: insnFormatVdVjVk},
+	"vfmaxa.s":         {bits: 0x71408000, fmt: insnFormatVdVjVk},
+	"vfmaxa.d":         {bits: 0x71410000, fmt: insnFormatVdVjVk},
+	"vfmina.s":         {bits: 0x71428000, fmt: insnFormatVdVjVk},
+	"vfmina.d":         {bits: 0x71430000, fmt: insnFormatVdVjVk},
+	"vfcvt.h.s":        {bits: 0x71460000, fmt: insnFormatVdVjVk},
+	"vfcvt.s.d":        {bits: 0x71468000, fmt: insnFormatVdVjVk},
+	"vffint.s.l":       {bits: 0x71480000, fmt: insnFormatVdVjVk},
+	"vftint.w.d":       {bits: 0x71498000, fmt: insnFormatVdVjVk},
+	"vftintrm.w.d":     {bits: 0x714a0000, fmt: insnFormatVdVjVk},
+	"vftintrp.w.d":     {bits: 0x714a8000, fmt: insnFormatVdVjVk},
+	"vftintrz.w.d":     {bits: 0x714b0000, fmt: insnFormatVdVjVk},
+	"vftintrne.w.d":    {bits: 0x714b8000, fmt: insnFormatVdVjVk},
+	"vshuf.h":          {bits: 0x717a8000, fmt: insnFormatVdVjVk},
+	"vshuf.w":          {bits: 0x717b0000, fmt: insnFormatVdVjVk},
+	"vshuf.d":          {bits: 0x717b8000, fmt: insnFormatVdVjVk},
+	"vseqi.b":          {bits: 0x72800000, fmt: insnFormatVdVjSk5},
+	"vseqi.h":          {bits: 0x72808000, fmt: insnFormatVdVjSk5},
+	"vseqi.w":          {bits: 0x72810000, fmt: insnFormatVdVjSk5},
+	"vseqi.d":          {bits: 0x72818000, fmt: insnFormatVdVjSk5},
+	"vslei.b":          {bits: 0x72820000, fmt: insnFormatVdVjSk5},
+	"vslei.h":          {bits: 0x72828000, fmt: insnFormatVdVjSk5},
+	"vslei.w":          {bits: 0x72830000, fmt: insnFormatVdVjSk5},
+	"vslei.d":          {bits: 0x72838000, fmt: insnFormatVdVjSk5},
+	"vslei.bu":         {bits: 0x72840000, fmt: insnFormatVdVjUk5},
+	"vslei.hu":         {bits: 0x72848000, fmt: insnFormatVdVjUk5},
+	"vslei.wu":         {bits: 0x72850000, fmt: insnFormatVdVjUk5},
+	"vslei.du":         {bits: 0x72858000, fmt: insnFormatVdVjUk5},
+	"vslti.b":          {bits: 0x72860000, fmt: insnFormatVdVjSk5},
+	"vslti.h":          {bits: 0x72868000, fmt: insnFormatVdVjSk5},
+	"vslti.w":          {bits: 0x72870000, fmt: insnFormatVdVjSk5},
+	"vslti.d":          {bits: 0x72878000, fmt: insnFormatVdVjSk5},
+	"vslti.bu":         {bits: 0x72880000, fmt: insnFormatVdVjUk5},
+	"vslti.hu":         {bits: 0x72888000, fmt: insnFormatVdVjUk5},
+	"vslti.wu":         {bits: 0x72890000, fmt: insnFormatVdVjUk5},
+	"vslti.du":         {bits: 0x72898000, fmt: insnFormatVdVjUk5},
+	"vaddi.bu":         {bits: 0x728a0000, fmt: insnFormatVdVjUk5},
+	"vaddi.hu":         {bits: 0x728a8000, fmt: insnFormatVdVjUk5},
+	"vaddi.wu":         {bits: 0x728b0000, fmt: insnFormatVdVjUk5},
+	"vaddi.du":         {bits: 0x728b8000, fmt: insnFormatVdVjUk5},
+	"vsubi.bu":         {bits: 0x728c0000, fmt: insnFormatVdVjUk5},
+	"vsubi.hu":         {bits: 0x728c8000, fmt: insnFormatVdVjUk5},
+	"vsubi.wu":         {bits: 0x728d0000, fmt: insnFormatVdVjUk5},
+	"vsubi.du":         {bits: 0x728d8000, fmt: insnFormatVdVjUk5},
+	"vbsll.v":          {bits: 0x728e0000, fmt: insnFormatVdVjUk5},
+	"vbsrl.v":          {bits: 0x728e8000, fmt: insnFormatVdVjUk5},
+	"vmaxi.b":          {bits: 0x72900000, fmt: insnFormatVdVjSk5},
+	"vmaxi.h":          {bits: 0x72908000, fmt: insnFormatVdVjSk5},
+	"vmaxi.w":          {bits: 0x72910000, fmt: insnFormatVdVjSk5},
+	"vmaxi.d":          {bits: 0x72918000, fmt: insnFormatVdVjSk5},
+	"vmini.b":          {bits: 0x72920000, fmt: insnFormatVdVjSk5},
+	"vmini.h":          {bits: 0x72928000, fmt: insnFormatVdVjSk5},
+	"vmini.w":          {bits: 0x72930000, fmt: insnFormatVdVjSk5},
+	"vmini.d":          {bits: 0x72938000, fmt: insnFormatVdVjSk5},
+	"vmaxi.bu":         {bits: 0x72940000, fmt: insnFormatVdVjUk5},
+	"vmaxi.hu":         {bits: 0x72948000, fmt: insnFormatVdVjUk5},
+	"vmaxi.wu":         {bits: 0x72950000, fmt: insnFormatVdVjUk5},
+	"vmaxi.du":         {bits: 0x72958000, fmt: insnFormatVdVjUk5},
+	"vmini.bu":         {bits: 0x72960000, fmt: insnFormatVdVjUk5},
+	"vmini.hu":         {bits: 0x72968000, fmt: insnFormatVdVjUk5},
+	"vmini.wu":         {bits: 0x72970000, fmt: insnFormatVdVjUk5},
+	"vmini.du":         {bits: 0x72978000, fmt: insnFormatVdVjUk5},
+	"vfrstpi.b":        {bits: 0x729a0000, fmt: insnFormatVdVjUk5},
+	"vfrstpi.h":        {bits: 0x729a8000, fmt: insnFormatVdVjUk5},
+	"vclo.b":           {bits: 0x729c0000, fmt: insnFormatVdVj},
+	"vclo.h":           {bits: 0x729c0400, fmt: insnFormatVdVj},
+	"vclo.w":           {bits: 0x729c0800, fmt: insnFormatVdVj},
+	"vclo.d":           {bits: 0x729c0c00, fmt: insnFormatVdVj},
+	"vclz.b":           {bits: 0x729c1000, fmt: insnFormatVdVj},
+	"vclz.h":           {bits: 0x729c1400, fmt: insnFormatVdVj},
+	"vclz.w":           {bits: 0x729c1800, fmt: insnFormatVdVj},
+	"vclz.d":           {bits: 0x729c1c00, fmt: insnFormatVdVj},
+	"vpcnt.b":          {bits: 0x729c2000, fmt: insnFormatVdVj},
+	"vpcnt.h":          {bits: 0x729c2400, fmt: insnFormatVdVj},
+	"vpcnt.w":          {bits: 0x729c2800, fmt: insnFormatVdVj},
+	"vpcnt.d":          {bits: 0x729c2c00, fmt: insnFormatVdVj},
+	"vneg.b":           {bits: 0x729c3000, fmt: insnFormatVdVj},
+	"vneg.h":           {bits: 0x729c3400, fmt: insnFormatVdVj},
+	"vneg.w":           {bits: 0x729c3800, fmt: insnFormatVdVj},
+	"vneg.d":           {bits: 0x729c3c00, fmt: insnFormatVdVj},
+	"vmskltz.b":        {bits: 0x729c4000, fmt: insnFormatVdVj},
+	"vmskltz.h":        {bits: 0x729c4400, fmt: insnFormatVdVj},
+	"vmskltz.w":        {bits: 0x729c4800, fmt: insnFormatVdVj},
+	"vmskltz.d":        {bits: 0x729c4c00, fmt: insnFormatVdVj},
+	"vmskgez.b":        {bits: 0x729c5000, fmt: insnFormatVdVj},
+	"vmsknz.b":         {bits: 0x729c6000, fmt: insnFormatVdVj},
+	"vseteqz.v":        {bits: 0x729c9800, fmt: insnFormatCdVj},
+	"vsetnez.v":        {bits: 0x729c9c00, fmt: insnFormatCdVj},
+	"vsetanyeqz.b":     {bits: 0x729ca000, fmt: insnFormatCdVj},
+	"vsetanyeqz.h":     {bits: 0x729ca400, fmt: insnFormatCdVj},
+	"vsetanyeqz.w":     {bits: 0x729ca800, fmt: insnFormatCdVj},
+	"vsetanyeqz.d":     {bits: 0x729cac00, fmt: insnFormatCdVj},
+	"vsetallnez.b":     {bits: 0x729cb000, fmt: insnFormatCdVj},
+	"vsetallnez.h":     {bits: 0x729cb400, fmt: insnFormatCdVj},
+	"vsetallnez.w":     {bits: 0x729cb800, fmt: insnFormatCdVj},
+	"vsetallnez.d":     {bits: 0x729cbc00, fmt: insnFormatCdVj},
+	"vflogb.s":         {bits: 0x729cc400, fmt: insnFormatVdVj},
+	"vflogb.d":         {bits: 0x729cc800, fmt: insnFormatVdVj},
+	"vfclass.s":        {bits: 0x729cd400, fmt: insnFormatVdVj},
+	"vfclass.d":        {bits: 0x729cd800, fmt: insnFormatVdVj},
+	"vfsqrt.s":         {bits: 0x729ce400, fmt: insnFormatVdVj},
+	"vfsqrt.d":         {bits: 0x729ce800, fmt: insnFormatVdVj},
+	"vfrecip.s":        {bits: 0x729cf400, fmt: insnFormatVdVj},
+	"vfrecip.d":        {bits: 0x729cf800, fmt: insnFormatVdVj},
+	"vfrsqrt.s":        {bits: 0x729d0400, fmt: insnFormatVdVj},
+	"vfrsqrt.d":        {bits: 0x729d0800, fmt: insnFormatVdVj},
+	"vfrint.s":         {bits: 0x729d3400, fmt: insnFormatVdVj},
+	"vfrint.d":         {bits: 0x729d3800, fmt: insnFormatVdVj},
+	"vfrintrm.s":       {bits: 0x729d4400, fmt: insnFormatVdVj},
+	"vfrintrm.d":       {bits: 0x729d4800, fmt: insnFormatVdVj},
+	"vfrintrp.s":       {bits: 0x729d5400, fmt: insnFormatVdVj},
+	"vfrintrp.d":       {bits: 0x729d5800, fmt: insnFormatVdVj},
+	"vfrintrz.s":       {bits: 0x729d6400, fmt: insnFormatVdVj},
+	"vfrintrz.d":       {bits: 0x729d6800, fmt: insnFormatVdVj},
+	"vfrintrne.s":      {bits: 0x729d7400, fmt: insnFormatVdVj},
+	"vfrintrne.d":      {bits: 0x729d7800, fmt: insnFormatVdVj},
+	"vfcvtl.s.h":       {bits: 0x729de800, fmt: insnFormatVdVj},
+	"vfcvth.s.h":       {bits: 0x729dec00, fmt: insnFormatVdVj},
+	"vfcvtl.d.s":       {bits: 0x729df000, fmt: insnFormatVdVj},
+	"vfcvth.d.s":       {bits: 0x729df400, fmt: insnFormatVdVj},
+	"vffint.s.w":       {bits: 0x729e0000, fmt: insnFormatVdVj},
+	"vffint.s.wu":      {bits: 0x729e0400, fmt: insnFormatVdVj},
+	"vffint.d.l":       {bits: 0x729e0800, fmt: insnFormatVdVj},
+	"vffint.d.lu":      {bits: 0x729e0c00, fmt: insnFormatVdVj},
+	"vffintl.d.w":      {bits: 0x729e1000, fmt: insnFormatVdVj},
+	"vffinth.d.w":      {bits: 0x729e1400, fmt: insnFormatVdVj},
+	"vftint.w.s":       {bits: 0x729e3000, fmt: insnFormatVdVj},
+	"vftint.l.d":       {bits: 0x729e3400, fmt: insnFormatVdVj},
+	"vftintrm.w.s":     {bits: 0x729e3800, fmt: insnFormatVdVj},
+	"vftintrm.l.d":     {bits: 0x729e3c00, fmt: insnFormatVdVj},
+	"vftintrp.w.s":     {bits: 0x729e4000, fmt: insnFormatVdVj},
+	"vftintrp.l.d":     {bits: 0x729e4400, fmt: insnFormatVdVj},
+	"vftintrz.w.s":     {bits: 0x729e4800, fmt: insnFormatVdVj},
+	"vftintrz.l.d":     {bits: 0x729e4c00, fmt: insnFormatVdVj},
+	"vftintrne.w.s":    {bits: 0x729e5000, fmt: insnFormatVdVj},
+	"vftintrne.l.d":    {bits: 0x729e5400, fmt: insnFormatVdVj},
+	"vftint.wu.s":      {bits: 0x729e5800, fmt: insnFormatVdVj},
+	"vftint.lu.d":      {bits: 0x729e5c00, fmt: insnFormatVdVj},
+	"vftintrz.wu.s":    {bits: 0x729e7000, fmt: insnFormatVdVj},
+	"vftintrz.lu.d":    {bits: 0x729e7400, fmt: insnFormatVdVj},
+	"vftintl.l.s":      {bits: 0x729e8000, fmt: insnFormatVdVj},
+	"vftinth.l.s":      {bits: 0x729e8400, fmt: insnFormatVdVj},
+	"vftintrml.l.s":    {bits: 0x729e8800, fmt: insnFormatVdVj},
+	"vftintrmh.l.s":    {bits: 0x729e8c00, fmt: insnFormatVdVj},
+	"vftintrpl.l.s":    {bits: 0x729e9000, fmt: insnFormatVdVj},
+	"vftintrph.l.s":    {bits: 0x729e9400, fmt: insnFormatVdVj},
+	"vftintrzl.l.s":    {bits: 0x729e9800, fmt: insnFormatVdVj},
+	"vftintrzh.l.s":    {bits: 0x729e9c00, fmt: insnFormatVdVj},
+	"vftintrnel.l.s":   {bits: 0x729ea000, fmt: insnFormatVdVj},
+	"vftintrneh.l.s":   {bits: 0x729ea400, fmt: insnFormatVdVj},
+	"vexth.h.b":        {bits: 0x729ee000, fmt: insnFormatVdVj},
+	"vexth.w.h":        {bits: 0x729ee400, fmt: insnFormatVdVj},
+	"vexth.d.w":        {bits: 0x729ee800, fmt: insnFormatVdVj},
+	"vexth.q.d":        {bits: 0x729eec00, fmt: insnFormatVdVj},
+	"vexth.hu.bu":      {bits: 0x729ef000, fmt: insnFormatVdVj},
+	"vexth.wu.hu":      {bits: 0x729ef400, fmt: insnFormatVdVj},
+	"vexth.du.wu":      {bits: 0x729ef800, fmt: insnFormatVdVj},
+	"vexth.qu.du":      {bits: 0x729efc00, fmt: insnFormatVdVj},
+	"vreplgr2vr.b":     {bits: 0x729f0000, fmt: insnFormatVdJ},
+	"vreplgr2vr.h":     {bits: 0x729f0400, fmt: insnFormatVdJ},
+	"vreplgr2vr.w":     {bits: 0x729f0800, fmt: insnFormatVdJ},
+	"vreplgr2vr.d":     {bits: 0x729f0c00, fmt: insnFormatVdJ},
+	"vrotri.b":         {bits: 0x72a02000, fmt: insnFormatVdVjUk3},
+	"vrotri.h":         {bits: 0x72a04000, fmt: insnFormatVdVjUk4},
+	"vrotri.w":         {bits: 0x72a08000, fmt: insnFormatVdVjUk5},
+	"vrotri.d":         {bits: 0x72a10000, fmt: insnFormatVdVjUk6},
+	"vsrlri.b":         {bits: 0x72a42000, fmt: insnFormatVdVjUk3},
+	"vsrlri.h":         {bits: 0x72a44000, fmt: insnFormatVdVjUk4},
+	"vsrlri.w":         {bits: 0x72a48000, fmt: insnFormatVdVjUk5},
+	"vsrlri.d":         {bits: 0x72a50000, fmt: insnFormatVdVjUk6},
+	"vsrari.b":         {bits: 0x72a82000, fmt: insnFormatVdVjUk3},
+	"vsrari.h":         {bits: 0x72a84000, fmt: insnFormatVdVjUk4},
+	"vsrari.w":         {bits: 0x72a88000, fmt: insnFormatVdVjUk5},
+	"vsrari.d":         {bits: 0x72a90000, fmt: insnFormatVdVjUk6},
+	"vinsgr2vr.b":      {bits: 0x72eb8000, fmt: insnFormatVdJUk4, role: &operandRole{idx: 2, kind: "element index"}},
+	"vinsgr2vr.h":      {bits: 0x72ebc000, fmt: insnFormatVdJUk3, role: &operandRole{idx: 2, kind: "element index"}},
+	"vinsgr2vr.w":      {bits: 0x72ebe000, fmt: insnFormatVdJUk2, role: &operandRole{idx: 2, kind: "element index"}},
+	"vinsgr2vr.d":      {bits: 0x72ebf000, fmt: insnFormatVdJUk1, role: &operandRole{idx: 2, kind: "element index"}},
+	"vpickve2gr.b":     {bits: 0x72ef8000, fmt: insnFormatDVjUk4, role: &operandRole{idx: 2, kind: "element index"}},
+	"vpickve2gr.h":     {bits: 0x72efc000, fmt: insnFormatDVjUk3, role: &operandRole{idx: 2, kind: "element index"}},
+	"vpickve2gr.w":     {bits: 0x72efe000, fmt: insnFormatDVjUk2, role: &operandRole{idx: 2, kind: "element index"}},
+	"vpickve2gr.d":     {bits: 0x72eff000, fmt: insnFormatDVjUk1, role: &operandRole{idx: 2, kind: "element index"}},
+	"vpickve2gr.bu":    {bits: 0x72f38000, fmt: insnFormatDVjUk4, role: &operandRole{idx: 2, kind: "element index"}},
+	"vpickve2gr.hu":    {bits: 0x72f3c000, fmt: insnFormatDVjUk3, role: &operandRole{idx: 2, kind: "element index"}},
+	"vpickve2gr.wu":    {bits: 0x72f3e000, fmt: insnFormatDVjUk2, role: &operandRole{idx: 2, kind: "element index"}},
+	"vpickve2gr.du":    {bits: 0x72f3f000, fmt: insnFormatDVjUk1, role: &operandRole{idx: 2, kind: "element index"}},
+	"vreplvei.b":       {bits: 0x72f78000, fmt: insnFormatVdVjUk4, role: &operandRole{idx: 2, kind: "element index"}},
+	"vreplvei.h":       {bits: 0x72f7c000, fmt: insnFormatVdVjUk3, role: &operandRole{idx: 2, kind: "element index"}},
+	"vreplvei.w":       {bits: 0x72f7e000, fmt: insnFormatVdVjUk2, role: &operandRole{idx: 2, kind: "element index"}},
+	"vreplvei.d":       {bits: 0x72f7f000, fmt: insnFormatVdVjUk1, role: &operandRole{idx: 2, kind: "element index"}},
+	"vsllwil.h.b":      {bits: 0x73082000, fmt: insnFormatVdVjUk3},
+	"vsllwil.w.h":      {bits: 0x73084000, fmt: insnFormatVdVjUk4},
+	"vsllwil.d.w":      {bits: 0x73088000, fmt: insnFormatVdVjUk5},
+	"vextl.q.d":        {bits: 0x73090000, fmt: insnFormatVdVj},
+	"vsllwil.hu.bu":    {bits: 0x730c2000, fmt: insnFormatVdVjUk3},
+	"vsllwil.wu.hu":    {bits: 0x730c4000, fmt: insnFormatVdVjUk4},
+	"vsllwil.du.wu":    {bits: 0x730c8000, fmt: insnFormatVdVjUk5},
+	"vextl.qu.du":      {bits: 0x730d0000, fmt: insnFormatVdVj},
+	"vbitclri.b":       {bits: 0x73102000, fmt: insnFormatVdVjUk3},
+	"vbitclri.h":       {bits: 0x73104000, fmt: insnFormatVdVjUk4},
+	"vbitclri.w":       {bits: 0x73108000, fmt: insnFormatVdVjUk5},
+	"vbitclri.d":       {bits: 0x73110000, fmt: insnFormatVdVjUk6},
+	"vbitseti.b":       {bits: 0x73142000, fmt: insnFormatVdVjUk3},
+	"vbitseti.h":       {bits: 0x73144000, fmt: insnFormatVdVjUk4},
+	"vbitseti.w":       {bits: 0x73148000, fmt: insnFormatVdVjUk5},
+	"vbitseti.d":       {bits: 0x73150000, fmt: insnFormatVdVjUk6},
+	"vbitrevi.b":       {bits: 0x73182000, fmt: insnFormatVdVjUk3},
+	"vbitrevi.h":       {bits: 0x73184000, fmt: insnFormatVdVjUk4},
+	"vbitrevi.w":       {bits: 0x73188000, fmt: insnFormatVdVjUk5},
+	"vbitrevi.d":       {bits: 0x73190000, fmt: insnFormatVdVjUk6},
+	"vsat.b":           {bits: 0x73242000, fmt: insnFormatVdVjUk3},
+	"vsat.h":           {bits: 0x73244000, fmt: insnFormatVdVjUk4},
+	"vsat.w":           {bits: 0x73248000, fmt: insnFormatVdVjUk5},
+	"vsat.d":           {bits: 0x73250000, fmt: insnFormatVdVjUk6},
+	"vsat.bu":          {bits: 0x73282000, fmt: insnFormatVdVjUk3},
+	"vsat.hu":          {bits: 0x73284000, fmt: insnFormatVdVjUk4},
+	"vsat.wu":          {bits: 0x73288000, fmt: insnFormatVdVjUk5},
+	"vsat.du":          {bits: 0x73290000, fmt: insnFormatVdVjUk6},
+	"vslli.b":          {bits: 0x732c2000, fmt: insnFormatVdVjUk3},
+	"vslli.h":          {bits: 0x732c4000, fmt: insnFormatVdVjUk4},
+	"vslli.w":          {bits: 0x732c8000, fmt: insnFormatVdVjUk5},
+	"vslli.d":          {bits: 0x732d0000, fmt: insnFormatVdVjUk6},
+	"vsrli.b":          {bits: 0x73302000, fmt: insnFormatVdVjUk3},
+	"vsrli.h":          {bits: 0x73304000, fmt: insnFormatVdVjUk4},
+	"vsrli.w":          {bits: 0x73308000, fmt: insnFormatVdVjUk5},
+	"vsrli.d":          {bits: 0x73310000, fmt: insnFormatVdVjUk6},
+	"vsrai.b":          {bits: 0x73342000, fmt: insnFormatVdVjUk3},
+	"vsrai.h":          {bits: 0x73344000, fmt: insnFormatVdVjUk4},
+	"vsrai.w":          {bits: 0x73348000, fmt: insnFormatVdVjUk5},
+	"vsrai.d":          {bits: 0x73350000, fmt: insnFormatVdVjUk6},
+	"vsrlni.b.h":       {bits: 0x73404000, fmt: insnFormatVdVjUk4},
+	"vsrlni.h.w":       {bits: 0x73408000, fmt: insnFormatVdVjUk5},
+	"vsrlni.w.d":       {bits: 0x73410000, fmt: insnFormatVdVjUk6},
+	"vsrlni.d.q":       {bits: 0x73420000, fmt: insnFormatVdVjUk7},
+	"vsrlrni.b.h":      {bits: 0x73444000, fmt: insnFormatVdVjUk4},
+	"vsrlrni.h.w":      {bits: 0x73448000, fmt: insnFormatVdVjUk5},
+	"vsrlrni.w.d":      {bits: 0x73450000, fmt: insnFormatVdVjUk6},
+	"vsrlrni.d.q":      {bits: 0x73460000, fmt: insnFormatVdVjUk7},
+	"vssrlni.b.h":      {bits: 0x73484000, fmt: insnFormatVdVjUk4},
+	"vssrlni.h.w":      {bits: 0x73488000, fmt: insnFormatVdVjUk5},
+	"vssrlni.w.d":      {bits: 0x73490000, fmt: insnFormatVdVjUk6},
+	"vssrlni.d.q":      {bits: 0x734a0000, fmt: insnFormatVdVjUk7},
+	"vssrlni.bu.h":     {bits: 0x734c4000, fmt: insnFormatVdVjUk4},
+	"vssrlni.hu.w":     {bits: 0x734c8000, fmt: insnFormatVdVjUk5},
+	"vssrlni.wu.d":     {bits: 0x734d0000, fmt: insnFormatVdVjUk6},
+	"vssrlni.du.q":     {bits: 0x734e0000, fmt: insnFormatVdVjUk7},
+	"vssrlrni.b.h":     {bits: 0x73504000, fmt: insnFormatVdVjUk4},
+	"vssrlrni.h.w":     {bits: 0x73508000, fmt: insnFormatVdVjUk5},
+	"vssrlrni.w.d":     {bits: 0x73510000, fmt: insnFormatVdVjUk6},
+	"vssrlrni.d.q":     {bits: 0x73520000, fmt: insnFormatVdVjUk7},
+	"vssrlrni.bu.h":    {bits: 0x73544000, fmt: insnFormatVdVjUk4},
+	"vssrlrni.hu.w":    {bits: 0x73548000, fmt: insnFormatVdVjUk5},
+	"vssrlrni.wu.d":    {bits: 0x73550000, fmt: insnFormatVdVjUk6},
+	"vssrlrni.du.q":    {bits: 0x73560000, fmt: insnFormatVdVjUk7},
+	"vsrani.b.h":       {bits: 0x73584000, fmt: insnFormatVdVjUk4},
+	"vsrani.h.w":       {bits: 0x73588000, fmt: insnFormatVdVjUk5},
+	"vsrani.w.d":       {bits: 0x73590000, fmt: insnFormatVdVjUk6},
+	"vsrani.d.q":       {bits: 0x735a0000, fmt: insnFormatVdVjUk7},
+	"vsrarni.b.h":      {bits: 0x735c4000, fmt: insnFormatVdVjUk4},
+	"vsrarni.h.w":      {bits: 0x735c8000, fmt: insnFormatVdVjUk5},
+	"vsrarni.w.d":      {bits: 0x735d0000, fmt: insnFormatVdVjUk6},
+	"vsrarni.d.q":      {bits: 0x735e0000, fmt: insnFormatVdVjUk7},
+	"vssrani.b.h":      {bits: 0x73604000, fmt: insnFormatVdVjUk4},
+	"vssrani.h.w":      {bits: 0x73608000, fmt: insnFormatVdVjUk5},
+	"vssrani.w.d":      {bits: 0x73610000, fmt: insnFormatVdVjUk6},
+	"vssrani.d.q":      {bits: 0x73620000, fmt: insnFormatVdVjUk7},
+	"vssrani.bu.h":     {bits: 0x73644000, fmt: insnFormatVdVjUk4},
+	"vssrani.hu.w":     {bits: 0x73648000, fmt: insnFormatVdVjUk5},
+	"vssrani.wu.d":     {bits: 0x73650000, fmt: insnFormatVdVjUk6},
+	"vssrani.du.q":     {bits: 0x73660000, fmt: insnFormatVdVjUk7},
+	"vssrarni.b.h":     {bits: 0x73684000, fmt: insnFormatVdVjUk4},
+	"vssrarni.h.w":     {bits: 0x73688000, fmt: insnFormatVdVjUk5},
+	"vssrarni.w.d":     {bits: 0x73690000, fmt: insnFormatVdVjUk6},
+	"vssrarni.d.q":     {bits: 0x736a0000, fmt: insnFormatVdVjUk7},
+	"vssrarni.bu.h":    {bits: 0x736c4000, fmt: insnFormatVdVjUk4},
+	"vssrarni.hu.w":    {bits: 0x736c8000, fmt: insnFormatVdVjUk5},
+	"vssrarni.wu.d":    {bits: 0x736d0000, fmt: insnFormatVdVjUk6},
+	"vssrarni.du.q":    {bits: 0x736e0000, fmt: insnFormatVdVjUk7},
+	"vextrins.d":       {bits: 0x73800000, fmt: insnFormatVdVjUk8},
+	"vextrins.w":       {bits: 0x73840000, fmt: insnFormatVdVjUk8},
+	"vextrins.h":       {bits: 0x73880000, fmt: insnFormatVdVjUk8},
+	"vextrins.b":       {bits: 0x738c0000, fmt: insnFormatVdVjUk8},
+	"vshuf4i.b":        {bits: 0x73900000, fmt: insnFormatVdVjUk8},
+	"vshuf4i.h":        {bits: 0x73940000, fmt: insnFormatVdVjUk8},
+	"vshuf4i.w":        {bits: 0x73980000, fmt: insnFormatVdVjUk8},
+	"vshuf4i.d":        {bits: 0x739c0000, fmt: insnFormatVdVjUk8},
+	"vbitseli.b":       {bits: 0x73c40000, fmt: insnFormatVdVjUk8},
+	"vandi.b":          {bits: 0x73d00000, fmt: insnFormatVdVjUk8},
+	"vori.b":           {bits: 0x73d40000, fmt: insnFormatVdVjUk8},
+	"vxori.b":          {bits: 0x73d80000, fmt: insnFormatVdVjUk8},
+	"vnori.b":          {bits: 0x73dc0000, fmt: insnFormatVdVjUk8},
+	"vldi":             {bits: 0x73e00000, fmt: insnFormatVdSj13},
+	"vpermi.w":         {bits: 0x73e40000, fmt: insnFormatVdVjUk8},
+	"xvseq.b":          {bits: 0x74000000, fmt: insnFormatXdXjXk},
+	"xvseq.h":          {bits: 0x74008000, fmt: insnFormatXdXjXk},
+	"xvseq.w":          {bits: 0x74010000, fmt: insnFormatXdXjXk},
+	"xvseq.d":          {bits: 0x74018000, fmt: insnFormatXdXjXk},
+	"xvsle.b":          {bits: 0x74020000, fmt: insnFormatXdXjXk},
+	"xvsle.h":          {bits: 0x74028000, fmt: insnFormatXdXjXk},
+	"xvsle.w":          {bits: 0x74030000, fmt: insnFormatXdXjXk},
+	"xvsle.d":          {bits: 0x74038000, fmt: insnFormatXdXjXk},
+	"xvsle.bu":         {bits: 0x74040000, fmt: insnFormatXdXjXk},
+	"xvsle.hu":         {bits: 0x74048000, fmt: insnFormatXdXjXk},
+	"xvsle.wu":         {bits: 0x74050000, fmt: insnFormatXdXjXk},
+	"xvsle.du":         {bits: 0x74058000, fmt: insnFormatXdXjXk},
+	"xvslt.b":          {bits: 0x74060000, fmt: insnFormatXdXjXk},
+	"xvslt.h":          {bits: 0x74068000, fmt: insnFormatXdXjXk},
+	"xvslt.w":          {bits: 0x74070000, fmt: insnFormatXdXjXk},
+	"xvslt.d":          {bits: 0x74078000, fmt: insnFormatXdXjXk},
+	"xvslt.bu":         {bits: 0x74080000, fmt: insnFormatXdXjXk},
+	"xvslt.hu":         {bits: 0x74088000, fmt: insnFormatXdXjXk},
+	"xvslt.wu":         {bits: 0x74090000, fmt: insnFormatXdXjXk},
+	"xvslt.du":         {bits: 0x74098000, fmt: insnFormatXdXjXk},
+	"xvadd.b":          {bits: 0x740a0000, fmt: insnFormatXdXjXk},
+	"xvadd.h":          {bits: 0x740a8000, fmt: insnFormatXdXjXk},
+	"xvadd.w":          {bits: 0x740b0000, fmt: insnFormatXdXjXk},
+	"xvadd.d":          {bits: 0x740b8000, fmt: insnFormatXdXjXk},
+	"xvsub.b":          {bits: 0x740c0000, fmt: insnFormatXdXjXk},
+	"xvsub.h":          {bits: 0x740c8000, fmt: insnFormatXdXjXk},
+	"xvsub.w":          {bits: 0x740d0000, fmt: insnFormatXdXjXk},
+	"xvsub.d":          {bits: 0x740d8000, fmt: insnFormatXdXjXk},
+	"xvaddwev.h.b":     {bits: 0x741e0000, fmt: insnFormatXdXjXk},
+	"xvaddwev.w.h":     {bits: 0x741e8000, fmt: insnFormatXdXjXk},
+	"xvaddwev.d.w":     {bits: 0x741f0000, fmt: insnFormatXdXjXk},
+	"xvaddwev.q.d":     {bits: 0x741f8000, fmt: insnFormatXdXjXk},
+	"xvsubwev.h.b":     {bits: 0x74200000, fmt: insnFormatXdXjXk},
+	"xvsubwev.w.h":     {bits: 0x74208000, fmt: insnFormatXdXjXk},
+	"xvsubwev.d.w":     {bits: 0x74210000, fmt: insnFormatXdXjXk},
+	"xvsubwev.q.d":     {bits: 0x74218000, fmt: insnFormatXdXjXk},
+	"xvaddwod.h.b":     {bits: 0x74220000, fmt: insnFormatXdXjXk},
+	"xvaddwod.w.h":     {bits: 0x74228000, fmt: insnFormatXdXjXk},
+	"xvaddwod.d.w":     {bits: 0x74230000, fmt: insnFormatXdXjXk},
+	"xvaddwod.q.d":     {bits: 0x74238000, fmt: insnFormatXdXjXk},
+	"xvsubwod.h.b":     {bits: 0x74240000, fmt: insnFormatXdXjXk},
+	"xvsubwod.w.h":     {bits: 0x74248000, fmt: insnFormatXdXjXk},
+	"xvsubwod.d.w":     {bits: 0x74250000, fmt: insnFormatXdXjXk},
+	"xvsubwod.q.d":     {bits: 0x74258000, fmt: insnFormatXdXjXk},
+	"xvaddwev.h.bu":    {bits: 0x742e0000, fmt: insnFormatXdXjXk},
+	"xvaddwev.w.hu":    {bits: 0x742e8000, fmt: insnFormatXdXjXk},
+	"xvaddwev.d.wu":    {bits: 0x742f0000, fmt: insnFormatXdXjXk},
+	"xvaddwev.q.du":    {bits: 0x742f8000, fmt: insnFormatXdXjXk},
+	"xvsubwev.h.bu":    {bits: 0x74300000, fmt: insnFormatXdXjXk},
+	"xvsubwev.w.hu":    {bits: 0x74308000, fmt: insnFormatXdXjXk},
+	"xvsubwev.d.wu":    {bits: 0x74310000, fmt: insnFormatXdXjXk},
+	"xvsubwev.q.du":    {bits: 0x74318000, fmt: insnFormatXdXjXk},
+	"xvaddwod.h.bu":    {bits: 0x74320000, fmt: insnFormatXdXjXk},
+	"xvaddwod.w.hu":    {bits: 0x74328000, fmt: insnFormatXdXjXk},
+	"xvaddwod.d.wu":    {bits: 0x74330000, fmt: insnFormatXdXjXk},
+	"xvaddwod.q.du":    {bits: 0x74338000, fmt: insnFormatXdXjXk},
+	"xvsubwod.h.bu":    {bits: 0x74340000, fmt: insnFormatXdXjXk},
+	"xvsubwod.w.hu":    {bits: 0x74348000, fmt: insnFormatXdXjXk},
+	"xvsubwod.d.wu":    {bits: 0x74350000, fmt: insnFormatXdXjXk},
+	"xvsubwod.q.du":    {bits: 0x74358000, fmt: insnFormatXdXjXk},
+	"xvaddwev.h.bu.b":  {bits: 0x743e0000, fmt: insnFormatXdXjXk},
+	"xvaddwev.w.hu.h":  {bits: 0x743e8000, fmt: insnFormatXdXjXk},
+	"xvaddwev.d.wu.w":  {bits: 0x743f0000, fmt: insnFormatXdXjXk},
+	"xvaddwev.q.du.d":  {bits: 0x743f8000, fmt: insnFormatXdXjXk},
+	"xvaddwod.h.bu.b":  {bits: 0x74400000, fmt: insnFormatXdXjXk},
+	"xvaddwod.w.hu.h":  {bits: 0x74408000, fmt: insnFormatXdXjXk},
+	"xvaddwod.d.wu.w":  {bits: 0x74410000, fmt: insnFormatXdXjXk},
+	"xvaddwod.q.du.d":  {bits: 0x74418000, fmt: insnFormatXdXjXk},
+	"xvsadd.b":         {bits: 0x74460000, fmt: insnFormatXdXjXk},
+	"xvsadd.h":         {bits: 0x74468000, fmt: insnFormatXdXjXk},
+	"xvsadd.w":         {bits: 0x74470000, fmt: insnFormatXdXjXk},
+	"xvsadd.d":         {bits: 0x74478000, fmt: insnFormatXdXjXk},
+	"xvssub.b":         {bits: 0x74480000, fmt: insnFormatXdXjXk},
+	"xvssub.h":         {bits: 0x74488000, fmt: insnFormatXdXjXk},
+	"xvssub.w":         {bits: 0x74490000, fmt: insnFormatXdXjXk},
+	"xvssub.d":         {bits: 0x74498000, fmt: insnFormatXdXjXk},
+	"xvsadd.bu":        {bits: 0x744a0000, fmt: insnFormatXdXjXk},
+	"xvsadd.hu":        {bits: 0x744a8000, fmt: insnFormatXdXjXk},
+	"xvsadd.wu":        {bits: 0x744b0000, fmt: insnFormatXdXjXk},
+	"xvsadd.du":        {bits: 0x744b8000, fmt: insnFormatXdXjXk},
+	"xvssub.bu":        {bits: 0x744c0000, fmt: insnFormatXdXjXk},
+	"xvssub.hu":        {bits: 0x744c8000, fmt: insnFormatXdXjXk},
+	"xvssub.wu":        {bits: 0x744d0000, fmt: insnFormatXdXjXk},
+	"xvssub.du":        {bits: 0x744d8000, fmt: insnFormatXdXjXk},
+	"xvhaddw.h.b":      {bits: 0x74540000, fmt: insnFormatXdXjXk},
+	"xvhaddw.w.h":      {bits: 0x74548000, fmt: insnFormatXdXjXk},
+	"xvhaddw.d.w":      {bits: 0x74550000, fmt: insnFormatXdXjXk},
+	"xvhaddw.q.d":      {bits: 0x74558000, fmt: insnFormatXdXjXk},
+	"xvhsubw.h.b":      {bits: 0x74560000, fmt: insnFormatXdXjXk},
+	"xvhsubw.w.h":      {bits: 0x74568000, fmt: insnFormatXdXjXk},
+	"xvhsubw.d.w":      {bits: 0x74570000, fmt: insnFormatXdXjXk},
+	"xvhsubw.q.d":      {bits: 0x74578000, fmt: insnFormatXdXjXk},
+	"xvhaddw.hu.bu":    {bits: 0x74580000, fmt: insnFormatXdXjXk},
+	"xvhaddw.wu.hu":    {bits: 0x74588000, fmt: insnFormatXdXjXk},
+	"xvhaddw.du.wu":    {bits: 0x74590000, fmt: insnFormatXdXjXk},
+	"xvhaddw.qu.du":    {bits: 0x74598000, fmt: insnFormatXdXjXk},
+	"xvhsubw.hu.bu":    {bits: 0x745a0000, fmt: insnFormatXdXjXk},
+	"xvhsubw.wu.hu":    {bits: 0x745a8000, fmt: insnFormatXdXjXk},
+	"xvhsubw.du.wu":    {bits: 0x745b0000, fmt: insnFormatXdXjXk},
+	"xvhsubw.qu.du":    {bits: 0x745b8000, fmt: insnFormatXdXjXk},
+	"xvadda.b":         {bits: 0x745c0000, fmt: insnFormatXdXjXk},
+	"xvadda.h":         {bits: 0x745c8000, fmt: insnFormatXdXjXk},
+	"xvadda.w":         {bits: 0x745d0000, fmt: insnFormatXdXjXk},
+	"xvadda.d":         {bits: 0x745d8000, fmt: insnFormatXdXjXk},
+	"xvabsd.b":         {bits: 0x74600000, fmt: insnFormatXdXjXk},
+	"xvabsd.h":         {bits: 0x74608000, fmt: insnFormatXdXjXk},
+	"xvabsd.w":         {bits: 0x74610000, fmt: insnFormatXdXjXk},
+	"xvabsd.d":         {bits: 0x74618000, fmt: insnFormatXdXjXk},
+	"xvabsd.bu":        {bits: 0x74620000, fmt: insnFormatXdXjXk},
+	"xvabsd.hu":        {bits: 0x74628000, fmt: insnFormatXdXjXk},
+	"xvabsd.wu":        {bits: 0x74630000, fmt: insnFormatXdXjXk},
+	"xvabsd.du":        {bits: 0x74638000, fmt: insnFormatXdXjXk},
+	"xvavg.b":          {bits: 0x74640000, fmt: insnFormatXdXjXk},
+	"xvavg.h":          {bits: 0x74648000, fmt: insnFormatXdXjXk},
+	"xvavg.w":          {bits: 0x74650000, fmt: insnFormatXdXjXk},
+	"xvavg.d":          {bits: 0x74658000, fmt: insnFormatXdXjXk},
+	"xvavg.bu":         {bits: 0x74660000, fmt: insnFormatXdXjXk},
+	"xvavg.hu":         {bits: 0x74668000, fmt: insnFormatXdXjXk},
+	"xvavg.wu":         {bits: 0x74670000, fmt: insnFormatXdXjXk},
+	"xvavg.du":         {bits: 0x74678000, fmt: insnFormatXdXjXk},
+	"xvavgr.b":         {bits: 0x74680000, fmt: insnFormatXdXjXk},
+	"xvavgr.h":         {bits: 0x74688000, fmt: insnFormatXdXjXk},
+	"xvavgr.w":         {bits: 0x74690000, fmt: insnFormatXdXjXk},
+	"xvavgr.d":         {bits: 0x74698000, fmt: insnFormatXdXjXk},
+	"xvavgr.bu":        {bits: 0x746a0000, fmt: insnFormatXdXjXk},
+	"xvavgr.hu":        {bits: 0x746a8000, fmt: insnFormatXdXjXk},
+	"xvavgr.wu":        {bits: 0x746b0000, fmt: insnFormatXdXjXk},
+	"xvavgr.du":        {bits: 0x746b8000, fmt: insnFormatXdXjXk},
+	"xvmax.b":          {bits: 0x74700000, fmt: insnFormatXdXjXk},
+	"xvmax.h":          {bits: 0x74708000, fmt: insnFormatXdXjXk},
+	"xvmax.w":          {bits: 0x74710000, fmt: insnFormatXdXjXk},
+	"xvmax.d":          {bits: 0x74718000, fmt: insnFormatXdXjXk},
+	"xvmin.b":          {bits: 0x74720000, fmt: insnFormatXdXjXk},
+	"xvmin.h":          {bits: 0x74728000, fmt: insnFormatXdXjXk},
+	"xvmin.w":          {bits: 0x74730000, fmt: insnFormatXdXjXk},
+	"xvmin.d":          {bits: 0x74738000, fmt: insnFormatXdXjXk},
+	"xvmax.bu":         {bits: 0x74740000, fmt: insnFormatXdXjXk},
+	"xvmax.hu":         {bits: 0x74748000, fmt: insnFormatXdXjXk},
+	"xvmax.wu":         {bits: 0x74750000, fmt: insnFormatXdXjXk},
+	"xvmax.du":         {bits: 0x74758000, fmt: insnFormatXdXjXk},
+	"xvmin.bu":         {bits: 0x74760000, fmt: insnFormatXdXjXk},
+	"xvmin.hu":         {bits: 0x74768000, fmt: insnFormatXdXjXk},
+	"xvmin.wu":         {bits: 0x74770000, fmt: insnFormatXdXjXk},
+	"xvmin.du":         {bits: 0x74778000, fmt: insnFormatXdXjXk},
+	"xvmul.b":          {bits: 0x74840000, fmt: insnFormatXdXjXk},
+	"xvmul.h":          {bits: 0x74848000, fmt: insnFormatXdXjXk},
+	"xvmul.w":          {bits: 0x74850000, fmt: insnFormatXdXjXk},
+	"xvmul.d":          {bits: 0x74858000, fmt: insnFormatXdXjXk},
+	"xvmuh.b":          {bits: 0x74860000, fmt: insnFormatXdXjXk},
+	"xvmuh.h":          {bits: 0x74868000, fmt: insnFormatXdXjXk},
+	"xvmuh.w":          {bits: 0x74870000, fmt: insnFormatXdXjXk},
+	"xvmuh.d":          {bits: 0x74878000, fmt: insnFormatXdXjXk},
+	"xvmuh.bu":         {bits: 0x74880000, fmt: insnFormatXdXjXk},
+	"xvmuh.hu":         {bits: 0x74888000, fmt: insnFormatXdXjXk},
+	"xvmuh.wu":         {bits: 0x74890000, fmt: insnFormatXdXjXk},
+	"xvmuh.du":         {bits: 0x74898000, fmt: insnFormatXdXjXk},
+	"xvmulwev.h.b":     {bits: 0x74900000, fmt: insnFormatXdXjXk},
+	"xvmulwev.w.h":     {bits: 0x74908000, fmt: insnFormatXdXjXk},
+	"xvmulwev.d.w":     {bits: 0x74910000, fmt: insnFormatXdXjXk},
+	"xvmulwev.q.d":     {bits: 0x74918000, fmt: insnFormatXdXjXk},
+	"xvmulwod.h.b":     {bits: 0x74920000, fmt: insnFormatXdXjXk},
+	"xvmulwod.w.h":     {bits: 0x74928000, fmt: insnFormatXdXjXk},
+	"xvmulwod.d.w":     {bits: 0x74930000, fmt: insnFormatXdXjXk},
+	"xvmulwod.q.d":     {bits: 0x74938000, fmt: insnFormatXdXjXk},
+	"xvmulwev.h.bu":    {bits: 0x74980000, fmt: insnFormatXdXjXk},
+	"xvmulwev.w.hu":    {bits: 0x74988000, fmt: insnFormatXdXjXk},
+	"xvmulwev.d.wu":    {bits: 0x74990000, fmt: insnFormatXdXjXk},
+	"xvmulwev.q.du":    {bits: 0x74998000, fmt: insnFormatXdXjXk},
+	"xvmulwod.h.bu":    {bits: 0x749a0000, fmt: insnFormatXdXjXk},
+	"xvmulwod.w.hu":    {bits: 0x749a8000, fmt: insnFormatXdXjXk},
+	"xvmulwod.d.wu":    {bits: 0x749b0000, fmt: insnFormatXdXjXk},
+	"xvmulwod.q.du":    {bits: 0x749b8000, fmt: insnFormatXdXjXk},
+	"xvmulwev.h.bu.b":  {bits: 0x74a00000, fmt: insnFormatXdXjXk},
+	"xvmulwev.w.hu.h":  {bits: 0x74a08000, fmt: insnFormatXdXjXk},
+	"xvmulwev.d.wu.w":  {bits: 0x74a10000, fmt: insnFormatXdXjXk},
+	"xvmulwev.q.du.d":  {bits: 0x74a18000, fmt: insnFormatXdXjXk},
+	"xvmulwod.h.bu.b":  {bits: 0x74a20000, fmt: insnFormatXdXjXk},
+	"xvmulwod.w.hu.h":  {bits: 0x74a28000, fmt: insnFormatXdXjXk},
+	"xvmulwod.d.wu.w":  {bits: 0x74a30000, fmt: insnFormatXdXjXk},
+	"xvmulwod.q.du.d":  {bits: 0x74a38000, fmt: insnFormatXdXjXk},
+	"xvmadd.b":         {bits: 0x74a80000, fmt: insnFormatXdXjXk},
+	"xvmadd.h":         {bits: 0x74a88000, fmt: insnFormatXdXjXk},
+	"xvmadd.w":         {bits: 0x74a90000, fmt: insnFormatXdXjXk},
+	"xvmadd.d":         {bits: 0x74a98000, fmt: insnFormatXdXjXk},
+	"xvmsub.b":         {bits: 0x74aa0000, fmt: insnFormatXdXjXk},
+	"xvmsub.h":         {bits: 0x74aa8000, fmt: insnFormatXdXjXk},
+	"xvmsub.w":         {bits: 0x74ab0000, fmt: insnFormatXdXjXk},
+	"xvmsub.d":         {bits: 0x74ab8000, fmt: insnFormatXdXjXk},
+	"xvmaddwev.h.b":    {bits: 0x74ac0000, fmt: insnFormatXdXjXk},
+	"xvmaddwev.w.h":    {bits: 0x74ac8000, fmt: insnFormatXdXjXk},
+	"xvmaddwev.d.w":    {bits: 0x74ad0000, fmt: insnFormatXdXjXk},
+	"xvmaddwev.q.d":    {bits: 0x74ad8000, fmt: insnFormatXdXjXk},
+	"xvmaddwod.h.b":    {bits: 0x74ae0000, fmt: insnFormatXdXjXk},
+	"xvmaddwod.w.h":    {bits: 0x74ae8000, fmt: insnFormatXdXjXk},
+	"xvmaddwod.d.w":    {bits: 0x74af0000, fmt: insnFormatXdXjXk},
+	"xvmaddwod.q.d":    {bits: 0x74af8000, fmt: insnFormatXdXjXk},
+	"xvmaddwev.h.bu":   {bits: 0x74b40000, fmt: insnFormatXdXjXk},
+	"xvmaddwev.w.hu":   {bits: 0x74b48000, fmt: insnFormatXdXjXk},
+	"xvmaddwev.d.wu":   {bits: 0x74b50000, fmt: insnFormatXdXjXk},
+	"xvmaddwev.q.du":   {bits: 0x74b58000, fmt: insnFormatXdXjXk},
+	"xvmaddwod.h.bu":   {bits: 0x74b60000, fmt: insnFormatXdXjXk},
+	"xvmaddwod.w.hu":   {bits: 0x74b68000, fmt: insnFormatXdXjXk},
+	"xvmaddwod.d.wu":   {bits: 0x74b70000, fmt: insnFormatXdXjXk},
+	"xvmaddwod.q.du":   {bits: 0x74b78000, fmt: insnFormatXdXjXk},
+	"xvmaddwev.h.bu.b": {bits: 0x74bc0000, fmt: insnFormatXdXjXk},
+	"xvmaddwev.w.hu.h": {bits: 0x74bc8000, fmt: insnFormatXdXjXk},
+	"xvmaddwev.d.wu.w": {bits: 0x74bd0000, fmt: insnFormatXdXjXk},
+	"xvmaddwev.q.du.d": {bits: 0x74bd8000, fmt: insnFormatXdXjXk},
+	"xvmaddwod.h.bu.b": {bits: 0x74be0000, fmt: insnFormatXdXjXk},
+	"xvmaddwod.w.hu.h": {bits: 0x74be8000, fmt: insnFormatXdXjXk},
+	"xvmaddwod.d.wu.w": {bits: 0x74bf0000, fmt: insnFormatXdXjXk},
+	"xvmaddwod.q.du.d": {bits: 0x74bf8000, fmt: insnFormatXdXjXk},
+	"xvdiv.b":          {bits: 0x74e00000, fmt: insnFormatXdXjXk},
+	"xvdiv.h":          {bits: 0x74e08000, fmt: insnFormatXdXjXk},
+	"xvdiv.w":          {bits: 0x74e10000, fmt: insnFormatXdXjXk},
+	"xvdiv.d":          {bits: 0x74e18000, fmt: insnFormatXdXjXk},
+	"xvmod.b":          {bits: 0x74e20000, fmt: insnFormatXdXjXk},
+	"xvmod.h":          {bits: 0x74e28000, fmt: insnFormatXdXjXk},
+	"xvmod.w":          {bits: 0x74e30000, fmt: insnFormatXdXjXk},
+	"xvmod.d":          {bits: 0x74e38000, fmt: insnFormatXdXjXk},
+	"xvdiv.bu":         {bits: 0x74e40000, fmt: insnFormatXdXjXk},
+	"xvdiv.hu":         {bits: 0x74e48000, fmt: insnFormatXdXjXk},
+	"xvdiv.wu":         {bits: 0x74e50000, fmt: insnFormatXdXjXk},
+	"xvdiv.du":         {bits: 0x74e58000, fmt: insnFormatXdXjXk},
+	"xvmod.bu":         {bits: 0x74e60000, fmt: insnFormatXdXjXk},
+	"xvmod.hu":         {bits: 0x74e68000, fmt: insnFormatXdXjXk},
+	"xvmod.wu":         {bits: 0x74e70000, fmt: insnFormatXdXjXk},
+	"xvmod.du":         {bits: 0x74e78000, fmt: insnFormatXdXjXk},
+	"xvsll.b":          {bits: 0x74e80000, fmt: insnFormatXdXjXk},
+	"xvsll.h":          {bits: 0x74e88000, fmt: insnFormatXdXjXk},
+	"xvsll.w":          {bits: 0x74e90000, fmt: insnFormatXdXjXk},
+	"xvsll.d":          {bits: 0x74e98000, fmt: insnFormatXdXjXk},
+	"xvsrl.b":          {bits: 0x74ea0000, fmt: insnFormatXdXjXk},
+	"xvsrl.h":          {bits: 0x74ea8000, fmt: insnFormatXdXjXk},
+	"xvsrl.w":          {bits: 0x74eb0000, fmt: insnFormatXdXjXk},
+	"xvsrl.d":          {bits: 0x74eb8000, fmt: insnFormatXdXjXk},
+	"xvsra.b":          {bits: 0x74ec0000, fmt: insnFormatXdXjXk},
+	"xvsra.h":          {bits: 0x74ec8000, fmt: insnFormatXdXjXk},
+	"xvsra.w":          {bits: 0x74ed0000, fmt: insnFormatXdXjXk},
+	"xvsra.d":          {bits: 0x74ed8000, fmt: insnFormatXdXjXk},
+	"xvrotr.b":         {bits: 0x74ee0000, fmt: insnFormatXdXjXk},
+	"xvrotr.h":         {bits: 0x74ee8000, fmt: insnFormatXdXjXk},
+	"xvrotr.w":         {bits: 0x74ef0000, fmt: insnFormatXdXjXk},
+	"xvrotr.d":         {bits: 0x74ef8000, fmt: insnFormatXdXjXk},
+	"xvsrlr.b":         {bits: 0x74f00000, fmt: insnFormatXdXjXk},
+	"xvsrlr.h":         {bits: 0x74f08000, fmt: insnFormatXdXjXk},
+	"xvsrlr.w":         {bits: 0x74f10000, fmt: insnFormatXdXjXk},
+	"xvsrlr.d":         {bits: 0x74f18000, fmt: insnFormatXdXjXk},
+	"xvsrar.b":         {bits: 0x74f20000, fmt: insnFormatXdXjXk},
+	"xvsrar.h":         {bits: 0x74f28000, fmt: insnFormatXdXjXk},
+	"xvsrar.w":         {bits: 0x74f30000, fmt: insnFormatXdXjXk},
+	"xvsrar.d":         {bits: 0x74f38000, fmt: insnFormatXdXjXk},
+	"xvsrln.b.h":       {bits: 0x74f48000, fmt: insnFormatXdXjXk},
+	"xvsrln.h.w":       {bits: 0x74f50000, fmt: insnFormatXdXjXk},
+	"xvsrln.w.d":       {bits: 0x74f58000, fmt: insnFormatXdXjXk},
+	"xvsran.b.h":       {bits: 0x74f68000, fmt: insnFormatXdXjXk},
+	"xvsran.h.w":       {bits: 0x74f70000, fmt: insnFormatXdXjXk},
+	"xvsran.w.d":       {bits: 0x74f78000, fmt: insnFormatXdXjXk},
+	"xvsrlrn.b.h":      {bits: 0x74f88000, fmt: insnFormatXdXjXk},
+	"xvsrlrn.h.w":      {bits: 0x74f90000, fmt: insnFormatXdXjXk},
+	"xvsrlrn.w.d":      {bits: 0x74f98000, fmt: insnFormatXdXjXk},
+	"xvsrarn.b.h":      {bits: 0x74fa8000, fmt: insnFormatXdXjXk},
+	"xvsrarn.h.w":      {bits: 0x74fb0000, fmt: insnFormatXdXjXk},
+	"xvsrarn.w.d":      {bits: 0x74fb8000, fmt: insnFormatXdXjXk},
+	"xvssrln.b.h":      {bits: 0x74fc8000, fmt: insnFormatXdXjXk},
+	"xvssrln.h.w":      {bits: 0x74fd0000, fmt: insnFormatXdXjXk},
+	"xvssrln.w.d":      {bits: 0x74fd8000, fmt: insnFormatXdXjXk},
+	"xvssran.b.h":      {bits: 0x74fe8000, fmt: insnFormatXdXjXk},
+	"xvssran.h.w":      {bits: 0x74ff0000, fmt: insnFormatXdXjXk},
+	"xvssran.w.d":      {bits: 0x74ff8000, fmt: insnFormatXdXjXk},
+	"xvssrlrn.b.h":     {bits: 0x75008000, fmt: insnFormatXdXjXk},
+	"xvssrlrn.h.w":     {bits: 0x75010000, fmt: insnFormatXdXjXk},
+	"xvssrlrn.w.d":     {bits: 0x75018000, fmt: insnFormatXdXjXk},
+	"xvssrarn.b.h":     {bits: 0x75028000, fmt: insnFormatXdXjXk},
+	"xvssrarn.h.w":     {bits: 0x75030000, fmt: insnFormatXdXjXk},
+	"xvssrarn.w.d":     {bits: 0x75038000, fmt: insnFormatXdXjXk},
+	"xvssrln.bu.h":     {bits: 0x75048000, fmt: insnFormatXdXjXk},
+	"xvssrln.hu.w":     {bits: 0x75050000, fmt: insnFormatXdXjXk},
+	"xvssrln.wu.d":     {bits: 0x75058000, fmt: insnFormatXdXjXk},
+	"xvssran.bu.h":     {bits: 0x75068000, fmt: insnFormatXdXjXk},
+	"xvssran.hu.w":     {bits: 0x75070000, fmt: insnFormatXdXjXk},
+	"xvssran.wu.d":     {bits: 0x75078000, fmt: insnFormatXdXjXk},
+	"xvssrlrn.bu.h":    {bits: 0x75088000, fmt: insnFormatXdXjXk},
+	"xvssrlrn.hu.w":    {bits: 0x75090000, fmt: insnFormatXdXjXk},
+	"xvssrlrn.wu.d":    {bits: 0x75098000, fmt: insnFormatXdXjXk},
+	"xvssrarn.bu.h":    {bits: 0x750a8000, fmt: insnFormatXdXjXk},
+	"xvssrarn.hu.w":    {bits: 0x750b0000, fmt: insnFormatXdXjXk},
+	"xvssrarn.wu.d":    {bits: 0x750b8000, fmt: insnFormatXdXjXk},
+	"xvbitclr.b":       {bits: 0x750c0000, fmt: insnFormatXdXjXk},
+	"xvbitclr.h":       {bits: 0x750c8000, fmt: insnFormatXdXjXk},
+	"xvbitclr.w":       {bits: 0x750d0000, fmt: insnFormatXdXjXk},
+	"xvbitclr.d":       {bits: 0x750d8000, fmt: insnFormatXdXjXk},
+	"xvbitset.b":       {bits: 0x750e0000, fmt: insnFormatXdXjXk},
+	"xvbitset.h":       {bits: 0x750e8000, fmt: insnFormatXdXjXk},
+	"xvbitset.w":       {bits: 0x750f0000, fmt: insnFormatXdXjXk},
+	"xvbitset.d":       {bits: 0x750f8000, fmt: insnFormatXdXjXk},
+	"xvbitrev.b":       {bits: 0x75100000, fmt: insnFormatXdXjXk},
+	"xvbitrev.h":       {bits: 0x75108000, fmt: insnFormatXdXjXk},
+	"xvbitrev.w":       {bits: 0x75110000, fmt: insnFormatXdXjXk},
+	"xvbitrev.d":       {bits: 0x75118000, fmt: insnFormatXdXjXk},
+	"xvpackev.b":       {bits: 0x75160000, fmt: insnFormatXdXjXk},
+	"xvpackev.h":       {bits: 0x75168000, fmt: insnFormatXdXjXk},
+	"xvpackev.w":       {bits: 0x75170000, fmt: insnFormatXdXjXk},
+	"xvpackev.d":       {bits: 0x75178000, fmt: insnFormatXdXjXk},
+	"xvpackod.b":       {bits: 0x75180000, fmt: insnFormatXdXjXk},
+	"xvpackod.h":       {bits: 0x75188000, fmt: insnFormatXdXjXk},
+	"xvpackod.w":       {bits: 0x75190000, fmt: insnFormatXdXjXk},
+	"xvpackod.d":       {bits: 0x75198000, fmt: insnFormatXdXjXk},
+	"xvilvl.b":         {bits: 0x751a0000, fmt: insnFormatXdXjXk},
+	"xvilvl.h":         {bits: 0x751a8000, fmt: insnFormatXdXjXk},
+	"xvilvl.w":         {bits: 0x751b0000, fmt: insnFormatXdXjXk},
+	"xvilvl.d":         {bits: 0x751b8000, fmt: insnFormatXdXjXk},
+	"xvilvh.b":         {bits: 0x751c0000, fmt: insnFormatXdXjXk},
+	"xvilvh.h":         {bits: 0x751c8000, fmt: insnFormatXdXjXk},
+	"xvilvh.w":         {bits: 0x751d0000, fmt: insnFormatXdXjXk},
+	"xvilvh.d":         {bits: 0x751d8000, fmt: insnFormatXdXjXk},
+	"xvpickev.b":       {bits: 0x751e0000, fmt: insnFormatXdXjXk},
+	"xvpickev.h":       {bits: 0x751e8000, fmt: insnFormatXdXjXk},
+	"xvpickev.w":       {bits: 0x751f0000, fmt: insnFormatXdXjXk},
+	"xvpickev.d":       {bits: 0x751f8000, fmt: insnFormatXdXjXk},
+	"xvpickod.b":       {bits: 0x75200000, fmt: insnFormatXdXjXk},
+	"xvpickod.h":       {bits: 0x75208000, fmt: insnFormatXdXjXk},
+	"xvpickod.w":       {bits: 0x75210000, fmt: insnFormatXdXjXk},
+	"xvpickod.d":       {bits: 0x75218000, fmt: insnFormatXdXjXk},
+	"xvreplve.b":       {bits: 0x75220000, fmt: insnFormatXdXjK},
+	"xvreplve.h":       {bits: 0x75228000, fmt: insnFormatXdXjK},
+	"xvreplve.w":       {bits: 0x75230000, fmt: insnFormatXdXjK},
+	"xvreplve.d":       {bits: 0x75238000, fmt: insnFormatXdXjK},
+	"xvand.v":          {bits: 0x75260000, fmt: insnFormatXdXjXk},
+	"xvor.v":           {bits: 0x75268000, fmt: insnFormatXdXjXk},
+	"xvxor.v":          {bits: 0x75270000, fmt: insnFormatXdXjXk},
+	"xvnor.v":          {bits: 0x75278000, fmt: insnFormatXdXjXk},
+	"xvandn.v":         {bits: 0x75280000, fmt: insnFormatXdXjXk},
+	"xvorn.v":          {bits: 0x75288000, fmt: insnFormatXdXjXk},
+	"xvfrstp.b":        {bits: 0x752b0000, fmt: insnFormatXdXjXk},
+	"xvfrstp.h":        {bits: 0x752b8000, fmt: insnFormatXdXjXk},
+	"xvadd.q":          {bits: 0x752d0000, fmt: insnFormatXdXjXk},
+	"xvsub.q":          {bits: 0x752d8000, fmt: insnFormatXdXjXk},
+	"xvsigncov.b":      {bits: 0x752e0000, fmt: insnFormatXdXjXk},
+	"xvsigncov.h":      {bits: 0x752e8000, fmt: insnFormatXdXjXk},
+	"xvsigncov.w":      {bits: 0x752f0000, fmt: insnFormatXdXjXk},
+	"xvsigncov.d":      {bits: 0x752f8000, fmt: insnFormatXdXjXk},
+	"xvfadd.s":         {bits: 0x75308000, fmt: insnFormatXdXjXk},
+	"xvfadd.d":         {bits: 0x75310000, fmt: insnFormatXdXjXk},
+	"xvfsub.s":         {bits: 0x75328000, fmt: insnFormatXdXjXk},
+	"xvfsub.d":         {bits: 0x75330000, fmt: insnFormatXdXjXk},
+	"xvfmul.s":         {bits: 0x75388000, fmt: insnFormatXdXjXk},
+	"xvfmul.d":         {bits: 0x75390000, fmt: insnFormatXdXjXk},
+	"xvfdiv.s":         {bits: 0x753a8000, fmt: insnFormatXdXjXk},
+	"xvfdiv.d":         {bits: 0x753b0000, fmt: insnFormatXdXjXk},
+	"xvfmax.s":         {bits: 0x753c8000, fmt: insnFormatXdXjXk},
+	"xvfmax.d":         {bits: 0x753d0000, fmt: insnFormatXdXjXk},
+	"xvfmin.s":         {bits: 0x753e8000, fmt: insnFormatXdXjXk},
+	"xvfmin.d":         {bits: 0x753f0000, fmt: insnFormatXdXjXk},
+	"xvfmaxa.s":        {bits: 0x75408000, fmt: insnFormatXdXjXk},
+	"xvfmaxa.d":        {bits: 0x75410000, fmt: insnFormatXdXjXk},
+	"xvfmina.s":        {bits: 0x75428000, fmt: insnFormatXdXjXk},
+	"xvfmina.d":        {bits: 0x75430000, fmt: insnFormatXdXjXk},
+	"xvfcvt.h.s":       {bits: 0x75460000, fmt: insnFormatXdXjXk},
+	"xvfcvt.s.d":       {bits: 0x75468000, fmt: insnFormatXdXjXk},
+	"xvffint.s.l":      {bits: 0x75480000, fmt: insnFormatXdXjXk},
+	"xvftint.w.d":      {bits: 0x75498000, fmt: insnFormatXdXjXk},
+	"xvftintrm.w.d":    {bits: 0x754a0000, fmt: insnFormatXdXjXk},
+	"xvftintrp.w.d":    {bits: 0x754a8000, fmt: insnFormatXdXjXk},
+	"xvftintrz.w.d":    {bits: 0x754b0000, fmt: insnFormatXdXjXk},
+	"xvftintrne.w.d":   {bits: 0x754b8000, fmt: insnFormatXdXjXk},
+	"xvshuf.h":         {bits: 0x757a8000, fmt: insnFormatXdXjXk},
+	"xvshuf.w":         {bits: 0x757b0000, fmt: insnFormatXdXjXk},
+	"xvshuf.d":         {bits: 0x757b8000, fmt: insnFormatXdXjXk},
+	"xvperm.w":         {bits: 0x757d0000, fmt: insnFormatXdXjXk},
+	"xvseqi.b":         {bits: 0x76800000, fmt: insnFormatXdXjSk5},
+	"xvseqi.h":         {bits: 0x76808000, fmt: insnFormatXdXjSk5},
+	"xvseqi.w":         {bits: 0x76810000, fmt: insnFormatXdXjSk5},
+	"xvseqi.d":         {bits: 0x76818000, fmt: insnFormatXdXjSk5},
+	"xvslei.b":         {bits: 0x76820000, fmt: insnFormatXdXjSk5},
+	"xvslei.h":         {bits: 0x76828000, fmt: insnFormatXdXjSk5},
+	"xvslei.w":         {bits: 0x76830000, fmt: insnFormatXdXjSk5},
+	"xvslei.d":         {bits: 0x76838000, fmt: insnFormatXdXjSk5},
+	"xvslei.bu":        {bits: 0x76840000, fmt: insnFormatXdXjUk5},
+	"xvslei.hu":        {bits: 0x76848000, fmt: insnFormatXdXjUk5},
+	"xvslei.wu":        {bits: 0x76850000, fmt: insnFormatXdXjUk5},
+	"xvslei.du":        {bits: 0x76858000, fmt: insnFormatXdXjUk5},
+	"xvslti.b":         {bits: 0x76860000, fmt: insnFormatXdXjSk5},
+	"xvslti.h":         {bits: 0x76868000, fmt: insnFormatXdXjSk5},
+	"xvslti.w":         {bits: 0x76870000, fmt: insnFormatXdXjSk5},
+	"xvslti.d":         {bits: 0x76878000, fmt: insnFormatXdXjSk5},
+	"xvslti.bu":        {bits: 0x76880000, fmt: insnFormatXdXjUk5},
+	"xvslti.hu":        {bits: 0x76888000, fmt: insnFormatXdXjUk5},
+	"xvslti.wu":        {bits: 0x76890000, fmt: insnFormatXdXjUk5},
+	"xvslti.du":        {bits: 0x76898000, fmt: insnFormatXdXjUk5},
+	"xvaddi.bu":        {bits: 0x768a0000, fmt: insnFormatXdXjUk5},
+	"xvaddi.hu":        {bits: 0x768a8000, fmt: insnFormatXdXjUk5},
+	"xvaddi.wu":        {bits: 0x768b0000, fmt: insnFormatXdXjUk5},
+	"xvaddi.du":        {bits: 0x768b8000, fmt: insnFormatXdXjUk5},
+	"xvsubi.bu":        {bits: 0x768c0000, fmt: insnFormatXdXjUk5},
+	"xvsubi.hu":        {bits: 0x768c8000, fmt: insnFormatXdXjUk5},
+	"xvsubi.wu":        {bits: 0x768d0000, fmt: insnFormatXdXjUk5},
+	"xvsubi.du":        {bits: 0x768d8000, fmt: insnFormatXdXjUk5},
+	"xvbsll.v":         {bits: 0x768e0000, fmt: insnFormatXdXjUk5},
+	"xvbsrl.v":         {bits: 0x768e8000, fmt: insnFormatXdXjUk5},
+	"xvmaxi.b":         {bits: 0x76900000, fmt: insnFormatXdXjSk5},
+	"xvmaxi.h":         {bits: 0x76908000, fmt: insnFormatXdXjSk5},
+	"xvmaxi.w":         {bits: 0x76910000, fmt: insnFormatXdXjSk5},
+	"xvmaxi.d":         {bits: 0x76918000, fmt: insnFormatXdXjSk5},
+	"xvmini.b":         {bits: 0x76920000, fmt: insnFormatXdXjSk5},
+	"xvmini.h":         {bits: 0x76928000, fmt: insnFormatXdXjSk5},
+	"xvmini.w":         {bits: 0x76930000, fmt: insnFormatXdXjSk5},
+	"xvmini.d":         {bits: 0x76938000, fmt: insnFormatXdXjSk5},
+	"xvmaxi.bu":        {bits: 0x76940000, fmt: insnFormatXdXjUk5},
+	"xvmaxi.hu":        {bits: 0x76948000, fmt: insnFormatXdXjUk5},
+	"xvmaxi.wu":        {bits: 0x76950000, fmt: insnFormatXdXjUk5},
+	"xvmaxi.du":        {bits: 0x76958000, fmt: insnFormatXdXjUk5},
+	"xvmini.bu":        {bits: 0x76960000, fmt: insnFormatXdXjUk5},
+	"xvmini.hu":        {bits: 0x76968000, fmt: insnFormatXdXjUk5},
+	"xvmini.wu":        {bits: 0x76970000, fmt: insnFormatXdXjUk5},
+	"xvmini.du":        {bits: 0x76978000, fmt: insnFormatXdXjUk5},
+	"xvfrstpi.b":       {bits: 0x769a0000, fmt: insnFormatXdXjUk5},
+	"xvfrstpi.h":       {bits: 0x769a8000, fmt: insnFormatXdXjUk5},
+	"xvclo.b":          {bits: 0x769c0000, fmt: insnFormatXdXj},
+	"xvclo.h":          {bits: 0x769c0400, fmt: insnFormatXdXj},
+	"xvclo.w":          {bits: 0x769c0800, fmt: insnFormatXdXj},
+	"xvclo.d":          {bits: 0x769c0c00, fmt: insnFormatXdXj},
+	"xvclz.b":          {bits: 0x769c1000, fmt: insnFormatXdXj},
+	"xvclz.h":          {bits: 0x769c1400, fmt: insnFormatXdXj},
+	"xvclz.w":          {bits: 0x769c1800, fmt: insnFormatXdXj},
+	"xvclz.d":          {bits: 0x769c1c00, fmt: insnFormatXdXj},
+	"xvpcnt.b":         {bits: 0x769c2000, fmt: insnFormatXdXj},
+	"xvpcnt.h":         {bits: 0x769c2400, fmt: insnFormatXdXj},
+	"xvpcnt.w":         {bits: 0x769c2800, fmt: insnFormatXdXj},
+	"xvpcnt.d":         {bits: 0x769c2c00, fmt: insnFormatXdXj},
+	"xvneg.b":          {bits: 0x769c3000, fmt: insnFormatXdXj},
+	"xvneg.h":          {bits: 0x769c3400, fmt: insnFormatXdXj},
+	"xvneg.w":          {bits: 0x769c3800, fmt: insnFormatXdXj},
+	"xvneg.d":          {bits: 0x769c3c00, fmt: insnFormatXdXj},
+	"xvmskltz.b":       {bits: 0x769c4000, fmt: insnFormatXdXj},
+	"xvmskltz.h":       {bits: 0x769c4400, fmt: insnFormatXdXj},
+	"xvmskltz.w":       {bits: 0x769c4800, fmt: insnFormatXdXj},
+	"xvmskltz.d":       {bits: 0x769c4c00, fmt: insnFormatXdXj},
+	"xvmskgez.b":       {bits: 0x769c5000, fmt: insnFormatXdXj},
+	"xvmsknz.b":        {bits: 0x769c6000, fmt: insnFormatXdXj},
+	"xvseteqz.v":       {bits: 0x769c9800, fmt: insnFormatCdXj},
+	"xvsetnez.v":       {bits: 0x769c9c00, fmt: insnFormatCdXj},
+	"xvsetanyeqz.b":    {bits: 0x769ca000, fmt: insnFormatCdXj},
+	"xvsetanyeqz.h":    {bits: 0x769ca400, fmt: insnFormatCdXj},
+	"xvsetanyeqz.w":    {bits: 0x769ca800, fmt: insnFormatCdXj},
+	"xvsetanyeqz.d":    {bits: 0x769cac00, fmt: insnFormatCdXj},
+	"xvsetallnez.b":    {bits: 0x769cb000, fmt: insnFormatCdXj},
+	"xvsetallnez.h":    {bits: 0x769cb400, fmt: insnFormatCdXj},
+	"xvsetallnez.w":    {bits: 0x769cb800, fmt: insnFormatCdXj},
+	"xvsetallnez.d":    {bits: 0x769cbc00, fmt: insnFormatCdXj},
+	"xvflogb.s":        {bits: 0x769cc400, fmt: insnFormatXdXj},
+	"xvflogb.d":        {bits: 0x769cc800, fmt: insnFormatXdXj},
+	"xvfclass.s":       {bits: 0x769cd400, fmt: insnFormatXdXj},
+	"xvfclass.d":       {bits: 0x769cd800, fmt: insnFormatXdXj},
+	"xvfsqrt.s":        {bits: 0x769ce400, fmt: insnFormatXdXj},
+	"xvfsqrt.d":        {bits: 0x769ce800, fmt: insnFormatXdXj},
+	"xvfrecip.s":       {bits: 0x769cf400, fmt: insnFormatXdXj},
+	"xvfrecip.d":       {bits: 0x769cf800, fmt: insnFormatXdXj},
+	"xvfrsqrt.s":       {bits: 0x769d0400, fmt: insnFormatXdXj},
+	"xvfrsqrt.d":       {bits: 0x769d0800, fmt: insnFormatXdXj},
+	"xvfrint.s":        {bits: 0x769d3400, fmt: insnFormatXdXj},
+	"xvfrint.d":        {bits: 0x769d3800, fmt: insnFormatXdXj},
+	"xvfrintrm.s":      {bits: 0x769d4400, fmt: insnFormatXdXj},
+	"xvfrintrm.d":      {bits: 0x769d4800, fmt: insnFormatXdXj},
+	"xvfrintrp.s":      {bits: 0x769d5400, fmt: insnFormatXdXj},
+	"xvfrintrp.d":      {bits: 0x769d5800, fmt: insnFormatXdXj},
+	"xvfrintrz.s":      {bits: 0x769d6400, fmt: insnFormatXdXj},
+	"xvfrintrz.d":      {bits: 0x769d6800, fmt: insnFormatXdXj},
+	"xvfrintrne.s":     {bits: 0x769d7400, fmt: insnFormatXdXj},
+	"xvfrintrne.d":     {bits: 0x769d7800, fmt: insnFormatXdXj},
+	"xvfcvtl.s.h":      {bits: 0x769de800, fmt: insnFormatXdXj},
+	"xvfcvth.s.h":      {bits: 0x769dec00, fmt: insnFormatXdXj},
+	"xvfcvtl.d.s":      {bits: 0x769df000, fmt: insnFormatXdXj},
+	"xvfcvth.d.s":      {bits: 0x769df400, fmt: insnFormatXdXj},
+	"xvffint.s.w":      {bits: 0x769e0000, fmt: insnFormatXdXj},
+	"xvffint.s.wu":     {bits: 0x769e0400, fmt: insnFormatXdXj},
+	"xvffint.d.l":      {bits: 0x769e0800, fmt: insnFormatXdXj},
+	"xvffint.d.lu":     {bits: 0x769e0c00, fmt: insnFormatXdXj},
+	"xvffintl.d.w":     {bits: 0x769e1000, fmt: insnFormatXdXj},
+	"xvffinth.d.w":     {bits: 0x769e1400, fmt: insnFormatXdXj},
+	"xvftint.w.s":      {bits: 0x769e3000, fmt: insnFormatXdXj},
+	"xvftint.l.d":      {bits: 0x769e3400, fmt: insnFormatXdXj},
+	"xvftintrm.w.s":    {bits: 0x769e3800, fmt: insnFormatXdXj},
+	"xvftintrm.l.d":    {bits: 0x769e3c00, fmt: insnFormatXdXj},
+	"xvftintrp.w.s":    {bits: 0x769e4000, fmt: insnFormatXdXj},
+	"xvftintrp.l.d":    {bits: 0x769e4400, fmt: insnFormatXdXj},
+	"xvftintrz.w.s":    {bits: 0x769e4800, fmt: insnFormatXdXj},
+	"xvftintrz.l.d":    {bits: 0x769e4c00, fmt: insnFormatXdXj},
+	"xvftintrne.w.s":   {bits: 0x769e5000, fmt: insnFormatXdXj},
+	"xvftintrne.l.d":   {bits: 0x769e5400, fmt: insnFormatXdXj},
+	"xvftint.wu.s":     {bits: 0x769e5800, fmt: insnFormatXdXj},
+	"xvftint.lu.d":     {bits: 0x769e5c00, fmt: insnFormatXdXj},
+	"xvftintrz.wu.s":   {bits: 0x769e7000, fmt: insnFormatXdXj},
+	"xvftintrz.lu.d":   {bits: 0x769e7400, fmt: insnFormatXdXj},
+	"xvftintl.l.s":     {bits: 0x769e8000, fmt: insnFormatXdXj},
+	"xvftinth.l.s":     {bits: 0x769e8400, fmt: insnFormatXdXj},
+	"xvftintrml.l.s":   {bits: 0x769e8800, fmt: insnFormatXdXj},
+	"xvftintrmh.l.s":   {bits: 0x769e8c00, fmt: insnFormatXdXj},
+	"xvftintrpl.l.s":   {bits: 0x769e9000, fmt: insnFormatXdXj},
+	"xvftintrph.l.s":   {bits: 0x769e9400, fmt: insnFormatXdXj},
+	"xvftintrzl.l.s":   {bits: 0x769e9800, fmt: insnFormatXdXj},
+	"xvftintrzh.l.s":   {bits: 0x769e9c00, fmt: insnFormatXdXj},
+	"xvftintrnel.l.s":  {bits: 0x769ea000, fmt: insnFormatXdXj},
+	"xvftintrneh.l.s":  {bits: 0x769ea400, fmt: insnFormatXdXj},
+	"xvexth.h.b":       {bits: 0x769ee000, fmt: insnFormatXdXj},
+	"xvexth.w.h":       {bits: 0x769ee400, fmt: insnFormatXdXj},
+	"xvexth.d.w":       {bits: 0x769ee800, fmt: insnFormatXdXj},
+	"xvexth.q.d":       {bits: 0x769eec00, fmt: insnFormatXdXj},
+	"xvexth.hu.bu":     {bits: 0x769ef000, fmt: insnFormatXdXj},
+	"xvexth.wu.hu":     {bits: 0x769ef400, fmt: insnFormatXdXj},
+	"xvexth.du.wu":     {bits: 0x769ef800, fmt: insnFormatXdXj},
+	"xvexth.qu.du":     {bits: 0x769efc00, fmt: insnFormatXdXj},
+	"xvreplgr2vr.b":    {bits: 0x769f0000, fmt: insnFormatXdJ},
+	"xvreplgr2vr.h":    {bits: 0x769f0400, fmt: insnFormatXdJ},
+	"xvreplgr2vr.w":    {bits: 0x769f0800, fmt: insnFormatXdJ},
+	"xvreplgr2vr.d":    {bits: 0x769f0c00, fmt: insnFormatXdJ},
+	"vext2xv.h.b":      {bits: 0x769f1000, fmt: insnFormatXdXj},
+	"vext2xv.w.b":      {bits: 0x769f1400, fmt: insnFormatXdXj},
+	"vext2xv.d.b":      {bits: 0x769f1800, fmt: insnFormatXdXj},
+	"vext2xv.w.h":      {bits: 0x769f1c00, fmt: insnFormatXdXj},
+	"vext2xv.d.h":      {bits: 0x769f2000, fmt: insnFormatXdXj},
+	"vext2xv.d.w":      {bits: 0x769f2400, fmt: insnFormatXdXj},
+	"vext2xv.hu.bu":    {bits: 0x769f2800, fmt: insnFormatXdXj},
+	"vext2xv.wu.bu":    {bits: 0x769f2c00, fmt: insnFormatXdXj},
+	"vext2xv.du.bu":    {bits: 0x769f3000, fmt: insnFormatXdXj},
+	"vext2xv.wu.hu":    {bits: 0x769f3400, fmt: insnFormatXdXj},
+	"vext2xv.du.hu":    {bits: 0x769f3800, fmt: insnFormatXdXj},
+	"vext2xv.du.wu":    {bits: 0x769f3c00, fmt: insnFormatXdXj},
+	"xvrotri.b":        {bits: 0x76a02000, fmt: insnFormatXdXjUk3},
+	"xvrotri.h":        {bits: 0x76a04000, fmt: insnFormatXdXjUk4},
+	"xvrotri.w":        {bits: 0x76a08000, fmt: insnFormatXdXjUk5},
+	"xvrotri.d":        {bits: 0x76a10000, fmt: insnFormatXdXjUk6},
+	"xvsrlri.b":        {bits: 0x76a42000, fmt: insnFormatXdXjUk3},
+	"xvsrlri.h":        {bits: 0x76a44000, fmt: insnFormatXdXjUk4},
+	"xvsrlri.w":        {bits: 0x76a48000, fmt: insnFormatXdXjUk5},
+	"xvsrlri.d":        {bits: 0x76a50000, fmt: insnFormatXdXjUk6},
+	"xvsrari.b":        {bits: 0x76a82000, fmt: insnFormatXdXjUk3},
+	"xvsrari.h":        {bits: 0x76a84000, fmt: insnFormatXdXjUk4},
+	"xvsrari.w":        {bits: 0x76a88000, fmt: insnFormatXdXjUk5},
+	"xvsrari.d":        {bits: 0x76a90000, fmt: insnFormatXdXjUk6},
+	"xvinsgr2vr.w":     {bits: 0x76ebc000, fmt: insnFormatXdJUk3, role: &operandRole{idx: 2, kind: "element index"}},
+	"xvinsgr2vr.d":     {bits: 0x76ebe000, fmt: insnFormatXdJUk2, role: &operandRole{idx: 2, kind: "element index"}},
+	"xvpickve2gr.w":    {bits: 0x76efc000, fmt: insnFormatDXjUk3, role: &operandRole{idx: 2, kind: "element index"}},
+	"xvpickve2gr.d":    {bits: 0x76efe000, fmt: insnFormatDXjUk2, role: &operandRole{idx: 2, kind: "element index"}},
+	"xvpickve2gr.wu":   {bits: 0x76f3c000, fmt: insnFormatDXjUk3, role: &operandRole{idx: 2, kind: "element index"}},
+	"xvpickve2gr.du":   {bits: 0x76f3e000, fmt: insnFormatDXjUk2, role: &operandRole{idx: 2, kind: "element index"}},
+	"xvrepl128vei.b":   {bits: 0x76f78000, fmt: insnFormatXdXjUk4, role: &operandRole{idx: 2, kind: "element index"}},
+	"xvrepl128vei.h":   {bits: 0x76f7c000, fmt: insnFormatXdXjUk3, role: &operandRole{idx: 2, kind: "element index"}},
+	"xvrepl128vei.w":   {bits: 0x76f7e000, fmt: insnFormatXdXjUk2, role: &operandRole{idx: 2, kind: "element index"}},
+	"xvrepl128vei.d":   {bits: 0x76f7f000, fmt: insnFormatXdXjUk1, role: &operandRole{idx: 2, kind: "element index"}},
+	"xvinsve0.w":       {bits: 0x76ffc000, fmt: insnFormatXdXjUk3, role: &operandRole{idx: 2, kind: "element index"}},
+	"xvinsve0.d":       {bits: 0x76ffe000, fmt: insnFormatXdXjUk2, role: &operandRole{idx: 2, kind: "element index"}},
+	"xvpickve.w":       {bits: 0x7703c000, fmt: insnFormatXdXjUk3, role: &operandRole{idx: 2, kind: "element index"}},
+	"xvpickve.d":       {bits: 0x7703e000, fmt: insnFormatXdXjUk2, role: &operandRole{idx: 2, kind: "element index"}},
+	"xvreplve0.b":      {bits: 0x77070000, fmt: insnFormatXdXj},
+	"xvreplve0.h":      {bits: 0x77078000, fmt: insnFormatXdXj},
+	"xvreplve0.w":      {bits: 0x7707c000, fmt: insnFormatXdXj},
+	"xvreplve0.d":      {bits: 0x7707e000, fmt: insnFormatXdXj},
+	"xvreplve0.q":      {bits: 0x7707f000, fmt: insnFormatXdXj},
+	"xvsllwil.h.b":     {bits: 0x77082000, fmt: insnFormatXdXjUk3},
+	"xvsllwil.w.h":     {bits: 0x77084000, fmt: insnFormatXdXjUk4},
+	"xvsllwil.d.w":     {bits: 0x77088000, fmt: insnFormatXdXjUk5},
+	"xvextl.q.d":       {bits: 0x77090000, fmt: insnFormatXdXj},
+	"xvsllwil.hu.bu":   {bits: 0x770c2000, fmt: insnFormatXdXjUk3},
+	"xvsllwil.wu.hu":   {bits: 0x770c4000, fmt: insnFormatXdXjUk4},
+	"xvsllwil.du.wu":   {bits: 0x770c8000, fmt: insnFormatXdXjUk5},
+	"xvextl.qu.du":     {bits: 0x770d0000, fmt: insnFormatXdXj},
+	"xvbitclri.b":      {bits: 0x77102000, fmt: insnFormatXdXjUk3},
+	"xvbitclri.h":      {bits: 0x77104000, fmt: insnFormatXdXjUk4},
+	"xvbitclri.w":      {bits: 0x77108000, fmt: insnFormatXdXjUk5},
+	"xvbitclri.d":      {bits: 0x77110000, fmt: insnFormatXdXjUk6},
+	"xvbitseti.b":      {bits: 0x77142000, fmt: insnFormatXdXjUk3},
+	"xvbitseti.h":      {bits: 0x77144000, fmt: insnFormatXdXjUk4},
+	"xvbitseti.w":      {bits: 0x77148000, fmt: insnFormatXdXjUk5},
+	"xvbitseti.d":      {bits: 0x77150000, fmt: insnFormatXdXjUk6},
+	"xvbitrevi.b":      {bits: 0x77182000, fmt: insnFormatXdXjUk3},
+	"xvbitrevi.h":      {bits: 0x77184000, fmt: insnFormatXdXjUk4},
+	"xvbitrevi.w":      {bits: 0x77188000, fmt: insnFormatXdXjUk5},
+	"xvbitrevi.d":      {bits: 0x77190000, fmt: insnFormatXdXjUk6},
+	"xvsat.b":          {bits: 0x77242000, fmt: insnFormatXdXjUk3},
+	"xvsat.h":          {bits: 0x77244000, fmt: insnFormatXdXjUk4},
+	"xvsat.w":          {bits: 0x77248000, fmt: insnFormatXdXjUk5},
+	"xvsat.d":          {bits: 0x77250000, fmt: insnFormatXdXjUk6},
+	"xvsat.bu":         {bits: 0x77282000, fmt: insnFormatXdXjUk3},
+	"xvsat.hu":         {bits: 0x77284000, fmt: insnFormatXdXjUk4},
+	"xvsat.wu":         {bits: 0x77288000, fmt: insnFormatXdXjUk5},
+	"xvsat.du":         {bits: 0x77290000, fmt: insnFormatXdXjUk6},
+	"xvslli.b":         {bits: 0x772c2000, fmt: insnFormatXdXjUk3},
+	"xvslli.h":         {bits: 0x772c4000, fmt: insnFormatXdXjUk4},
+	"xvslli.w":         {bits: 0x772c8000, fmt: insnFormatXdXjUk5},
+	"xvslli.d":         {bits: 0x772d0000, fmt: insnFormatXdXjUk6},
+	"xvsrli.b":         {bits: 0x77302000, fmt: insnFormatXdXjUk3},
+	"xvsrli.h":         {bits: 0x77304000, fmt: insnFormatXdXjUk4},
+	"xvsrli.w":         {bits: 0x77308000, fmt: insnFormatXdXjUk5},
+	"xvsrli.d":         {bits: 0x77310000, fmt: insnFormatXdXjUk6},
+	"xvsrai.b":         {bits: 0x77342000, fmt: insnFormatXdXjUk3},
+	"xvsrai.h":         {bits: 0x77344000, fmt: insnFormatXdXjUk4},
+	"xvsrai.w":         {bits: 0x77348000, fmt: insnFormatXdXjUk5},
+	"xvsrai.d":         {bits: 0x77350000, fmt: insnFormatXdXjUk6},
+	"xvsrlni.b.h":      {bits: 0x77404000, fmt: insnFormatXdXjUk4},
+	"xvsrlni.h.w":      {bits: 0x77408000, fmt: insnFormatXdXjUk5},
+	"xvsrlni.w.d":      {bits: 0x77410000, fmt: insnFormatXdXjUk6},
+	"xvsrlni.d.q":      {bits: 0x77420000, fmt: insnFormatXdXjUk7},
+	"xvsrlrni.b.h":     {bits: 0x77444000, fmt: insnFormatXdXjUk4},
+	"xvsrlrni.h.w":     {bits: 0x77448000, fmt: insnFormatXdXjUk5},
+	"xvsrlrni.w.d":     {bits: 0x77450000, fmt: insnFormatXdXjUk6},
+	"xvsrlrni.d.q":     {bits: 0x77460000, fmt: insnFormatXdXjUk7},
+	"xvssrlni.b.h":     {bits: 0x77484000, fmt: insnFormatXdXjUk4},
+	"xvssrlni.h.w":     {bits: 0x77488000, fmt: insnFormatXdXjUk5},
+	"xvssrlni.w.d":     {bits: 0x77490000, fmt: insnFormatXdXjUk6},
+	"xvssrlni.d.q":     {bits: 0x774a0000, fmt: insnFormatXdXjUk7},
+	"xvssrlni.bu.h":    {bits: 0x774c4000, fmt: insnFormatXdXjUk4},
+	"xvssrlni.hu.w":    {bits: 0x774c8000, fmt: insnFormatXdXjUk5},
+	"xvssrlni.wu.d":    {bits: 0x774d0000, fmt: insnFormatXdXjUk6},
+	"xvssrlni.du.q":    {bits: 0x774e0000, fmt: insnFormatXdXjUk7},
+	"xvssrlrni.b.h":    {bits: 0x77504000, fmt: insnFormatXdXjUk4},
+	"xvssrlrni.h.w":    {bits: 0x77508000, fmt: insnFormatXdXjUk5},
+	"xvssrlrni.w.d":    {bits: 0x77510000, fmt: insnFormatXdXjUk6},
+	"xvssrlrni.d.q":    {bits: 0x77520000, fmt: insnFormatXdXjUk7},
+	"xvssrlrni.bu.h":   {bits: 0x77544000, fmt: insnFormatXdXjUk4},
+	"xvssrlrni.hu.w":   {bits: 0x77548000, fmt: insnFormatXdXjUk5},
+	"xvssrlrni.wu.d":   {bits: 0x77550000, fmt: insnFormatXdXjUk6},
+	"xvssrlrni.du.q":   {bits: 0x77560000, fmt: insnFormatXdXjUk7},
+	"xvsrani.b.h":      {bits: 0x77584000, fmt: insnFormatXdXjUk4},
+	"xvsrani.h.w":      {bits: 0x77588000, fmt: insnFormatXdXjUk5},
+	"xvsrani.w.d":      {bits: 0x77590000, fmt: insnFormatXdXjUk6},
+	"xvsrani.d.q":      {bits: 0x775a0000, fmt: insnFormatXdXjUk7},
+	"xvsrarni.b.h":     {bits: 0x775c4000, fmt: insnFormatXdXjUk4},
+	"xvsrarni.h.w":     {bits: 0x775c8000, fmt: insnFormatXdXjUk5},
+	"xvsrarni.w.d":     {bits: 0x775d0000, fmt: insnFormatXdXjUk6},
+	"xvsrarni.d.q":     {bits: 0x775e0000, fmt: insnFormatXdXjUk7},
+	"xvssrani.b.h":     {bits: 0x77604000, fmt: insnFormatXdXjUk4},
+	"xvssrani.h.w":     {bits: 0x77608000, fmt: insnFormatXdXjUk5},
+	"xvssrani.w.d":     {bits: 0x77610000, fmt: insnFormatXdXjUk6},
+	"xvssrani.d.q":     {bits: 0x77620000, fmt: insnFormatXdXjUk7},
+	"xvssrani.bu.h":    {bits: 0x77644000, fmt: insnFormatXdXjUk4},
+	"xvssrani.hu.w":    {bits: 0x77648000, fmt: insnFormatXdXjUk5},
+	"xvssrani.wu.d":    {bits: 0x77650000, fmt: insnFormatXdXjUk6},
+	"xvssrani.du.q":    {bits: 0x77660000, fmt: insnFormatXdXjUk7},
+	"xvssrarni.b.h":    {bits: 0x77684000, fmt: insnFormatXdXjUk4},
+	"xvssrarni.h.w":    {bits: 0x77688000, fmt: insnFormatXdXjUk5},
+	"xvssrarni.w.d":    {bits: 0x77690000, fmt: insnFormatXdXjUk6},
+	"xvssrarni.d.q":    {bits: 0x776a0000, fmt: insnFormatXdXjUk7},
+	"xvssrarni.bu.h":   {bits: 0x776c4000, fmt: insnFormatXdXjUk4},
+	"xvssrarni.hu.w":   {bits: 0x776c8000, fmt: insnFormatXdXjUk5},
+	"xvssrarni.wu.d":   {bits: 0x776d0000, fmt: insnFormatXdXjUk6},
+	"xvssrarni.du.q":   {bits: 0x776e0000, fmt: insnFormatXdXjUk7},
+	"xvextrins.d":      {bits: 0x77800000, fmt: insnFormatXdXjUk8},
+	"xvextrins.w":      {bits: 0x77840000, fmt: insnFormatXdXjUk8},
+	"xvextrins.h":      {bits: 0x77880000, fmt: insnFormatXdXjUk8},
+	"xvextrins.b":      {bits: 0x778c0000, fmt: insnFormatXdXjUk8},
+	"xvshuf4i.b":       {bits: 0x77900000, fmt: insnFormatXdXjUk8},
+	"xvshuf4i.h":       {bits: 0x77940000, fmt: insnFormatXdXjUk8},
+	"xvshuf4i.w":       {bits: 0x77980000, fmt: insnFormatXdXjUk8},
+	"xvshuf4i.d":       {bits: 0x779c0000, fmt: insnFormatXdXjUk8},
+	"xvbitseli.b":      {bits: 0x77c40000, fmt: insnFormatXdXjUk8},
+	"xvandi.b":         {bits: 0x77d00000, fmt: insnFormatXdXjUk8},
+	"xvori.b":          {bits: 0x77d40000, fmt: insnFormatXdXjUk8},
+	"xvxori.b":         {bits: 0x77d80000, fmt: insnFormatXdXjUk8},
+	"xvnori.b":         {bits: 0x77dc0000, fmt: insnFormatXdXjUk8},
+	"xvldi":            {bits: 0x77e00000, fmt: insnFormatXdSj13},
+	"xvpermi.w":        {bits: 0x77e40000, fmt: insnFormatXdXjUk8},
+	"xvpermi.d":        {bits: 0x77e80000, fmt: insnFormatXdXjUk8},
+	"xvpermi.q":        {bits: 0x77ec0000, fmt: insnFormatXdXjUk8},
+}
+
+type relocOperand struct {
+	idx   int
+	width uint
+}
+
+// relocOperands maps mnemonics to their relocatable operand.
+var relocOperands = map[string]relocOperand{
+	"addi.w":    {idx: 2, width: 12},
+	"addi.d":    {idx: 2, width: 12},
+	"cu52i.d":   {idx: 2, width: 12},
+	"ori":       {idx: 2, width: 12},
+	"lu12i.w":   {idx: 1, width: 20},
+	"cu32i.d":   {idx: 1, width: 20},
+	"pcaddu2i":  {idx: 1, width: 20},
+	"pcalau12i": {idx: 1, width: 20},
+	"pcaddu12i": {idx: 1, width: 20},
+	"pcaddu18i": {idx: 1, width: 20},
+	"ld.b":      {idx: 2, width: 12},
+	"ld.h":      {idx: 2, width: 12},
+	"ld.w":      {idx: 2, width: 12},
+	"ld.d":      {idx: 2, width: 12},
+	"st.b":      {idx: 2, width: 12},
+	"st.h":      {idx: 2, width: 12},
+	"st.w":      {idx: 2, width: 12},
+	"st.d":      {idx: 2, width: 12},
+	"ld.bu":     {idx: 2, width: 12},
+	"ld.hu":     {idx: 2, width: 12},
+	"ld.wu":     {idx: 2, width: 12},
+	"fld.s":     {idx: 2, width: 12},
+	"fst.s":     {idx: 2, width: 12},
+	"fld.d":     {idx: 2, width: 12},
+	"fst.d":     {idx: 2, width: 12},
+	"beqz":      {idx: 1, width: 21},
+	"bnez":      {idx: 1, width: 21},
+	"bceqz":     {idx: 1, width: 21},
+	"bcnez":     {idx: 1, width: 21},
+	"jirl":      {idx: 2, width: 16},
+	"b":         {idx: 0, width: 26},
+	"bl":        {idx: 0, width: 26},
+	"beq":       {idx: 2, width: 16},
+	"bne":       {idx: 2, width: 16},
+	"bgt":       {idx: 2, width: 16},
+	"ble":       {idx: 2, width: 16},
+	"bgtu":      {idx: 2, width: 16},
+	"bleu":      {idx: 2, width: 16},
+}
+
+func wantIntReg(idx int, name string, v int64) error {
+	return wantInRange(idx, name, "integer register", v, 0, 31)
+}
+
+func wantFPReg(idx int, name string, v int64) error {
+	return wantInRange(idx, name, "FP register", v, 0, 31)
+}
+
+func wantFCCReg(idx int, name string, v int64) error {
+	return wantInRange(idx, name, "FCC register", v, 0, 7)
+}
+
+func wantScratchReg(idx int, name string, v int64) error {
+	return wantInRange(idx, name, "scratch register", v, 0, 3)
+}
+
+func wantVReg(idx int, name string, v int64) error {
+	return wantInRange(idx, name, "LSX register", v, 0, 31)
+}
+
+func wantXReg(idx int, name string, v int64) error {
+	return wantInRange(idx, name, "LASX register", v, 0, 31)
+}
+
+// GPReg is an integer register, only constructible with a valid number.
+type GPReg struct {
+	n uint8
+}
+
+// NewGPReg returns the integer register numbered n.
+func NewGPReg(n uint) (GPReg, error) {
+	if n > 31 {
+		return GPReg{}, &RegisterError{Kind: "integer register", Num: n, Max: 31}
+	}
+	return GPReg{n: uint8(n)}, nil
+}
+
+// Num returns the number of the register.
+func (r GPReg) Num() uint {
+	return uint(r.n)
+}
+
+// FPReg is an FP register, only constructible with a valid number.
+type FPReg struct {
+	n uint8
+}
+
+// NewFPReg returns the FP register numbered n.
+func NewFPReg(n uint) (FPReg, error) {
+	if n > 31 {
+		return FPReg{}, &RegisterError{Kind: "FP register", Num: n, Max: 31}
+	}
+	return FPReg{n: uint8(n)}, nil
+}
+
+// Num returns the number of the register.
+func (r FPReg) Num() uint {
+	return uint(r.n)
+}
+
+// FCCReg is an FCC register, only constructible with a valid number.
+type FCCReg struct {
+	n uint8
+}
+
+// NewFCCReg returns the FCC register numbered n.
+func NewFCCReg(n uint) (FCCReg, error) {
+	if n > 7 {
+		return FCCReg{}, &RegisterError{Kind: "FCC register", Num: n, Max: 7}
+	}
+	return FCCReg{n: uint8(n)}, nil
+}
+
+// Num returns the number of the register.
+func (r FCCReg) Num() uint {
+	return uint(r.n)
+}
+
+// ScratchReg is a scratch register, only constructible with a valid number.
+type ScratchReg struct {
+	n uint8
+}
+
+// NewScratchReg returns the scratch register numbered n.
+func NewScratchReg(n uint) (ScratchReg, error) {
+	if n > 3 {
+		return ScratchReg{}, &RegisterError{Kind: "scratch register", Num: n, Max: 3}
+	}
+	return ScratchReg{n: uint8(n)}, nil
+}
+
+// Num returns the number of the register.
+func (r ScratchReg) Num() uint {
+	return uint(r.n)
+}
+
+// VReg is an LSX register, only constructible with a valid number.
+type VReg struct {
+	n uint8
+}
+
+// NewVReg returns the LSX register numbered n.
+func NewVReg(n uint) (VReg, error) {
+	if n > 31 {
+		return VReg{}, &RegisterError{Kind: "LSX register", Num: n, Max: 31}
+	}
+	return VReg{n: uint8(n)}, nil
+}
+
+// Num returns the number of the register.
+func (r VReg) Num() uint {
+	return uint(r.n)
+}
+
+// XReg is an LASX register, only constructible with a valid number.
+type XReg struct {
+	n uint8
+}
+
+// NewXReg returns the LASX register numbered n.
+func NewXReg(n uint) (XReg, error) {
+	if n > 31 {
+		return XReg{}, &RegisterError{Kind: "LASX register", Num: n, Max: 31}
+	}
+	return XReg{n: uint8(n)}, nil
+}
+
+// Num returns the number of the register.
+func (r XReg) Num() uint {
+	return uint(r.n)
+}
+
+// EncodeCdFj encodes the instruction of format CdFj with the given mnemonic.
+func EncodeCdFj(mnemonic string, cd FCCReg, fj FPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatCdFj {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "CdFj"}
+	}
+
+	result, err := encodeCdFj(insn.bits, int64(cd.Num()), int64(fj.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeCdFjFk encodes the instruction of format CdFjFk with the given mnemonic.
+func EncodeCdFjFk(mnemonic string, cd FCCReg, fj FPReg, fk FPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatCdFjFk {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "CdFjFk"}
+	}
+
+	result, err := encodeCdFjFk(insn.bits, int64(cd.Num()), int64(fj.Num()), int64(fk.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeCdJ encodes the instruction of format CdJ with the given mnemonic.
+func EncodeCdJ(mnemonic string, cd FCCReg, j GPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatCdJ {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "CdJ"}
+	}
+
+	result, err := encodeCdJ(insn.bits, int64(cd.Num()), int64(j.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeCdVj encodes the instruction of format CdVj with the given mnemonic.
+func EncodeCdVj(mnemonic string, cd FCCReg, vj VReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatCdVj {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "CdVj"}
+	}
+
+	result, err := encodeCdVj(insn.bits, int64(cd.Num()), int64(vj.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeCdXj encodes the instruction of format CdXj with the given mnemonic.
+func EncodeCdXj(mnemonic string, cd FCCReg, xj XReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatCdXj {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "CdXj"}
+	}
+
+	result, err := encodeCdXj(insn.bits, int64(cd.Num()), int64(xj.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeCjSd5k16 encodes the instruction of format CjSd5k16 with the given mnemonic.
+func EncodeCjSd5k16(mnemonic string, cj FCCReg, sd5k16 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatCjSd5k16 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "CjSd5k16"}
+	}
+
+	result, err := encodeCjSd5k16(insn.bits, int64(cj.Num()), sd5k16)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeD encodes the instruction of format D with the given mnemonic.
+func EncodeD(mnemonic string, d GPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatD {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "D"}
+	}
+
+	result, err := encodeD(insn.bits, int64(d.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDCj encodes the instruction of format DCj with the given mnemonic.
+func EncodeDCj(mnemonic string, d GPReg, cj FCCReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDCj {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DCj"}
+	}
+
+	result, err := encodeDCj(insn.bits, int64(d.Num()), int64(cj.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDFj encodes the instruction of format DFj with the given mnemonic.
+func EncodeDFj(mnemonic string, d GPReg, fj FPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDFj {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DFj"}
+	}
+
+	result, err := encodeDFj(insn.bits, int64(d.Num()), int64(fj.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJ encodes the instruction of format DJ with the given mnemonic.
+func EncodeDJ(mnemonic string, d GPReg, j GPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJ {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJ"}
+	}
+
+	result, err := encodeDJ(insn.bits, int64(d.Num()), int64(j.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJK encodes the instruction of format DJK with the given mnemonic.
+func EncodeDJK(mnemonic string, d GPReg, j GPReg, k GPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJK {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJK"}
+	}
+
+	result, err := encodeDJK(insn.bits, int64(d.Num()), int64(j.Num()), int64(k.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJKUa2 encodes the instruction of format DJKUa2 with the given mnemonic.
+func EncodeDJKUa2(mnemonic string, d GPReg, j GPReg, k GPReg, ua2 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJKUa2 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJKUa2"}
+	}
+
+	result, err := encodeDJKUa2(insn.bits, int64(d.Num()), int64(j.Num()), int64(k.Num()), ua2)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJKUa3 encodes the instruction of format DJKUa3 with the given mnemonic.
+func EncodeDJKUa3(mnemonic string, d GPReg, j GPReg, k GPReg, ua3 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJKUa3 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJKUa3"}
+	}
+
+	result, err := encodeDJKUa3(insn.bits, int64(d.Num()), int64(j.Num()), int64(k.Num()), ua3)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJSk12 encodes the instruction of format DJSk12 with the given mnemonic.
+func EncodeDJSk12(mnemonic string, d GPReg, j GPReg, sk12 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJSk12 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJSk12"}
+	}
+
+	result, err := encodeDJSk12(insn.bits, int64(d.Num()), int64(j.Num()), sk12)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJSk14 encodes the instruction of format DJSk14 with the given mnemonic.
+func EncodeDJSk14(mnemonic string, d GPReg, j GPReg, sk14 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJSk14 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJSk14"}
+	}
+
+	result, err := encodeDJSk14(insn.bits, int64(d.Num()), int64(j.Num()), sk14)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJSk16 encodes the instruction of format DJSk16 with the given mnemonic.
+func EncodeDJSk16(mnemonic string, d GPReg, j GPReg, sk16 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJSk16 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJSk16"}
+	}
+
+	result, err := encodeDJSk16(insn.bits, int64(d.Num()), int64(j.Num()), sk16)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJSk5 encodes the instruction of format DJSk5 with the given mnemonic.
+func EncodeDJSk5(mnemonic string, d GPReg, j GPReg, sk5 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJSk5 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJSk5"}
+	}
+
+	result, err := encodeDJSk5(insn.bits, int64(d.Num()), int64(j.Num()), sk5)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJUk12 encodes the instruction of format DJUk12 with the given mnemonic.
+func EncodeDJUk12(mnemonic string, d GPReg, j GPReg, uk12 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJUk12 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJUk12"}
+	}
+
+	result, err := encodeDJUk12(insn.bits, int64(d.Num()), int64(j.Num()), uk12)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJUk14 encodes the instruction of format DJUk14 with the given mnemonic.
+func EncodeDJUk14(mnemonic string, d GPReg, j GPReg, uk14 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJUk14 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJUk14"}
+	}
+
+	result, err := encodeDJUk14(insn.bits, int64(d.Num()), int64(j.Num()), uk14)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJUk3 encodes the instruction of format DJUk3 with the given mnemonic.
+func EncodeDJUk3(mnemonic string, d GPReg, j GPReg, uk3 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJUk3 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJUk3"}
+	}
+
+	result, err := encodeDJUk3(insn.bits, int64(d.Num()), int64(j.Num()), uk3)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJUk4 encodes the instruction of format DJUk4 with the given mnemonic.
+func EncodeDJUk4(mnemonic string, d GPReg, j GPReg, uk4 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJUk4 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJUk4"}
+	}
+
+	result, err := encodeDJUk4(insn.bits, int64(d.Num()), int64(j.Num()), uk4)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJUk5 encodes the instruction of format DJUk5 with the given mnemonic.
+func EncodeDJUk5(mnemonic string, d GPReg, j GPReg, uk5 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJUk5 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJUk5"}
+	}
+
+	result, err := encodeDJUk5(insn.bits, int64(d.Num()), int64(j.Num()), uk5)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJUk5Um5 encodes the instruction of format DJUk5Um5 with the given mnemonic.
+func EncodeDJUk5Um5(mnemonic string, d GPReg, j GPReg, uk5 int64, um5 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJUk5Um5 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJUk5Um5"}
+	}
+
+	result, err := encodeDJUk5Um5(insn.bits, int64(d.Num()), int64(j.Num()), uk5, um5)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJUk6 encodes the instruction of format DJUk6 with the given mnemonic.
+func EncodeDJUk6(mnemonic string, d GPReg, j GPReg, uk6 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJUk6 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJUk6"}
+	}
+
+	result, err := encodeDJUk6(insn.bits, int64(d.Num()), int64(j.Num()), uk6)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJUk6Um6 encodes the instruction of format DJUk6Um6 with the given mnemonic.
+func EncodeDJUk6Um6(mnemonic string, d GPReg, j GPReg, uk6 int64, um6 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJUk6Um6 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJUk6Um6"}
+	}
+
+	result, err := encodeDJUk6Um6(insn.bits, int64(d.Num()), int64(j.Num()), uk6, um6)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDJUk8 encodes the instruction of format DJUk8 with the given mnemonic.
+func EncodeDJUk8(mnemonic string, d GPReg, j GPReg, uk8 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDJUk8 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DJUk8"}
+	}
+
+	result, err := encodeDJUk8(insn.bits, int64(d.Num()), int64(j.Num()), uk8)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDSj20 encodes the instruction of format DSj20 with the given mnemonic.
+func EncodeDSj20(mnemonic string, d GPReg, sj20 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDSj20 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DSj20"}
+	}
+
+	result, err := encodeDSj20(insn.bits, int64(d.Num()), sj20)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDTj encodes the instruction of format DTj with the given mnemonic.
+func EncodeDTj(mnemonic string, d GPReg, tj ScratchReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDTj {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DTj"}
+	}
+
+	result, err := encodeDTj(insn.bits, int64(d.Num()), int64(tj.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDUj5 encodes the instruction of format DUj5 with the given mnemonic.
+func EncodeDUj5(mnemonic string, d GPReg, uj5 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDUj5 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DUj5"}
+	}
+
+	result, err := encodeDUj5(insn.bits, int64(d.Num()), uj5)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDUj5Uk8 encodes the instruction of format DUj5Uk8 with the given mnemonic.
+func EncodeDUj5Uk8(mnemonic string, d GPReg, uj5 int64, uk8 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDUj5Uk8 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DUj5Uk8"}
+	}
+
+	result, err := encodeDUj5Uk8(insn.bits, int64(d.Num()), uj5, uk8)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDUk4 encodes the instruction of format DUk4 with the given mnemonic.
+func EncodeDUk4(mnemonic string, d GPReg, uk4 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDUk4 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DUk4"}
+	}
+
+	result, err := encodeDUk4(insn.bits, int64(d.Num()), uk4)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDUk8 encodes the instruction of format DUk8 with the given mnemonic.
+func EncodeDUk8(mnemonic string, d GPReg, uk8 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDUk8 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DUk8"}
+	}
+
+	result, err := encodeDUk8(insn.bits, int64(d.Num()), uk8)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDVjUk1 encodes the instruction of format DVjUk1 with the given mnemonic.
+func EncodeDVjUk1(mnemonic string, d GPReg, vj VReg, uk1 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDVjUk1 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DVjUk1"}
+	}
+
+	result, err := encodeDVjUk1(insn.bits, int64(d.Num()), int64(vj.Num()), uk1)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDVjUk2 encodes the instruction of format DVjUk2 with the given mnemonic.
+func EncodeDVjUk2(mnemonic string, d GPReg, vj VReg, uk2 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDVjUk2 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DVjUk2"}
+	}
+
+	result, err := encodeDVjUk2(insn.bits, int64(d.Num()), int64(vj.Num()), uk2)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDVjUk3 encodes the instruction of format DVjUk3 with the given mnemonic.
+func EncodeDVjUk3(mnemonic string, d GPReg, vj VReg, uk3 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDVjUk3 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DVjUk3"}
+	}
+
+	result, err := encodeDVjUk3(insn.bits, int64(d.Num()), int64(vj.Num()), uk3)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDVjUk4 encodes the instruction of format DVjUk4 with the given mnemonic.
+func EncodeDVjUk4(mnemonic string, d GPReg, vj VReg, uk4 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDVjUk4 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DVjUk4"}
+	}
+
+	result, err := encodeDVjUk4(insn.bits, int64(d.Num()), int64(vj.Num()), uk4)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDXjUk2 encodes the instruction of format DXjUk2 with the given mnemonic.
+func EncodeDXjUk2(mnemonic string, d GPReg, xj XReg, uk2 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDXjUk2 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DXjUk2"}
+	}
+
+	result, err := encodeDXjUk2(insn.bits, int64(d.Num()), int64(xj.Num()), uk2)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeDXjUk3 encodes the instruction of format DXjUk3 with the given mnemonic.
+func EncodeDXjUk3(mnemonic string, d GPReg, xj XReg, uk3 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatDXjUk3 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "DXjUk3"}
+	}
+
+	result, err := encodeDXjUk3(insn.bits, int64(d.Num()), int64(xj.Num()), uk3)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeEMPTY encodes the instruction of format EMPTY with the given mnemonic.
+func EncodeEMPTY(mnemonic string) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatEMPTY {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "EMPTY"}
+	}
+
+	result, err := encodeEMPTY(insn.bits)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeFdCj encodes the instruction of format FdCj with the given mnemonic.
+func EncodeFdCj(mnemonic string, fd FPReg, cj FCCReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatFdCj {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "FdCj"}
+	}
+
+	result, err := encodeFdCj(insn.bits, int64(fd.Num()), int64(cj.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeFdFj encodes the instruction of format FdFj with the given mnemonic.
+func EncodeFdFj(mnemonic string, fd FPReg, fj FPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatFdFj {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "FdFj"}
+	}
+
+	result, err := encodeFdFj(insn.bits, int64(fd.Num()), int64(fj.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeFdFjFk encodes the instruction of format FdFjFk with the given mnemonic.
+func EncodeFdFjFk(mnemonic string, fd FPReg, fj FPReg, fk FPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatFdFjFk {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "FdFjFk"}
+	}
+
+	result, err := encodeFdFjFk(insn.bits, int64(fd.Num()), int64(fj.Num()), int64(fk.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeFdFjFkCa encodes the instruction of format FdFjFkCa with the given mnemonic.
+func EncodeFdFjFkCa(mnemonic string, fd FPReg, fj FPReg, fk FPReg, ca FCCReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatFdFjFkCa {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "FdFjFkCa"}
+	}
+
+	result, err := encodeFdFjFkCa(insn.bits, int64(fd.Num()), int64(fj.Num()), int64(fk.Num()), int64(ca.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeFdFjFkFa encodes the instruction of format FdFjFkFa with the given mnemonic.
+func EncodeFdFjFkFa(mnemonic string, fd FPReg, fj FPReg, fk FPReg, fa FPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatFdFjFkFa {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "FdFjFkFa"}
+	}
+
+	result, err := encodeFdFjFkFa(insn.bits, int64(fd.Num()), int64(fj.Num()), int64(fk.Num()), int64(fa.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeFdJ encodes the instruction of format FdJ with the given mnemonic.
+func EncodeFdJ(mnemonic string, fd FPReg, j GPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatFdJ {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "FdJ"}
+	}
+
+	result, err := encodeFdJ(insn.bits, int64(fd.Num()), int64(j.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeFdJK encodes the instruction of format FdJK with the given mnemonic.
+func EncodeFdJK(mnemonic string, fd FPReg, j GPReg, k GPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatFdJK {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "FdJK"}
+	}
+
+	result, err := encodeFdJK(insn.bits, int64(fd.Num()), int64(j.Num()), int64(k.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeFdJSk12 encodes the instruction of format FdJSk12 with the given mnemonic.
+func EncodeFdJSk12(mnemonic string, fd FPReg, j GPReg, sk12 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatFdJSk12 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "FdJSk12"}
+	}
+
+	result, err := encodeFdJSk12(insn.bits, int64(fd.Num()), int64(j.Num()), sk12)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeJ encodes the instruction of format J with the given mnemonic.
+func EncodeJ(mnemonic string, j GPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatJ {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "J"}
+	}
+
+	result, err := encodeJ(insn.bits, int64(j.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeJK encodes the instruction of format JK with the given mnemonic.
+func EncodeJK(mnemonic string, j GPReg, k GPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatJK {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "JK"}
+	}
+
+	result, err := encodeJK(insn.bits, int64(j.Num()), int64(k.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeJKUd4 encodes the instruction of format JKUd4 with the given mnemonic.
+func EncodeJKUd4(mnemonic string, j GPReg, k GPReg, ud4 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatJKUd4 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "JKUd4"}
+	}
+
+	result, err := encodeJKUd4(insn.bits, int64(j.Num()), int64(k.Num()), ud4)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeJKUd5 encodes the instruction of format JKUd5 with the given mnemonic.
+func EncodeJKUd5(mnemonic string, j GPReg, k GPReg, ud5 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatJKUd5 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "JKUd5"}
+	}
+
+	result, err := encodeJKUd5(insn.bits, int64(j.Num()), int64(k.Num()), ud5)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeJSd5k16 encodes the instruction of format JSd5k16 with the given mnemonic.
+func EncodeJSd5k16(mnemonic string, j GPReg, sd5k16 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatJSd5k16 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "JSd5k16"}
+	}
+
+	result, err := encodeJSd5k16(insn.bits, int64(j.Num()), sd5k16)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeJUd4Uk5 encodes the instruction of format JUd4Uk5 with the given mnemonic.
+func EncodeJUd4Uk5(mnemonic string, j GPReg, ud4 int64, uk5 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatJUd4Uk5 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "JUd4Uk5"}
+	}
+
+	result, err := encodeJUd4Uk5(insn.bits, int64(j.Num()), ud4, uk5)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeJUd5 encodes the instruction of format JUd5 with the given mnemonic.
+func EncodeJUd5(mnemonic string, j GPReg, ud5 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatJUd5 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "JUd5"}
+	}
+
+	result, err := encodeJUd5(insn.bits, int64(j.Num()), ud5)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeJUd5Sk12 encodes the instruction of format JUd5Sk12 with the given mnemonic.
+func EncodeJUd5Sk12(mnemonic string, j GPReg, ud5 int64, sk12 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatJUd5Sk12 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "JUd5Sk12"}
+	}
+
+	result, err := encodeJUd5Sk12(insn.bits, int64(j.Num()), ud5, sk12)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeJUk3 encodes the instruction of format JUk3 with the given mnemonic.
+func EncodeJUk3(mnemonic string, j GPReg, uk3 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatJUk3 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "JUk3"}
+	}
+
+	result, err := encodeJUk3(insn.bits, int64(j.Num()), uk3)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeJUk4 encodes the instruction of format JUk4 with the given mnemonic.
+func EncodeJUk4(mnemonic string, j GPReg, uk4 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatJUk4 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "JUk4"}
+	}
+
+	result, err := encodeJUk4(insn.bits, int64(j.Num()), uk4)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeJUk5 encodes the instruction of format JUk5 with the given mnemonic.
+func EncodeJUk5(mnemonic string, j GPReg, uk5 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatJUk5 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "JUk5"}
+	}
+
+	result, err := encodeJUk5(insn.bits, int64(j.Num()), uk5)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeJUk6 encodes the instruction of format JUk6 with the given mnemonic.
+func EncodeJUk6(mnemonic string, j GPReg, uk6 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatJUk6 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "JUk6"}
+	}
+
+	result, err := encodeJUk6(insn.bits, int64(j.Num()), uk6)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeJUk8 encodes the instruction of format JUk8 with the given mnemonic.
+func EncodeJUk8(mnemonic string, j GPReg, uk8 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatJUk8 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "JUk8"}
+	}
+
+	result, err := encodeJUk8(insn.bits, int64(j.Num()), uk8)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeSd10k16 encodes the instruction of format Sd10k16 with the given mnemonic.
+func EncodeSd10k16(mnemonic string, sd10k16 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatSd10k16 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "Sd10k16"}
+	}
+
+	result, err := encodeSd10k16(insn.bits, sd10k16)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeSd5k16 encodes the instruction of format Sd5k16 with the given mnemonic.
+func EncodeSd5k16(mnemonic string, sd5k16 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatSd5k16 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "Sd5k16"}
+	}
+
+	result, err := encodeSd5k16(insn.bits, sd5k16)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeTdJ encodes the instruction of format TdJ with the given mnemonic.
+func EncodeTdJ(mnemonic string, td ScratchReg, j GPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatTdJ {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "TdJ"}
+	}
+
+	result, err := encodeTdJ(insn.bits, int64(td.Num()), int64(j.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeUd15 encodes the instruction of format Ud15 with the given mnemonic.
+func EncodeUd15(mnemonic string, ud15 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatUd15 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "Ud15"}
+	}
+
+	result, err := encodeUd15(insn.bits, ud15)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeUj3 encodes the instruction of format Uj3 with the given mnemonic.
+func EncodeUj3(mnemonic string, uj3 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatUj3 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "Uj3"}
+	}
+
+	result, err := encodeUj3(insn.bits, uj3)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdJ encodes the instruction of format VdJ with the given mnemonic.
+func EncodeVdJ(mnemonic string, vd VReg, j GPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdJ {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdJ"}
+	}
+
+	result, err := encodeVdJ(insn.bits, int64(vd.Num()), int64(j.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdJK encodes the instruction of format VdJK with the given mnemonic.
+func EncodeVdJK(mnemonic string, vd VReg, j GPReg, k GPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdJK {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdJK"}
+	}
+
+	result, err := encodeVdJK(insn.bits, int64(vd.Num()), int64(j.Num()), int64(k.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdJSk10 encodes the instruction of format VdJSk10 with the given mnemonic.
+func EncodeVdJSk10(mnemonic string, vd VReg, j GPReg, sk10 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdJSk10 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdJSk10"}
+	}
+
+	result, err := encodeVdJSk10(insn.bits, int64(vd.Num()), int64(j.Num()), sk10)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdJSk11 encodes the instruction of format VdJSk11 with the given mnemonic.
+func EncodeVdJSk11(mnemonic string, vd VReg, j GPReg, sk11 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdJSk11 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdJSk11"}
+	}
+
+	result, err := encodeVdJSk11(insn.bits, int64(vd.Num()), int64(j.Num()), sk11)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdJSk12 encodes the instruction of format VdJSk12 with the given mnemonic.
+func EncodeVdJSk12(mnemonic string, vd VReg, j GPReg, sk12 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdJSk12 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdJSk12"}
+	}
+
+	result, err := encodeVdJSk12(insn.bits, int64(vd.Num()), int64(j.Num()), sk12)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdJSk8Un1 encodes the instruction of format VdJSk8Un1 with the given mnemonic.
+func EncodeVdJSk8Un1(mnemonic string, vd VReg, j GPReg, sk8 int64, un1 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdJSk8Un1 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdJSk8Un1"}
+	}
+
+	result, err := encodeVdJSk8Un1(insn.bits, int64(vd.Num()), int64(j.Num()), sk8, un1)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdJSk8Un2 encodes the instruction of format VdJSk8Un2 with the given mnemonic.
+func EncodeVdJSk8Un2(mnemonic string, vd VReg, j GPReg, sk8 int64, un2 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdJSk8Un2 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdJSk8Un2"}
+	}
+
+	result, err := encodeVdJSk8Un2(insn.bits, int64(vd.Num()), int64(j.Num()), sk8, un2)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdJSk8Un3 encodes the instruction of format VdJSk8Un3 with the given mnemonic.
+func EncodeVdJSk8Un3(mnemonic string, vd VReg, j GPReg, sk8 int64, un3 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdJSk8Un3 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdJSk8Un3"}
+	}
+
+	result, err := encodeVdJSk8Un3(insn.bits, int64(vd.Num()), int64(j.Num()), sk8, un3)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdJSk8Un4 encodes the instruction of format VdJSk8Un4 with the given mnemonic.
+func EncodeVdJSk8Un4(mnemonic string, vd VReg, j GPReg, sk8 int64, un4 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdJSk8Un4 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdJSk8Un4"}
+	}
+
+	result, err := encodeVdJSk8Un4(insn.bits, int64(vd.Num()), int64(j.Num()), sk8, un4)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdJSk9 encodes the instruction of format VdJSk9 with the given mnemonic.
+func EncodeVdJSk9(mnemonic string, vd VReg, j GPReg, sk9 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdJSk9 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdJSk9"}
+	}
+
+	result, err := encodeVdJSk9(insn.bits, int64(vd.Num()), int64(j.Num()), sk9)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdJUk1 encodes the instruction of format VdJUk1 with the given mnemonic.
+func EncodeVdJUk1(mnemonic string, vd VReg, j GPReg, uk1 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdJUk1 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdJUk1"}
+	}
+
+	result, err := encodeVdJUk1(insn.bits, int64(vd.Num()), int64(j.Num()), uk1)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdJUk2 encodes the instruction of format VdJUk2 with the given mnemonic.
+func EncodeVdJUk2(mnemonic string, vd VReg, j GPReg, uk2 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdJUk2 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdJUk2"}
+	}
+
+	result, err := encodeVdJUk2(insn.bits, int64(vd.Num()), int64(j.Num()), uk2)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdJUk3 encodes the instruction of format VdJUk3 with the given mnemonic.
+func EncodeVdJUk3(mnemonic string, vd VReg, j GPReg, uk3 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdJUk3 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdJUk3"}
+	}
+
+	result, err := encodeVdJUk3(insn.bits, int64(vd.Num()), int64(j.Num()), uk3)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdJUk4 encodes the instruction of format VdJUk4 with the given mnemonic.
+func EncodeVdJUk4(mnemonic string, vd VReg, j GPReg, uk4 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdJUk4 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdJUk4"}
+	}
+
+	result, err := encodeVdJUk4(insn.bits, int64(vd.Num()), int64(j.Num()), uk4)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdSj13 encodes the instruction of format VdSj13 with the given mnemonic.
+func EncodeVdSj13(mnemonic string, vd VReg, sj13 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdSj13 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdSj13"}
+	}
+
+	result, err := encodeVdSj13(insn.bits, int64(vd.Num()), sj13)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdVj encodes the instruction of format VdVj with the given mnemonic.
+func EncodeVdVj(mnemonic string, vd VReg, vj VReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdVj {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdVj"}
+	}
+
+	result, err := encodeVdVj(insn.bits, int64(vd.Num()), int64(vj.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdVjK encodes the instruction of format VdVjK with the given mnemonic.
+func EncodeVdVjK(mnemonic string, vd VReg, vj VReg, k GPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdVjK {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdVjK"}
+	}
+
+	result, err := encodeVdVjK(insn.bits, int64(vd.Num()), int64(vj.Num()), int64(k.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdVjSk5 encodes the instruction of format VdVjSk5 with the given mnemonic.
+func EncodeVdVjSk5(mnemonic string, vd VReg, vj VReg, sk5 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdVjSk5 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdVjSk5"}
+	}
+
+	result, err := encodeVdVjSk5(insn.bits, int64(vd.Num()), int64(vj.Num()), sk5)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdVjUk1 encodes the instruction of format VdVjUk1 with the given mnemonic.
+func EncodeVdVjUk1(mnemonic string, vd VReg, vj VReg, uk1 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdVjUk1 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdVjUk1"}
+	}
+
+	result, err := encodeVdVjUk1(insn.bits, int64(vd.Num()), int64(vj.Num()), uk1)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdVjUk2 encodes the instruction of format VdVjUk2 with the given mnemonic.
+func EncodeVdVjUk2(mnemonic string, vd VReg, vj VReg, uk2 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdVjUk2 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdVjUk2"}
+	}
+
+	result, err := encodeVdVjUk2(insn.bits, int64(vd.Num()), int64(vj.Num()), uk2)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdVjUk3 encodes the instruction of format VdVjUk3 with the given mnemonic.
+func EncodeVdVjUk3(mnemonic string, vd VReg, vj VReg, uk3 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdVjUk3 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdVjUk3"}
+	}
+
+	result, err := encodeVdVjUk3(insn.bits, int64(vd.Num()), int64(vj.Num()), uk3)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdVjUk4 encodes the instruction of format VdVjUk4 with the given mnemonic.
+func EncodeVdVjUk4(mnemonic string, vd VReg, vj VReg, uk4 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdVjUk4 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdVjUk4"}
+	}
+
+	result, err := encodeVdVjUk4(insn.bits, int64(vd.Num()), int64(vj.Num()), uk4)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdVjUk5 encodes the instruction of format VdVjUk5 with the given mnemonic.
+func EncodeVdVjUk5(mnemonic string, vd VReg, vj VReg, uk5 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdVjUk5 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdVjUk5"}
+	}
+
+	result, err := encodeVdVjUk5(insn.bits, int64(vd.Num()), int64(vj.Num()), uk5)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdVjUk6 encodes the instruction of format VdVjUk6 with the given mnemonic.
+func EncodeVdVjUk6(mnemonic string, vd VReg, vj VReg, uk6 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdVjUk6 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdVjUk6"}
+	}
+
+	result, err := encodeVdVjUk6(insn.bits, int64(vd.Num()), int64(vj.Num()), uk6)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdVjUk7 encodes the instruction of format VdVjUk7 with the given mnemonic.
+func EncodeVdVjUk7(mnemonic string, vd VReg, vj VReg, uk7 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdVjUk7 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdVjUk7"}
+	}
+
+	result, err := encodeVdVjUk7(insn.bits, int64(vd.Num()), int64(vj.Num()), uk7)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdVjUk8 encodes the instruction of format VdVjUk8 with the given mnemonic.
+func EncodeVdVjUk8(mnemonic string, vd VReg, vj VReg, uk8 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdVjUk8 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdVjUk8"}
+	}
+
+	result, err := encodeVdVjUk8(insn.bits, int64(vd.Num()), int64(vj.Num()), uk8)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdVjVk encodes the instruction of format VdVjVk with the given mnemonic.
+func EncodeVdVjVk(mnemonic string, vd VReg, vj VReg, vk VReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdVjVk {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdVjVk"}
+	}
+
+	result, err := encodeVdVjVk(insn.bits, int64(vd.Num()), int64(vj.Num()), int64(vk.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeVdVjVkVa encodes the instruction of format VdVjVkVa with the given mnemonic.
+func EncodeVdVjVkVa(mnemonic string, vd VReg, vj VReg, vk VReg, va VReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatVdVjVkVa {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "VdVjVkVa"}
+	}
+
+	result, err := encodeVdVjVkVa(insn.bits, int64(vd.Num()), int64(vj.Num()), int64(vk.Num()), int64(va.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdJ encodes the instruction of format XdJ with the given mnemonic.
+func EncodeXdJ(mnemonic string, xd XReg, j GPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdJ {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdJ"}
+	}
+
+	result, err := encodeXdJ(insn.bits, int64(xd.Num()), int64(j.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdJK encodes the instruction of format XdJK with the given mnemonic.
+func EncodeXdJK(mnemonic string, xd XReg, j GPReg, k GPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdJK {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdJK"}
+	}
+
+	result, err := encodeXdJK(insn.bits, int64(xd.Num()), int64(j.Num()), int64(k.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdJSk10 encodes the instruction of format XdJSk10 with the given mnemonic.
+func EncodeXdJSk10(mnemonic string, xd XReg, j GPReg, sk10 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdJSk10 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdJSk10"}
+	}
+
+	result, err := encodeXdJSk10(insn.bits, int64(xd.Num()), int64(j.Num()), sk10)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdJSk11 encodes the instruction of format XdJSk11 with the given mnemonic.
+func EncodeXdJSk11(mnemonic string, xd XReg, j GPReg, sk11 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdJSk11 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdJSk11"}
+	}
+
+	result, err := encodeXdJSk11(insn.bits, int64(xd.Num()), int64(j.Num()), sk11)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdJSk12 encodes the instruction of format XdJSk12 with the given mnemonic.
+func EncodeXdJSk12(mnemonic string, xd XReg, j GPReg, sk12 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdJSk12 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdJSk12"}
+	}
+
+	result, err := encodeXdJSk12(insn.bits, int64(xd.Num()), int64(j.Num()), sk12)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdJSk8Un2 encodes the instruction of format XdJSk8Un2 with the given mnemonic.
+func EncodeXdJSk8Un2(mnemonic string, xd XReg, j GPReg, sk8 int64, un2 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdJSk8Un2 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdJSk8Un2"}
+	}
+
+	result, err := encodeXdJSk8Un2(insn.bits, int64(xd.Num()), int64(j.Num()), sk8, un2)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdJSk8Un3 encodes the instruction of format XdJSk8Un3 with the given mnemonic.
+func EncodeXdJSk8Un3(mnemonic string, xd XReg, j GPReg, sk8 int64, un3 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdJSk8Un3 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdJSk8Un3"}
+	}
+
+	result, err := encodeXdJSk8Un3(insn.bits, int64(xd.Num()), int64(j.Num()), sk8, un3)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdJSk8Un4 encodes the instruction of format XdJSk8Un4 with the given mnemonic.
+func EncodeXdJSk8Un4(mnemonic string, xd XReg, j GPReg, sk8 int64, un4 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdJSk8Un4 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdJSk8Un4"}
+	}
+
+	result, err := encodeXdJSk8Un4(insn.bits, int64(xd.Num()), int64(j.Num()), sk8, un4)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdJSk8Un5 encodes the instruction of format XdJSk8Un5 with the given mnemonic.
+func EncodeXdJSk8Un5(mnemonic string, xd XReg, j GPReg, sk8 int64, un5 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdJSk8Un5 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdJSk8Un5"}
+	}
+
+	result, err := encodeXdJSk8Un5(insn.bits, int64(xd.Num()), int64(j.Num()), sk8, un5)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdJSk9 encodes the instruction of format XdJSk9 with the given mnemonic.
+func EncodeXdJSk9(mnemonic string, xd XReg, j GPReg, sk9 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdJSk9 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdJSk9"}
+	}
+
+	result, err := encodeXdJSk9(insn.bits, int64(xd.Num()), int64(j.Num()), sk9)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdJUk2 encodes the instruction of format XdJUk2 with the given mnemonic.
+func EncodeXdJUk2(mnemonic string, xd XReg, j GPReg, uk2 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdJUk2 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdJUk2"}
+	}
+
+	result, err := encodeXdJUk2(insn.bits, int64(xd.Num()), int64(j.Num()), uk2)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdJUk3 encodes the instruction of format XdJUk3 with the given mnemonic.
+func EncodeXdJUk3(mnemonic string, xd XReg, j GPReg, uk3 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdJUk3 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdJUk3"}
+	}
+
+	result, err := encodeXdJUk3(insn.bits, int64(xd.Num()), int64(j.Num()), uk3)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdSj13 encodes the instruction of format XdSj13 with the given mnemonic.
+func EncodeXdSj13(mnemonic string, xd XReg, sj13 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdSj13 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdSj13"}
+	}
+
+	result, err := encodeXdSj13(insn.bits, int64(xd.Num()), sj13)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdXj encodes the instruction of format XdXj with the given mnemonic.
+func EncodeXdXj(mnemonic string, xd XReg, xj XReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdXj {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdXj"}
+	}
+
+	result, err := encodeXdXj(insn.bits, int64(xd.Num()), int64(xj.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdXjK encodes the instruction of format XdXjK with the given mnemonic.
+func EncodeXdXjK(mnemonic string, xd XReg, xj XReg, k GPReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdXjK {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdXjK"}
+	}
+
+	result, err := encodeXdXjK(insn.bits, int64(xd.Num()), int64(xj.Num()), int64(k.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdXjSk5 encodes the instruction of format XdXjSk5 with the given mnemonic.
+func EncodeXdXjSk5(mnemonic string, xd XReg, xj XReg, sk5 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdXjSk5 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdXjSk5"}
+	}
+
+	result, err := encodeXdXjSk5(insn.bits, int64(xd.Num()), int64(xj.Num()), sk5)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdXjUk1 encodes the instruction of format XdXjUk1 with the given mnemonic.
+func EncodeXdXjUk1(mnemonic string, xd XReg, xj XReg, uk1 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdXjUk1 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdXjUk1"}
+	}
+
+	result, err := encodeXdXjUk1(insn.bits, int64(xd.Num()), int64(xj.Num()), uk1)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdXjUk2 encodes the instruction of format XdXjUk2 with the given mnemonic.
+func EncodeXdXjUk2(mnemonic string, xd XReg, xj XReg, uk2 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdXjUk2 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdXjUk2"}
+	}
+
+	result, err := encodeXdXjUk2(insn.bits, int64(xd.Num()), int64(xj.Num()), uk2)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdXjUk3 encodes the instruction of format XdXjUk3 with the given mnemonic.
+func EncodeXdXjUk3(mnemonic string, xd XReg, xj XReg, uk3 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdXjUk3 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdXjUk3"}
+	}
+
+	result, err := encodeXdXjUk3(insn.bits, int64(xd.Num()), int64(xj.Num()), uk3)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdXjUk4 encodes the instruction of format XdXjUk4 with the given mnemonic.
+func EncodeXdXjUk4(mnemonic string, xd XReg, xj XReg, uk4 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdXjUk4 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdXjUk4"}
+	}
+
+	result, err := encodeXdXjUk4(insn.bits, int64(xd.Num()), int64(xj.Num()), uk4)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdXjUk5 encodes the instruction of format XdXjUk5 with the given mnemonic.
+func EncodeXdXjUk5(mnemonic string, xd XReg, xj XReg, uk5 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdXjUk5 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdXjUk5"}
+	}
+
+	result, err := encodeXdXjUk5(insn.bits, int64(xd.Num()), int64(xj.Num()), uk5)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdXjUk6 encodes the instruction of format XdXjUk6 with the given mnemonic.
+func EncodeXdXjUk6(mnemonic string, xd XReg, xj XReg, uk6 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdXjUk6 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdXjUk6"}
+	}
+
+	result, err := encodeXdXjUk6(insn.bits, int64(xd.Num()), int64(xj.Num()), uk6)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdXjUk7 encodes the instruction of format XdXjUk7 with the given mnemonic.
+func EncodeXdXjUk7(mnemonic string, xd XReg, xj XReg, uk7 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdXjUk7 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdXjUk7"}
+	}
+
+	result, err := encodeXdXjUk7(insn.bits, int64(xd.Num()), int64(xj.Num()), uk7)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdXjUk8 encodes the instruction of format XdXjUk8 with the given mnemonic.
+func EncodeXdXjUk8(mnemonic string, xd XReg, xj XReg, uk8 int64) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdXjUk8 {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdXjUk8"}
+	}
+
+	result, err := encodeXdXjUk8(insn.bits, int64(xd.Num()), int64(xj.Num()), uk8)
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdXjXk encodes the instruction of format XdXjXk with the given mnemonic.
+func EncodeXdXjXk(mnemonic string, xd XReg, xj XReg, xk XReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdXjXk {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdXjXk"}
+	}
+
+	result, err := encodeXdXjXk(insn.bits, int64(xd.Num()), int64(xj.Num()), int64(xk.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
+
+// EncodeXdXjXkXa encodes the instruction of format XdXjXkXa with the given mnemonic.
+func EncodeXdXjXkXa(mnemonic string, xd XReg, xj XReg, xk XReg, xa XReg) (uint32, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+	if insn.fmt != insnFormatXdXjXkXa {
+		return 0, &FormatMismatchError{Mnemonic: mnemonic, Format: "XdXjXkXa"}
+	}
+
+	result, err := encodeXdXjXkXa(insn.bits, int64(xd.Num()), int64(xj.Num()), int64(xk.Num()), int64(xa.Num()))
+	if err != nil {
+		return 0, applyOperandRole(insn, err)
+	}
+	return result, nil
+}
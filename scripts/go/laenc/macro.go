@@ -0,0 +1,92 @@
+package laenc
+
+type macroOperandKind int
+
+const (
+	macroOperandReg macroOperandKind = iota
+	macroOperandImm
+	macroOperandSym
+)
+
+type macroOperand struct {
+	name   string
+	kind   macroOperandKind
+	signed bool
+	width  uint
+}
+
+// macroOverload is a description of a macro, accepting the operands in the
+// ranges given by operands.
+type macroOverload struct {
+	operands []macroOperand
+	expand   func(sym string, operands []int64) ([]uint32, []RelocRecord, error)
+}
+
+func (o *macroOverload) accepts(operands []int64) error {
+	for i, mo := range o.operands {
+		var err error
+		switch mo.kind {
+		case macroOperandReg:
+			err = wantIntReg(i, mo.name, operands[i])
+		case macroOperandImm:
+			if mo.signed {
+				err = wantSignedImm(i, mo.name, operands[i], mo.width)
+			} else {
+				err = wantUnsignedImm(i, mo.name, operands[i], mo.width)
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExpandMacro expands the assembler macro, or pseudo-op, with the given name
+// and operands into the insn words, like li.w or la.local. The operands of the
+// expanded insns are in the canonical order, as for Encode.
+//
+// The value of a symbol operand is the addend of the relocations referring to
+// sym. The relocation record relocs[i] applies to words[i], that is at byte
+// offset 4*i of the sequence, and is of type RelocNone if the insn needs no
+// relocation.
+func ExpandMacro(name string, sym string, operands ...int64) (words []uint32, relocs []RelocRecord, err error) {
+	overloads, ok := macros[name]
+	if !ok {
+		return nil, nil, &UnknownMnemonicError{Mnemonic: name}
+	}
+
+	if arity := len(overloads[0].operands); len(operands) != arity {
+		return nil, nil, &ArityError{Mnemonic: name, Want: arity, Got: len(operands)}
+	}
+
+	// the overloads are ordered from the narrowest immediate ranges, so the
+	// error of the last one is the most useful
+	for _, o := range overloads {
+		err = o.accepts(operands)
+		if err == nil {
+			return o.expand(sym, operands)
+		}
+	}
+	return nil, nil, err
+}
+
+// absHi20 returns bits 12 to 31 of v, sign-extended, like %abs_hi20.
+func absHi20(v int64) int64 {
+	return v << 32 >> 44
+}
+
+// absLo12 returns bits 0 to 11 of v, like %abs_lo12.
+func absLo12(v int64) int64 {
+	return v & 0xfff
+}
+
+// abs64Lo20 returns bits 32 to 51 of v, sign-extended, like %abs64_lo20.
+func abs64Lo20(v int64) int64 {
+	return v << 12 >> 44
+}
+
+// abs64Hi12 returns bits 52 to 63 of v, sign-extended, like %abs64_hi12.
+func abs64Hi12(v int64) int64 {
+	return v >> 52
+}
@@ -0,0 +1,139 @@
+package laenc
+
+import "fmt"
+
+// RelocType is a LoongArch ELF relocation type, with the same numbering as
+// the psABI.
+type RelocType uint32
+
+const (
+	// RelocNone is no relocation at all.
+	RelocNone        RelocType = 0
+	RelocB16         RelocType = 64
+	RelocB21         RelocType = 65
+	RelocB26         RelocType = 66
+	RelocAbsHi20     RelocType = 67
+	RelocAbsLo12     RelocType = 68
+	RelocAbs64Lo20   RelocType = 69
+	RelocAbs64Hi12   RelocType = 70
+	RelocPCALAHi20   RelocType = 71
+	RelocPCALALo12   RelocType = 72
+	RelocPCALA64Lo20 RelocType = 73
+	RelocPCALA64Hi12 RelocType = 74
+	RelocGOTPCHi20   RelocType = 75
+	RelocGOTPCLo12   RelocType = 76
+	RelocGOT64PCLo20 RelocType = 77
+	RelocGOT64PCHi12 RelocType = 78
+	RelocGOTHi20     RelocType = 79
+	RelocGOTLo12     RelocType = 80
+	RelocGOT64Lo20   RelocType = 81
+	RelocGOT64Hi12   RelocType = 82
+)
+
+type relocTypeInfo struct {
+	name string
+	// width is the number of bits of the operand the relocation fills in
+	width uint
+}
+
+var relocTypeInfos = map[RelocType]relocTypeInfo{
+	RelocNone:        {name: "R_LARCH_NONE", width: 0},
+	RelocB16:         {name: "R_LARCH_B16", width: 16},
+	RelocB21:         {name: "R_LARCH_B21", width: 21},
+	RelocB26:         {name: "R_LARCH_B26", width: 26},
+	RelocAbsHi20:     {name: "R_LARCH_ABS_HI20", width: 20},
+	RelocAbsLo12:     {name: "R_LARCH_ABS_LO12", width: 12},
+	RelocAbs64Lo20:   {name: "R_LARCH_ABS64_LO20", width: 20},
+	RelocAbs64Hi12:   {name: "R_LARCH_ABS64_HI12", width: 12},
+	RelocPCALAHi20:   {name: "R_LARCH_PCALA_HI20", width: 20},
+	RelocPCALALo12:   {name: "R_LARCH_PCALA_LO12", width: 12},
+	RelocPCALA64Lo20: {name: "R_LARCH_PCALA64_LO20", width: 20},
+	RelocPCALA64Hi12: {name: "R_LARCH_PCALA64_HI12", width: 12},
+	RelocGOTPCHi20:   {name: "R_LARCH_GOT_PC_HI20", width: 20},
+	RelocGOTPCLo12:   {name: "R_LARCH_GOT_PC_LO12", width: 12},
+	RelocGOT64PCLo20: {name: "R_LARCH_GOT64_PC_LO20", width: 20},
+	RelocGOT64PCHi12: {name: "R_LARCH_GOT64_PC_HI12", width: 12},
+	RelocGOTHi20:     {name: "R_LARCH_GOT_HI20", width: 20},
+	RelocGOTLo12:     {name: "R_LARCH_GOT_LO12", width: 12},
+	RelocGOT64Lo20:   {name: "R_LARCH_GOT64_LO20", width: 20},
+	RelocGOT64Hi12:   {name: "R_LARCH_GOT64_HI12", width: 12},
+}
+
+func (t RelocType) String() string {
+	if info, ok := relocTypeInfos[t]; ok {
+		return info.name
+	}
+	return fmt.Sprintf("RelocType(%d)", uint32(t))
+}
+
+// RelocRecord describes the relocation to apply to an instruction word
+// encoded by EncodeWithReloc.
+type RelocRecord struct {
+	Type RelocType
+	Sym  string
+	// Addend is the value given for the relocatable operand.
+	Addend int64
+	// Operand is the index of the relocatable operand in canonical order.
+	Operand int
+}
+
+// RelocError is returned when a relocation can't be applied to an
+// instruction.
+type RelocError struct {
+	Mnemonic string
+	Type     RelocType
+	Reason   string
+}
+
+func (e *RelocError) Error() string {
+	return fmt.Sprintf("%s: cannot apply %s: %s", e.Mnemonic, e.Type, e.Reason)
+}
+
+// EncodeWithReloc encodes the instruction like Encode, but with the
+// relocatable operand zeroed, to be filled in by the linker according to the
+// returned relocation record. The value given for the relocatable operand
+// becomes the addend.
+func EncodeWithReloc(mnemonic string, operands []int64, sym string, typ RelocType) (uint32, RelocRecord, error) {
+	insn, ok := insns[mnemonic]
+	if !ok {
+		return 0, RelocRecord{}, &UnknownMnemonicError{Mnemonic: mnemonic}
+	}
+
+	if arity := insnFormatArities[insn.fmt]; len(operands) != arity {
+		return 0, RelocRecord{}, &ArityError{Mnemonic: mnemonic, Want: arity, Got: len(operands)}
+	}
+
+	ro, ok := relocOperands[mnemonic]
+	if !ok {
+		return 0, RelocRecord{}, &RelocError{Mnemonic: mnemonic, Type: typ, Reason: "no relocatable operand"}
+	}
+
+	info, ok := relocTypeInfos[typ]
+	if !ok {
+		return 0, RelocRecord{}, &RelocError{Mnemonic: mnemonic, Type: typ, Reason: "unsupported relocation type"}
+	}
+
+	if ro.width != info.width {
+		return 0, RelocRecord{}, &RelocError{
+			Mnemonic: mnemonic,
+			Type:     typ,
+			Reason:   fmt.Sprintf("operand is %d bits wide, relocation fills in %d bits", ro.width, info.width),
+		}
+	}
+
+	zeroed := make([]int64, len(operands))
+	copy(zeroed, operands)
+	zeroed[ro.idx] = 0
+
+	word, err := Encode(mnemonic, zeroed...)
+	if err != nil {
+		return 0, RelocRecord{}, err
+	}
+
+	return word, RelocRecord{
+		Type:    typ,
+		Sym:     sym,
+		Addend:  operands[ro.idx],
+		Operand: ro.idx,
+	}, nil
+}
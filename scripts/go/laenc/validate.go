@@ -0,0 +1,40 @@
+package laenc
+
+import "errors"
+
+func wantInRange(idx int, name string, kind string, v int64, min int64, max int64) error {
+	if v < min || v > max {
+		return &OperandError{
+			Index: idx,
+			Name:  name,
+			Kind:  kind,
+			Value: v,
+			Min:   min,
+			Max:   max,
+		}
+	}
+	return nil
+}
+
+func wantSignedImm(idx int, name string, v int64, width uint) error {
+	max := int64(1)<<(width-1) - 1
+	return wantInRange(idx, name, "signed immediate", v, -max-1, max)
+}
+
+func wantUnsignedImm(idx int, name string, v int64, width uint) error {
+	return wantInRange(idx, name, "unsigned immediate", v, 0, int64(1)<<width-1)
+}
+
+// applyOperandRole describes the operand in err by the role given to it in
+// the description of insn, if any.
+func applyOperandRole(insn insn, err error) error {
+	var oe *OperandError
+	if insn.role == nil || !errors.As(err, &oe) {
+		return err
+	}
+
+	if oe.Index == insn.role.idx {
+		oe.Kind = insn.role.kind
+	}
+	return err
+}
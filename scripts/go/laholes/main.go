@@ -0,0 +1,42 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func main() {
+	prefixBits := flag.Uint("bits", 22, "number of high-order bits to consider when looking for holes")
+	limit := flag.Int("n", 20, "report at most this many holes; 0 means no limit")
+	flag.Parse()
+	inputs := flag.Args()
+
+	descs, err := common.ReadInsnDescs(inputs)
+	if err != nil {
+		panic(err)
+	}
+
+	holes := common.FindOpcodeHoles(descs, *prefixBits)
+	if *limit > 0 && len(holes) > *limit {
+		holes = holes[:*limit]
+	}
+
+	for _, h := range holes {
+		fmt.Printf("[0x%08x, 0x%08x]  %10d words  shared high bits: %s\n", h.Lo, h.Hi, h.Size(), formatSharedHighBits(h))
+	}
+
+	if len(holes) == 0 {
+		fmt.Fprintln(os.Stderr, "no holes found")
+	}
+}
+
+func formatSharedHighBits(r common.OpcodeRange) string {
+	n, value := r.SharedHighBits()
+	if n == 0 {
+		return "(none)"
+	}
+	return fmt.Sprintf("%0*b (%d bits)", n, value, n)
+}
@@ -0,0 +1,319 @@
+package main
+
+import (
+	"debug/elf"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func main() {
+	insnsGlob := flag.String("insns", "", "glob pattern of the instruction description files; if empty, use the descriptions built into the common package")
+	stats := flag.Bool("stats", false, "print histograms of decoded instructions instead of the disassembly")
+	regs := flag.Bool("regs", false, "print how often each register is read and written instead of the disassembly")
+	flag.Parse()
+
+	descs := common.Builtin()
+	if *insnsGlob != "" {
+		inputs, err := filepath.Glob(*insnsGlob)
+		if err != nil {
+			panic(err)
+		}
+
+		descs, err = common.ReadInsnDescs(inputs)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	dec := common.NewIndexedDecoder(descs)
+
+	var st insnStats
+	var ru regUsage
+	for _, path := range flag.Args() {
+		text, err := readText(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "fatal: %s: %v\n", path, err)
+			os.Exit(1)
+		}
+		if text.byteOrder != binary.LittleEndian {
+			fmt.Fprintf(os.Stderr, "warning: %s: big-endian ELF, but LoongArch is little-endian; decoding the words as big-endian\n", path)
+		}
+
+		for _, sect := range text.sections {
+			if !*stats && !*regs {
+				fmt.Printf("\n%s: section %s\n\n", path, sect.name)
+			}
+
+			for off := 0; off+4 <= len(sect.data); off += 4 {
+				pc, word := text.insnAt(sect, off)
+				x, ok := dec.Decode(word)
+
+				if *stats || *regs {
+					if *stats {
+						st.add(x, ok)
+					}
+					if *regs {
+						ru.add(x, ok)
+					}
+					continue
+				}
+
+				if ok {
+					fmt.Printf("%12x:\t%08x\t%s\n", pc, word, x.AsmString())
+				} else {
+					fmt.Printf("%12x:\t%08x\t.word 0x%08x\n", pc, word, word)
+				}
+			}
+		}
+	}
+
+	if *stats {
+		st.print()
+	}
+	if *regs {
+		ru.print()
+	}
+}
+
+type textSection struct {
+	name string
+	addr uint64
+	data []byte
+}
+
+// elfText holds the executable sections of an ELF file, along with the class
+// and byte order of the file needed for interpreting them.
+type elfText struct {
+	class     elf.Class
+	byteOrder binary.ByteOrder
+	sections  []textSection
+}
+
+// insnAt returns the address and the insn word at offset off of sect, in the
+// byte order of the ELF file. Addresses wrap around at 32 bits for ELFCLASS32.
+func (t *elfText) insnAt(sect textSection, off int) (uint64, uint32) {
+	pc := sect.addr + uint64(off)
+	if t.class == elf.ELFCLASS32 {
+		pc = uint64(uint32(pc))
+	}
+	return pc, t.byteOrder.Uint32(sect.data[off:])
+}
+
+func readText(path string) (*elfText, error) {
+	f, err := elf.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	if f.Machine != elf.EM_LOONGARCH {
+		return nil, fmt.Errorf("not a LoongArch ELF: machine is %s", f.Machine)
+	}
+
+	result := elfText{
+		class:     f.Class,
+		byteOrder: f.ByteOrder,
+	}
+	for _, s := range f.Sections {
+		if s.Type != elf.SHT_PROGBITS || s.Flags&elf.SHF_EXECINSTR == 0 {
+			continue
+		}
+
+		data, err := s.Data()
+		if err != nil {
+			return nil, err
+		}
+
+		result.sections = append(result.sections, textSection{
+			name: s.Name,
+			addr: s.Addr,
+			data: data,
+		})
+	}
+
+	return &result, nil
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+type insnStats struct {
+	total      int
+	unknown    int
+	illegal    int
+	byMnemonic map[string]int
+	byFormat   map[string]int
+}
+
+func (s *insnStats) add(x *common.DecodedInsn, ok bool) {
+	if s.byMnemonic == nil {
+		s.byMnemonic = make(map[string]int)
+		s.byFormat = make(map[string]int)
+	}
+
+	s.total++
+	if !ok {
+		s.unknown++
+		return
+	}
+
+	if x.Illegal {
+		s.illegal++
+		return
+	}
+
+	s.byMnemonic[x.Desc.Mnemonic]++
+	s.byFormat[x.Desc.Format.CanonicalRepr()]++
+}
+
+func (s *insnStats) print() {
+	fmt.Printf("%d instructions, %d bytes", s.total, s.total*4)
+	if s.unknown > 0 {
+		fmt.Printf(", %d undecodable words", s.unknown)
+	}
+	if s.illegal > 0 {
+		fmt.Printf(", %d words with reserved bits set", s.illegal)
+	}
+	fmt.Printf("\n")
+
+	fmt.Printf("\nBy format:\n\n")
+	s.printHistogram(s.byFormat)
+
+	fmt.Printf("\nBy mnemonic:\n\n")
+	s.printHistogram(s.byMnemonic)
+}
+
+const histogramBarWidth = 40
+
+func (s *insnStats) printHistogram(counts map[string]int) {
+	keys := make([]string, 0, len(counts))
+	keyWidth := 0
+	for k := range counts {
+		keys = append(keys, k)
+		if len(k) > keyWidth {
+			keyWidth = len(k)
+		}
+	}
+
+	// most frequent first, then alphabetically
+	sort.Slice(keys, func(i int, j int) bool {
+		if counts[keys[i]] != counts[keys[j]] {
+			return counts[keys[i]] > counts[keys[j]]
+		}
+		return keys[i] < keys[j]
+	})
+
+	maxCount := 0
+	if len(keys) > 0 {
+		maxCount = counts[keys[0]]
+	}
+
+	for _, k := range keys {
+		n := counts[k]
+		barLen := n * histogramBarWidth / maxCount
+		if barLen == 0 {
+			barLen = 1
+		}
+
+		fmt.Printf(
+			"  %-*s %8d %10d B %6.2f%% %s\n",
+			keyWidth,
+			k,
+			n,
+			n*4,
+			float64(n)*100/float64(s.total),
+			strings.Repeat("#", barLen),
+		)
+	}
+}
+
+////////////////////////////////////////////////////////////////////////////
+
+type reg struct {
+	kind common.ArgKind
+	num  int64
+}
+
+type regCounts struct {
+	reads  int
+	writes int
+}
+
+type regUsage struct {
+	total  int
+	counts map[reg]*regCounts
+}
+
+func (u *regUsage) countsFor(r reg) *regCounts {
+	if u.counts == nil {
+		u.counts = make(map[reg]*regCounts)
+	}
+
+	c, ok := u.counts[r]
+	if !ok {
+		c = &regCounts{}
+		u.counts[r] = c
+	}
+	return c
+}
+
+// add tallies the registers accessed by the insn, both as operands and
+// implicitly.
+func (u *regUsage) add(x *common.DecodedInsn, ok bool) {
+	u.total++
+	if !ok || x.Illegal {
+		return
+	}
+
+	for i, acc := range x.Desc.ArgAccesses() {
+		r := reg{kind: x.Desc.Format.Args[i].Kind, num: x.Args[i]}
+		if acc&common.ArgAccessRead != 0 {
+			u.countsFor(r).reads++
+		}
+		if acc&common.ArgAccessWrite != 0 {
+			u.countsFor(r).writes++
+		}
+	}
+
+	for _, n := range x.Desc.ImplicitReads() {
+		u.countsFor(reg{kind: common.ArgKindIntReg, num: int64(n)}).reads++
+	}
+	for _, n := range x.Desc.ImplicitWrites() {
+		u.countsFor(reg{kind: common.ArgKindIntReg, num: int64(n)}).writes++
+	}
+}
+
+func (u *regUsage) print() {
+	regs := make([]reg, 0, len(u.counts))
+	for r := range u.counts {
+		regs = append(regs, r)
+	}
+
+	// by register class, then by number
+	sort.Slice(regs, func(i int, j int) bool {
+		if regs[i].kind != regs[j].kind {
+			return regs[i].kind < regs[j].kind
+		}
+		return regs[i].num < regs[j].num
+	})
+
+	fmt.Printf("%d instructions\n\n", u.total)
+	fmt.Printf("  %-8s %10s %10s %8s %8s\n", "reg", "reads", "writes", "read%", "write%")
+	for _, r := range regs {
+		c := u.counts[r]
+		fmt.Printf(
+			"  %-8s %10d %10d %7.2f%% %7.2f%%\n",
+			common.RegName(r.kind, r.num),
+			c.reads,
+			c.writes,
+			float64(c.reads)*100/float64(u.total),
+			float64(c.writes)*100/float64(u.total),
+		)
+	}
+}
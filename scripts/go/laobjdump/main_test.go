@@ -0,0 +1,162 @@
+package main
+
+import (
+	"bytes"
+	"debug/elf"
+	"encoding/binary"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestRegUsage(t *testing.T) {
+	var descs []*common.InsnDescription
+	for _, l := range []string{
+		"00100000 add.w                  DJK",
+		"29c00000 st.d                   DJSk12          @writes=",
+		"54000000 bl                     Sd10k16         @implicit-write=r1",
+		"01008000 fadd.s                 FdFjFk",
+	} {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		descs = append(descs, d)
+	}
+	dec := common.NewDecoder(descs)
+
+	var u regUsage
+	for _, w := range []uint32{
+		0x00101483, // add.w $r3, $r4, $r5
+		0x29c00064, // st.d $r4, $r3, 0
+		0x54000400, // bl 1
+		0x01009883, // fadd.s $f3, $f4, $f6
+		0xffffffff,
+	} {
+		x, ok := dec.Decode(w)
+		u.add(x, ok)
+	}
+
+	assert.Equal(t, 5, u.total)
+	assert.Equal(t, map[reg]*regCounts{
+		{kind: common.ArgKindIntReg, num: 1}: {writes: 1},
+		{kind: common.ArgKindIntReg, num: 3}: {reads: 1, writes: 1},
+		{kind: common.ArgKindIntReg, num: 4}: {reads: 2},
+		{kind: common.ArgKindIntReg, num: 5}: {reads: 1},
+		{kind: common.ArgKindFPReg, num: 3}:  {writes: 1},
+		{kind: common.ArgKindFPReg, num: 4}:  {reads: 1},
+		{kind: common.ArgKindFPReg, num: 6}:  {reads: 1},
+	}, u.counts)
+}
+
+// writeTestELF writes a LoongArch ELF relocatable file of the given class and
+// byte order, with the words as its .text section at addr, and returns its
+// path.
+func writeTestELF(t *testing.T, class elf.Class, order binary.ByteOrder, addr uint64, words []uint32) string {
+	var text bytes.Buffer
+	for _, w := range words {
+		assert.NoError(t, binary.Write(&text, order, w))
+	}
+	shstrtab := []byte("\x00.text\x00.shstrtab\x00")
+
+	data := elf.ELFDATA2LSB
+	if order == binary.BigEndian {
+		data = elf.ELFDATA2MSB
+	}
+	ident := [elf.EI_NIDENT]byte{0x7f, 'E', 'L', 'F', byte(class), byte(data), byte(elf.EV_CURRENT)}
+
+	var hdr, shdrs interface{}
+	var ehsize, shentsize int
+	if class == elf.ELFCLASS32 {
+		ehsize, shentsize = 52, 40
+		textOff := uint32(ehsize)
+		strOff := textOff + uint32(text.Len())
+		hdr = &elf.Header32{
+			Ident:     ident,
+			Type:      uint16(elf.ET_REL),
+			Machine:   uint16(elf.EM_LOONGARCH),
+			Version:   uint32(elf.EV_CURRENT),
+			Shoff:     strOff + uint32(len(shstrtab)),
+			Ehsize:    uint16(ehsize),
+			Shentsize: uint16(shentsize),
+			Shnum:     3,
+			Shstrndx:  2,
+		}
+		shdrs = []elf.Section32{
+			{},
+			{Name: 1, Type: uint32(elf.SHT_PROGBITS), Flags: uint32(elf.SHF_ALLOC | elf.SHF_EXECINSTR), Addr: uint32(addr), Off: textOff, Size: uint32(text.Len()), Addralign: 4},
+			{Name: 7, Type: uint32(elf.SHT_STRTAB), Off: strOff, Size: uint32(len(shstrtab)), Addralign: 1},
+		}
+	} else {
+		ehsize, shentsize = 64, 64
+		textOff := uint64(ehsize)
+		strOff := textOff + uint64(text.Len())
+		hdr = &elf.Header64{
+			Ident:     ident,
+			Type:      uint16(elf.ET_REL),
+			Machine:   uint16(elf.EM_LOONGARCH),
+			Version:   uint32(elf.EV_CURRENT),
+			Shoff:     strOff + uint64(len(shstrtab)),
+			Ehsize:    uint16(ehsize),
+			Shentsize: uint16(shentsize),
+			Shnum:     3,
+			Shstrndx:  2,
+		}
+		shdrs = []elf.Section64{
+			{},
+			{Name: 1, Type: uint32(elf.SHT_PROGBITS), Flags: uint64(elf.SHF_ALLOC | elf.SHF_EXECINSTR), Addr: addr, Off: textOff, Size: uint64(text.Len()), Addralign: 4},
+			{Name: 7, Type: uint32(elf.SHT_STRTAB), Off: strOff, Size: uint64(len(shstrtab)), Addralign: 1},
+		}
+	}
+
+	var buf bytes.Buffer
+	assert.NoError(t, binary.Write(&buf, order, hdr))
+	buf.Write(text.Bytes())
+	buf.Write(shstrtab)
+	assert.NoError(t, binary.Write(&buf, order, shdrs))
+
+	path := filepath.Join(t.TempDir(), "test.o")
+	assert.NoError(t, os.WriteFile(path, buf.Bytes(), 0644))
+	return path
+}
+
+func TestReadText(t *testing.T) {
+	words := []uint32{
+		0x00101483, // add.w $r3, $r4, $r5
+		0x29c00064, // st.d $r4, $r3, 0
+	}
+
+	testcases := []struct {
+		class   elf.Class
+		order   binary.ByteOrder
+		addr    uint64
+		wantPCs []uint64
+	}{
+		{class: elf.ELFCLASS64, order: binary.LittleEndian, addr: 0x120000000, wantPCs: []uint64{0x120000000, 0x120000004}},
+		{class: elf.ELFCLASS64, order: binary.BigEndian, addr: 0x120000000, wantPCs: []uint64{0x120000000, 0x120000004}},
+		{class: elf.ELFCLASS32, order: binary.LittleEndian, addr: 0x1c000000, wantPCs: []uint64{0x1c000000, 0x1c000004}},
+		// addresses wrap around at 32 bits
+		{class: elf.ELFCLASS32, order: binary.BigEndian, addr: 0xfffffffc, wantPCs: []uint64{0xfffffffc, 0}},
+	}
+
+	for _, tc := range testcases {
+		text, err := readText(writeTestELF(t, tc.class, tc.order, tc.addr, words))
+		if !assert.NoError(t, err, "%s %s", tc.class, tc.order) {
+			continue
+		}
+
+		assert.Equal(t, tc.class, text.class)
+		assert.Equal(t, tc.order, text.byteOrder)
+		if assert.Len(t, text.sections, 1) {
+			sect := text.sections[0]
+			assert.Equal(t, ".text", sect.name)
+			for i, w := range words {
+				pc, word := text.insnAt(sect, i*4)
+				assert.Equal(t, tc.wantPCs[i], pc, "%s %s", tc.class, tc.order)
+				assert.Equal(t, w, word, "%s %s", tc.class, tc.order)
+			}
+		}
+	}
+}
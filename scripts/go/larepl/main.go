@@ -0,0 +1,115 @@
+// Command larepl is an interactive assembler and disassembler. Each line read
+// from stdin is one of:
+//
+//	add.w $r4, $r5, $r6    assembled, printing the insn word in hex
+//	0x00101944             disassembled, printing the insn
+//	add?                   the mnemonics starting with "add"
+//
+// Errors are printed and the loop goes on; it ends at EOF.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func main() {
+	flag.Parse()
+	inputs := flag.Args()
+
+	// the descriptions built into the common package if no files are given
+	descs := common.Builtin()
+	if len(inputs) > 0 {
+		var err error
+		descs, err = common.ReadInsnDescs(inputs)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	r := newRepl(descs)
+	if err := r.run(os.Stdin, os.Stdout); err != nil {
+		panic(err)
+	}
+}
+
+type repl struct {
+	asm  *common.Assembler
+	dec  *common.Decoder
+	trie *common.MnemonicTrie
+}
+
+func newRepl(descs []*common.InsnDescription) *repl {
+	return &repl{
+		asm:  common.NewAssembler(descs),
+		dec:  common.NewDecoder(descs),
+		trie: common.NewMnemonicTrie(descs),
+	}
+}
+
+func (r *repl) run(in io.Reader, out io.Writer) error {
+	sc := bufio.NewScanner(in)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" {
+			continue
+		}
+
+		result, err := r.eval(line)
+		if err != nil {
+			fmt.Fprintf(out, "error: %v\n", err)
+			continue
+		}
+		fmt.Fprintln(out, result)
+	}
+	return sc.Err()
+}
+
+func (r *repl) eval(line string) (string, error) {
+	if strings.HasPrefix(line, "0x") || strings.HasPrefix(line, "0X") {
+		return r.disassemble(line[2:])
+	}
+
+	if prefix := strings.TrimSuffix(line, "?"); prefix != line {
+		return r.complete(strings.TrimSpace(prefix))
+	}
+
+	word, err := r.asm.AssembleLine(line)
+	if err != nil {
+		// suggest the completions of a partially typed mnemonic
+		mnemonic, _, _ := strings.Cut(line, " ")
+		if candidates := r.trie.Complete(mnemonic); len(candidates) > 0 && candidates[0] != mnemonic {
+			return "", fmt.Errorf("%w; did you mean %s", err, strings.Join(candidates, ", "))
+		}
+		return "", err
+	}
+	return fmt.Sprintf("0x%08x", word), nil
+}
+
+func (r *repl) disassemble(hex string) (string, error) {
+	word, err := strconv.ParseUint(hex, 16, 32)
+	if err != nil {
+		return "", fmt.Errorf("invalid insn word %q", "0x"+hex)
+	}
+
+	x, ok := r.dec.Decode(uint32(word))
+	if !ok {
+		return "", fmt.Errorf("0x%08x does not encode any known insn", word)
+	}
+	return x.String(), nil
+}
+
+func (r *repl) complete(prefix string) (string, error) {
+	candidates := r.trie.Complete(prefix)
+	if len(candidates) == 0 {
+		return "", fmt.Errorf("no mnemonic starts with %q", prefix)
+	}
+	return strings.Join(candidates, " "), nil
+}
@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func TestRun(t *testing.T) {
+	var descs []*common.InsnDescription
+	for _, l := range []string{
+		"00100000 add.w                  DJK",
+		"00108000 add.d                  DJK",
+		"02800000 addi.w                 DJSk12",
+	} {
+		d, err := common.ParseInsnDescriptionLine(l)
+		assert.NoError(t, err)
+		descs = append(descs, d)
+	}
+
+	in := strings.Join([]string{
+		"add.w $r4, $r5, $r6",
+		"0x00101944",
+		"",
+		"addi.w $r4, $r5, 5000",
+		"0xffffffff",
+		"0xnothex",
+		"add $r4, $r5, $r6",
+		"add.?",
+		"sub?",
+		"addi.w $r4, $r5, -16",
+	}, "\n")
+
+	var out bytes.Buffer
+	assert.NoError(t, newRepl(descs).run(strings.NewReader(in), &out))
+
+	lines := strings.Split(strings.TrimSuffix(out.String(), "\n"), "\n")
+	if !assert.Len(t, lines, 9) {
+		return
+	}
+	assert.Equal(t, "0x001018a4", lines[0])
+	assert.Equal(t, "add.w $r4, $r10, $r6", lines[1])
+	assert.Contains(t, lines[2], "error: ")
+	assert.Equal(t, "error: 0xffffffff does not encode any known insn", lines[3])
+	assert.Equal(t, `error: invalid insn word "0xnothex"`, lines[4])
+	assert.Equal(t, `error: unknown mnemonic "add"; did you mean add.d, add.w, addi.w`, lines[5])
+	assert.Equal(t, "add.d add.w", lines[6])
+	assert.Equal(t, `error: no mnemonic starts with "sub"`, lines[7])
+	assert.Equal(t, "0x02bfc0a4", lines[8])
+}
+
+func TestRoundTripCorpus(t *testing.T) {
+	r := newRepl(common.Builtin())
+	for _, line := range []string{
+		"add.w $r4, $r5, $r6",
+		"ld.d $r1, $r3, -8",
+		"fadd.d $f0, $f1, $f2",
+		"beq $r4, $r5, 16",
+	} {
+		hex, err := r.eval(line)
+		assert.NoError(t, err)
+
+		asm, err := r.eval(hex)
+		assert.NoError(t, err)
+		assert.Equal(t, line, asm)
+	}
+}
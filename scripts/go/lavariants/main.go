@@ -0,0 +1,68 @@
+// Command lavariants reports the pairs of insns differing only in the operand
+// width, like add.w/add.d or ld.bu/ld.hu, and the bits in which their
+// encodings differ, flagging the pairs differing in more bits than expected
+// for review.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func main() {
+	anomaliesOnly := flag.Bool("anomalies", false, "report only the pairs flagged as anomalous")
+	flag.Parse()
+	inputs := flag.Args()
+
+	// the descriptions built into the common package if no files are given
+	descs := common.Builtin()
+	if len(inputs) > 0 {
+		var err error
+		descs, err = common.ReadInsnDescs(inputs)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	numAnomalies := 0
+	for _, p := range common.FindVariantPairs(descs) {
+		if p.IsAnomalous() {
+			numAnomalies++
+		} else if *anomaliesOnly {
+			continue
+		}
+		fmt.Println(formatVariantPair(p))
+	}
+
+	if numAnomalies > 0 {
+		fmt.Fprintf(os.Stderr, "%d anomalous pairs found\n", numAnomalies)
+	}
+}
+
+func formatVariantPair(p *common.VariantPair) string {
+	diffBits := p.DiffBits()
+	positions := make([]string, len(diffBits))
+	for i, b := range diffBits {
+		positions[i] = fmt.Sprint(b)
+	}
+
+	var sb strings.Builder
+	fmt.Fprintf(
+		&sb,
+		"%-20s %-20s %08x ^ %08x = %08x  bits: %s",
+		p.Narrow.Mnemonic,
+		p.Wide.Mnemonic,
+		p.Narrow.Word,
+		p.Wide.Word,
+		p.Narrow.Word^p.Wide.Word,
+		strings.Join(positions, ","),
+	)
+	if p.IsAnomalous() {
+		sb.WriteString("  ANOMALY")
+	}
+	return sb.String()
+}
@@ -0,0 +1,43 @@
+// Command lintinsn checks the instruction description files for encoding
+// mistakes made while editing them by hand:
+//
+//	$ lintinsn ../../*.txt
+//
+// An insn word with bits set inside the slots of its operands is rejected
+// when reading the files, with the line at fault. Then every pair of insns
+// some word decodes to both of is reported, with a word matching both. The
+// exit status is non-zero if anything is found.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/loongson-community/loongarch-opcodes/scripts/go/common"
+)
+
+func main() {
+	flag.Parse()
+	os.Exit(run(flag.Args(), os.Stderr))
+}
+
+// run checks the description files at paths, reporting to w, and returns the
+// exit status.
+func run(paths []string, w io.Writer) int {
+	descs, err := common.ReadInsnDescs(paths)
+	if err != nil {
+		fmt.Fprintf(w, "fatal: %v\n", err)
+		return 1
+	}
+
+	collisions := common.FindEncodingCollisions(descs)
+	for _, c := range collisions {
+		fmt.Fprintf(w, "collision: %s\n", c)
+	}
+	if len(collisions) > 0 {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRun(t *testing.T) {
+	paths, err := filepath.Glob("../../../*.txt")
+	assert.NoError(t, err)
+	var sb strings.Builder
+	assert.Equal(t, 0, run(paths, &sb))
+	assert.Empty(t, sb.String())
+
+	write := func(name string, content string) string {
+		path := filepath.Join(t.TempDir(), name)
+		assert.NoError(t, os.WriteFile(path, []byte(content), 0644))
+		return path
+	}
+
+	// foo is inside the rk of add.w, and bar duplicates add.d
+	path := write("collide.txt", `00100000 add.w                  DJK
+00108000 add.d                  DJK
+00100400 foo                    DJ
+00108000 bar                    DJK
+`)
+	sb.Reset()
+	assert.Equal(t, 1, run([]string{path}, &sb))
+	assert.Equal(t, `collision: add.w and foo both match 0x00100400
+collision: add.d and bar both match 0x00108000
+`, sb.String())
+
+	// a bit set in the second slot of sd5k16
+	path = write("slot.txt", `00100000 add.w                  DJK
+40000100 beqz                   JSd5k16
+`)
+	sb.Reset()
+	assert.Equal(t, 1, run([]string{path}, &sb))
+	assert.Equal(t, "fatal: "+path+":2: insn word has non-zero bit inside arg slots: 40000100 (JSd5k16)\n", sb.String())
+}